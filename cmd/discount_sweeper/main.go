@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/sweep_discounts"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Configuration for the discount sweeper
+type Config struct {
+	SpannerDB string
+	BatchSize int
+	Interval  time.Duration
+	Once      bool
+
+	// Price rounding policy of the effective prices in events, see domain.ParseRoundingPolicy
+	Rounding           string
+	RoundingByCategory string
+	RoundingByCurrency string
+}
+
+func main() {
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.IntVar(&config.BatchSize, "batch-size", sweep_discounts.DefaultBatchSize, "Maximum products swept per transaction batch")
+	flag.DurationVar(&config.Interval, "interval", time.Minute, "Wait between sweeps")
+	flag.BoolVar(&config.Once, "once", false, "Sweep until nothing is due, then exit (for cron jobs)")
+	flag.StringVar(&config.Rounding, "rounding", os.Getenv("PRICE_ROUNDING"), "Default price rounding mode (half_up, half_even, floor or charm)")
+	flag.StringVar(&config.RoundingByCategory, "rounding-by-category", os.Getenv("PRICE_ROUNDING_BY_CATEGORY"), "Rounding modes by category, e.g. grocery=charm,books=floor")
+	flag.StringVar(&config.RoundingByCurrency, "rounding-by-currency", os.Getenv("PRICE_ROUNDING_BY_CURRENCY"), "Rounding modes by currency, e.g. JPY=floor")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+
+	rounding, err := domain.ParseRoundingPolicy(config.Rounding, config.RoundingByCategory, config.RoundingByCurrency)
+	if err != nil {
+		log.Fatalf("Error: invalid price rounding configuration: %v", err)
+	}
+
+	if err := run(config, domain.NewPricingCalculatorWithRounding(rounding)); err != nil {
+		log.Fatalf("Discount sweeper failed: %v", err)
+	}
+
+	log.Println("Discount sweeper stopped")
+}
+
+func run(config Config, pricing *domain.PricingCalculator) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	clk := clock.NewRealClock()
+	sweeper := sweep_discounts.NewInteractor(
+		repo.NewProductRepo(client, clk),
+		repo.NewDiscountRepo(),
+		repo.NewDiscountHistoryRepo(),
+		repo.NewOutboxRepo(client),
+		committer.NewCommitter(client),
+		clk,
+		pricing,
+	)
+
+	log.Printf("Starting discount sweeper...")
+	log.Printf("  Batch size: %d, interval: %s, once: %v", config.BatchSize, config.Interval, config.Once)
+
+	// Stop on SIGINT/SIGTERM; each product is committed atomically, so nothing is half-swept
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		log.Println("Shutting down gracefully...")
+		cancel()
+	}()
+
+	for {
+		if err := sweepAll(ctx, sweeper, config.BatchSize); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if config.Once {
+				return err
+			}
+			log.Printf("Discount sweep failed: %v", err)
+		}
+		if config.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(config.Interval):
+		}
+	}
+}
+
+// sweepAll sweeps batch after batch until a batch comes back short or fails.
+// Failed products stay due, so stopping on failure avoids sweeping them in a loop.
+func sweepAll(ctx context.Context, sweeper *sweep_discounts.Interactor, batchSize int) error {
+	for {
+		resp, err := sweeper.Execute(ctx, &sweep_discounts.Request{BatchSize: batchSize})
+		if resp != nil && resp.ProductCount > 0 {
+			log.Printf("Swept %d products: %d discounts started, %d expired, %d products failed",
+				resp.ProductCount, resp.StartedCount, resp.ExpiredCount, resp.FailedCount)
+		}
+		if err != nil {
+			return err
+		}
+		if resp.ProductCount < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/queries/list_failed_events"
+	"github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_event"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_events_by_filter"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+const usage = `Usage: outbox_admin <command> -database=DB [flags]
+
+Commands:
+  list-failed    List failed outbox events and their attempt history
+  retry          Requeue a single failed event: retry -database=DB EVENT_ID
+  retry-filter   Requeue all failed events matching the filters
+
+Run "outbox_admin <command> -h" for command flags.
+`
+
+// Configuration for the outbox admin commands
+type Config struct {
+	SpannerDB    string
+	EventType    string
+	AggregateID  string
+	FailedAfter  string
+	FailedBefore string
+	Limit        int
+	DryRun       bool
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+
+	// Parse command-line flags
+	config := Config{}
+	fs.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	switch command {
+	case "list-failed", "retry-filter":
+		fs.StringVar(&config.EventType, "event-type", "", "Only events of this type")
+		fs.StringVar(&config.AggregateID, "aggregate-id", "", "Only events of this aggregate")
+		fs.StringVar(&config.FailedAfter, "failed-after", "", "Only events that failed at or after this RFC3339 time")
+		fs.StringVar(&config.FailedBefore, "failed-before", "", "Only events that failed before this RFC3339 time")
+	case "retry":
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
+	if command == "list-failed" {
+		fs.IntVar(&config.Limit, "limit", 50, "Maximum events to list (max 500)")
+	}
+	if command == "retry-filter" {
+		fs.BoolVar(&config.DryRun, "dry-run", false, "Show how many events would be requeued without requeueing them")
+	}
+	_ = fs.Parse(os.Args[2:])
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+
+	ctx := context.Background()
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer client.Close()
+
+	deadLetterRepo := repo.NewDeadLetterRepo(client)
+
+	switch command {
+	case "list-failed":
+		err = listFailed(ctx, deadLetterRepo, config)
+	case "retry":
+		if fs.NArg() != 1 {
+			log.Fatal("Error: retry takes exactly one event ID")
+		}
+		err = retryEvent(ctx, deadLetterRepo, fs.Arg(0))
+	case "retry-filter":
+		err = retryFilter(ctx, deadLetterRepo, config)
+	}
+	if err != nil {
+		client.Close()
+		log.Fatalf("%s failed: %v", command, err)
+	}
+}
+
+func listFailed(ctx context.Context, deadLetterRepo contracts.DeadLetterRepository, config Config) error {
+	failedAfter, failedBefore, err := parseTimeRange(config)
+	if err != nil {
+		return err
+	}
+
+	events, err := list_failed_events.NewQuery(deadLetterRepo).Execute(ctx, &list_failed_events.Request{
+		EventType:    config.EventType,
+		AggregateID:  config.AggregateID,
+		FailedAfter:  failedAfter,
+		FailedBefore: failedBefore,
+		Limit:        config.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dto := range events {
+		event := dto.Event
+		fmt.Printf("%s  %s  aggregate=%s  failed_at=%s  retries=%d\n",
+			event.EventID, event.EventType, event.AggregateID,
+			event.ProcessedAt.Time.Format(time.RFC3339), event.RetryCount)
+		fmt.Printf("  error: %s\n", event.ErrorMessage.StringVal)
+		for _, attempt := range dto.Attempts {
+			line := fmt.Sprintf("  %s  %-15s  retry=%d", attempt.AttemptedAt.Format(time.RFC3339), attempt.Outcome, attempt.RetryCount)
+			if attempt.ErrorMessage != "" {
+				line += "  " + attempt.ErrorMessage
+			}
+			fmt.Println(line)
+		}
+	}
+
+	log.Printf("Listed %d failed events", len(events))
+	return nil
+}
+
+func retryEvent(ctx context.Context, deadLetterRepo contracts.DeadLetterRepository, eventID string) error {
+	if err := retry_event.NewInteractor(deadLetterRepo).Execute(ctx, &retry_event.Request{EventID: eventID}); err != nil {
+		return err
+	}
+
+	log.Printf("Requeued event %s", eventID)
+	return nil
+}
+
+func retryFilter(ctx context.Context, deadLetterRepo contracts.DeadLetterRepository, config Config) error {
+	failedAfter, failedBefore, err := parseTimeRange(config)
+	if err != nil {
+		return err
+	}
+
+	interactor := retry_events_by_filter.NewInteractor(deadLetterRepo, clock.NewRealClock())
+	resp, err := interactor.Execute(ctx, &retry_events_by_filter.Request{
+		EventType:    config.EventType,
+		AggregateID:  config.AggregateID,
+		FailedAfter:  failedAfter,
+		FailedBefore: failedBefore,
+		DryRun:       config.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if config.DryRun {
+		log.Printf("DRY RUN: Would requeue %d failed events", resp.MatchedCount)
+		log.Println("Run without --dry-run to actually requeue events")
+		return nil
+	}
+
+	log.Printf("Requeued %d of %d matching failed events", resp.RetriedCount, resp.MatchedCount)
+	return nil
+}
+
+// parseTimeRange parses the optional RFC3339 failure time bounds.
+func parseTimeRange(config Config) (*time.Time, *time.Time, error) {
+	parse := func(name, value string) (*time.Time, error) {
+		if strings.TrimSpace(value) == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -%s: %w", name, err)
+		}
+		return &t, nil
+	}
+
+	failedAfter, err := parse("failed-after", config.FailedAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	failedBefore, err := parse("failed-before", config.FailedBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	return failedAfter, failedBefore, nil
+}
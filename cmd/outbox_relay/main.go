@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/publisher"
+	"github.com/light-bringer/procat-service/internal/app/outbox/relay"
+	"github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/webhook/fanout"
+	webhookrepo "github.com/light-bringer/procat-service/internal/app/webhook/repo"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// Configuration for the outbox relay
+type Config struct {
+	SpannerDB    string
+	Publisher    string
+	Backend      publisher.BackendConfig
+	CESource     string
+	HTTPMode     string
+	KafkaBrokers string
+	KafkaAcks    int
+	Relay        relay.Config
+}
+
+func main() {
+	defaults := relay.DefaultConfig()
+
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.StringVar(&config.Publisher, "publisher", "log", "Comma-separated publisher backends (log, http, kafka, nats, file, webhook)")
+	flag.StringVar(&config.CESource, "ce-source", cloudevents.DefaultSource, "CloudEvents source attribute")
+	flag.StringVar(&config.Backend.HTTP.URL, "http-url", "", "Endpoint for the http publisher")
+	flag.StringVar(&config.HTTPMode, "ce-mode", string(cloudevents.ModeStructured), "CloudEvents HTTP content mode (structured, binary)")
+	flag.DurationVar(&config.Backend.HTTP.Timeout, "http-timeout", 10*time.Second, "Request timeout for the http publisher")
+	flag.StringVar(&config.KafkaBrokers, "kafka-brokers", "", "Comma-separated bootstrap brokers (host:port) for the kafka publisher")
+	flag.StringVar(&config.Backend.Kafka.Topic, "kafka-topic", "", "Topic for the kafka publisher")
+	flag.IntVar(&config.KafkaAcks, "kafka-acks", int(publisher.KafkaAcksAll), "Required acks for the kafka publisher: -1 (all in-sync replicas) or 1 (leader)")
+	flag.StringVar(&config.Backend.NATS.URL, "nats-url", "", "Server URL for the nats publisher (nats://[user:password@]host:port)")
+	flag.StringVar(&config.Backend.NATS.Subject, "nats-subject", "procat.events", "Subject prefix for the nats publisher; the aggregate ID is appended")
+	flag.BoolVar(&config.Backend.NATS.JetStream, "nats-jetstream", false, "Wait for JetStream acks on the nats publisher")
+	flag.StringVar(&config.Backend.File.Path, "file-path", publisher.StdoutPath, "JSON Lines file for the file publisher (- for stdout)")
+	flag.IntVar(&config.Relay.BatchSize, "batch-size", defaults.BatchSize, "Maximum events claimed per poll")
+	flag.DurationVar(&config.Relay.PollInterval, "poll-interval", defaults.PollInterval, "Wait between polls when the outbox is empty")
+	flag.DurationVar(&config.Relay.LeaseDuration, "lease", defaults.LeaseDuration, "How long a claimed event is reserved before another relay may reclaim it")
+	flag.Int64Var(&config.Relay.Retry.MaxRetries, "max-retries", defaults.Retry.MaxRetries, "Publish attempts before an event is marked failed")
+	flag.DurationVar(&config.Relay.Retry.InitialBackoff, "initial-backoff", defaults.Retry.InitialBackoff, "Delay after the first failed attempt")
+	flag.DurationVar(&config.Relay.Retry.MaxBackoff, "max-backoff", defaults.Retry.MaxBackoff, "Maximum delay between attempts")
+	flag.DurationVar(&config.Relay.BlockedCheckInterval, "blocked-check-interval", defaults.BlockedCheckInterval, "How often to log aggregates blocked behind a failed event (0 disables)")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+
+	if err := run(config); err != nil {
+		log.Fatalf("Outbox relay failed: %v", err)
+	}
+
+	log.Println("Outbox relay stopped")
+}
+
+func run(config Config) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	pub, err := newPublisher(config, client)
+	if err != nil {
+		return err
+	}
+	defer pub.Close()
+
+	log.Printf("Starting outbox relay...")
+	log.Printf("  Publisher: %s", config.Publisher)
+	log.Printf("  Batch size: %d, poll interval: %s, lease: %s", config.Relay.BatchSize, config.Relay.PollInterval, config.Relay.LeaseDuration)
+	log.Printf("  Max retries: %d, backoff: %s..%s", config.Relay.Retry.MaxRetries, config.Relay.Retry.InitialBackoff, config.Relay.Retry.MaxBackoff)
+
+	// Stop on SIGINT/SIGTERM; in-flight events either complete or are reclaimed after their lease
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		log.Println("Shutting down gracefully...")
+		cancel()
+	}()
+
+	r := relay.NewRelay(repo.NewRelayRepo(client), pub, clock.NewRealClock(), config.Relay)
+	return r.Run(ctx)
+}
+
+func newPublisher(config Config, client *spanner.Client) (contracts.Publisher, error) {
+	names := strings.Split(config.Publisher, ",")
+	if len(names) == 1 {
+		return newBackend(strings.TrimSpace(names[0]), config, client)
+	}
+
+	publishers := make([]contracts.Publisher, 0, len(names))
+	for _, name := range names {
+		pub, err := newBackend(strings.TrimSpace(name), config, client)
+		if err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, pub)
+	}
+	return publisher.NewMultiPublisher(publishers...), nil
+}
+
+func newBackend(name string, config Config, client *spanner.Client) (contracts.Publisher, error) {
+	switch name {
+	case "webhook":
+		// Enqueues deliveries; cmd/webhook_dispatcher sends them
+		return fanout.NewPublisher(webhookrepo.NewSubscriptionRepo(client), webhookrepo.NewDeliveryRepo(client), clock.NewRealClock()), nil
+	case "http":
+		if config.Backend.HTTP.URL == "" {
+			return nil, fmt.Errorf("-http-url is required for the http publisher")
+		}
+	case "kafka":
+		if config.KafkaBrokers == "" || config.Backend.Kafka.Topic == "" {
+			return nil, fmt.Errorf("-kafka-brokers and -kafka-topic are required for the kafka publisher")
+		}
+	case "nats":
+		if config.Backend.NATS.URL == "" {
+			return nil, fmt.Errorf("-nats-url is required for the nats publisher")
+		}
+	}
+
+	mode, err := cloudevents.ParseMode(config.HTTPMode)
+	if err != nil {
+		return nil, err
+	}
+	backend := config.Backend
+	backend.HTTP.Mode = mode
+	backend.Kafka.Brokers = splitList(config.KafkaBrokers)
+	backend.Kafka.Acks = int16(config.KafkaAcks)
+	backend.SetSource(config.CESource)
+	return publisher.NewBackend(name, backend)
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
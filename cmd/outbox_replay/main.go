@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/publisher"
+	"github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/replay_events"
+)
+
+// Configuration for the outbox replay job
+type Config struct {
+	SpannerDB     string
+	Publisher     string
+	Backend       publisher.BackendConfig
+	CESource      string
+	HTTPMode      string
+	KafkaBrokers  string
+	KafkaAcks     int
+	EventType     string
+	AggregateID   string
+	CreatedAfter  string
+	CreatedBefore string
+	DryRun        bool
+}
+
+func main() {
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.StringVar(&config.Publisher, "publisher", "log", "Publisher backend receiving the replayed copies (log, http, kafka, nats, file)")
+	flag.StringVar(&config.CESource, "ce-source", cloudevents.DefaultSource, "CloudEvents source attribute")
+	flag.StringVar(&config.Backend.HTTP.URL, "http-url", "", "Endpoint for the http publisher")
+	flag.StringVar(&config.HTTPMode, "ce-mode", string(cloudevents.ModeStructured), "CloudEvents HTTP content mode (structured, binary)")
+	flag.DurationVar(&config.Backend.HTTP.Timeout, "http-timeout", 10*time.Second, "Request timeout for the http publisher")
+	flag.StringVar(&config.KafkaBrokers, "kafka-brokers", "", "Comma-separated bootstrap brokers (host:port) for the kafka publisher")
+	flag.StringVar(&config.Backend.Kafka.Topic, "kafka-topic", "", "Topic for the kafka publisher")
+	flag.IntVar(&config.KafkaAcks, "kafka-acks", int(publisher.KafkaAcksAll), "Required acks for the kafka publisher: -1 (all in-sync replicas) or 1 (leader)")
+	flag.StringVar(&config.Backend.NATS.URL, "nats-url", "", "Server URL for the nats publisher (nats://[user:password@]host:port)")
+	flag.StringVar(&config.Backend.NATS.Subject, "nats-subject", "procat.events", "Subject prefix for the nats publisher; the aggregate ID is appended")
+	flag.BoolVar(&config.Backend.NATS.JetStream, "nats-jetstream", false, "Wait for JetStream acks on the nats publisher")
+	flag.StringVar(&config.Backend.File.Path, "file-path", publisher.StdoutPath, "JSON Lines file for the file publisher (- for stdout)")
+	flag.StringVar(&config.EventType, "event-type", "", "Only replay events of this type")
+	flag.StringVar(&config.AggregateID, "aggregate-id", "", "Only replay events of this aggregate")
+	flag.StringVar(&config.CreatedAfter, "created-after", "", "Only replay events created at or after this RFC3339 time")
+	flag.StringVar(&config.CreatedBefore, "created-before", "", "Only replay events created before this RFC3339 time")
+	flag.BoolVar(&config.DryRun, "dry-run", false, "Show how many events would be replayed without publishing them")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+
+	if err := run(config); err != nil {
+		log.Fatalf("Replay failed: %v", err)
+	}
+
+	log.Println("Replay completed successfully")
+}
+
+func run(config Config) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createdAfter, err := parseTime("created-after", config.CreatedAfter)
+	if err != nil {
+		return err
+	}
+	createdBefore, err := parseTime("created-before", config.CreatedBefore)
+	if err != nil {
+		return err
+	}
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	pub, err := newPublisher(config)
+	if err != nil {
+		return err
+	}
+	defer pub.Close()
+
+	log.Printf("Starting outbox replay...")
+	log.Printf("  Publisher: %s", config.Publisher)
+	log.Printf("  Event type: %q, aggregate: %q", config.EventType, config.AggregateID)
+	log.Printf("  Created: [%s, %s)", orOpen(config.CreatedAfter), orOpen(config.CreatedBefore))
+	log.Printf("  Dry run: %v", config.DryRun)
+
+	// Stop on SIGINT/SIGTERM; copies already published stay published
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		log.Println("Stopping replay...")
+		cancel()
+	}()
+
+	interactor := replay_events.NewInteractor(repo.NewReplayRepo(client), map[string]contracts.Publisher{
+		config.Publisher: pub,
+	})
+	resp, err := interactor.Execute(ctx, &replay_events.Request{
+		EventType:     config.EventType,
+		AggregateID:   config.AggregateID,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Publisher:     config.Publisher,
+		DryRun:        config.DryRun,
+	})
+	if err != nil {
+		if resp != nil {
+			log.Printf("Replayed %d of %d events before stopping", resp.ReplayedCount, resp.MatchedCount)
+		}
+		return err
+	}
+
+	if config.DryRun {
+		log.Printf("DRY RUN: Would replay %d events", resp.MatchedCount)
+		log.Println("Run without --dry-run to actually replay events")
+		return nil
+	}
+
+	log.Printf("Replayed %d events", resp.ReplayedCount)
+	return nil
+}
+
+// newPublisher creates the replay target. The webhook fan-out cannot be used: its
+// deliveries are read back from outbox_events, where replayed copies do not exist.
+func newPublisher(config Config) (contracts.Publisher, error) {
+	switch config.Publisher {
+	case "http":
+		if config.Backend.HTTP.URL == "" {
+			return nil, fmt.Errorf("-http-url is required for the http publisher")
+		}
+	case "kafka":
+		if config.KafkaBrokers == "" || config.Backend.Kafka.Topic == "" {
+			return nil, fmt.Errorf("-kafka-brokers and -kafka-topic are required for the kafka publisher")
+		}
+	case "nats":
+		if config.Backend.NATS.URL == "" {
+			return nil, fmt.Errorf("-nats-url is required for the nats publisher")
+		}
+	}
+
+	mode, err := cloudevents.ParseMode(config.HTTPMode)
+	if err != nil {
+		return nil, err
+	}
+	backend := config.Backend
+	backend.HTTP.Mode = mode
+	backend.Kafka.Brokers = splitList(config.KafkaBrokers)
+	backend.Kafka.Acks = int16(config.KafkaAcks)
+	backend.SetSource(config.CESource)
+	return publisher.NewBackend(config.Publisher, backend)
+}
+
+func parseTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -%s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func orOpen(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_price_changes"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Configuration for the price change worker
+type Config struct {
+	SpannerDB string
+	BatchSize int
+	Interval  time.Duration
+	Once      bool
+
+	// Price rounding policy of the effective prices in events, see domain.ParseRoundingPolicy
+	Rounding           string
+	RoundingByCategory string
+	RoundingByCurrency string
+
+	// Price guardrails that hold due changes for approval, see domain.ParsePriceGuardrails
+	MaxPriceChange string
+	MinMargin      string
+	PriceBounds    string
+}
+
+func main() {
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.IntVar(&config.BatchSize, "batch-size", apply_price_changes.DefaultBatchSize, "Maximum products processed per batch")
+	flag.DurationVar(&config.Interval, "interval", time.Minute, "Wait between runs")
+	flag.BoolVar(&config.Once, "once", false, "Apply until nothing is due, then exit (for cron jobs)")
+	flag.StringVar(&config.Rounding, "rounding", os.Getenv("PRICE_ROUNDING"), "Default price rounding mode (half_up, half_even, floor or charm)")
+	flag.StringVar(&config.RoundingByCategory, "rounding-by-category", os.Getenv("PRICE_ROUNDING_BY_CATEGORY"), "Rounding modes by category, e.g. grocery=charm,books=floor")
+	flag.StringVar(&config.RoundingByCurrency, "rounding-by-currency", os.Getenv("PRICE_ROUNDING_BY_CURRENCY"), "Rounding modes by currency, e.g. JPY=floor")
+	flag.StringVar(&config.MaxPriceChange, "max-price-change", os.Getenv("PRICE_GUARDRAIL_MAX_CHANGE_PERCENT"), "Largest price change in percent applied without approval")
+	flag.StringVar(&config.MinMargin, "min-margin", os.Getenv("PRICE_GUARDRAIL_MIN_MARGIN_PERCENT"), "Smallest margin over the cost price in percent applied without approval")
+	flag.StringVar(&config.PriceBounds, "price-bounds", os.Getenv("PRICE_GUARDRAIL_BOUNDS"), "Price ranges by category, e.g. books=USD 1.00..500.00")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+
+	rounding, err := domain.ParseRoundingPolicy(config.Rounding, config.RoundingByCategory, config.RoundingByCurrency)
+	if err != nil {
+		log.Fatalf("Error: invalid price rounding configuration: %v", err)
+	}
+
+	guardrails, err := domain.ParsePriceGuardrails(config.MaxPriceChange, config.MinMargin, config.PriceBounds)
+	if err != nil {
+		log.Fatalf("Error: invalid price guardrail configuration: %v", err)
+	}
+
+	if err := run(config, domain.NewPricingCalculatorWithRounding(rounding), guardrails); err != nil {
+		log.Fatalf("Price change worker failed: %v", err)
+	}
+
+	log.Println("Price change worker stopped")
+}
+
+func run(config Config, pricing *domain.PricingCalculator, guardrails *domain.PriceGuardrails) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	clk := clock.NewRealClock()
+	worker := apply_price_changes.NewInteractor(
+		repo.NewProductRepo(client, clk),
+		repo.NewPriceChangeRepo(),
+		repo.NewPriceChangeRequestRepo(),
+		repo.NewPriceHistoryRepo(client),
+		repo.NewOutboxRepo(client),
+		committer.NewCommitter(client),
+		clk,
+		pricing,
+		guardrails,
+	)
+
+	log.Printf("Starting price change worker...")
+	log.Printf("  Batch size: %d, interval: %s, once: %v", config.BatchSize, config.Interval, config.Once)
+
+	// Stop on SIGINT/SIGTERM; each product is committed atomically, so nothing is half-applied
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		log.Println("Shutting down gracefully...")
+		cancel()
+	}()
+
+	for {
+		if err := applyAll(ctx, worker, config.BatchSize); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if config.Once {
+				return err
+			}
+			log.Printf("Applying price changes failed: %v", err)
+		}
+		if config.Once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(config.Interval):
+		}
+	}
+}
+
+// applyAll processes batch after batch until a batch comes back short or fails.
+// Failed products stay due, so stopping on failure avoids retrying them in a loop.
+func applyAll(ctx context.Context, worker *apply_price_changes.Interactor, batchSize int) error {
+	for {
+		resp, err := worker.Execute(ctx, &apply_price_changes.Request{BatchSize: batchSize})
+		if resp != nil && resp.ProductCount > 0 {
+			log.Printf("Processed %d products: %d price changes applied, %d held for approval, %d dropped, %d products failed",
+				resp.ProductCount, resp.AppliedCount, resp.HeldCount, resp.DroppedCount, resp.FailedCount)
+		}
+		if err != nil {
+			return err
+		}
+		if resp.ProductCount < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Configuration for the archived product purge job
+type Config struct {
+	SpannerDB     string
+	RetentionDays int
+	BatchSize     int
+	DryRun        bool
+}
+
+func main() {
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.IntVar(&config.RetentionDays, "retention", 0, "Retention days of archived products (required)")
+	flag.IntVar(&config.BatchSize, "batch-size", purge_archived_products.DefaultBatchSize, "Maximum products purged per batch")
+	flag.BoolVar(&config.DryRun, "dry-run", false, "Show what would be purged without actually deleting")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+	if config.RetentionDays <= 0 {
+		log.Fatal("Error: -retention flag must be a positive number of days")
+	}
+	if config.BatchSize <= 0 {
+		log.Fatal("Error: -batch-size flag must be positive")
+	}
+
+	ctx := context.Background()
+
+	// Run purge
+	if err := purgeProducts(ctx, config); err != nil {
+		log.Fatalf("Purge failed: %v", err)
+	}
+
+	log.Println("Purge completed successfully")
+}
+
+func purgeProducts(ctx context.Context, config Config) error {
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	clk := clock.NewRealClock()
+	purger := purge_archived_products.NewInteractor(
+		repo.NewProductRepo(client, clk),
+		repo.NewOutboxRepo(client),
+		committer.NewCommitter(client),
+		clk,
+	)
+
+	// Calculate cutoff timestamp
+	cutoff := clk.Now().UTC().AddDate(0, 0, -config.RetentionDays)
+
+	log.Printf("Starting archived product purge...")
+	log.Printf("  Archived before: %s (retention: %d days)", cutoff.Format(time.RFC3339), config.RetentionDays)
+	log.Printf("  Dry run: %v", config.DryRun)
+
+	if config.DryRun {
+		resp, err := purger.Execute(ctx, &purge_archived_products.Request{ArchivedBefore: cutoff, DryRun: true})
+		if err != nil {
+			return err
+		}
+		log.Printf("DRY RUN: Would purge %d products with %d price history rows", resp.ProductCount, resp.PriceHistoryCount)
+		log.Println("Run without --dry-run to actually purge products")
+		return nil
+	}
+
+	return purgeAll(ctx, purger, cutoff, config.BatchSize)
+}
+
+// purgeAll purges batch after batch until a batch comes back short or fails.
+// Failed products stay archived, so stopping on failure avoids purging them in a loop.
+func purgeAll(ctx context.Context, purger *purge_archived_products.Interactor, cutoff time.Time, batchSize int) error {
+	var purged int
+	var priceHistory int64
+	for {
+		resp, err := purger.Execute(ctx, &purge_archived_products.Request{ArchivedBefore: cutoff, BatchSize: batchSize})
+		if resp != nil && resp.ProductCount > 0 {
+			log.Printf("Purged %d of %d products (%d price history rows), %d failed",
+				resp.PurgedCount, resp.ProductCount, resp.PriceHistoryCount, resp.FailedCount)
+			purged += resp.PurgedCount
+			priceHistory += resp.PriceHistoryCount
+		}
+		if err != nil {
+			return err
+		}
+		if resp.ProductCount < batchSize {
+			if purged == 0 {
+				log.Println("No archived products to purge")
+			} else {
+				log.Printf("Successfully purged %d products with %d price history rows", purged, priceHistory)
+			}
+			return nil
+		}
+	}
+}
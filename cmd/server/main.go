@@ -8,16 +8,26 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/publisher"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
 	"github.com/light-bringer/procat-service/internal/services"
 	httphandler "github.com/light-bringer/procat-service/internal/transport/http"
 	pb "github.com/light-bringer/procat-service/proto/product/v1"
+	webhookpb "github.com/light-bringer/procat-service/proto/webhook/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
+// gracefulStopTimeout bounds how long shutdown waits for in-flight gRPC calls.
+const gracefulStopTimeout = 10 * time.Second
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatalf("Failed to run server: %v", err)
@@ -36,7 +46,30 @@ func run() error {
 	log.Printf("HTTP Port: %s", config.HTTPPort)
 
 	// 2. Initialize service dependencies (DI container)
-	serviceOpts, err := services.NewServiceOptions(ctx, config.SpannerDB)
+	rounding, err := domain.ParseRoundingPolicy(config.Rounding, config.RoundingByCategory, config.RoundingByCurrency)
+	if err != nil {
+		return fmt.Errorf("invalid price rounding configuration: %w", err)
+	}
+
+	guardrails, err := domain.ParsePriceGuardrails(config.MaxPriceChange, config.MinMargin, config.PriceBounds)
+	if err != nil {
+		return fmt.Errorf("invalid price guardrail configuration: %w", err)
+	}
+
+	restoreWindow, err := domain.ParseRestoreWindow(config.RestoreWindow)
+	if err != nil {
+		return fmt.Errorf("invalid restore window configuration: %w", err)
+	}
+
+	replayPublishers, err := newReplayPublishers(config)
+	if err != nil {
+		return fmt.Errorf("failed to configure replay publishers: %w", err)
+	}
+	serviceOpts, err := services.NewServiceOptions(ctx, config.SpannerDB, replayPublishers, services.DomainConfig{
+		Rounding:      rounding,
+		Guardrails:    guardrails,
+		RestoreWindow: restoreWindow,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize service: %w", err)
 	}
@@ -47,6 +80,7 @@ func run() error {
 
 	// 4. Register services
 	pb.RegisterProductServiceServer(grpcServer, serviceOpts.ProductHandler)
+	webhookpb.RegisterWebhookServiceServer(grpcServer, serviceOpts.WebhookHandler)
 
 	// 5. Enable reflection (for grpcurl and debugging)
 	reflection.Register(grpcServer)
@@ -81,10 +115,43 @@ func run() error {
 		handler := httphandler.NewEventsHandler(grpcClient)
 		handler.ServeHTTP(w, r)
 	})
+	httpMux.HandleFunc("/api/v1/events/stream", func(w http.ResponseWriter, r *http.Request) {
+		// Each stream holds its own gRPC connection for its lifetime
+		grpcConn, err := grpc.NewClient("localhost:"+config.GRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			http.Error(w, "Failed to connect to gRPC: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer grpcConn.Close()
+
+		grpcClient := pb.NewProductServiceClient(grpcConn)
+		handler := httphandler.NewEventStreamHandler(grpcClient, httphandler.DefaultKeepAliveInterval)
+		handler.ServeHTTP(w, r)
+	})
+	httpMux.HandleFunc(httphandler.PriceHistoryPath, func(w http.ResponseWriter, r *http.Request) {
+		grpcConn, err := grpc.NewClient("localhost:"+config.GRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			http.Error(w, "Failed to connect to gRPC: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer grpcConn.Close()
+
+		grpcClient := pb.NewProductServiceClient(grpcConn)
+		handler := httphandler.NewPriceHistoryHandler(grpcClient)
+		handler.ServeHTTP(w, r)
+	})
+	schemasHandler := httphandler.NewSchemasHandler()
+	httpMux.Handle("/api/v1/events/schemas", schemasHandler)
+	httpMux.Handle("/api/v1/events/schemas/", schemasHandler)
+
+	// Request contexts derive from streamCtx so shutdown can end long-lived event streams
+	streamCtx, cancelStreams := context.WithCancel(ctx)
+	defer cancelStreams()
 
 	httpServer := &http.Server{
-		Addr:    ":" + config.HTTPPort,
-		Handler: httpMux,
+		Addr:        ":" + config.HTTPPort,
+		Handler:     httpMux,
+		BaseContext: func(net.Listener) context.Context { return streamCtx },
 	}
 
 	// 9. Start HTTP server in background
@@ -102,22 +169,47 @@ func run() error {
 
 	log.Println("Shutting down gracefully...")
 
-	// Shutdown HTTP server
+	// Shutdown HTTP server (ending open event streams first)
+	cancelStreams()
 	if err := httpServer.Shutdown(context.Background()); err != nil {
 		log.Printf("HTTP server shutdown error: %v", err)
 	}
 
-	// Stop gRPC server
-	grpcServer.GracefulStop()
+	// Stop gRPC server; WatchEvents streams never finish on their own, so bound the wait
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(gracefulStopTimeout):
+		grpcServer.Stop()
+	}
 
 	return nil
 }
 
 // Config holds application configuration.
 type Config struct {
-	SpannerDB string
-	GRPCPort  string
-	HTTPPort  string
+	SpannerDB    string
+	GRPCPort     string
+	HTTPPort     string
+	Replay       publisher.BackendConfig // Targets of ReplayEvents
+	ReplayCEMode string
+
+	// Price rounding policy, see domain.ParseRoundingPolicy
+	Rounding           string
+	RoundingByCategory string
+	RoundingByCurrency string
+
+	// Price guardrails, see domain.ParsePriceGuardrails
+	MaxPriceChange string
+	MinMargin      string
+	PriceBounds    string
+
+	// How long archived products can be restored, see domain.ParseRestoreWindow
+	RestoreWindow string
 }
 
 // loadConfig loads configuration from environment variables with defaults.
@@ -138,9 +230,90 @@ func loadConfig() Config {
 		httpPort = "8080"
 	}
 
+	replayCEMode := os.Getenv("REPLAY_CE_MODE")
+	if replayCEMode == "" {
+		replayCEMode = string(cloudevents.ModeStructured)
+	}
+
+	replay := publisher.BackendConfig{
+		HTTP: publisher.HTTPConfig{URL: os.Getenv("REPLAY_HTTP_URL")},
+		Kafka: publisher.KafkaConfig{
+			Brokers: splitList(os.Getenv("REPLAY_KAFKA_BROKERS")),
+			Topic:   os.Getenv("REPLAY_KAFKA_TOPIC"),
+		},
+		NATS: publisher.NATSConfig{
+			URL:       os.Getenv("REPLAY_NATS_URL"),
+			Subject:   os.Getenv("REPLAY_NATS_SUBJECT"),
+			JetStream: os.Getenv("REPLAY_NATS_JETSTREAM") == "true",
+		},
+		File: publisher.FileConfig{Path: os.Getenv("REPLAY_FILE_PATH")},
+	}
+	replay.SetSource(os.Getenv("REPLAY_CE_SOURCE"))
+
 	return Config{
-		SpannerDB: spannerDB,
-		GRPCPort:  grpcPort,
-		HTTPPort:  httpPort,
+		SpannerDB:    spannerDB,
+		GRPCPort:     grpcPort,
+		HTTPPort:     httpPort,
+		Replay:       replay,
+		ReplayCEMode: replayCEMode,
+
+		Rounding:           os.Getenv("PRICE_ROUNDING"),
+		RoundingByCategory: os.Getenv("PRICE_ROUNDING_BY_CATEGORY"),
+		RoundingByCurrency: os.Getenv("PRICE_ROUNDING_BY_CURRENCY"),
+
+		MaxPriceChange: os.Getenv("PRICE_GUARDRAIL_MAX_CHANGE_PERCENT"),
+		MinMargin:      os.Getenv("PRICE_GUARDRAIL_MIN_MARGIN_PERCENT"),
+		PriceBounds:    os.Getenv("PRICE_GUARDRAIL_BOUNDS"),
+
+		RestoreWindow: os.Getenv("PRODUCT_RESTORE_WINDOW"),
+	}
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// newReplayPublishers creates the publishers ReplayEvents may target.
+// "log" is always available; the other backends only when their target is configured.
+func newReplayPublishers(config Config) (map[string]contracts.Publisher, error) {
+	mode, err := cloudevents.ParseMode(config.ReplayCEMode)
+	if err != nil {
+		return nil, err
+	}
+	backend := config.Replay
+	backend.HTTP.Mode = mode
+
+	names := []string{"log"}
+	if backend.HTTP.URL != "" {
+		names = append(names, "http")
+	}
+	if len(backend.Kafka.Brokers) > 0 {
+		names = append(names, "kafka")
+	}
+	if backend.NATS.URL != "" {
+		names = append(names, "nats")
+	}
+	if backend.File.Path != "" {
+		names = append(names, "file")
+	}
+
+	publishers := make(map[string]contracts.Publisher, len(names))
+	for _, name := range names {
+		pub, err := publisher.NewBackend(name, backend)
+		if err != nil {
+			for _, created := range publishers {
+				created.Close()
+			}
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		publishers[name] = pub
 	}
+	return publishers, nil
 }
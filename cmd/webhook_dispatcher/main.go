@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/dispatcher"
+	"github.com/light-bringer/procat-service/internal/app/webhook/repo"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// Configuration for the webhook dispatcher
+type Config struct {
+	SpannerDB  string
+	Dispatcher dispatcher.Config
+}
+
+func main() {
+	defaults := dispatcher.DefaultConfig()
+
+	// Parse command-line flags
+	config := Config{}
+	flag.StringVar(&config.SpannerDB, "database", "", "Spanner database (required, format: projects/PROJECT/instances/INSTANCE/databases/DATABASE)")
+	flag.StringVar(&config.Dispatcher.Source, "ce-source", defaults.Source, "CloudEvents source attribute")
+	flag.IntVar(&config.Dispatcher.BatchSize, "batch-size", defaults.BatchSize, "Maximum deliveries claimed per poll")
+	flag.DurationVar(&config.Dispatcher.PollInterval, "poll-interval", defaults.PollInterval, "Wait between polls when nothing is due")
+	flag.DurationVar(&config.Dispatcher.LeaseDuration, "lease", defaults.LeaseDuration, "How long a claimed delivery is reserved before it may be reclaimed")
+	flag.DurationVar(&config.Dispatcher.RequestTimeout, "timeout", defaults.RequestTimeout, "Request timeout per delivery attempt")
+	flag.Int64Var(&config.Dispatcher.Retry.MaxRetries, "max-attempts", defaults.Retry.MaxRetries, "Delivery attempts before a delivery is marked failed")
+	flag.DurationVar(&config.Dispatcher.Retry.InitialBackoff, "initial-backoff", defaults.Retry.InitialBackoff, "Delay after the first failed attempt")
+	flag.DurationVar(&config.Dispatcher.Retry.MaxBackoff, "max-backoff", defaults.Retry.MaxBackoff, "Maximum delay between attempts")
+	flag.Parse()
+
+	if config.SpannerDB == "" {
+		log.Fatal("Error: -database flag is required")
+	}
+	if config.Dispatcher.LeaseDuration <= config.Dispatcher.RequestTimeout {
+		log.Fatal("Error: -lease must be longer than -timeout")
+	}
+
+	if err := run(config); err != nil {
+		log.Fatalf("Webhook dispatcher failed: %v", err)
+	}
+
+	log.Println("Webhook dispatcher stopped")
+}
+
+func run(config Config) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create Spanner client
+	client, err := spanner.NewClient(ctx, config.SpannerDB)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	log.Printf("Starting webhook dispatcher...")
+	log.Printf("  Batch size: %d, poll interval: %s, lease: %s, timeout: %s",
+		config.Dispatcher.BatchSize, config.Dispatcher.PollInterval, config.Dispatcher.LeaseDuration, config.Dispatcher.RequestTimeout)
+	log.Printf("  Max attempts: %d, backoff: %s..%s",
+		config.Dispatcher.Retry.MaxRetries, config.Dispatcher.Retry.InitialBackoff, config.Dispatcher.Retry.MaxBackoff)
+
+	// Stop on SIGINT/SIGTERM; in-flight deliveries are reclaimed after their lease
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		log.Println("Shutting down gracefully...")
+		cancel()
+	}()
+
+	d := dispatcher.NewDispatcher(repo.NewDeliveryRepo(client), clock.NewRealClock(), config.Dispatcher)
+	return d.Run(ctx)
+}
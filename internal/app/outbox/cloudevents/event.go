@@ -0,0 +1,101 @@
+// Package cloudevents maps outbox messages onto CloudEvents 1.0
+// (https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md) and encodes them
+// for HTTP delivery in binary or structured content mode.
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version produced by this package.
+	SpecVersion = "1.0"
+
+	// StructuredContentType is the media type of a structured-mode JSON CloudEvent.
+	StructuredContentType = "application/cloudevents+json; charset=UTF-8"
+
+	// DataContentType is the media type of the event data (the outbox payload).
+	DataContentType = "application/json"
+
+	// DefaultSource is used when no source is configured.
+	DefaultSource = "/procat-service"
+)
+
+// Event is a CloudEvent carrying an outbox payload as JSON data.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Sequence        string          `json:"sequence,omitempty"`        // Sequence extension: per-aggregate order
+	PartitionKey    string          `json:"partitionkey,omitempty"`    // Partitioning extension: the aggregate ID
+	Replayed        bool            `json:"replayed,omitempty"`        // Extension: set on replayed copies
+	OriginalEventID string          `json:"originaleventid,omitempty"` // Extension: id of the replayed event
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// FromMessage converts an outbox message into a CloudEvent.
+// EventID maps to id, EventType to type, AggregateID to subject and partitionkey, and
+// CreatedAt to time.
+func FromMessage(msg *contracts.Message, source string) *Event {
+	if source == "" {
+		source = DefaultSource
+	}
+
+	event := &Event{
+		SpecVersion:  SpecVersion,
+		ID:           msg.EventID,
+		Source:       source,
+		Type:         msg.EventType,
+		Subject:      msg.AggregateID,
+		PartitionKey: msg.AggregateID,
+		Time:         msg.CreatedAt.UTC(),
+	}
+
+	if msg.SequenceNumber > 0 {
+		event.Sequence = strconv.FormatInt(msg.SequenceNumber, 10)
+	}
+
+	if msg.Replayed {
+		event.Replayed = true
+		event.OriginalEventID = msg.OriginalEventID
+	}
+
+	if len(msg.Payload) > 0 {
+		event.DataContentType = DataContentType
+		event.Data = json.RawMessage(msg.Payload)
+	}
+
+	return event
+}
+
+// Validate checks the attributes required by the specification.
+func (e *Event) Validate() error {
+	switch {
+	case e.SpecVersion != SpecVersion:
+		return fmt.Errorf("unsupported specversion %q", e.SpecVersion)
+	case e.ID == "":
+		return fmt.Errorf("id is required")
+	case e.Source == "":
+		return fmt.Errorf("source is required")
+	case e.Type == "":
+		return fmt.Errorf("type is required")
+	}
+	return nil
+}
+
+// MarshalStructured encodes the event in the JSON event format (structured mode).
+func (e *Event) MarshalStructured() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(e)
+}
@@ -0,0 +1,146 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+func testMessage() *contracts.Message {
+	return &contracts.Message{
+		EventID:        "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		EventType:      "product.activated",
+		AggregateID:    "product-1",
+		SequenceNumber: 1000,
+		Payload:        []byte(`{"schema_version":1,"data":{"product_id":"product-1"}}`),
+		CreatedAt:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+	}
+}
+
+func TestFromMessage(t *testing.T) {
+	t.Run("maps outbox fields to context attributes", func(t *testing.T) {
+		event := FromMessage(testMessage(), "//catalog.example/products")
+
+		assert.Equal(t, "1.0", event.SpecVersion)
+		assert.Equal(t, "7c9e6679-7425-40de-944b-e07fc1f90ae7", event.ID)
+		assert.Equal(t, "product.activated", event.Type)
+		assert.Equal(t, "product-1", event.Subject)
+		assert.Equal(t, "product-1", event.PartitionKey)
+		assert.Equal(t, "//catalog.example/products", event.Source)
+		assert.Equal(t, time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC), event.Time)
+		assert.Equal(t, "1000", event.Sequence)
+		assert.Equal(t, "application/json", event.DataContentType)
+	})
+
+	t.Run("defaults source", func(t *testing.T) {
+		event := FromMessage(testMessage(), "")
+		assert.Equal(t, DefaultSource, event.Source)
+		assert.False(t, event.Replayed)
+	})
+
+	t.Run("marks replayed copies", func(t *testing.T) {
+		msg := testMessage()
+		msg.Replayed = true
+		msg.OriginalEventID = msg.EventID
+		msg.EventID = "0f6a7c1e-5b8e-4a53-9d43-6c1f2a9e8b10"
+
+		event := FromMessage(msg, "")
+		assert.Equal(t, "0f6a7c1e-5b8e-4a53-9d43-6c1f2a9e8b10", event.ID)
+		assert.True(t, event.Replayed)
+		assert.Equal(t, "7c9e6679-7425-40de-944b-e07fc1f90ae7", event.OriginalEventID)
+	})
+}
+
+func TestMarshalStructured(t *testing.T) {
+	data, err := FromMessage(testMessage(), "/procat-service").MarshalStructured()
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		"source": "/procat-service",
+		"type": "product.activated",
+		"subject": "product-1",
+		"time": "2026-03-01T11:00:00Z",
+		"datacontenttype": "application/json",
+		"sequence": "1000",
+		"partitionkey": "product-1",
+		"data": {"schema_version": 1, "data": {"product_id": "product-1"}}
+	}`, string(data))
+}
+
+func TestWriteRequest(t *testing.T) {
+	event := FromMessage(testMessage(), "/procat-service")
+
+	t.Run("structured mode", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, event.WriteRequest(req, ModeStructured))
+
+		assert.Equal(t, StructuredContentType, req.Header.Get("Content-Type"))
+		assert.Empty(t, req.Header.Get("ce-id"))
+
+		decoded, err := FromRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, event, decoded)
+	})
+
+	t.Run("binary mode", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, event.WriteRequest(req, ModeBinary))
+
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, "1.0", req.Header.Get("ce-specversion"))
+		assert.Equal(t, event.ID, req.Header.Get("ce-id"))
+		assert.Equal(t, "product.activated", req.Header.Get("ce-type"))
+		assert.Equal(t, "product-1", req.Header.Get("ce-subject"))
+		assert.Equal(t, "2026-03-01T11:00:00Z", req.Header.Get("ce-time"))
+		assert.Equal(t, "product-1", req.Header.Get("ce-partitionkey"))
+
+		decoded, err := FromRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, event, decoded)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(decoded.Data, &payload))
+		assert.Equal(t, float64(1), payload["schema_version"])
+	})
+
+	t.Run("binary mode carries the replay marker", func(t *testing.T) {
+		msg := testMessage()
+		msg.Replayed = true
+		msg.OriginalEventID = "original-id"
+		replayed := FromMessage(msg, "/procat-service")
+
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, replayed.WriteRequest(req, ModeBinary))
+
+		assert.Equal(t, "true", req.Header.Get("ce-replayed"))
+		assert.Equal(t, "original-id", req.Header.Get("ce-originaleventid"))
+
+		decoded, err := FromRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, replayed, decoded)
+	})
+
+	t.Run("rejects invalid event", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		err := (&Event{SpecVersion: SpecVersion}).WriteRequest(req, ModeStructured)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := ParseMode("Binary")
+	require.NoError(t, err)
+	assert.Equal(t, ModeBinary, mode)
+
+	_, err = ParseMode("batched")
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Mode selects the HTTP content mode.
+type Mode string
+
+const (
+	// ModeStructured sends the whole event as an application/cloudevents+json body.
+	ModeStructured Mode = "structured"
+
+	// ModeBinary sends the data as the body and attributes as ce-* headers.
+	ModeBinary Mode = "binary"
+)
+
+// ParseMode parses a content mode name.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(strings.ToLower(s)) {
+	case ModeStructured:
+		return ModeStructured, nil
+	case ModeBinary:
+		return ModeBinary, nil
+	default:
+		return "", fmt.Errorf("unknown CloudEvents content mode %q (want structured or binary)", s)
+	}
+}
+
+// headerPrefix prefixes attribute headers in binary mode.
+const headerPrefix = "ce-"
+
+// WriteRequest sets the body and headers of req for the given content mode.
+func (e *Event) WriteRequest(req *http.Request, mode Mode) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	var body []byte
+	switch mode {
+	case ModeStructured:
+		data, err := e.MarshalStructured()
+		if err != nil {
+			return err
+		}
+		body = data
+		req.Header.Set("Content-Type", StructuredContentType)
+
+	case ModeBinary:
+		body = e.Data
+		for name, value := range e.Attributes() {
+			req.Header.Set(headerPrefix+name, value)
+		}
+		if e.DataContentType != "" {
+			req.Header.Set("Content-Type", e.DataContentType)
+		}
+
+	default:
+		return fmt.Errorf("unknown CloudEvents content mode %q", mode)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+// Attributes returns the context attributes carried as headers in binary mode, keyed
+// by attribute name. Each protocol binding adds its own prefix (ce- for HTTP and NATS,
+// ce_ for Kafka). datacontenttype maps to the protocol's content type instead.
+func (e *Event) Attributes() map[string]string {
+	attrs := map[string]string{
+		"specversion": e.SpecVersion,
+		"id":          e.ID,
+		"source":      e.Source,
+		"type":        e.Type,
+		"time":        e.Time.Format(time.RFC3339Nano),
+	}
+	if e.Subject != "" {
+		attrs["subject"] = e.Subject
+	}
+	if e.Sequence != "" {
+		attrs["sequence"] = e.Sequence
+	}
+	if e.PartitionKey != "" {
+		attrs["partitionkey"] = e.PartitionKey
+	}
+	if e.Replayed {
+		attrs["replayed"] = "true"
+		attrs["originaleventid"] = e.OriginalEventID
+	}
+	return attrs
+}
+
+// FromRequest decodes a CloudEvent from an HTTP request in either content mode.
+// It is the receiving counterpart of WriteRequest.
+func FromRequest(req *http.Request) (*Event, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	var event Event
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/cloudevents+json") {
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, fmt.Errorf("failed to decode structured event: %w", err)
+		}
+	} else {
+		event = Event{
+			SpecVersion:     req.Header.Get(headerPrefix + "specversion"),
+			ID:              req.Header.Get(headerPrefix + "id"),
+			Source:          req.Header.Get(headerPrefix + "source"),
+			Type:            req.Header.Get(headerPrefix + "type"),
+			Subject:         req.Header.Get(headerPrefix + "subject"),
+			Sequence:        req.Header.Get(headerPrefix + "sequence"),
+			PartitionKey:    req.Header.Get(headerPrefix + "partitionkey"),
+			Replayed:        req.Header.Get(headerPrefix+"replayed") == "true",
+			OriginalEventID: req.Header.Get(headerPrefix + "originaleventid"),
+			DataContentType: req.Header.Get("Content-Type"),
+		}
+		if t := req.Header.Get(headerPrefix + "time"); t != "" {
+			if event.Time, err = time.Parse(time.RFC3339Nano, t); err != nil {
+				return nil, fmt.Errorf("invalid time attribute: %w", err)
+			}
+		}
+		if len(body) > 0 {
+			event.Data = json.RawMessage(body)
+		}
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
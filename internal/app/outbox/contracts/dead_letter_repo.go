@@ -0,0 +1,52 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// FailedEventFilter selects failed events. Failure time is the event's processed_at,
+// which the relay sets when it gives up on an event.
+type FailedEventFilter struct {
+	EventType    string     // Optional
+	AggregateID  string     // Optional
+	FailedAfter  *time.Time // Optional, inclusive
+	FailedBefore *time.Time // Optional, exclusive
+	Limit        int
+}
+
+// AttemptDTO is one entry of an event's attempt history.
+type AttemptDTO struct {
+	Outcome      string // retry_scheduled, failed or requeued
+	RetryCount   int64
+	ErrorMessage string
+	AttemptedAt  time.Time
+}
+
+// FailedEventDTO is a failed event together with its attempt history, oldest first.
+type FailedEventDTO struct {
+	Event    *m_outbox.Data
+	Attempts []*AttemptDTO
+}
+
+// DeadLetterRepository defines inspection and manual retry of failed events.
+type DeadLetterRepository interface {
+	// ListFailed retrieves failed events matching the filter, most recently failed first.
+	ListFailed(ctx context.Context, filter *FailedEventFilter) ([]*FailedEventDTO, error)
+
+	// ListFailedIDs retrieves the IDs of failed events matching the filter, oldest failure first.
+	ListFailedIDs(ctx context.Context, filter *FailedEventFilter) ([]string, error)
+
+	// CountFailed counts failed events matching the filter. Limit is ignored.
+	CountFailed(ctx context.Context, filter *FailedEventFilter) (int64, error)
+
+	// Requeue returns failed events to pending with retry_count reset, recording a
+	// requeued attempt for each. Events that are no longer failed are skipped.
+	// Returns the number of events requeued.
+	Requeue(ctx context.Context, eventIDs []string) (int, error)
+
+	// GetStatus returns the current status of an event, or domain.ErrEventNotFound.
+	GetStatus(ctx context.Context, eventID string) (string, error)
+}
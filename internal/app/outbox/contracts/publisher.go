@@ -0,0 +1,56 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// Message is an outbox event handed to a Publisher by the relay.
+type Message struct {
+	EventID        string
+	EventType      string
+	AggregateID    string
+	SequenceNumber int64  // Per-aggregate ordering key; 0 for events written before sequencing
+	Payload        []byte // Raw JSON payload as stored in outbox_events
+	CreatedAt      time.Time
+
+	// Replay marker: set on copies re-emitted by a replay. A replayed copy has its own
+	// EventID; OriginalEventID is the outbox event it was copied from.
+	Replayed        bool
+	OriginalEventID string
+}
+
+// NewMessage converts an outbox row into a publishable message.
+func NewMessage(event *m_outbox.Data) (*Message, error) {
+	msg := &Message{
+		EventID:        event.EventID,
+		EventType:      event.EventType,
+		AggregateID:    event.AggregateID,
+		SequenceNumber: event.SequenceNumber.Int64,
+		CreatedAt:      event.CreatedAt,
+	}
+
+	if event.Payload.Valid {
+		payload, err := json.Marshal(event.Payload.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode payload: %w", err)
+		}
+		msg.Payload = payload
+	}
+
+	return msg, nil
+}
+
+// Publisher delivers outbox events to a downstream transport (message broker, log, etc.).
+// Publish must be safe to call repeatedly with the same message: the relay guarantees
+// at-least-once delivery, so consumers should deduplicate on EventID.
+// Messages for the same AggregateID are published one at a time in commit order;
+// the next one is only handed over after the previous Publish succeeded.
+type Publisher interface {
+	Publish(ctx context.Context, msg *Message) error
+	Close() error
+}
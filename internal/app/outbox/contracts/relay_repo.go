@@ -0,0 +1,39 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// RelayRepository defines the outbox state transitions performed by the relay.
+type RelayRepository interface {
+	// ClaimPending atomically moves up to limit publishable events to processing,
+	// leasing them until leaseUntil. Events are returned in created_at order.
+	// Publishable events are pending events whose retry time has passed, and
+	// processing events whose lease has expired (a previous relay crashed).
+	ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*m_outbox.Data, error)
+
+	// MarkCompleted records a successful publish.
+	MarkCompleted(ctx context.Context, eventID string, processedAt time.Time) error
+
+	// ScheduleRetry returns the event to pending with an updated retry count and error.
+	ScheduleRetry(ctx context.Context, eventID string, retryCount int64, errMsg string, nextAttemptAt time.Time) error
+
+	// MarkFailed moves the event to the terminal failed status after exhausting retries.
+	MarkFailed(ctx context.Context, eventID string, retryCount int64, errMsg string, failedAt time.Time) error
+
+	// ListBlockedAggregates returns up to limit aggregates whose earliest incomplete event
+	// has failed while later events wait behind it, oldest failure first.
+	ListBlockedAggregates(ctx context.Context, limit int) ([]*BlockedAggregate, error)
+}
+
+// BlockedAggregate is an aggregate whose events are held back by a failed head event.
+// They stay unpublished until the failed event is retried or cleaned up.
+type BlockedAggregate struct {
+	AggregateID     string
+	FailedEventID   string
+	FailedEventType string
+	Waiting         int64 // Pending or processing events behind the failed event
+}
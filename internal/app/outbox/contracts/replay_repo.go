@@ -0,0 +1,34 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// ReplayFilter selects completed events to replay.
+type ReplayFilter struct {
+	EventType     string     // Optional
+	AggregateID   string     // Optional
+	CreatedAfter  *time.Time // Optional, inclusive
+	CreatedBefore *time.Time // Optional, exclusive
+}
+
+// ReplayCursor is the position of the last event read, in commit order.
+type ReplayCursor struct {
+	CreatedAt      time.Time
+	SequenceNumber int64
+	EventID        string
+}
+
+// ReplayRepository reads completed events in commit order for replay.
+type ReplayRepository interface {
+	// CountCompleted counts completed events matching the filter.
+	CountCompleted(ctx context.Context, filter *ReplayFilter) (int64, error)
+
+	// ListCompleted retrieves up to limit completed events matching the filter that
+	// come after the cursor (from the start if nil), ordered by created_at, then
+	// sequence_number, then event_id.
+	ListCompleted(ctx context.Context, filter *ReplayFilter, after *ReplayCursor, limit int) ([]*m_outbox.Data, error)
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+// Domain errors for outbox event administration.
+var (
+	ErrEventNotFound  = errors.New("outbox event not found")
+	ErrEventNotFailed = errors.New("outbox event is not in failed status")
+	ErrUnknownTarget  = errors.New("unknown replay publisher")
+	ErrInvalidRange   = errors.New("time range start must be before its end")
+	ErrReplayTooLarge = errors.New("too many events to replay in one request")
+)
@@ -0,0 +1,55 @@
+package publisher
+
+import (
+	"fmt"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// BackendConfig holds the settings of every standalone publisher backend.
+// Only the section of the selected backend is used.
+type BackendConfig struct {
+	HTTP  HTTPConfig
+	Kafka KafkaConfig
+	NATS  NATSConfig
+	File  FileConfig
+}
+
+// SetSource sets the CloudEvents source attribute of every backend.
+func (c *BackendConfig) SetSource(source string) {
+	c.HTTP.Source = source
+	c.Kafka.Source = source
+	c.NATS.Source = source
+	c.File.Source = source
+}
+
+// NewBackend creates the publisher backend with the given name.
+// Backends that need the database (webhook fan-out) are wired by the commands themselves.
+func NewBackend(name string, config BackendConfig) (contracts.Publisher, error) {
+	switch name {
+	case "log":
+		return NewLogPublisher(), nil
+	case "http":
+		if config.HTTP.URL == "" {
+			return nil, fmt.Errorf("an endpoint URL is required for the http publisher")
+		}
+		return NewHTTPPublisher(config.HTTP), nil
+	case "kafka":
+		if len(config.Kafka.Brokers) == 0 || config.Kafka.Topic == "" {
+			return nil, fmt.Errorf("brokers and a topic are required for the kafka publisher")
+		}
+		if config.Kafka.Acks != 0 && config.Kafka.Acks != KafkaAcksAll && config.Kafka.Acks != KafkaAcksLeader {
+			return nil, fmt.Errorf("kafka acks must be %d (all) or %d (leader)", KafkaAcksAll, KafkaAcksLeader)
+		}
+		return NewKafkaPublisher(config.Kafka), nil
+	case "nats":
+		if config.NATS.URL == "" || config.NATS.Subject == "" {
+			return nil, fmt.Errorf("a server URL and a subject are required for the nats publisher")
+		}
+		return NewNATSPublisher(config.NATS), nil
+	case "file":
+		return NewFilePublisher(config.File)
+	default:
+		return nil, fmt.Errorf("unknown publisher %q", name)
+	}
+}
@@ -0,0 +1,79 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// StdoutPath selects standard output as the file publisher's destination.
+const StdoutPath = "-"
+
+// FileConfig configures a FilePublisher.
+type FileConfig struct {
+	Path   string // File to append to, or StdoutPath
+	Source string // CloudEvents source attribute
+}
+
+// FilePublisher appends each event as one structured CloudEvent per line (JSON Lines)
+// to a local file or standard output. The partitionkey attribute carries the aggregate ID.
+// Useful for local development, audits and piping into other tools.
+type FilePublisher struct {
+	config FileConfig
+
+	mu   sync.Mutex
+	w    io.Writer
+	file *os.File // nil when writing to stdout
+}
+
+// NewFilePublisher creates a new FilePublisher, creating the file if needed.
+func NewFilePublisher(config FileConfig) (contracts.Publisher, error) {
+	if config.Path == "" || config.Path == StdoutPath {
+		return &FilePublisher{config: config, w: os.Stdout}, nil
+	}
+
+	file, err := os.OpenFile(config.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", config.Path, err)
+	}
+	return &FilePublisher{config: config, w: file, file: file}, nil
+}
+
+// Publish writes the event line. Files are synced before returning so an event the
+// relay marks completed is on disk.
+func (p *FilePublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	line, err := cloudevents.FromMessage(msg, p.config.Source).MarshalStructured()
+	if err != nil {
+		return fmt.Errorf("failed to encode CloudEvent: %w", err)
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.w.Write(line); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	if p.file != nil {
+		if err := p.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync %s: %w", p.config.Path, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the file. Standard output is left open.
+func (p *FilePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file == nil {
+		return nil
+	}
+	return p.file.Close()
+}
@@ -0,0 +1,62 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+func TestFilePublisher_Publish(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	pub, err := NewFilePublisher(FileConfig{Path: path, Source: "/test"})
+	require.NoError(t, err)
+	for _, id := range []string{"event-1", "event-2"} {
+		require.NoError(t, pub.Publish(context.Background(), &contracts.Message{
+			EventID:     id,
+			EventType:   "product.created",
+			AggregateID: "product-1",
+			Payload:     []byte(`{"schema_version":1}`),
+			CreatedAt:   created,
+		}))
+	}
+	require.NoError(t, pub.Close())
+
+	// Reopening appends rather than truncating
+	pub, err = NewFilePublisher(FileConfig{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, pub.Publish(context.Background(), &contracts.Message{EventID: "event-3", EventType: "product.activated", AggregateID: "product-2", CreatedAt: created}))
+	require.NoError(t, pub.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var events []cloudevents.Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event cloudevents.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, events, 3)
+	assert.Equal(t, "event-1", events[0].ID)
+	assert.Equal(t, "/test", events[0].Source)
+	assert.Equal(t, "product-1", events[0].PartitionKey)
+	assert.JSONEq(t, `{"schema_version":1}`, string(events[0].Data))
+	assert.Equal(t, "event-3", events[2].ID)
+	assert.Equal(t, "product-2", events[2].PartitionKey)
+}
@@ -0,0 +1,73 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// HTTPConfig configures an HTTPPublisher.
+type HTTPConfig struct {
+	URL     string           // Endpoint receiving the events
+	Source  string           // CloudEvents source attribute (URI-reference)
+	Mode    cloudevents.Mode // Binary or structured content mode
+	Timeout time.Duration    // Per-request timeout
+}
+
+// HTTPPublisher POSTs each event as a CloudEvent to a fixed endpoint.
+// Any non-2xx response is treated as a failure so the relay retries the event.
+type HTTPPublisher struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+// NewHTTPPublisher creates a new HTTPPublisher.
+func NewHTTPPublisher(config HTTPConfig) contracts.Publisher {
+	if config.Mode == "" {
+		config.Mode = cloudevents.ModeStructured
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &HTTPPublisher{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Publish sends the event and waits for a 2xx response.
+func (p *HTTPPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	event := cloudevents.FromMessage(msg, p.config.Source)
+	if err := event.WriteRequest(req, p.config.Mode); err != nil {
+		return fmt.Errorf("failed to encode CloudEvent: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Close releases idle connections.
+func (p *HTTPPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
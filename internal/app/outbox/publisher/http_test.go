@@ -0,0 +1,60 @@
+package publisher
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+func TestHTTPPublisher_Publish(t *testing.T) {
+	msg := &contracts.Message{
+		EventID:     "event-1",
+		EventType:   "product.created",
+		AggregateID: "product-1",
+		Payload:     []byte(`{"schema_version":1}`),
+		CreatedAt:   time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	for _, mode := range []cloudevents.Mode{cloudevents.ModeStructured, cloudevents.ModeBinary} {
+		t.Run(string(mode)+" mode delivers a CloudEvent", func(t *testing.T) {
+			var received *cloudevents.Event
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				event, err := cloudevents.FromRequest(r)
+				require.NoError(t, err)
+				received = event
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			pub := NewHTTPPublisher(HTTPConfig{URL: server.URL, Source: "/test", Mode: mode})
+			defer pub.Close()
+
+			require.NoError(t, pub.Publish(context.Background(), msg))
+			require.NotNil(t, received)
+			assert.Equal(t, "event-1", received.ID)
+			assert.Equal(t, "/test", received.Source)
+			assert.Equal(t, "product.created", received.Type)
+			assert.Equal(t, "product-1", received.Subject)
+			assert.JSONEq(t, `{"schema_version":1}`, string(received.Data))
+		})
+	}
+
+	t.Run("non-2xx response is an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		pub := NewHTTPPublisher(HTTPConfig{URL: server.URL})
+		err := pub.Publish(context.Background(), msg)
+		assert.ErrorContains(t, err, "503")
+	})
+}
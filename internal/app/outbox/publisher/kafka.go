@@ -0,0 +1,326 @@
+package publisher
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// Kafka acknowledgement levels. Fire-and-forget (acks=0) is not offered: the relay
+// only marks an event completed once the broker confirmed it.
+const (
+	KafkaAcksAll    int16 = -1 // All in-sync replicas
+	KafkaAcksLeader int16 = 1  // Partition leader only
+)
+
+// KafkaConfig configures a KafkaPublisher.
+type KafkaConfig struct {
+	Brokers  []string      // Bootstrap brokers (host:port)
+	Topic    string        // Destination topic; must already exist
+	ClientID string        // Client id sent to the brokers
+	Acks     int16         // KafkaAcksAll (default) or KafkaAcksLeader
+	Source   string        // CloudEvents source attribute
+	Timeout  time.Duration // Dial and request timeout
+}
+
+// KafkaPublisher produces each event as a record to a Kafka-compatible broker
+// (Apache Kafka 0.11+, Redpanda, ...) over plaintext TCP.
+//
+// Records follow the CloudEvents Kafka binding in binary mode: the value is the
+// outbox payload and attributes are ce_* headers. The record key is the aggregate ID,
+// hashed like the Java client, so all events of an aggregate land on one partition
+// and keep their order.
+type KafkaPublisher struct {
+	config KafkaConfig
+
+	mu            sync.Mutex
+	correlationID int32
+	conns         map[int32]*kafkaConn // Open connections by broker node id
+	brokers       map[int32]string     // Broker addresses by node id
+	leaders       []int32              // Leader node id by partition; nil until metadata is loaded
+}
+
+// kafkaConn is a connection to one broker. Requests are sent one at a time.
+type kafkaConn struct {
+	conn net.Conn
+}
+
+// NewKafkaPublisher creates a new KafkaPublisher. Brokers are contacted on first publish.
+func NewKafkaPublisher(config KafkaConfig) contracts.Publisher {
+	if config.ClientID == "" {
+		config.ClientID = "procat-outbox"
+	}
+	if config.Acks == 0 {
+		config.Acks = KafkaAcksAll
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &KafkaPublisher{
+		config: config,
+		conns:  make(map[int32]*kafkaConn),
+	}
+}
+
+// Publish produces the event and waits for the broker acknowledgement.
+// On any failure cached metadata is dropped, so leadership changes are picked up
+// when the relay retries.
+func (p *KafkaPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.produce(ctx, msg); err != nil {
+		p.reset()
+		return err
+	}
+	return nil
+}
+
+func (p *KafkaPublisher) produce(ctx context.Context, msg *contracts.Message) error {
+	if p.leaders == nil {
+		if err := p.loadMetadata(ctx); err != nil {
+			return err
+		}
+	}
+
+	key := []byte(msg.AggregateID)
+	partition := kafkaPartition(key, len(p.leaders))
+	leader := p.leaders[partition]
+	if leader < 0 {
+		return fmt.Errorf("kafka: partition %s/%d has no leader", p.config.Topic, partition)
+	}
+
+	conn, err := p.conn(ctx, leader)
+	if err != nil {
+		return err
+	}
+
+	event := cloudevents.FromMessage(msg, p.config.Source)
+	record := kafkaRecord{
+		Key:       key,
+		Value:     msg.Payload,
+		Timestamp: msg.CreatedAt.UnixMilli(),
+	}
+	attrs := event.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		record.Headers = append(record.Headers, kafkaHeader{Key: "ce_" + name, Value: []byte(attrs[name])})
+	}
+	if event.DataContentType != "" {
+		record.Headers = append(record.Headers, kafkaHeader{Key: "content-type", Value: []byte(event.DataContentType)})
+	}
+
+	var req kafkaEncoder
+	req.nullString() // transactional id
+	req.int16(p.config.Acks)
+	req.int32(int32(p.config.Timeout / time.Millisecond))
+	req.int32(1) // topics
+	req.string(p.config.Topic)
+	req.int32(1) // partitions
+	req.int32(int32(partition))
+	req.bytes(encodeRecordBatch([]kafkaRecord{record}))
+
+	resp, err := p.roundTrip(ctx, conn, kafkaAPIProduce, kafkaProduceVersion, req.buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	for topics := resp.arrayLen(); topics > 0; topics-- {
+		resp.string()
+		for partitions := resp.arrayLen(); partitions > 0; partitions-- {
+			index := resp.int32()
+			code := resp.int16()
+			resp.int64() // base offset
+			resp.int64() // log append time
+			if resp.err == nil && code != 0 {
+				return fmt.Errorf("kafka: produce to %s/%d failed: %w", p.config.Topic, index, kafkaError(code))
+			}
+		}
+	}
+	if resp.err != nil {
+		return fmt.Errorf("kafka: invalid produce response: %w", resp.err)
+	}
+	return nil
+}
+
+// loadMetadata fetches partition leaders of the topic from the first reachable bootstrap broker.
+func (p *KafkaPublisher) loadMetadata(ctx context.Context) error {
+	if len(p.config.Brokers) == 0 {
+		return errors.New("kafka: no brokers configured")
+	}
+
+	var req kafkaEncoder
+	req.int32(1) // topics
+	req.string(p.config.Topic)
+	req.bool(false) // allow auto topic creation
+
+	var lastErr error
+	for _, addr := range p.config.Brokers {
+		conn, err := p.dial(ctx, addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp, err := p.roundTrip(ctx, conn, kafkaAPIMetadata, kafkaMetadataVersion, req.buf.Bytes())
+		conn.conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return p.parseMetadata(resp)
+	}
+	return fmt.Errorf("kafka: failed to load metadata: %w", lastErr)
+}
+
+func (p *KafkaPublisher) parseMetadata(resp *kafkaDecoder) error {
+	resp.int32() // throttle time
+
+	brokers := make(map[int32]string)
+	for n := resp.arrayLen(); n > 0; n-- {
+		nodeID := resp.int32()
+		host := resp.string()
+		port := resp.int32()
+		resp.string() // rack
+		brokers[nodeID] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	resp.string() // cluster id
+	resp.int32()  // controller id
+
+	var leaders []int32
+	for n := resp.arrayLen(); n > 0; n-- {
+		topicErr := resp.int16()
+		name := resp.string()
+		resp.bool() // internal
+		partitions := make(map[int32]int32)
+		for m := resp.arrayLen(); m > 0; m-- {
+			resp.int16() // partition error; a missing leader is reported as leader -1
+			index := resp.int32()
+			partitions[index] = resp.int32()
+			resp.int32Array() // replicas
+			resp.int32Array() // in-sync replicas
+		}
+		if resp.err != nil || name != p.config.Topic {
+			continue
+		}
+		if topicErr != 0 {
+			return fmt.Errorf("kafka: topic %s: %w", name, kafkaError(topicErr))
+		}
+		leaders = make([]int32, len(partitions))
+		for i := range leaders {
+			leader, ok := partitions[int32(i)]
+			if !ok {
+				return fmt.Errorf("kafka: topic %s is missing partition %d in metadata", name, i)
+			}
+			leaders[i] = leader
+		}
+	}
+	if resp.err != nil {
+		return fmt.Errorf("kafka: invalid metadata response: %w", resp.err)
+	}
+	if len(leaders) == 0 {
+		return fmt.Errorf("kafka: topic %s not found", p.config.Topic)
+	}
+
+	p.brokers = brokers
+	p.leaders = leaders
+	return nil
+}
+
+// conn returns an open connection to a broker node.
+func (p *KafkaPublisher) conn(ctx context.Context, nodeID int32) (*kafkaConn, error) {
+	if conn, ok := p.conns[nodeID]; ok {
+		return conn, nil
+	}
+	addr, ok := p.brokers[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("kafka: unknown broker node %d", nodeID)
+	}
+	conn, err := p.dial(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	p.conns[nodeID] = conn
+	return conn, nil
+}
+
+func (p *KafkaPublisher) dial(ctx context.Context, addr string) (*kafkaConn, error) {
+	dialer := net.Dialer{Timeout: p.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: failed to connect to %s: %w", addr, err)
+	}
+	return &kafkaConn{conn: conn}, nil
+}
+
+// roundTrip sends a request and returns a decoder positioned at the response body.
+func (p *KafkaPublisher) roundTrip(ctx context.Context, conn *kafkaConn, apiKey, apiVersion int16, body []byte) (*kafkaDecoder, error) {
+	deadline := time.Now().Add(p.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.conn.SetDeadline(deadline); err != nil {
+		return nil, fmt.Errorf("kafka: %w", err)
+	}
+
+	p.correlationID++
+	var req kafkaEncoder
+	req.int16(apiKey)
+	req.int16(apiVersion)
+	req.int32(p.correlationID)
+	req.string(p.config.ClientID)
+	req.buf.Write(body)
+
+	var frame kafkaEncoder
+	frame.bytes(req.buf.Bytes())
+	if _, err := conn.conn.Write(frame.buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("kafka: failed to send request: %w", err)
+	}
+
+	var size [4]byte
+	if _, err := io.ReadFull(conn.conn, size[:]); err != nil {
+		return nil, fmt.Errorf("kafka: failed to read response: %w", err)
+	}
+	n := int32(binary.BigEndian.Uint32(size[:]))
+	if n < 4 {
+		return nil, fmt.Errorf("kafka: invalid response size %d", n)
+	}
+	resp := make([]byte, n)
+	if _, err := io.ReadFull(conn.conn, resp); err != nil {
+		return nil, fmt.Errorf("kafka: failed to read response: %w", err)
+	}
+
+	d := &kafkaDecoder{data: resp}
+	if id := d.int32(); id != p.correlationID {
+		return nil, fmt.Errorf("kafka: response correlation id %d does not match request %d", id, p.correlationID)
+	}
+	return d, nil
+}
+
+// reset closes all connections and forgets cached metadata.
+func (p *KafkaPublisher) reset() {
+	for id, conn := range p.conns {
+		conn.conn.Close()
+		delete(p.conns, id)
+	}
+	p.leaders = nil
+}
+
+// Close closes all broker connections.
+func (p *KafkaPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.reset()
+	return nil
+}
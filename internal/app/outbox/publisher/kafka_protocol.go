@@ -0,0 +1,267 @@
+package publisher
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+// Minimal encoder/decoder for the Kafka wire protocol
+// (https://kafka.apache.org/protocol). Only the requests the producer needs are
+// implemented: Metadata v4 and Produce v3 with a v2 record batch.
+
+const (
+	kafkaAPIProduce  int16 = 0
+	kafkaAPIMetadata int16 = 3
+
+	kafkaProduceVersion  int16 = 3
+	kafkaMetadataVersion int16 = 4
+)
+
+// Kafka error codes the producer reports by name.
+var kafkaErrorNames = map[int16]string{
+	1:  "OFFSET_OUT_OF_RANGE",
+	2:  "CORRUPT_MESSAGE",
+	3:  "UNKNOWN_TOPIC_OR_PARTITION",
+	5:  "LEADER_NOT_AVAILABLE",
+	6:  "NOT_LEADER_OR_FOLLOWER",
+	7:  "REQUEST_TIMED_OUT",
+	10: "MESSAGE_TOO_LARGE",
+	19: "NOT_ENOUGH_REPLICAS",
+	20: "NOT_ENOUGH_REPLICAS_AFTER_APPEND",
+	29: "TOPIC_AUTHORIZATION_FAILED",
+}
+
+// kafkaError is a non-zero error code returned by a broker.
+type kafkaError int16
+
+func (e kafkaError) Error() string {
+	if name, ok := kafkaErrorNames[int16(e)]; ok {
+		return name
+	}
+	return fmt.Sprintf("kafka error code %d", int16(e))
+}
+
+// kafkaHeader is a record header.
+type kafkaHeader struct {
+	Key   string
+	Value []byte
+}
+
+// kafkaRecord is a single record of a produce request.
+type kafkaRecord struct {
+	Key       []byte
+	Value     []byte
+	Headers   []kafkaHeader
+	Timestamp int64 // Milliseconds since the epoch
+}
+
+// kafkaEncoder appends big-endian protocol primitives to a buffer.
+type kafkaEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *kafkaEncoder) int8(v int8)   { e.buf.WriteByte(byte(v)) }
+func (e *kafkaEncoder) int16(v int16) { e.buf.Write(binary.BigEndian.AppendUint16(nil, uint16(v))) }
+func (e *kafkaEncoder) int32(v int32) { e.buf.Write(binary.BigEndian.AppendUint32(nil, uint32(v))) }
+func (e *kafkaEncoder) int64(v int64) { e.buf.Write(binary.BigEndian.AppendUint64(nil, uint64(v))) }
+
+func (e *kafkaEncoder) bool(v bool) {
+	if v {
+		e.int8(1)
+	} else {
+		e.int8(0)
+	}
+}
+
+func (e *kafkaEncoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e *kafkaEncoder) nullString() { e.int16(-1) }
+
+func (e *kafkaEncoder) bytes(v []byte) {
+	e.int32(int32(len(v)))
+	e.buf.Write(v)
+}
+
+// varint writes a zigzag-encoded variable-length integer, as used inside record batches.
+func (e *kafkaEncoder) varint(v int64) { e.buf.Write(binary.AppendVarint(nil, v)) }
+
+func (e *kafkaEncoder) varbytes(v []byte) {
+	if v == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(v)))
+	e.buf.Write(v)
+}
+
+// kafkaDecoder reads big-endian protocol primitives. The first short read is kept
+// in err and turns all later reads into zero values.
+type kafkaDecoder struct {
+	data []byte
+	err  error
+}
+
+var errKafkaShortRead = errors.New("kafka: truncated message")
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.data) < n {
+		d.err = errKafkaShortRead
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) bool() bool { return d.int8() != 0 }
+
+// string reads a STRING or NULLABLE_STRING; null decodes as "".
+func (d *kafkaDecoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+// arrayLen reads an ARRAY length; null arrays have length 0.
+func (d *kafkaDecoder) arrayLen() int {
+	n := d.int32()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+func (d *kafkaDecoder) int32Array() {
+	for i := d.arrayLen(); i > 0; i-- {
+		d.int32()
+	}
+}
+
+// encodeRecordBatch encodes records as a v2 record batch (magic 2) without compression.
+func encodeRecordBatch(records []kafkaRecord) []byte {
+	base := records[0].Timestamp
+	maxTimestamp := base
+	for _, r := range records {
+		if r.Timestamp > maxTimestamp {
+			maxTimestamp = r.Timestamp
+		}
+	}
+
+	// Everything from attributes onwards is covered by the CRC
+	var body kafkaEncoder
+	body.int16(0) // attributes: no compression, create time
+	body.int32(int32(len(records) - 1))
+	body.int64(base)
+	body.int64(maxTimestamp)
+	body.int64(-1) // producer id: not idempotent
+	body.int16(-1) // producer epoch
+	body.int32(-1) // base sequence
+	body.int32(int32(len(records)))
+	for i, r := range records {
+		var rec kafkaEncoder
+		rec.int8(0) // attributes
+		rec.varint(r.Timestamp - base)
+		rec.varint(int64(i))
+		rec.varbytes(r.Key)
+		rec.varbytes(r.Value)
+		rec.varint(int64(len(r.Headers)))
+		for _, h := range r.Headers {
+			rec.varbytes([]byte(h.Key))
+			rec.varbytes(h.Value)
+		}
+		body.varint(int64(rec.buf.Len()))
+		body.buf.Write(rec.buf.Bytes())
+	}
+
+	var batch kafkaEncoder
+	batch.int64(0)                                 // base offset, assigned by the broker
+	batch.int32(int32(4 + 1 + 4 + body.buf.Len())) // length after this field
+	batch.int32(-1)                                // partition leader epoch
+	batch.int8(2)                                  // magic
+	batch.int32(int32(crc32.Checksum(body.buf.Bytes(), crc32.MakeTable(crc32.Castagnoli))))
+	batch.buf.Write(body.buf.Bytes())
+	return batch.buf.Bytes()
+}
+
+// kafkaPartition picks the partition for a key like the Java client's default
+// partitioner, so consumers of the same topic agree on key placement.
+func kafkaPartition(key []byte, partitions int) int {
+	return int(murmur2(key)&0x7fffffff) % partitions
+}
+
+// murmur2 is the 32-bit MurmurHash2 variant used by Kafka clients.
+func murmur2(data []byte) uint32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+
+	length := len(data)
+	h := seed ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
@@ -0,0 +1,319 @@
+package publisher
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// producedRecord is a record received by fakeKafkaBroker.
+type producedRecord struct {
+	Topic     string
+	Partition int32
+	Key       string
+	Value     string
+	Headers   map[string]string
+	Timestamp int64
+}
+
+// fakeKafkaBroker is a single-node, in-process stand-in for a Kafka broker. It answers
+// Metadata v4 and Produce v3 requests and records what was produced.
+type fakeKafkaBroker struct {
+	t          *testing.T
+	listener   net.Listener
+	topic      string
+	partitions int
+
+	mu          sync.Mutex
+	records     []producedRecord
+	produceErr  int16 // Error code returned by the next produce, then cleared
+	metadataReq int
+}
+
+func newFakeKafkaBroker(t *testing.T, topic string, partitions int) *fakeKafkaBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &fakeKafkaBroker{t: t, listener: listener, topic: topic, partitions: partitions}
+	go b.serve()
+	t.Cleanup(func() { listener.Close() })
+	return b
+}
+
+func (b *fakeKafkaBroker) addr() string { return b.listener.Addr().String() }
+
+func (b *fakeKafkaBroker) produced() []producedRecord {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]producedRecord(nil), b.records...)
+}
+
+func (b *fakeKafkaBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *fakeKafkaBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		frame := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, frame); err != nil {
+			return
+		}
+
+		req := &kafkaDecoder{data: frame}
+		apiKey, apiVersion, correlationID := req.int16(), req.int16(), req.int32()
+		req.string() // client id
+
+		var resp kafkaEncoder
+		resp.int32(correlationID)
+		switch {
+		case apiKey == kafkaAPIMetadata && apiVersion == kafkaMetadataVersion:
+			b.metadata(&resp)
+		case apiKey == kafkaAPIProduce && apiVersion == kafkaProduceVersion:
+			b.produce(req, &resp)
+		default:
+			b.t.Errorf("unexpected request api=%d version=%d", apiKey, apiVersion)
+			return
+		}
+
+		var out kafkaEncoder
+		out.bytes(resp.buf.Bytes())
+		if _, err := conn.Write(out.buf.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+func (b *fakeKafkaBroker) metadata(resp *kafkaEncoder) {
+	b.mu.Lock()
+	b.metadataReq++
+	b.mu.Unlock()
+
+	host, portStr, _ := net.SplitHostPort(b.addr())
+	port, _ := strconv.Atoi(portStr)
+
+	resp.int32(0) // throttle time
+	resp.int32(1) // brokers
+	resp.int32(1)
+	resp.string(host)
+	resp.int32(int32(port))
+	resp.nullString() // rack
+	resp.nullString() // cluster id
+	resp.int32(1)     // controller
+	resp.int32(1)     // topics
+	resp.int16(0)
+	resp.string(b.topic)
+	resp.bool(false)
+	resp.int32(int32(b.partitions))
+	for i := b.partitions - 1; i >= 0; i-- { // brokers do not promise partition order
+		resp.int16(0)
+		resp.int32(int32(i))
+		resp.int32(1) // leader
+		resp.int32(1) // replicas
+		resp.int32(1)
+		resp.int32(1) // isr
+		resp.int32(1)
+	}
+}
+
+func (b *fakeKafkaBroker) produce(req *kafkaDecoder, resp *kafkaEncoder) {
+	req.string() // transactional id
+	acks := req.int16()
+	assert.Equal(b.t, KafkaAcksAll, acks)
+	req.int32() // timeout
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	code := b.produceErr
+	b.produceErr = 0
+
+	topics := req.arrayLen()
+	resp.int32(int32(topics))
+	for ; topics > 0; topics-- {
+		topic := req.string()
+		partitions := req.arrayLen()
+		resp.string(topic)
+		resp.int32(int32(partitions))
+		for ; partitions > 0; partitions-- {
+			partition := req.int32()
+			batch := req.next(int(req.int32()))
+			if code == 0 {
+				for _, r := range decodeRecordBatch(b.t, batch) {
+					r.Topic, r.Partition = topic, partition
+					b.records = append(b.records, r)
+				}
+			}
+			resp.int32(partition)
+			resp.int16(code)
+			resp.int64(int64(len(b.records)))
+			resp.int64(-1)
+		}
+	}
+	resp.int32(0) // throttle time
+}
+
+// decodeRecordBatch parses a v2 record batch and checks its CRC. It runs on the
+// broker goroutine, so failures are reported with assert rather than require.
+func decodeRecordBatch(t *testing.T, batch []byte) []producedRecord {
+	d := &kafkaDecoder{data: batch}
+	d.int64() // base offset
+	length := d.int32()
+	assert.Equal(t, int(length), len(d.data))
+	d.int32() // leader epoch
+	assert.Equal(t, int8(2), d.int8(), "magic")
+	crc := uint32(d.int32())
+	assert.Equal(t, crc32.Checksum(d.data, crc32.MakeTable(crc32.Castagnoli)), crc, "crc")
+
+	d.int16() // attributes
+	d.int32() // last offset delta
+	baseTimestamp := d.int64()
+	d.int64() // max timestamp
+	d.int64() // producer id
+	d.int16() // producer epoch
+	d.int32() // base sequence
+
+	varint := func() int64 {
+		v, n := binary.Varint(d.data)
+		d.next(n)
+		return v
+	}
+	varstring := func() string {
+		return string(d.next(int(varint())))
+	}
+
+	var records []producedRecord
+	for count := d.int32(); count > 0; count-- {
+		varint() // length
+		d.int8() // attributes
+		record := producedRecord{Timestamp: baseTimestamp + varint(), Headers: map[string]string{}}
+		varint() // offset delta
+		record.Key = varstring()
+		record.Value = varstring()
+		for headers := varint(); headers > 0; headers-- {
+			key := varstring()
+			record.Headers[key] = varstring()
+		}
+		records = append(records, record)
+	}
+	assert.NoError(t, d.err)
+	return records
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	msg := &contracts.Message{
+		EventID:        "event-1",
+		EventType:      "product.created",
+		AggregateID:    "product-1",
+		SequenceNumber: 3,
+		Payload:        []byte(`{"schema_version":1}`),
+		CreatedAt:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("produces a CloudEvents record keyed by aggregate", func(t *testing.T) {
+		broker := newFakeKafkaBroker(t, "product-events", 6)
+		pub := NewKafkaPublisher(KafkaConfig{Brokers: []string{broker.addr()}, Topic: "product-events", Source: "/test"})
+		defer pub.Close()
+
+		require.NoError(t, pub.Publish(ctx, msg))
+
+		records := broker.produced()
+		require.Len(t, records, 1)
+		record := records[0]
+		assert.Equal(t, "product-events", record.Topic)
+		assert.Equal(t, int32(kafkaPartition([]byte("product-1"), 6)), record.Partition)
+		assert.Equal(t, "product-1", record.Key)
+		assert.JSONEq(t, `{"schema_version":1}`, record.Value)
+		assert.Equal(t, msg.CreatedAt.UnixMilli(), record.Timestamp)
+		assert.Equal(t, "event-1", record.Headers["ce_id"])
+		assert.Equal(t, "product.created", record.Headers["ce_type"])
+		assert.Equal(t, "/test", record.Headers["ce_source"])
+		assert.Equal(t, "product-1", record.Headers["ce_partitionkey"])
+		assert.Equal(t, "3", record.Headers["ce_sequence"])
+		assert.Equal(t, "application/json", record.Headers["content-type"])
+	})
+
+	t.Run("events of an aggregate share a partition", func(t *testing.T) {
+		broker := newFakeKafkaBroker(t, "product-events", 12)
+		pub := NewKafkaPublisher(KafkaConfig{Brokers: []string{broker.addr()}, Topic: "product-events"})
+		defer pub.Close()
+
+		for _, aggregateID := range []string{"a", "b", "a", "c", "a"} {
+			m := *msg
+			m.AggregateID = aggregateID
+			require.NoError(t, pub.Publish(ctx, &m))
+		}
+
+		partitions := map[string]int32{}
+		for _, record := range broker.produced() {
+			if p, ok := partitions[record.Key]; ok {
+				assert.Equal(t, p, record.Partition, "aggregate %s moved partition", record.Key)
+			}
+			partitions[record.Key] = record.Partition
+		}
+		assert.Len(t, partitions, 3)
+	})
+
+	t.Run("broker errors fail the publish and refresh metadata", func(t *testing.T) {
+		broker := newFakeKafkaBroker(t, "product-events", 1)
+		pub := NewKafkaPublisher(KafkaConfig{Brokers: []string{broker.addr()}, Topic: "product-events"})
+		defer pub.Close()
+
+		broker.mu.Lock()
+		broker.produceErr = 6 // NOT_LEADER_OR_FOLLOWER
+		broker.mu.Unlock()
+
+		err := pub.Publish(ctx, msg)
+		assert.ErrorContains(t, err, "NOT_LEADER_OR_FOLLOWER")
+
+		require.NoError(t, pub.Publish(ctx, msg))
+		assert.Len(t, broker.produced(), 1)
+		broker.mu.Lock()
+		assert.Equal(t, 2, broker.metadataReq)
+		broker.mu.Unlock()
+	})
+
+	t.Run("unknown topic is an error", func(t *testing.T) {
+		broker := newFakeKafkaBroker(t, "product-events", 1)
+		pub := NewKafkaPublisher(KafkaConfig{Brokers: []string{broker.addr()}, Topic: "other"})
+		defer pub.Close()
+
+		assert.ErrorContains(t, pub.Publish(ctx, msg), "topic other not found")
+	})
+}
+
+func TestMurmur2(t *testing.T) {
+	// Reference values from the Java client's partitioner tests
+	cases := map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	}
+	for input, want := range cases {
+		assert.Equal(t, want, int32(murmur2([]byte(input))), input)
+	}
+}
@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"context"
+	"log"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// LogPublisher writes events to the standard logger.
+// Useful for local development and for verifying the relay end to end.
+type LogPublisher struct{}
+
+// NewLogPublisher creates a new LogPublisher.
+func NewLogPublisher() contracts.Publisher {
+	return &LogPublisher{}
+}
+
+// Publish logs the event.
+func (p *LogPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	if msg.Replayed {
+		log.Printf("event %s type=%s aggregate=%s replay_of=%s payload=%s", msg.EventID, msg.EventType, msg.AggregateID, msg.OriginalEventID, msg.Payload)
+		return nil
+	}
+	log.Printf("event %s type=%s aggregate=%s payload=%s", msg.EventID, msg.EventType, msg.AggregateID, msg.Payload)
+	return nil
+}
+
+// Close is a no-op.
+func (p *LogPublisher) Close() error {
+	return nil
+}
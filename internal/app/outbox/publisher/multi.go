@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// MultiPublisher publishes each event to several backends.
+// An event counts as published only when every backend accepted it; on failure the
+// relay retries the whole event, so backends must tolerate duplicates (the relay is
+// at-least-once anyway).
+type MultiPublisher struct {
+	publishers []contracts.Publisher
+}
+
+// NewMultiPublisher creates a new MultiPublisher.
+func NewMultiPublisher(publishers ...contracts.Publisher) contracts.Publisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+// Publish sends the event to every backend and joins their errors.
+func (p *MultiPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	var errs []error
+	for _, pub := range p.publishers {
+		if err := pub.Publish(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes every backend.
+func (p *MultiPublisher) Close() error {
+	var errs []error
+	for _, pub := range p.publishers {
+		if err := pub.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
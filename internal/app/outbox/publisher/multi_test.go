@@ -0,0 +1,51 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+type recordingPublisher struct {
+	published []string
+	err       error
+	closed    bool
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	p.published = append(p.published, msg.EventID)
+	return p.err
+}
+
+func (p *recordingPublisher) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestMultiPublisher(t *testing.T) {
+	t.Run("publishes to every backend", func(t *testing.T) {
+		a, b := &recordingPublisher{}, &recordingPublisher{}
+		pub := NewMultiPublisher(a, b)
+
+		assert.NoError(t, pub.Publish(context.Background(), &contracts.Message{EventID: "e1"}))
+		assert.NoError(t, pub.Close())
+
+		assert.Equal(t, []string{"e1"}, a.published)
+		assert.Equal(t, []string{"e1"}, b.published)
+		assert.True(t, a.closed)
+		assert.True(t, b.closed)
+	})
+
+	t.Run("any backend failure fails the event", func(t *testing.T) {
+		a, b := &recordingPublisher{err: errors.New("broker down")}, &recordingPublisher{}
+		pub := NewMultiPublisher(a, b)
+
+		err := pub.Publish(context.Background(), &contracts.Message{EventID: "e1"})
+		assert.ErrorContains(t, err, "broker down")
+		assert.Equal(t, []string{"e1"}, b.published, "remaining backends are still attempted")
+	})
+}
@@ -0,0 +1,362 @@
+package publisher
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// NATSConfig configures a NATSPublisher.
+type NATSConfig struct {
+	URL       string        // nats://[user:password@]host[:port]
+	Subject   string        // Subject prefix; the aggregate ID is appended as the last token
+	JetStream bool          // Wait for a JetStream stream ack instead of a server round trip
+	Source    string        // CloudEvents source attribute
+	Timeout   time.Duration // Dial and publish timeout
+}
+
+// NATSPublisher publishes each event to a NATS server using the core text protocol
+// over plaintext TCP (NATS 2.2+, which supports headers).
+//
+// Messages follow the CloudEvents NATS binding in binary mode: the body is the outbox
+// payload and attributes are ce-* headers. The subject is <Subject>.<aggregate_id>,
+// so consumers and stream subject mappings can partition on the last token.
+// Nats-Msg-Id is set to the event ID for JetStream deduplication.
+//
+// Without JetStream a publish is confirmed by a PING/PONG round trip, which proves the
+// server accepted it but not that any subscriber received it. With JetStream the
+// publisher waits for the stream's ack.
+type NATSPublisher struct {
+	config NATSConfig
+
+	mu         sync.Mutex
+	conn       net.Conn
+	reader     *bufio.Reader
+	maxPayload int
+	inbox      string // Reply subject prefix for JetStream acks
+	replies    int64
+}
+
+// natsOp is one operation read from the server.
+type natsOp struct {
+	name    string
+	args    []string
+	headers []byte // HMSG only
+	payload []byte // MSG and HMSG only
+}
+
+// NewNATSPublisher creates a new NATSPublisher. The server is contacted on first publish.
+func NewNATSPublisher(config NATSConfig) contracts.Publisher {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &NATSPublisher{
+		config: config,
+	}
+}
+
+// Publish sends the event and waits for the server (or JetStream) to confirm it.
+// Any failure drops the connection; the next publish reconnects.
+func (p *NATSPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.publish(ctx, msg); err != nil {
+		p.disconnect()
+		return err
+	}
+	return nil
+}
+
+func (p *NATSPublisher) publish(ctx context.Context, msg *contracts.Message) error {
+	if msg.AggregateID == "" || strings.ContainsAny(msg.AggregateID, " \t\r\n.*>") {
+		return fmt.Errorf("nats: aggregate id %q is not a valid subject token", msg.AggregateID)
+	}
+
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if err := p.setDeadline(ctx); err != nil {
+		return err
+	}
+
+	event := cloudevents.FromMessage(msg, p.config.Source)
+	var headers bytes.Buffer
+	headers.WriteString("NATS/1.0\r\n")
+	headers.WriteString("Nats-Msg-Id: " + msg.EventID + "\r\n")
+	attrs := event.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		headers.WriteString("ce-" + name + ": " + attrs[name] + "\r\n")
+	}
+	if event.DataContentType != "" {
+		headers.WriteString("Content-Type: " + event.DataContentType + "\r\n")
+	}
+	headers.WriteString("\r\n")
+
+	size := headers.Len() + len(msg.Payload)
+	if p.maxPayload > 0 && size > p.maxPayload {
+		return fmt.Errorf("nats: message of %d bytes exceeds the server limit of %d", size, p.maxPayload)
+	}
+
+	subject := p.config.Subject + "." + msg.AggregateID
+	reply := ""
+	if p.config.JetStream {
+		p.replies++
+		reply = p.inbox + "." + strconv.FormatInt(p.replies, 10) + " "
+	}
+
+	var cmd bytes.Buffer
+	fmt.Fprintf(&cmd, "HPUB %s %s%d %d\r\n", subject, reply, headers.Len(), size)
+	cmd.Write(headers.Bytes())
+	cmd.Write(msg.Payload)
+	cmd.WriteString("\r\n")
+	if !p.config.JetStream {
+		cmd.WriteString("PING\r\n")
+	}
+	if _, err := p.conn.Write(cmd.Bytes()); err != nil {
+		return fmt.Errorf("nats: failed to publish: %w", err)
+	}
+
+	if p.config.JetStream {
+		return p.awaitAck(strings.TrimSpace(reply))
+	}
+	return p.awaitPong()
+}
+
+// connect dials the server and performs the INFO/CONNECT handshake.
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	u, err := url.Parse(p.config.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("nats: invalid url %q", p.config.URL)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	dialer := net.Dialer{Timeout: p.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("nats: failed to connect to %s: %w", addr, err)
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+	if err := p.setDeadline(ctx); err != nil {
+		return err
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return err
+	}
+	if op.name != "INFO" {
+		return fmt.Errorf("nats: expected INFO, got %s", op.name)
+	}
+	var info struct {
+		Headers     bool `json:"headers"`
+		TLSRequired bool `json:"tls_required"`
+		MaxPayload  int  `json:"max_payload"`
+	}
+	if err := json.Unmarshal([]byte(strings.Join(op.args, " ")), &info); err != nil {
+		return fmt.Errorf("nats: invalid INFO: %w", err)
+	}
+	if info.TLSRequired {
+		return errors.New("nats: server requires TLS, which is not supported")
+	}
+	if !info.Headers {
+		return errors.New("nats: server does not support headers (NATS 2.2+ required)")
+	}
+	p.maxPayload = info.MaxPayload
+
+	options := map[string]interface{}{
+		"verbose":       false,
+		"pedantic":      false,
+		"lang":          "go",
+		"version":       "procat-outbox",
+		"protocol":      1,
+		"headers":       true,
+		"no_responders": true,
+	}
+	if u.User != nil {
+		if password, ok := u.User.Password(); ok {
+			options["user"] = u.User.Username()
+			options["pass"] = password
+		} else {
+			options["auth_token"] = u.User.Username()
+		}
+	}
+	connectJSON, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("nats: failed to encode CONNECT: %w", err)
+	}
+
+	var cmd bytes.Buffer
+	cmd.WriteString("CONNECT " + string(connectJSON) + "\r\n")
+	if p.config.JetStream {
+		p.inbox = "_INBOX." + strings.ReplaceAll(uuid.New().String(), "-", "")
+		cmd.WriteString("SUB " + p.inbox + ".* 1\r\n")
+	}
+	cmd.WriteString("PING\r\n")
+	if _, err := conn.Write(cmd.Bytes()); err != nil {
+		return fmt.Errorf("nats: failed to send CONNECT: %w", err)
+	}
+	return p.awaitPong()
+}
+
+// awaitPong waits for the PONG answering our PING.
+func (p *NATSPublisher) awaitPong() error {
+	for {
+		op, err := p.next()
+		if err != nil {
+			return err
+		}
+		if op.name == "PONG" {
+			return nil
+		}
+	}
+}
+
+// awaitAck waits for the JetStream reply on the given subject. Replies to earlier,
+// abandoned publishes are skipped.
+func (p *NATSPublisher) awaitAck(reply string) error {
+	for {
+		op, err := p.next()
+		if err != nil {
+			return err
+		}
+		if (op.name != "MSG" && op.name != "HMSG") || op.args[0] != reply {
+			continue
+		}
+
+		if strings.HasPrefix(string(op.headers), "NATS/1.0 503") {
+			return errors.New("nats: no JetStream stream captures the subject")
+		}
+		var ack struct {
+			Stream string `json:"stream"`
+			Error  *struct {
+				Code        int    `json:"code"`
+				Description string `json:"description"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(op.payload, &ack); err != nil {
+			return fmt.Errorf("nats: invalid JetStream ack: %w", err)
+		}
+		if ack.Error != nil {
+			return fmt.Errorf("nats: JetStream rejected the message: %s (%d)", ack.Error.Description, ack.Error.Code)
+		}
+		return nil
+	}
+}
+
+// next reads the next server operation, answering server PINGs on the way.
+func (p *NATSPublisher) next() (*natsOp, error) {
+	for {
+		line, err := p.reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("nats: failed to read from server: %w", err)
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		op := &natsOp{name: strings.ToUpper(fields[0]), args: fields[1:]}
+
+		switch op.name {
+		case "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return nil, fmt.Errorf("nats: failed to answer PING: %w", err)
+			}
+			continue
+		case "+OK":
+			continue
+		case "-ERR":
+			return nil, fmt.Errorf("nats: server error: %s", strings.Trim(strings.Join(op.args, " "), "'"))
+		case "MSG", "HMSG":
+			if err := p.readPayload(op); err != nil {
+				return nil, err
+			}
+		}
+		return op, nil
+	}
+}
+
+// readPayload reads the body of a MSG (subject sid [reply] size) or
+// HMSG (subject sid [reply] header-size total-size).
+func (p *NATSPublisher) readPayload(op *natsOp) error {
+	minArgs := 3
+	if op.name == "HMSG" {
+		minArgs = 4
+	}
+	if len(op.args) < minArgs {
+		return fmt.Errorf("nats: malformed %s", op.name)
+	}
+
+	total, err := strconv.Atoi(op.args[len(op.args)-1])
+	if err != nil || total < 0 {
+		return fmt.Errorf("nats: malformed %s", op.name)
+	}
+	headerSize := 0
+	if op.name == "HMSG" {
+		headerSize, err = strconv.Atoi(op.args[len(op.args)-2])
+		if err != nil || headerSize < 0 || headerSize > total {
+			return fmt.Errorf("nats: malformed %s", op.name)
+		}
+	}
+
+	body := make([]byte, total+2) // trailing CRLF
+	if _, err := io.ReadFull(p.reader, body); err != nil {
+		return fmt.Errorf("nats: failed to read message: %w", err)
+	}
+	op.headers = body[:headerSize]
+	op.payload = body[headerSize:total]
+	return nil
+}
+
+func (p *NATSPublisher) setDeadline(ctx context.Context) error {
+	deadline := time.Now().Add(p.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := p.conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("nats: %w", err)
+	}
+	return nil
+}
+
+// disconnect closes the connection, if any.
+func (p *NATSPublisher) disconnect() {
+	if p.conn != nil {
+		p.conn.Close()
+		p.conn = nil
+		p.reader = nil
+	}
+}
+
+// Close closes the connection.
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.disconnect()
+	return nil
+}
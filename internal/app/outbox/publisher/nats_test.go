@@ -0,0 +1,216 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// natsMessage is a message received by fakeNATSServer.
+type natsMessage struct {
+	Subject string
+	Reply   string
+	Headers map[string]string
+	Payload string
+}
+
+// fakeNATSServer is an in-process stand-in for a NATS server speaking the core text
+// protocol. With jetStream set it answers publishes that carry a reply subject with
+// ack (or, if set, the JetStream error) like a stream would.
+type fakeNATSServer struct {
+	t         *testing.T
+	listener  net.Listener
+	jetStream bool
+
+	mu       sync.Mutex
+	connect  string
+	messages []natsMessage
+	ackError string // JetStream error description for the next publish, then cleared
+}
+
+func newFakeNATSServer(t *testing.T, jetStream bool) *fakeNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeNATSServer{t: t, listener: listener, jetStream: jetStream}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *fakeNATSServer) url() string { return "nats://" + s.listener.Addr().String() }
+
+func (s *fakeNATSServer) received() []natsMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]natsMessage(nil), s.messages...)
+}
+
+func (s *fakeNATSServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeNATSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	// Ping right away: clients must answer server PINGs at any time
+	fmt.Fprint(conn, `INFO {"server_id":"fake","headers":true,"max_payload":1048576}`+"\r\nPING\r\n")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "CONNECT":
+			s.mu.Lock()
+			s.connect = strings.TrimSpace(strings.TrimPrefix(line, "CONNECT"))
+			s.mu.Unlock()
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "HPUB":
+			if !s.hpub(conn, reader, fields[1:]) {
+				return
+			}
+		}
+	}
+}
+
+// hpub reads HPUB <subject> [reply] <header size> <total size> and its body.
+func (s *fakeNATSServer) hpub(conn net.Conn, reader *bufio.Reader, args []string) bool {
+	msg := natsMessage{Subject: args[0], Headers: map[string]string{}}
+	if len(args) == 4 {
+		msg.Reply = args[1]
+	}
+	headerSize, _ := strconv.Atoi(args[len(args)-2])
+	total, _ := strconv.Atoi(args[len(args)-1])
+
+	body := make([]byte, total+2)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return false
+	}
+	lines := strings.Split(string(body[:headerSize]), "\r\n")
+	assert.Equal(s.t, "NATS/1.0", lines[0])
+	for _, header := range lines[1:] {
+		if name, value, ok := strings.Cut(header, ": "); ok {
+			msg.Headers[name] = value
+		}
+	}
+	msg.Payload = string(body[headerSize:total])
+
+	s.mu.Lock()
+	ackError := s.ackError
+	s.ackError = ""
+	if ackError == "" {
+		s.messages = append(s.messages, msg)
+	}
+	seq := len(s.messages)
+	s.mu.Unlock()
+
+	if s.jetStream && msg.Reply != "" {
+		ack := fmt.Sprintf(`{"stream":"EVENTS","seq":%d}`, seq)
+		if ackError != "" {
+			ack = fmt.Sprintf(`{"error":{"code":400,"description":%q}}`, ackError)
+		}
+		fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", msg.Reply, len(ack), ack)
+	}
+	return true
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	msg := &contracts.Message{
+		EventID:     "event-1",
+		EventType:   "product.created",
+		AggregateID: "product-1",
+		Payload:     []byte(`{"schema_version":1}`),
+		CreatedAt:   time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("publishes a CloudEvents message on the aggregate subject", func(t *testing.T) {
+		server := newFakeNATSServer(t, false)
+		pub := NewNATSPublisher(NATSConfig{URL: server.url(), Subject: "procat.events", Source: "/test"})
+		defer pub.Close()
+
+		require.NoError(t, pub.Publish(ctx, msg))
+		require.NoError(t, pub.Publish(ctx, msg))
+
+		messages := server.received()
+		require.Len(t, messages, 2)
+		received := messages[0]
+		assert.Equal(t, "procat.events.product-1", received.Subject)
+		assert.Empty(t, received.Reply)
+		assert.Equal(t, "event-1", received.Headers["Nats-Msg-Id"])
+		assert.Equal(t, "event-1", received.Headers["ce-id"])
+		assert.Equal(t, "product.created", received.Headers["ce-type"])
+		assert.Equal(t, "/test", received.Headers["ce-source"])
+		assert.Equal(t, "product-1", received.Headers["ce-partitionkey"])
+		assert.Equal(t, "application/json", received.Headers["Content-Type"])
+		assert.JSONEq(t, `{"schema_version":1}`, received.Payload)
+	})
+
+	t.Run("passes credentials from the url", func(t *testing.T) {
+		server := newFakeNATSServer(t, false)
+		pub := NewNATSPublisher(NATSConfig{URL: strings.Replace(server.url(), "nats://", "nats://relay:secret@", 1), Subject: "events"})
+		defer pub.Close()
+
+		require.NoError(t, pub.Publish(ctx, msg))
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		assert.Contains(t, server.connect, `"user":"relay"`)
+		assert.Contains(t, server.connect, `"pass":"secret"`)
+		assert.Contains(t, server.connect, `"headers":true`)
+	})
+
+	t.Run("waits for the JetStream ack", func(t *testing.T) {
+		server := newFakeNATSServer(t, true)
+		pub := NewNATSPublisher(NATSConfig{URL: server.url(), Subject: "events", JetStream: true})
+		defer pub.Close()
+
+		require.NoError(t, pub.Publish(ctx, msg))
+		messages := server.received()
+		require.Len(t, messages, 1)
+		assert.True(t, strings.HasPrefix(messages[0].Reply, "_INBOX."), messages[0].Reply)
+
+		server.mu.Lock()
+		server.ackError = "maximum messages exceeded"
+		server.mu.Unlock()
+		err := pub.Publish(ctx, msg)
+		assert.ErrorContains(t, err, "maximum messages exceeded")
+
+		// The failed publish dropped the connection; the next one reconnects
+		require.NoError(t, pub.Publish(ctx, msg))
+		assert.Len(t, server.received(), 2)
+	})
+
+	t.Run("rejects aggregate ids that are not subject tokens", func(t *testing.T) {
+		pub := NewNATSPublisher(NATSConfig{URL: "nats://127.0.0.1:1", Subject: "events"})
+		bad := *msg
+		bad.AggregateID = "a.b"
+		assert.ErrorContains(t, pub.Publish(ctx, &bad), "not a valid subject token")
+	})
+}
@@ -0,0 +1,48 @@
+package list_failed_events
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// Request contains filtering parameters for listing failed events.
+type Request struct {
+	EventType    string     // Optional
+	AggregateID  string     // Optional
+	FailedAfter  *time.Time // Optional, inclusive
+	FailedBefore *time.Time // Optional, exclusive
+	Limit        int        // Max number of events to return (default: 50)
+}
+
+// Query handles the list failed events query use case.
+type Query struct {
+	repo contracts.DeadLetterRepository
+}
+
+// NewQuery creates a new list failed events query.
+func NewQuery(repo contracts.DeadLetterRepository) *Query {
+	return &Query{
+		repo: repo,
+	}
+}
+
+// Execute retrieves failed events with their attempt history, most recently failed first.
+func (q *Query) Execute(ctx context.Context, req *Request) ([]*contracts.FailedEventDTO, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50 // Default limit
+	}
+	if limit > 500 {
+		limit = 500 // Max limit
+	}
+
+	return q.repo.ListFailed(ctx, &contracts.FailedEventFilter{
+		EventType:    req.EventType,
+		AggregateID:  req.AggregateID,
+		FailedAfter:  req.FailedAfter,
+		FailedBefore: req.FailedBefore,
+		Limit:        limit,
+	})
+}
@@ -0,0 +1,195 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// maxErrorMessageLength bounds the error_message column so a verbose publisher error
+// cannot bloat outbox rows.
+const maxErrorMessageLength = 1024
+
+// RetryPolicy controls how failed publishes are retried.
+type RetryPolicy struct {
+	MaxRetries     int64         // Attempts before an event is marked failed
+	InitialBackoff time.Duration // Delay after the first failure
+	MaxBackoff     time.Duration // Upper bound for the exponential delay
+}
+
+// Backoff returns the delay before the given retry attempt (1-based).
+// The delay doubles on each attempt and is capped at MaxBackoff.
+func (p RetryPolicy) Backoff(attempt int64) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := p.InitialBackoff
+	for i := int64(1); i < attempt; i++ {
+		delay *= 2
+		if delay >= p.MaxBackoff || delay <= 0 {
+			return p.MaxBackoff
+		}
+	}
+
+	if delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+// Config holds relay settings.
+type Config struct {
+	BatchSize     int           // Maximum events claimed per poll
+	PollInterval  time.Duration // Sleep between polls when the outbox is drained
+	LeaseDuration time.Duration // How long a claimed event stays reserved for this relay
+	Retry         RetryPolicy
+
+	// BlockedCheckInterval is how often aggregates blocked behind a failed event are
+	// logged, zero to disable the check
+	BlockedCheckInterval time.Duration
+}
+
+// DefaultConfig returns sensible defaults for the relay.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:     100,
+		PollInterval:  time.Second,
+		LeaseDuration: 30 * time.Second,
+		Retry: RetryPolicy{
+			MaxRetries:     10,
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Minute,
+		},
+		BlockedCheckInterval: 5 * time.Minute,
+	}
+}
+
+// Relay moves pending outbox events to a Publisher and records the outcome.
+type Relay struct {
+	repo      contracts.RelayRepository
+	publisher contracts.Publisher
+	clock     clock.Clock
+	config    Config
+}
+
+// NewRelay creates a new Relay.
+func NewRelay(repo contracts.RelayRepository, publisher contracts.Publisher, clk clock.Clock, config Config) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		clock:     clk,
+		config:    config,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled.
+// A non-empty batch is followed immediately by another poll: only the head event of
+// each aggregate is claimed per batch, so successors become publishable right away.
+// Every BlockedCheckInterval it also reports aggregates blocked behind a failed event.
+func (r *Relay) Run(ctx context.Context) error {
+	var nextBlockedCheck time.Time
+	for {
+		processed, err := r.ProcessBatch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Outbox relay batch failed: %v", err)
+		}
+
+		if r.config.BlockedCheckInterval > 0 && !r.clock.Now().Before(nextBlockedCheck) {
+			if _, err := r.ReportBlocked(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Outbox blocked aggregate check failed: %v", err)
+			}
+			nextBlockedCheck = r.clock.Now().Add(r.config.BlockedCheckInterval)
+		}
+
+		if err == nil && processed > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// ProcessBatch claims one batch of events and publishes them in created_at order.
+// Returns the number of events claimed.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	now := r.clock.Now()
+
+	events, err := r.repo.ClaimPending(ctx, r.config.BatchSize, now, now.Add(r.config.LeaseDuration))
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, event := range events {
+		if err := r.processEvent(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(events), errors.Join(errs...)
+}
+
+// ReportBlocked logs aggregates whose later events are held back by a failed event.
+// Such events are not published until the failed event is retried or cleaned up.
+// Returns the number of blocked aggregates found, at most BatchSize.
+func (r *Relay) ReportBlocked(ctx context.Context) (int, error) {
+	blocked, err := r.repo.ListBlockedAggregates(ctx, r.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, b := range blocked {
+		log.Printf("Outbox aggregate %s is blocked: %d events wait behind failed event %s (%s); retry or clean up the failed event",
+			b.AggregateID, b.Waiting, b.FailedEventID, b.FailedEventType)
+	}
+	return len(blocked), nil
+}
+
+// processEvent publishes a single event and records success, retry or failure.
+// The returned error is only for outbox bookkeeping failures; publish failures are
+// recorded on the event itself.
+func (r *Relay) processEvent(ctx context.Context, event *m_outbox.Data) error {
+	msg, err := contracts.NewMessage(event)
+	if err == nil {
+		err = r.publisher.Publish(ctx, msg)
+	}
+
+	now := r.clock.Now()
+	if err == nil {
+		return r.repo.MarkCompleted(ctx, event.EventID, now)
+	}
+
+	retryCount := event.RetryCount + 1
+	errMsg := truncate(err.Error(), maxErrorMessageLength)
+
+	if retryCount >= r.config.Retry.MaxRetries {
+		log.Printf("Outbox event %s (%s) failed permanently after %d attempts: %v", event.EventID, event.EventType, retryCount, err)
+		return r.repo.MarkFailed(ctx, event.EventID, retryCount, errMsg, now)
+	}
+
+	nextAttempt := now.Add(r.config.Retry.Backoff(retryCount))
+	log.Printf("Outbox event %s (%s) publish failed (attempt %d), retrying at %s: %v",
+		event.EventID, event.EventType, retryCount, nextAttempt.Format(time.RFC3339), err)
+
+	return r.repo.ScheduleRetry(ctx, event.EventID, retryCount, errMsg, nextAttempt)
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
@@ -0,0 +1,402 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// fakeRepo is an in-memory RelayRepository.
+type fakeRepo struct {
+	events []*m_outbox.Data
+}
+
+// add appends an event; events must be added in created_at order.
+func (r *fakeRepo) add(eventID, aggregateID string, createdAt time.Time) {
+	r.events = append(r.events, &m_outbox.Data{
+		EventID:     eventID,
+		EventType:   "product.created",
+		AggregateID: aggregateID,
+		Payload:     spanner.NullJSON{Value: map[string]interface{}{"id": eventID}, Valid: true},
+		Status:      m_outbox.StatusPending,
+		CreatedAt:   createdAt,
+	})
+}
+
+func (r *fakeRepo) get(eventID string) *m_outbox.Data {
+	for _, e := range r.events {
+		if e.EventID == eventID {
+			return e
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*m_outbox.Data, error) {
+	var claimed []*m_outbox.Data
+	blocked := make(map[string]bool) // Aggregates with an earlier incomplete event
+	for _, e := range r.events {
+		if len(claimed) == limit {
+			break
+		}
+		if blocked[e.AggregateID] {
+			continue
+		}
+		if e.Status != m_outbox.StatusCompleted {
+			blocked[e.AggregateID] = true
+		}
+		due := !e.NextAttemptAt.Valid || !e.NextAttemptAt.Time.After(now)
+		if (e.Status == m_outbox.StatusPending || e.Status == m_outbox.StatusProcessing) && due {
+			e.Status = m_outbox.StatusProcessing
+			e.NextAttemptAt = spanner.NullTime{Time: leaseUntil, Valid: true}
+			copied := *e
+			claimed = append(claimed, &copied)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *fakeRepo) MarkCompleted(ctx context.Context, eventID string, processedAt time.Time) error {
+	e := r.get(eventID)
+	e.Status = m_outbox.StatusCompleted
+	e.ProcessedAt = spanner.NullTime{Time: processedAt, Valid: true}
+	e.NextAttemptAt = spanner.NullTime{}
+	return nil
+}
+
+func (r *fakeRepo) ScheduleRetry(ctx context.Context, eventID string, retryCount int64, errMsg string, nextAttemptAt time.Time) error {
+	e := r.get(eventID)
+	e.Status = m_outbox.StatusPending
+	e.RetryCount = retryCount
+	e.ErrorMessage = spanner.NullString{StringVal: errMsg, Valid: true}
+	e.NextAttemptAt = spanner.NullTime{Time: nextAttemptAt, Valid: true}
+	return nil
+}
+
+func (r *fakeRepo) MarkFailed(ctx context.Context, eventID string, retryCount int64, errMsg string, failedAt time.Time) error {
+	e := r.get(eventID)
+	e.Status = m_outbox.StatusFailed
+	e.RetryCount = retryCount
+	e.ErrorMessage = spanner.NullString{StringVal: errMsg, Valid: true}
+	e.ProcessedAt = spanner.NullTime{Time: failedAt, Valid: true}
+	e.NextAttemptAt = spanner.NullTime{}
+	return nil
+}
+
+func (r *fakeRepo) ListBlockedAggregates(ctx context.Context, limit int) ([]*contracts.BlockedAggregate, error) {
+	var blocked []*contracts.BlockedAggregate
+	heads := make(map[string]*contracts.BlockedAggregate) // Aggregates with an earlier incomplete event
+	for _, e := range r.events {
+		if head, ok := heads[e.AggregateID]; ok {
+			if head != nil && (e.Status == m_outbox.StatusPending || e.Status == m_outbox.StatusProcessing) {
+				head.Waiting++
+			}
+			continue
+		}
+		switch e.Status {
+		case m_outbox.StatusCompleted:
+		case m_outbox.StatusFailed:
+			heads[e.AggregateID] = &contracts.BlockedAggregate{
+				AggregateID: e.AggregateID, FailedEventID: e.EventID, FailedEventType: e.EventType,
+			}
+		default:
+			heads[e.AggregateID] = nil
+		}
+	}
+	for _, e := range r.events {
+		if head := heads[e.AggregateID]; head != nil && head.FailedEventID == e.EventID && head.Waiting > 0 && len(blocked) < limit {
+			blocked = append(blocked, head)
+		}
+	}
+	return blocked, nil
+}
+
+// fakePublisher records published messages and fails while err is set.
+type fakePublisher struct {
+	published []*contracts.Message
+	err       error
+	failing   map[string]error // Per-aggregate failures
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	if p.err != nil {
+		return p.err
+	}
+	if err := p.failing[msg.AggregateID]; err != nil {
+		return err
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *fakePublisher) Close() error { return nil }
+
+func testConfig() Config {
+	return Config{
+		BatchSize:     10,
+		PollInterval:  time.Millisecond,
+		LeaseDuration: 30 * time.Second,
+		Retry: RetryPolicy{
+			MaxRetries:     3,
+			InitialBackoff: time.Second,
+			MaxBackoff:     10 * time.Second,
+		},
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	t.Run("doubles on each attempt", func(t *testing.T) {
+		assert.Equal(t, time.Second, policy.Backoff(1))
+		assert.Equal(t, 2*time.Second, policy.Backoff(2))
+		assert.Equal(t, 4*time.Second, policy.Backoff(3))
+		assert.Equal(t, 8*time.Second, policy.Backoff(4))
+	})
+
+	t.Run("caps at max backoff", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, policy.Backoff(5))
+		assert.Equal(t, 10*time.Second, policy.Backoff(100))
+	})
+
+	t.Run("treats non-positive attempt as first", func(t *testing.T) {
+		assert.Equal(t, time.Second, policy.Backoff(0))
+	})
+}
+
+func TestRelay_ProcessBatch(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("publishes in created_at order and marks completed", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("e1", "product-1", start)
+		repo.add("e2", "product-2", start.Add(time.Second))
+		pub := &fakePublisher{}
+		r := NewRelay(repo, pub, clock.NewMockClock(start), testConfig())
+
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		require.Len(t, pub.published, 2)
+		assert.Equal(t, "e1", pub.published[0].EventID)
+		assert.Equal(t, "e2", pub.published[1].EventID)
+		assert.JSONEq(t, `{"id":"e1"}`, string(pub.published[0].Payload))
+
+		assert.Equal(t, m_outbox.StatusCompleted, repo.get("e1").Status)
+		assert.True(t, repo.get("e1").ProcessedAt.Valid)
+	})
+
+	t.Run("schedules retry with backoff on failure", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("e1", "product-1", start)
+		pub := &fakePublisher{err: errors.New("broker unavailable")}
+		clk := clock.NewMockClock(start)
+		r := NewRelay(repo, pub, clk, testConfig())
+
+		_, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+
+		event := repo.get("e1")
+		assert.Equal(t, m_outbox.StatusPending, event.Status)
+		assert.Equal(t, int64(1), event.RetryCount)
+		assert.Equal(t, "broker unavailable", event.ErrorMessage.StringVal)
+		assert.Equal(t, start.Add(time.Second), event.NextAttemptAt.Time)
+
+		// Not eligible again until the backoff elapses
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		clk.Advance(time.Second)
+		pub.err = nil
+		n, err = r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, m_outbox.StatusCompleted, event.Status)
+	})
+
+	t.Run("marks failed after max retries", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("e1", "product-1", start)
+		pub := &fakePublisher{err: errors.New("rejected")}
+		clk := clock.NewMockClock(start)
+		r := NewRelay(repo, pub, clk, testConfig())
+
+		for i := 0; i < 3; i++ {
+			_, err := r.ProcessBatch(ctx)
+			require.NoError(t, err)
+			clk.Advance(time.Minute)
+		}
+
+		event := repo.get("e1")
+		assert.Equal(t, m_outbox.StatusFailed, event.Status)
+		assert.Equal(t, int64(3), event.RetryCount)
+		assert.True(t, event.ProcessedAt.Valid)
+
+		// Failed events are never claimed again
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("respects batch size", func(t *testing.T) {
+		repo := &fakeRepo{}
+		for i := 0; i < 5; i++ {
+			id := string(rune('a' + i))
+			repo.add(id, "product-"+id, start.Add(time.Duration(i)*time.Second))
+		}
+		pub := &fakePublisher{}
+		cfg := testConfig()
+		cfg.BatchSize = 2
+		r := NewRelay(repo, pub, clock.NewMockClock(start), cfg)
+
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Len(t, pub.published, 2)
+	})
+}
+
+func TestRelay_PerAggregateOrdering(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("claims only the head event of each aggregate", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("a1", "product-a", start)
+		repo.add("a2", "product-a", start.Add(time.Second))
+		repo.add("b1", "product-b", start.Add(2*time.Second))
+		pub := &fakePublisher{}
+		r := NewRelay(repo, pub, clock.NewMockClock(start), testConfig())
+
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, m_outbox.StatusPending, repo.get("a2").Status)
+
+		n, err = r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		require.Len(t, pub.published, 3)
+		assert.Equal(t, []string{"a1", "b1", "a2"}, []string{
+			pub.published[0].EventID, pub.published[1].EventID, pub.published[2].EventID,
+		})
+	})
+
+	t.Run("failed head blocks later events of the same aggregate", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("a1", "product-a", start)
+		repo.add("a2", "product-a", start.Add(time.Second))
+		repo.add("b1", "product-b", start.Add(2*time.Second))
+		pub := &fakePublisher{failing: map[string]error{"product-a": errors.New("unavailable")}}
+		clk := clock.NewMockClock(start)
+		r := NewRelay(repo, pub, clk, testConfig())
+
+		// Other aggregates keep flowing while a1 backs off
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		require.Len(t, pub.published, 1)
+		assert.Equal(t, "b1", pub.published[0].EventID)
+
+		n, err = r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n, "a2 must not overtake a1 while it backs off")
+		assert.Zero(t, repo.get("a2").RetryCount)
+
+		delete(pub.failing, "product-a")
+		clk.Advance(time.Second)
+		_, err = r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		_, err = r.ProcessBatch(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, pub.published, 3)
+		assert.Equal(t, "a1", pub.published[1].EventID)
+		assert.Equal(t, "a2", pub.published[2].EventID)
+	})
+}
+
+func TestRelay_ReportBlocked(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := &fakeRepo{}
+	repo.add("a1", "product-a", start)
+	repo.add("a2", "product-a", start.Add(time.Second))
+	repo.add("a3", "product-a", start.Add(2*time.Second))
+	repo.add("b1", "product-b", start.Add(3*time.Second))
+	pub := &fakePublisher{failing: map[string]error{"product-a": errors.New("rejected")}}
+	clk := clock.NewMockClock(start)
+	r := NewRelay(repo, pub, clk, testConfig())
+
+	// Exhaust a1's retries; b1 is published meanwhile
+	for i := 0; i < 3; i++ {
+		_, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		clk.Advance(time.Minute)
+	}
+	require.Equal(t, m_outbox.StatusFailed, repo.get("a1").Status)
+	delete(pub.failing, "product-a")
+
+	t.Run("later events stay pending behind the failed head", func(t *testing.T) {
+		n, err := r.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.Equal(t, m_outbox.StatusPending, repo.get("a2").Status)
+		assert.Equal(t, m_outbox.StatusPending, repo.get("a3").Status)
+	})
+
+	t.Run("blocked aggregates are reported", func(t *testing.T) {
+		n, err := r.ReportBlocked(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		blocked, err := repo.ListBlockedAggregates(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, blocked, 1)
+		assert.Equal(t, &contracts.BlockedAggregate{
+			AggregateID: "product-a", FailedEventID: "a1", FailedEventType: "product.created", Waiting: 2,
+		}, blocked[0])
+	})
+
+	t.Run("retrying the failed event unblocks the aggregate", func(t *testing.T) {
+		repo.get("a1").Status = m_outbox.StatusPending
+		for i := 0; i < 3; i++ {
+			_, err := r.ProcessBatch(ctx)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, m_outbox.StatusCompleted, repo.get("a3").Status)
+
+		n, err := r.ReportBlocked(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestRelay_Run(t *testing.T) {
+	t.Run("stops when context is cancelled", func(t *testing.T) {
+		repo := &fakeRepo{}
+		repo.add("e1", "product-1", time.Now())
+		pub := &fakePublisher{}
+		r := NewRelay(repo, pub, clock.NewRealClock(), testConfig())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.NoError(t, r.Run(ctx))
+		assert.Len(t, pub.published, 1)
+	})
+}
@@ -0,0 +1,285 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox_attempt"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// eventColumns lists outbox columns in the order scanEvent expects.
+var eventColumns = []string{
+	m_outbox.EventID,
+	m_outbox.EventType,
+	m_outbox.AggregateID,
+	m_outbox.SequenceNumber,
+	m_outbox.Payload,
+	m_outbox.Status,
+	m_outbox.CreatedAt,
+	m_outbox.ProcessedAt,
+	m_outbox.RetryCount,
+	m_outbox.ErrorMessage,
+	m_outbox.NextAttemptAt,
+}
+
+// DeadLetterRepo implements DeadLetterRepository for Spanner.
+type DeadLetterRepo struct {
+	client       *spanner.Client
+	model        *m_outbox.Model
+	attemptModel *m_outbox_attempt.Model
+}
+
+// NewDeadLetterRepo creates a new DeadLetterRepo.
+func NewDeadLetterRepo(client *spanner.Client) contracts.DeadLetterRepository {
+	return &DeadLetterRepo{
+		client:       client,
+		model:        m_outbox.NewModel(),
+		attemptModel: m_outbox_attempt.NewModel(),
+	}
+}
+
+// ListFailed retrieves failed events matching the filter with their attempt history.
+func (r *DeadLetterRepo) ListFailed(ctx context.Context, filter *contracts.FailedEventFilter) ([]*contracts.FailedEventDTO, error) {
+	stmt := failedQuery(filter).
+		Select(eventColumns...).
+		OrderBy(m_outbox.ProcessedAt, query.Desc).
+		Limit(int64(filter.Limit)).
+		Build()
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	var events []*contracts.FailedEventDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query failed events: %w", err)
+		}
+
+		event, err := scanEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &contracts.FailedEventDTO{Event: event})
+	}
+
+	// Read attempt history in the same snapshot as the events
+	for _, dto := range events {
+		attempts, err := r.listAttempts(ctx, txn, dto.Event.EventID)
+		if err != nil {
+			return nil, err
+		}
+		dto.Attempts = attempts
+	}
+
+	return events, nil
+}
+
+// ListFailedIDs retrieves the IDs of failed events matching the filter.
+func (r *DeadLetterRepo) ListFailedIDs(ctx context.Context, filter *contracts.FailedEventFilter) ([]string, error) {
+	stmt := failedQuery(filter).
+		Select(m_outbox.EventID).
+		OrderBy(m_outbox.ProcessedAt, query.Asc).
+		Limit(int64(filter.Limit)).
+		Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query failed events: %w", err)
+		}
+
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, fmt.Errorf("failed to parse event id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// CountFailed counts failed events matching the filter.
+func (r *DeadLetterRepo) CountFailed(ctx context.Context, filter *contracts.FailedEventFilter) (int64, error) {
+	stmt := failedQuery(filter).Count().Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count failed events: %w", err)
+	}
+
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse count: %w", err)
+	}
+	return count, nil
+}
+
+// Requeue re-checks the status of each event and requeues those still failed in a
+// single read-write transaction, so an event is never requeued twice.
+func (r *DeadLetterRepo) Requeue(ctx context.Context, eventIDs []string) (int, error) {
+	if len(eventIDs) == 0 {
+		return 0, nil
+	}
+
+	var requeued int
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Reset on retry of the transaction function
+		requeued = 0
+
+		keys := make([]spanner.Key, 0, len(eventIDs))
+		for _, id := range eventIDs {
+			keys = append(keys, spanner.Key{id})
+		}
+
+		iter := txn.Read(ctx, m_outbox.TableName, spanner.KeySetFromKeys(keys...),
+			[]string{m_outbox.EventID, m_outbox.Status, m_outbox.RetryCount})
+		defer iter.Stop()
+
+		var mutations []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read events: %w", err)
+			}
+
+			var eventID, status string
+			var retryCount int64
+			if err := row.Columns(&eventID, &status, &retryCount); err != nil {
+				return fmt.Errorf("failed to parse event: %w", err)
+			}
+			if status != m_outbox.StatusFailed {
+				continue
+			}
+
+			mutations = append(mutations,
+				r.model.UpdateMut(eventID, map[string]interface{}{
+					m_outbox.Status:        m_outbox.StatusPending,
+					m_outbox.RetryCount:    int64(0),
+					m_outbox.ErrorMessage:  spanner.NullString{},
+					m_outbox.ProcessedAt:   spanner.NullTime{},
+					m_outbox.NextAttemptAt: spanner.NullTime{},
+				}),
+				r.attemptModel.InsertMut(&m_outbox_attempt.Data{
+					EventID:    eventID,
+					AttemptID:  uuid.New().String(),
+					Outcome:    m_outbox_attempt.OutcomeRequeued,
+					RetryCount: retryCount,
+				}),
+			)
+			requeued++
+		}
+
+		if len(mutations) == 0 {
+			return nil
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue events: %w", err)
+	}
+
+	return requeued, nil
+}
+
+// GetStatus returns the current status of an event.
+func (r *DeadLetterRepo) GetStatus(ctx context.Context, eventID string) (string, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_outbox.TableName, spanner.Key{eventID}, []string{m_outbox.Status})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return "", domain.ErrEventNotFound
+		}
+		return "", fmt.Errorf("failed to read event: %w", err)
+	}
+
+	var status string
+	if err := row.Columns(&status); err != nil {
+		return "", fmt.Errorf("failed to parse event: %w", err)
+	}
+	return status, nil
+}
+
+// listAttempts reads the attempt history of an event, oldest first.
+func (r *DeadLetterRepo) listAttempts(ctx context.Context, txn *spanner.ReadOnlyTransaction, eventID string) ([]*contracts.AttemptDTO, error) {
+	iter := txn.Read(ctx, m_outbox_attempt.TableName, spanner.Key{eventID}.AsPrefix(), r.attemptModel.ReadColumns())
+	defer iter.Stop()
+
+	var attempts []*contracts.AttemptDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read event attempts: %w", err)
+		}
+
+		var data m_outbox_attempt.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse event attempt: %w", err)
+		}
+
+		attempts = append(attempts, &contracts.AttemptDTO{
+			Outcome:      data.Outcome,
+			RetryCount:   data.RetryCount,
+			ErrorMessage: data.ErrorMessage.StringVal,
+			AttemptedAt:  data.AttemptedAt,
+		})
+	}
+
+	// Attempt IDs are random, so key order is not chronological
+	sort.SliceStable(attempts, func(i, j int) bool {
+		return attempts[i].AttemptedAt.Before(attempts[j].AttemptedAt)
+	})
+
+	return attempts, nil
+}
+
+// failedQuery builds the shared FROM/WHERE clauses for failed event queries.
+func failedQuery(filter *contracts.FailedEventFilter) *query.Builder {
+	builder := query.From(m_outbox.TableName).
+		Where(query.Eq(m_outbox.Status, m_outbox.StatusFailed))
+
+	if filter.EventType != "" {
+		builder = builder.Where(query.Eq(m_outbox.EventType, filter.EventType))
+	}
+	if filter.AggregateID != "" {
+		builder = builder.Where(query.Eq(m_outbox.AggregateID, filter.AggregateID))
+	}
+	if filter.FailedAfter != nil {
+		builder = builder.Where(query.Gte(m_outbox.ProcessedAt, *filter.FailedAfter))
+	}
+	if filter.FailedBefore != nil {
+		builder = builder.Where(query.Lt(m_outbox.ProcessedAt, *filter.FailedBefore))
+	}
+
+	return builder
+}
@@ -0,0 +1,261 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox_attempt"
+	"google.golang.org/api/iterator"
+)
+
+// claimQuery selects publishable events in commit order.
+// Pending events are publishable once their retry time has passed; processing
+// events are reclaimed when their lease expires.
+//
+// Only the head of each aggregate's stream is publishable: an event is skipped while
+// any earlier event for the same aggregate_id (by created_at, then sequence_number) is
+// not yet completed. A failed or backing-off event therefore blocks its successors
+// instead of being overtaken. The lookup uses idx_outbox_aggregate.
+//
+// A failed event blocks its aggregate until it is retried through the dead-letter API
+// (RetryEvent, RetryEventsByFilter) or deleted by cmd/cleanup_outbox. The relay reports
+// such aggregates periodically, see blockedQuery.
+const claimQuery = `
+	SELECT e.event_id, e.event_type, e.aggregate_id, e.sequence_number, e.payload, e.status,
+	       e.created_at, e.processed_at, e.retry_count, e.error_message, e.next_attempt_at
+	FROM outbox_events AS e
+	WHERE ((e.status = @pending AND (e.next_attempt_at IS NULL OR e.next_attempt_at <= @now))
+	    OR (e.status = @processing AND e.next_attempt_at <= @now))
+	  AND NOT EXISTS (
+		SELECT 1
+		FROM outbox_events@{FORCE_INDEX=idx_outbox_aggregate} AS prev
+		WHERE prev.aggregate_id = e.aggregate_id
+		  AND prev.status != @completed
+		  AND (prev.created_at < e.created_at
+		    OR (prev.created_at = e.created_at AND prev.sequence_number < e.sequence_number))
+	  )
+	ORDER BY e.created_at ASC, e.sequence_number ASC
+	LIMIT @limit
+`
+
+// blockedQuery selects failed events at the head of their aggregate's stream that hold
+// back pending or processing events, with the number of events waiting behind them.
+const blockedQuery = `
+	SELECT aggregate_id, event_id, event_type, waiting
+	FROM (
+		SELECT f.aggregate_id, f.event_id, f.event_type, f.created_at, f.sequence_number,
+		       (SELECT COUNT(*)
+		        FROM outbox_events@{FORCE_INDEX=idx_outbox_aggregate} AS w
+		        WHERE w.aggregate_id = f.aggregate_id
+		          AND w.status IN (@pending, @processing)
+		          AND (w.created_at > f.created_at
+		            OR (w.created_at = f.created_at AND w.sequence_number > f.sequence_number))
+		       ) AS waiting
+		FROM outbox_events AS f
+		WHERE f.status = @failed
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM outbox_events@{FORCE_INDEX=idx_outbox_aggregate} AS prev
+			WHERE prev.aggregate_id = f.aggregate_id
+			  AND prev.status != @completed
+			  AND (prev.created_at < f.created_at
+			    OR (prev.created_at = f.created_at AND prev.sequence_number < f.sequence_number))
+		  )
+	)
+	WHERE waiting > 0
+	ORDER BY created_at ASC, sequence_number ASC
+	LIMIT @limit
+`
+
+// RelayRepo implements RelayRepository for Spanner.
+type RelayRepo struct {
+	client       *spanner.Client
+	model        *m_outbox.Model
+	attemptModel *m_outbox_attempt.Model
+}
+
+// NewRelayRepo creates a new RelayRepo.
+func NewRelayRepo(client *spanner.Client) contracts.RelayRepository {
+	return &RelayRepo{
+		client:       client,
+		model:        m_outbox.NewModel(),
+		attemptModel: m_outbox_attempt.NewModel(),
+	}
+}
+
+// ClaimPending selects publishable events and leases them in a single read-write transaction,
+// so concurrent relays never claim the same event. At most one event per aggregate is
+// claimed per call, which keeps per-aggregate delivery strictly ordered.
+func (r *RelayRepo) ClaimPending(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*m_outbox.Data, error) {
+	var claimed []*m_outbox.Data
+
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Reset on retry of the transaction function
+		claimed = nil
+
+		stmt := spanner.Statement{
+			SQL: claimQuery,
+			Params: map[string]interface{}{
+				"pending":    m_outbox.StatusPending,
+				"processing": m_outbox.StatusProcessing,
+				"completed":  m_outbox.StatusCompleted,
+				"now":        now,
+				"limit":      int64(limit),
+			},
+		}
+
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to query pending events: %w", err)
+			}
+
+			event, err := scanEvent(row)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, event)
+		}
+
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		mutations := make([]*spanner.Mutation, 0, len(claimed))
+		for _, event := range claimed {
+			mutations = append(mutations, r.model.UpdateMut(event.EventID, map[string]interface{}{
+				m_outbox.Status:        m_outbox.StatusProcessing,
+				m_outbox.NextAttemptAt: leaseUntil,
+			}))
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim events: %w", err)
+	}
+
+	for _, event := range claimed {
+		event.Status = m_outbox.StatusProcessing
+		event.NextAttemptAt = spanner.NullTime{Time: leaseUntil, Valid: true}
+	}
+
+	return claimed, nil
+}
+
+// MarkCompleted records a successful publish.
+func (r *RelayRepo) MarkCompleted(ctx context.Context, eventID string, processedAt time.Time) error {
+	return r.apply(ctx, eventID, map[string]interface{}{
+		m_outbox.Status:        m_outbox.StatusCompleted,
+		m_outbox.ProcessedAt:   processedAt,
+		m_outbox.NextAttemptAt: spanner.NullTime{},
+		m_outbox.ErrorMessage:  spanner.NullString{},
+	})
+}
+
+// ScheduleRetry returns the event to pending until nextAttemptAt and records the attempt.
+func (r *RelayRepo) ScheduleRetry(ctx context.Context, eventID string, retryCount int64, errMsg string, nextAttemptAt time.Time) error {
+	return r.apply(ctx, eventID, map[string]interface{}{
+		m_outbox.Status:        m_outbox.StatusPending,
+		m_outbox.RetryCount:    retryCount,
+		m_outbox.ErrorMessage:  errMsg,
+		m_outbox.NextAttemptAt: nextAttemptAt,
+	}, r.attemptMut(eventID, m_outbox_attempt.OutcomeRetryScheduled, retryCount, errMsg))
+}
+
+// MarkFailed moves the event to failed and records the attempt. processed_at is set so
+// the failed-event retention in cmd/cleanup_outbox applies.
+func (r *RelayRepo) MarkFailed(ctx context.Context, eventID string, retryCount int64, errMsg string, failedAt time.Time) error {
+	return r.apply(ctx, eventID, map[string]interface{}{
+		m_outbox.Status:        m_outbox.StatusFailed,
+		m_outbox.RetryCount:    retryCount,
+		m_outbox.ErrorMessage:  errMsg,
+		m_outbox.ProcessedAt:   failedAt,
+		m_outbox.NextAttemptAt: spanner.NullTime{},
+	}, r.attemptMut(eventID, m_outbox_attempt.OutcomeFailed, retryCount, errMsg))
+}
+
+// ListBlockedAggregates returns aggregates held back by a failed head event.
+func (r *RelayRepo) ListBlockedAggregates(ctx context.Context, limit int) ([]*contracts.BlockedAggregate, error) {
+	stmt := spanner.Statement{
+		SQL: blockedQuery,
+		Params: map[string]interface{}{
+			"pending":    m_outbox.StatusPending,
+			"processing": m_outbox.StatusProcessing,
+			"completed":  m_outbox.StatusCompleted,
+			"failed":     m_outbox.StatusFailed,
+			"limit":      int64(limit),
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var blocked []*contracts.BlockedAggregate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query blocked aggregates: %w", err)
+		}
+
+		var b contracts.BlockedAggregate
+		if err := row.Columns(&b.AggregateID, &b.FailedEventID, &b.FailedEventType, &b.Waiting); err != nil {
+			return nil, fmt.Errorf("failed to scan blocked aggregate: %w", err)
+		}
+		blocked = append(blocked, &b)
+	}
+	return blocked, nil
+}
+
+func (r *RelayRepo) apply(ctx context.Context, eventID string, updates map[string]interface{}, extra ...*spanner.Mutation) error {
+	mutations := append([]*spanner.Mutation{r.model.UpdateMut(eventID, updates)}, extra...)
+	if _, err := r.client.Apply(ctx, mutations); err != nil {
+		return fmt.Errorf("failed to update event %s: %w", eventID, err)
+	}
+	return nil
+}
+
+func (r *RelayRepo) attemptMut(eventID, outcome string, retryCount int64, errMsg string) *spanner.Mutation {
+	return r.attemptModel.InsertMut(&m_outbox_attempt.Data{
+		EventID:      eventID,
+		AttemptID:    uuid.New().String(),
+		Outcome:      outcome,
+		RetryCount:   retryCount,
+		ErrorMessage: spanner.NullString{StringVal: errMsg, Valid: errMsg != ""},
+	})
+}
+
+func scanEvent(row *spanner.Row) (*m_outbox.Data, error) {
+	var event m_outbox.Data
+	// Manually scan columns to handle field mapping
+	if err := row.Columns(
+		&event.EventID,
+		&event.EventType,
+		&event.AggregateID,
+		&event.SequenceNumber,
+		&event.Payload,
+		&event.Status,
+		&event.CreatedAt,
+		&event.ProcessedAt,
+		&event.RetryCount,
+		&event.ErrorMessage,
+		&event.NextAttemptAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan event: %w", err)
+	}
+	return &event, nil
+}
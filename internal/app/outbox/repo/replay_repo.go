@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+	"google.golang.org/api/iterator"
+)
+
+// sequenceOrder sorts events written before sequencing (NULL sequence_number) as 0.
+const sequenceOrder = "IFNULL(" + m_outbox.SequenceNumber + ", 0)"
+
+// ReplayRepo implements ReplayRepository for Spanner.
+type ReplayRepo struct {
+	client *spanner.Client
+}
+
+// NewReplayRepo creates a new ReplayRepo.
+func NewReplayRepo(client *spanner.Client) contracts.ReplayRepository {
+	return &ReplayRepo{
+		client: client,
+	}
+}
+
+// CountCompleted counts completed events matching the filter.
+func (r *ReplayRepo) CountCompleted(ctx context.Context, filter *contracts.ReplayFilter) (int64, error) {
+	stmt := completedQuery(filter).Count().Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count completed events: %w", err)
+	}
+
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse count: %w", err)
+	}
+	return count, nil
+}
+
+// ListCompleted retrieves the next page of completed events in commit order.
+func (r *ReplayRepo) ListCompleted(ctx context.Context, filter *contracts.ReplayFilter, after *contracts.ReplayCursor, limit int) ([]*m_outbox.Data, error) {
+	builder := completedQuery(filter).Select(eventColumns...)
+	if after != nil {
+		builder = builder.Where(query.After(
+			[]string{m_outbox.CreatedAt, sequenceOrder, m_outbox.EventID},
+			after.CreatedAt, after.SequenceNumber, after.EventID,
+		))
+	}
+
+	stmt := builder.
+		OrderBy(m_outbox.CreatedAt, query.Asc).
+		ThenBy(sequenceOrder, query.Asc).
+		ThenBy(m_outbox.EventID, query.Asc).
+		Limit(int64(limit)).
+		Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var events []*m_outbox.Data
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query completed events: %w", err)
+		}
+
+		event, err := scanEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// completedQuery builds the shared FROM/WHERE clauses for replay queries.
+func completedQuery(filter *contracts.ReplayFilter) *query.Builder {
+	builder := query.From(m_outbox.TableName).
+		Where(query.Eq(m_outbox.Status, m_outbox.StatusCompleted))
+
+	if filter.EventType != "" {
+		builder = builder.Where(query.Eq(m_outbox.EventType, filter.EventType))
+	}
+	if filter.AggregateID != "" {
+		builder = builder.Where(query.Eq(m_outbox.AggregateID, filter.AggregateID))
+	}
+	if filter.CreatedAfter != nil {
+		builder = builder.Where(query.Gte(m_outbox.CreatedAt, *filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		builder = builder.Where(query.Lt(m_outbox.CreatedAt, *filter.CreatedBefore))
+	}
+
+	return builder
+}
@@ -0,0 +1,122 @@
+package replay_events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/domain"
+)
+
+// pageSize bounds the number of events read per query.
+const pageSize = 500
+
+// MaxRequestEvents bounds a replay served within a single request, such as the
+// ReplayEvents RPC. Larger replays belong to cmd/outbox_replay.
+const MaxRequestEvents = 10000
+
+// Request selects the completed events to replay and where to send them.
+type Request struct {
+	EventType     string     // Optional
+	AggregateID   string     // Optional
+	CreatedAfter  *time.Time // Optional, inclusive
+	CreatedBefore *time.Time // Optional, exclusive
+	Publisher     string     // Name of a configured replay publisher
+	DryRun        bool       // Count matching events without publishing them
+	MaxEvents     int64      // Refuse to publish more matching events than this; zero for no limit
+}
+
+// Response reports how many events matched and how many copies were published.
+// On error, ReplayedCount is the number published before the failure.
+type Response struct {
+	MatchedCount  int64
+	ReplayedCount int64
+}
+
+// Interactor handles the replay events use case.
+type Interactor struct {
+	repo       contracts.ReplayRepository
+	publishers map[string]contracts.Publisher
+}
+
+// NewInteractor creates a new replay events interactor.
+// publishers maps the names accepted in Request.Publisher to their backends.
+func NewInteractor(repo contracts.ReplayRepository, publishers map[string]contracts.Publisher) *Interactor {
+	return &Interactor{
+		repo:       repo,
+		publishers: publishers,
+	}
+}
+
+// Execute re-publishes completed events in commit order. Each event is sent as a copy
+// with a new event ID, the replay marker set and OriginalEventID pointing at the
+// original. Outbox rows are only read, never modified.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Validate request
+	pub, ok := i.publishers[req.Publisher]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnknownTarget, req.Publisher)
+	}
+	if req.CreatedAfter != nil && req.CreatedBefore != nil && !req.CreatedAfter.Before(*req.CreatedBefore) {
+		return nil, domain.ErrInvalidRange
+	}
+
+	filter := &contracts.ReplayFilter{
+		EventType:     req.EventType,
+		AggregateID:   req.AggregateID,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+	}
+
+	// 2. Count matching events
+	matched, err := i.repo.CountCompleted(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Response{MatchedCount: matched}
+	if req.DryRun || matched == 0 {
+		return resp, nil
+	}
+	if req.MaxEvents > 0 && matched > req.MaxEvents {
+		return nil, fmt.Errorf("%w: %d events match, at most %d allowed", domain.ErrReplayTooLarge, matched, req.MaxEvents)
+	}
+
+	// 3. Publish copies page by page
+	var cursor *contracts.ReplayCursor
+	for {
+		events, err := i.repo.ListCompleted(ctx, filter, cursor, pageSize)
+		if err != nil {
+			return resp, err
+		}
+
+		for _, event := range events {
+			msg, err := contracts.NewMessage(event)
+			if err != nil {
+				return resp, fmt.Errorf("failed to replay event %s: %w", event.EventID, err)
+			}
+			msg.OriginalEventID = msg.EventID
+			msg.EventID = uuid.New().String()
+			msg.Replayed = true
+
+			if err := pub.Publish(ctx, msg); err != nil {
+				return resp, fmt.Errorf("failed to replay event %s: %w", event.EventID, err)
+			}
+			resp.ReplayedCount++
+		}
+
+		if len(events) < pageSize {
+			break
+		}
+		last := events[len(events)-1]
+		cursor = &contracts.ReplayCursor{
+			CreatedAt:      last.CreatedAt,
+			SequenceNumber: last.SequenceNumber.Int64,
+			EventID:        last.EventID,
+		}
+	}
+
+	return resp, nil
+}
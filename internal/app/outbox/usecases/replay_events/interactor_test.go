@@ -0,0 +1,195 @@
+package replay_events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// fakeRepo is an in-memory ReplayRepository; events must be added in commit order.
+type fakeRepo struct {
+	events []*m_outbox.Data
+}
+
+func (r *fakeRepo) add(eventID, eventType string, createdAt time.Time) {
+	r.events = append(r.events, &m_outbox.Data{
+		EventID:        eventID,
+		EventType:      eventType,
+		AggregateID:    "product-1",
+		SequenceNumber: spanner.NullInt64{Int64: int64(len(r.events)), Valid: true},
+		Payload:        spanner.NullJSON{Value: map[string]interface{}{"id": eventID}, Valid: true},
+		Status:         m_outbox.StatusCompleted,
+		CreatedAt:      createdAt,
+	})
+}
+
+func (r *fakeRepo) matching(filter *contracts.ReplayFilter) []*m_outbox.Data {
+	var events []*m_outbox.Data
+	for _, e := range r.events {
+		if filter.EventType != "" && e.EventType != filter.EventType {
+			continue
+		}
+		if filter.CreatedAfter != nil && e.CreatedAt.Before(*filter.CreatedAfter) {
+			continue
+		}
+		if filter.CreatedBefore != nil && !e.CreatedAt.Before(*filter.CreatedBefore) {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func (r *fakeRepo) CountCompleted(ctx context.Context, filter *contracts.ReplayFilter) (int64, error) {
+	return int64(len(r.matching(filter))), nil
+}
+
+func (r *fakeRepo) ListCompleted(ctx context.Context, filter *contracts.ReplayFilter, after *contracts.ReplayCursor, limit int) ([]*m_outbox.Data, error) {
+	events := r.matching(filter)
+	if after != nil {
+		for i, e := range events {
+			if e.EventID == after.EventID {
+				events = events[i+1:]
+				break
+			}
+		}
+	}
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// recordingPublisher records published messages and can fail on a given event.
+type recordingPublisher struct {
+	published []*contracts.Message
+	failOn    string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	if msg.OriginalEventID == p.failOn {
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error { return nil }
+
+func TestInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newFixture := func() (*fakeRepo, *recordingPublisher, *Interactor) {
+		repo := &fakeRepo{}
+		repo.add("e1", "product.created", base)
+		repo.add("e2", "product.activated", base.Add(time.Hour))
+		repo.add("e3", "product.price.changed", base.Add(2*time.Hour))
+		pub := &recordingPublisher{}
+		return repo, pub, NewInteractor(repo, map[string]contracts.Publisher{"log": pub})
+	}
+
+	t.Run("publishes marked copies in commit order", func(t *testing.T) {
+		repo, pub, interactor := newFixture()
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.MatchedCount)
+		assert.Equal(t, int64(3), resp.ReplayedCount)
+
+		require.Len(t, pub.published, 3)
+		for i, msg := range pub.published {
+			original := repo.events[i]
+			assert.True(t, msg.Replayed)
+			assert.Equal(t, original.EventID, msg.OriginalEventID)
+			assert.NotEqual(t, original.EventID, msg.EventID, "copies get their own event ID")
+			assert.Equal(t, original.EventType, msg.EventType)
+			assert.Equal(t, original.CreatedAt, msg.CreatedAt)
+			assert.JSONEq(t, fmt.Sprintf(`{"id":%q}`, original.EventID), string(msg.Payload))
+		}
+	})
+
+	t.Run("filters by type and time range", func(t *testing.T) {
+		_, pub, interactor := newFixture()
+		after, before := base.Add(time.Hour), base.Add(2*time.Hour)
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log", CreatedAfter: &after, CreatedBefore: &before})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.ReplayedCount)
+		assert.Equal(t, "e2", pub.published[0].OriginalEventID)
+
+		resp, err = interactor.Execute(ctx, &Request{Publisher: "log", EventType: "product.created"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.ReplayedCount)
+	})
+
+	t.Run("dry run only counts", func(t *testing.T) {
+		_, pub, interactor := newFixture()
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log", DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.MatchedCount)
+		assert.Zero(t, resp.ReplayedCount)
+		assert.Empty(t, pub.published)
+	})
+
+	t.Run("pages through large ranges", func(t *testing.T) {
+		repo := &fakeRepo{}
+		for i := 0; i < pageSize+1; i++ {
+			repo.add(fmt.Sprintf("e%d", i), "product.created", base.Add(time.Duration(i)*time.Second))
+		}
+		pub := &recordingPublisher{}
+		interactor := NewInteractor(repo, map[string]contracts.Publisher{"log": pub})
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(pageSize+1), resp.ReplayedCount)
+		assert.Equal(t, fmt.Sprintf("e%d", pageSize), pub.published[pageSize].OriginalEventID)
+	})
+
+	t.Run("stops at the first publish failure", func(t *testing.T) {
+		_, pub, interactor := newFixture()
+		pub.failOn = "e2"
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "e2")
+		assert.Equal(t, int64(1), resp.ReplayedCount)
+	})
+
+	t.Run("refuses more events than the maximum", func(t *testing.T) {
+		_, pub, interactor := newFixture()
+
+		_, err := interactor.Execute(ctx, &Request{Publisher: "log", MaxEvents: 2})
+		assert.ErrorIs(t, err, domain.ErrReplayTooLarge)
+		assert.Empty(t, pub.published)
+
+		resp, err := interactor.Execute(ctx, &Request{Publisher: "log", MaxEvents: 2, DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.MatchedCount, "dry runs still count")
+
+		resp, err = interactor.Execute(ctx, &Request{Publisher: "log", MaxEvents: 3})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.ReplayedCount)
+	})
+
+	t.Run("rejects unknown publisher and empty range", func(t *testing.T) {
+		_, _, interactor := newFixture()
+
+		_, err := interactor.Execute(ctx, &Request{Publisher: "kafka"})
+		assert.ErrorIs(t, err, domain.ErrUnknownTarget)
+
+		_, err = interactor.Execute(ctx, &Request{Publisher: "log", CreatedAfter: &base, CreatedBefore: &base})
+		assert.ErrorIs(t, err, domain.ErrInvalidRange)
+	})
+}
@@ -0,0 +1,45 @@
+package retry_event
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/domain"
+)
+
+// Request contains the ID of the failed event to retry.
+type Request struct {
+	EventID string
+}
+
+// Interactor handles the retry event use case.
+type Interactor struct {
+	repo contracts.DeadLetterRepository
+}
+
+// NewInteractor creates a new retry event interactor.
+func NewInteractor(repo contracts.DeadLetterRepository) *Interactor {
+	return &Interactor{
+		repo: repo,
+	}
+}
+
+// Execute returns a failed event to pending so the relay publishes it again.
+// The event keeps its place in its aggregate's stream, so it is published before
+// any later events of the same aggregate that it was blocking.
+func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+	// 1. Requeue the event if it is still failed
+	requeued, err := i.repo.Requeue(ctx, []string{req.EventID})
+	if err != nil {
+		return err
+	}
+	if requeued == 1 {
+		return nil
+	}
+
+	// 2. Explain why nothing was requeued
+	if _, err := i.repo.GetStatus(ctx, req.EventID); err != nil {
+		return err
+	}
+	return domain.ErrEventNotFailed
+}
@@ -0,0 +1,94 @@
+package retry_events_by_filter
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// batchSize bounds the number of events requeued per transaction.
+const batchSize = 500
+
+// Request selects the failed events to retry.
+type Request struct {
+	EventType    string     // Optional
+	AggregateID  string     // Optional
+	FailedAfter  *time.Time // Optional, inclusive
+	FailedBefore *time.Time // Optional, exclusive
+	DryRun       bool       // Count matching events without requeueing them
+}
+
+// Response reports how many events matched and how many were requeued.
+type Response struct {
+	MatchedCount int64
+	RetriedCount int64
+}
+
+// Interactor handles the retry events by filter use case.
+type Interactor struct {
+	repo  contracts.DeadLetterRepository
+	clock clock.Clock
+}
+
+// NewInteractor creates a new retry events by filter interactor.
+func NewInteractor(repo contracts.DeadLetterRepository, clock clock.Clock) *Interactor {
+	return &Interactor{
+		repo:  repo,
+		clock: clock,
+	}
+}
+
+// Execute requeues all failed events matching the filter in batches.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Pin the upper bound to now, so events that fail again while the
+	//    retry is running are not picked up a second time
+	before := i.clock.Now()
+	if req.FailedBefore != nil && req.FailedBefore.Before(before) {
+		before = *req.FailedBefore
+	}
+
+	filter := &contracts.FailedEventFilter{
+		EventType:    req.EventType,
+		AggregateID:  req.AggregateID,
+		FailedAfter:  req.FailedAfter,
+		FailedBefore: &before,
+		Limit:        batchSize,
+	}
+
+	// 2. Count matching events
+	matched, err := i.repo.CountFailed(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Response{MatchedCount: matched}
+	if req.DryRun || matched == 0 {
+		return resp, nil
+	}
+
+	// 3. Requeue batch by batch; requeued events drop out of the filter
+	for {
+		ids, err := i.repo.ListFailedIDs(ctx, filter)
+		if err != nil {
+			return resp, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		requeued, err := i.repo.Requeue(ctx, ids)
+		if err != nil {
+			return resp, err
+		}
+		resp.RetriedCount += int64(requeued)
+
+		// Stop on a short batch, or if every event was requeued concurrently
+		if len(ids) < batchSize || requeued == 0 {
+			break
+		}
+	}
+
+	return resp, nil
+}
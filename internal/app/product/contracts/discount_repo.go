@@ -0,0 +1,39 @@
+package contracts
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// DiscountRepository defines the interface for discount schedule persistence.
+type DiscountRepository interface {
+	// InsertMut creates a mutation that adds a discount to the product's schedule.
+	// Returns error if the discount amount exceeds int64 bounds.
+	InsertMut(productID string, discount *domain.Discount) (*spanner.Mutation, error)
+
+	// MarkStartedMut creates a mutation that records when a scheduled discount took effect.
+	MarkStartedMut(productID, discountID string, startedAt time.Time) *spanner.Mutation
+
+	// DeleteMut creates a mutation that removes a discount from the product's schedule.
+	DeleteMut(productID, discountID string) *spanner.Mutation
+
+	// DeleteAllMut creates a mutation that removes the product's whole schedule.
+	DeleteAllMut(productID string) *spanner.Mutation
+}
+
+// DiscountHistoryRepository defines the interface for persisting the discounts a product had.
+type DiscountHistoryRepository interface {
+	// InsertMut creates a mutation that records a discount as it is applied at appliedAt.
+	// Returns error if the discount amount exceeds int64 bounds.
+	InsertMut(productID string, discount *domain.Discount, appliedAt time.Time) (*spanner.Mutation, error)
+
+	// EndMut creates a mutation that records a discount leaving the schedule after taking
+	// effect. endedAt is when it was removed before its end date, nil if it ran its course.
+	// Returns error if the discount amount exceeds int64 bounds.
+	EndMut(productID string, discount *domain.Discount, endedAt *time.Time) (*spanner.Mutation, error)
+
+	// DeleteMut creates a mutation that forgets a discount that never took effect.
+	DeleteMut(productID, discountID string) *spanner.Mutation
+}
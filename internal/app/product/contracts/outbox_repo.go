@@ -5,13 +5,26 @@ import (
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
 )
 
+// MaxEventsPerCommit bounds how many events a single commit may record for one aggregate.
+// It is the stride between the sequence numbers of consecutive aggregate versions.
+const MaxEventsPerCommit = 1000
+
 // OutboxEvent represents an enriched domain event ready for persistence.
 type OutboxEvent struct {
-	EventID     string
-	EventType   string
-	AggregateID string
-	Payload     string // JSON
-	Status      string
+	EventID        string
+	EventType      string
+	AggregateID    string
+	SequenceNumber int64  // Per-aggregate ordering key, see EventSequence
+	Payload        string // JSON
+	Status         string
+}
+
+// EventSequence returns the per-aggregate sequence number for the index-th event
+// recorded in the commit that writes aggregateVersion.
+// Optimistic locking guarantees each aggregate version is committed once, so the
+// result is unique and increases in commit order for a given aggregate.
+func EventSequence(aggregateVersion int64, index int) int64 {
+	return aggregateVersion*MaxEventsPerCommit + int64(index)
 }
 
 // OutboxRepository defines the interface for outbox event persistence.
@@ -20,5 +33,5 @@ type OutboxRepository interface {
 	InsertMut(event *OutboxEvent) *spanner.Mutation
 
 	// EnrichEvent converts a domain event to an outbox event with metadata
-	EnrichEvent(event domain.DomainEvent, payload string) *OutboxEvent
+	EnrichEvent(event domain.DomainEvent, payload string, sequenceNumber int64) *OutboxEvent
 }
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// PriceChangeRepository defines the interface for scheduled price change persistence.
+type PriceChangeRepository interface {
+	// InsertMut creates a mutation that adds a price change to the product's schedule.
+	// Returns error if the new price exceeds int64 bounds.
+	InsertMut(productID string, change *domain.ScheduledPriceChange) (*spanner.Mutation, error)
+
+	// DeleteMut creates a mutation that removes a price change from the product's schedule.
+	DeleteMut(productID, priceChangeID string) *spanner.Mutation
+
+	// DeleteAllMut creates a mutation that removes all of the product's scheduled price changes.
+	DeleteAllMut(productID string) *spanner.Mutation
+}
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// PriceChangeRequestRepository defines the interface for pending price change request persistence.
+type PriceChangeRequestRepository interface {
+	// InsertMut creates a mutation that stores a product's pending price change request.
+	// Returns error if either price exceeds int64 bounds.
+	InsertMut(productID string, request *domain.PriceChangeRequest) (*spanner.Mutation, error)
+
+	// DeleteMut creates a mutation that removes a decided price change request.
+	DeleteMut(productID, requestID string) *spanner.Mutation
+
+	// DeleteAllMut creates a mutation that removes all of the product's price change requests.
+	DeleteAllMut(productID string) *spanner.Mutation
+}
@@ -11,11 +11,14 @@ import (
 // PriceHistoryRepository defines the interface for price history persistence.
 type PriceHistoryRepository interface {
 	// InsertMut creates a mutation for inserting a price change record.
-	// oldPrice can be nil for initial product creation.
+	// region is empty for the base price.
+	// oldPrice can be nil for initial product creation or a new regional price,
+	// newPrice is nil when a regional price is removed.
 	// Returns error if money values exceed int64 bounds.
 	InsertMut(
 		historyID string,
 		productID string,
+		region string,
 		oldPrice *domain.Money,
 		newPrice *domain.Money,
 		changedBy string,
@@ -23,6 +26,19 @@ type PriceHistoryRepository interface {
 		changedAt time.Time,
 	) (*spanner.Mutation, error)
 
+	// InsertDecisionMut creates a mutation recording the decision on a price change request.
+	// An approved request also records the base price change itself.
+	// Returns error if money values exceed int64 bounds.
+	InsertDecisionMut(
+		historyID string,
+		productID string,
+		request *domain.PriceChangeRequest,
+		decision domain.PriceChangeDecision,
+		decidedBy string,
+		decisionReason string,
+		decidedAt time.Time,
+	) (*spanner.Mutation, error)
+
 	// GetByProductID retrieves price history for a product, ordered by time (most recent first).
 	GetByProductID(ctx context.Context, productID string, limit int) ([]PriceHistoryRecord, error)
 }
@@ -31,9 +47,16 @@ type PriceHistoryRepository interface {
 type PriceHistoryRecord struct {
 	HistoryID     string
 	ProductID     string
+	Region        string        // Empty for the base price
 	OldPrice      *domain.Money // nil for initial price
-	NewPrice      *domain.Money
+	NewPrice      *domain.Money // nil when a regional price was removed
 	ChangedBy     string
 	ChangedReason string
 	ChangedAt     time.Time
+
+	// Set for decisions on price change requests
+	RequestID      string
+	Decision       domain.PriceChangeDecision
+	DecidedBy      string // Empty when a request was dropped by archiving the product
+	DecisionReason string
 }
@@ -0,0 +1,16 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// PriceListRepository defines the interface for regional price persistence.
+type PriceListRepository interface {
+	// UpsertMut creates a mutation that sets the product's price in a region.
+	// Returns error if the price exceeds int64 bounds.
+	UpsertMut(productID, region string, price *domain.Money) (*spanner.Mutation, error)
+
+	// DeleteMut creates a mutation that removes the product's price in a region.
+	DeleteMut(productID, region string) *spanner.Mutation
+}
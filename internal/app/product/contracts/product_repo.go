@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"context"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
@@ -18,9 +19,35 @@ type ProductRepository interface {
 	// Returns error if money values exceed int64 bounds
 	UpdateMut(product *domain.Product) (*spanner.Mutation, error)
 
+	// DeleteMut creates a mutation for permanently deleting a product; its interleaved
+	// child rows (price history, discounts, price lists, ...) are deleted by cascade
+	DeleteMut(productID string) *spanner.Mutation
+
 	// GetByID retrieves a product by ID, reconstructing the domain aggregate
 	GetByID(ctx context.Context, productID string) (*domain.Product, error)
 
 	// Exists checks if a product exists
 	Exists(ctx context.Context, productID string) (bool, error)
+
+	// ListWithDueDiscounts returns up to limit IDs of products whose discount schedule is
+	// out of date at now: a discount has ended, or has started without being recorded
+	ListWithDueDiscounts(ctx context.Context, now time.Time, limit int) ([]string, error)
+
+	// ListWithDuePriceChanges returns up to limit IDs of products with a scheduled price
+	// change whose effective time has been reached at now
+	ListWithDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]string, error)
+
+	// ListArchivedBefore returns up to limit archived products archived before cutoff,
+	// oldest first
+	ListArchivedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*PurgeCandidate, error)
+
+	// CountArchivedBefore counts the products archived before cutoff and their price
+	// history rows
+	CountArchivedBefore(ctx context.Context, cutoff time.Time) (products, priceHistory int64, err error)
+}
+
+// PurgeCandidate is an archived product past the retention cutoff.
+type PurgeCandidate struct {
+	ProductID         string
+	PriceHistoryCount int64 // Price history rows deleted with the product
 }
@@ -2,30 +2,75 @@ package contracts
 
 import (
 	"context"
+	"math/big"
 	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
 )
 
 // ProductDTO is a data transfer object for product queries.
 type ProductDTO struct {
-	ProductID       string
-	Name            string
-	Description     string
-	Category        string
-	BasePrice       float64  // Approximate representation for display
-	EffectivePrice  float64  // Current price with discount applied
-	DiscountPercent *float64 // Changed from *int64 to *float64 for fractional percentages
-	DiscountActive  bool
-	Status          string
-	Version         int64 // For optimistic locking
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	ArchivedAt      *time.Time
+	ProductID             string
+	Name                  string
+	Description           string
+	Category              string
+	TaxClass              string
+	BasePrice             float64              // List price in the requested market, approximate representation for display
+	EffectivePrice        float64              // Current price with discount applied
+	RoundedEffectivePrice *domain.RoundedPrice // Exact EffectivePrice with its rounding under the rounding policy
+	Tax                   *domain.TaxBreakdown // Exact EffectivePrice split under the market region's tax rate, nil without one
+	Currency              string               // ISO 4217 code of BasePrice and EffectivePrice
+	PriceRegion           string               // Price list region BasePrice comes from, empty for the base price
+	DiscountPercent       *float64             // Changed from *int64 to *float64 for fractional percentages
+	DiscountPercentExact  *big.Rat             // Exact percentage, set with DiscountPercent
+	DiscountAmount        *domain.Money        // Amount off for fixed-amount discounts, nil otherwise
+	DiscountActive        bool
+	CostPrice             *domain.Money              // Exact cost price, nil when unknown
+	PendingPriceChange    *domain.PriceChangeRequest // Base price change awaiting approval, only set by GetProductByID
+	Status                string
+	Version               int64 // For optimistic locking
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	ArchivedAt            *time.Time
+}
+
+// Market selects the price list entry products are priced with and the region whose tax
+// rates apply. The zero value prices products with their base price, without taxes.
+type Market struct {
+	Region   string          // Normalized region code, see domain.ParseRegion
+	Currency domain.Currency // Preferred currency when the region has no price
+}
+
+// NewMarket validates and normalizes a market. Both codes are optional.
+func NewMarket(region, currency string) (Market, error) {
+	var market Market
+	if region != "" {
+		parsed, err := domain.ParseRegion(region)
+		if err != nil {
+			return Market{}, err
+		}
+		market.Region = parsed
+	}
+	if currency != "" {
+		parsed, err := domain.ParseCurrency(currency)
+		if err != nil {
+			return Market{}, err
+		}
+		market.Currency = parsed
+	}
+	return market, nil
+}
+
+// IsZero reports whether no market was requested.
+func (m Market) IsZero() bool {
+	return m.Region == "" && m.Currency == ""
 }
 
 // ListFilter defines filtering options for listing products.
 type ListFilter struct {
 	Category  string
 	Status    string
+	Market    Market
 	PageSize  int
 	PageToken string
 }
@@ -37,12 +82,115 @@ type ListResult struct {
 	TotalCount    int64
 }
 
+// RegionalPriceDTO is one entry of a product's price list.
+type RegionalPriceDTO struct {
+	Region    string
+	Price     *domain.Money
+	UpdatedAt time.Time
+}
+
+// PricesDTO contains a product's base price and its price list ordered by region.
+type PricesDTO struct {
+	ProductID      string
+	BasePrice      *domain.Money
+	RegionalPrices []*RegionalPriceDTO
+}
+
+// Discount statuses relative to the time of the query.
+const (
+	DiscountStatusScheduled = "scheduled" // Starts in the future
+	DiscountStatusActive    = "active"    // Valid now
+	DiscountStatusEnded     = "ended"     // Ended, awaiting cleanup
+)
+
+// DiscountDTO is one discount of a product's schedule.
+type DiscountDTO struct {
+	DiscountID           string
+	Kind                 domain.DiscountKind
+	DiscountPercent      *float64      // Set for percentage discounts
+	DiscountPercentExact *big.Rat      // Exact percentage, set with DiscountPercent
+	DiscountAmount       *domain.Money // Set for fixed-amount discounts
+	StartDate            time.Time
+	EndDate              time.Time
+	Status               string // See DiscountStatusScheduled, DiscountStatusActive, DiscountStatusEnded
+}
+
+// NewDiscountDTO converts a scheduled discount, deriving its status at now.
+func NewDiscountDTO(discount *domain.Discount, now time.Time) *DiscountDTO {
+	dto := &DiscountDTO{
+		DiscountID: discount.ID(),
+		Kind:       discount.Kind(),
+		StartDate:  discount.StartDate(),
+		EndDate:    discount.EndDate(),
+		Status:     DiscountStatusActive,
+	}
+	if discount.IsFixedAmount() {
+		dto.DiscountAmount = discount.Amount()
+	} else {
+		percent := discount.Percentage()
+		dto.DiscountPercent = &percent
+		dto.DiscountPercentExact = discount.ExactPercentage()
+	}
+	switch {
+	case !discount.HasStarted(now):
+		dto.Status = DiscountStatusScheduled
+	case discount.HasEnded(now):
+		dto.Status = DiscountStatusEnded
+	}
+	return dto
+}
+
+// PriceChangeDTO is one pending change of a product's base price schedule.
+type PriceChangeDTO struct {
+	Change    *domain.ScheduledPriceChange
+	CreatedAt time.Time
+}
+
+// PriceAtDTO is a product's base and effective price at a point in time, reconstructed
+// from its price and discount history.
+type PriceAtDTO struct {
+	ProductID      string
+	At             time.Time
+	BasePrice      *domain.Money
+	BasePriceSince time.Time            // When BasePrice took effect, zero if it predates the price history
+	Discount       *DiscountDTO         // Discount in effect at At, nil if none
+	EffectivePrice *domain.RoundedPrice // BasePrice with Discount applied, rounded under the current rounding policy
+}
+
+// TaxRateFilter defines filtering options for listing tax rates. Empty fields match everything.
+type TaxRateFilter struct {
+	Region   string // Normalized region code
+	TaxClass string // Normalized tax class
+}
+
+// TaxRateDTO is one entry of a region's tax rate table.
+type TaxRateDTO struct {
+	Rate      *domain.TaxRate
+	Current   bool // Whether this is the rate in force now for its region and tax class
+	UpdatedAt time.Time
+}
+
 // ReadModel defines the interface for product queries.
 // Read models can bypass the domain layer for performance.
 type ReadModel interface {
-	// GetProductByID retrieves a product DTO by ID
-	GetProductByID(ctx context.Context, productID string) (*ProductDTO, error)
+	// GetProductByID retrieves a product DTO by ID, priced for the market
+	GetProductByID(ctx context.Context, productID string, market Market) (*ProductDTO, error)
 
 	// ListProducts retrieves a paginated list of products with filtering
 	ListProducts(ctx context.Context, filter *ListFilter) (*ListResult, error)
+
+	// GetPrices retrieves a product's base price and price list
+	GetPrices(ctx context.Context, productID string) (*PricesDTO, error)
+
+	// ListDiscounts retrieves a product's discount schedule ordered by start date
+	ListDiscounts(ctx context.Context, productID string) ([]*DiscountDTO, error)
+
+	// ListPriceChanges retrieves a product's pending base price changes ordered by effective time
+	ListPriceChanges(ctx context.Context, productID string) ([]*PriceChangeDTO, error)
+
+	// ListTaxRates retrieves the tax rates matching the filter
+	ListTaxRates(ctx context.Context, filter *TaxRateFilter) ([]*TaxRateDTO, error)
+
+	// GetPriceAt retrieves a product's base and effective price at a point in time
+	GetPriceAt(ctx context.Context, productID string, at time.Time) (*PriceAtDTO, error)
 }
@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// TaxRateRepository defines the interface for regional tax rate persistence.
+type TaxRateRepository interface {
+	// UpsertMut creates a mutation that sets a rate, replacing the rate of the same
+	// region and tax class with the same effective date.
+	UpsertMut(rate *domain.TaxRate) *spanner.Mutation
+}
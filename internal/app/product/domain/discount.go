@@ -3,54 +3,81 @@ package domain
 import (
 	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 	"time"
 )
 
-// Discount represents a time-bound percentage discount on a product.
+// DiscountKind distinguishes how a discount reduces the price.
+type DiscountKind string
+
+const (
+	// DiscountKindPercentage takes a percentage off the price.
+	DiscountKindPercentage DiscountKind = "percentage"
+	// DiscountKindFixedAmount takes a fixed amount off the price, never going below zero.
+	DiscountKindFixedAmount DiscountKind = "fixed_amount"
+)
+
+// MaxPercentageDecimals is the number of fractional digits a discount percentage may have,
+// the scale of the NUMERIC columns it is stored in.
+const MaxPercentageDecimals = 9
+
+// Discount represents a time-bound discount on a product, either a percentage or a fixed amount.
 // Supports fractional percentages (e.g., 12.5%, 7.25%) for flexible pricing.
 // Uses *big.Rat internally for precise arithmetic.
 type Discount struct {
-	percentage         *big.Rat // 0.0-100.0, stored as rational for precision
+	id                 string // Identifies the discount in its product's schedule
+	kind               DiscountKind
+	percentage         *big.Rat // 0.0-100.0, stored as rational for precision (zero for fixed amounts)
+	amount             *Money   // Amount off the price (nil for percentages)
 	startDate          time.Time
 	endDate            time.Time
-	discountMultiplier *big.Rat // Cached percentage/100 for performance
+	startedAt          *time.Time // When the product recorded the discount as started, nil until then
+	discountMultiplier *big.Rat   // Cached percentage/100 for performance
 }
 
 // NewDiscount creates a new Discount with validation.
 // All dates must be in UTC timezone to prevent ambiguity across distributed systems.
 // Discount duration is limited to 2 years maximum for business policy compliance.
-// Percentage supports fractional values (e.g., 12.5 for 12.5% discount).
+// Percentage supports fractional values (e.g., 12.5 for 12.5% discount). The float is read
+// as the decimal it was written as, rounded to MaxPercentageDecimals, so 12.3 is exactly 12.3%.
+// Use NewDiscountFromRat for percentages that must not pass through a float.
 func NewDiscount(percentageFloat float64, startDate, endDate time.Time) (*Discount, error) {
 	if percentageFloat < 0 || percentageFloat > 100 {
 		return nil, fmt.Errorf("discount percentage must be between 0 and 100, got %.2f", percentageFloat)
 	}
 
-	// Require UTC timezone for consistency
-	if startDate.Location() != time.UTC {
-		return nil, fmt.Errorf("discount start date must be in UTC timezone")
+	percentage, _ := new(big.Rat).SetString(strconv.FormatFloat(percentageFloat, 'f', MaxPercentageDecimals, 64))
+	return NewDiscountFromRat(percentage, startDate, endDate)
+}
+
+// NewDiscountFromRat creates a new Discount with an exact percentage, e.g. 123/10 for 12.3%.
+// The percentage must be between 0 and 100 with at most MaxPercentageDecimals fractional
+// digits, so it is stored without rounding. Dates follow the same rules as NewDiscount.
+func NewDiscountFromRat(percentage *big.Rat, startDate, endDate time.Time) (*Discount, error) {
+	if percentage == nil {
+		return nil, ErrInvalidDiscountPercent
 	}
-	if endDate.Location() != time.UTC {
-		return nil, fmt.Errorf("discount end date must be in UTC timezone")
+	if percentage.Sign() < 0 || percentage.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, fmt.Errorf("%w, got %s", ErrInvalidDiscountPercent, FormatPercentage(percentage))
 	}
-
-	if endDate.Before(startDate) || endDate.Equal(startDate) {
-		return nil, ErrInvalidDiscountPeriod
+	if !hasPercentageScale(percentage) {
+		return nil, fmt.Errorf("%w, got %s", ErrDiscountPercentPrecision, percentage.RatString())
 	}
 
-	// Limit discount duration to 2 years (extract to const per code review recommendation)
-	const MaxDiscountDuration = 2 * 365 * 24 * time.Hour
-	if endDate.Sub(startDate) > MaxDiscountDuration {
-		return nil, fmt.Errorf("discount duration cannot exceed 2 years")
+	if err := validateDiscountPeriod(startDate, endDate); err != nil {
+		return nil, err
 	}
 
-	// Convert percentage to *big.Rat for precise arithmetic
-	percentage := new(big.Rat).SetFloat64(percentageFloat)
+	// Copy so the caller cannot mutate the discount
+	percentage = new(big.Rat).Set(percentage)
 
 	// Pre-calculate discount multiplier for performance (avoids allocation on every Apply())
 	hundred := big.NewRat(100, 1)
 	discountMultiplier := new(big.Rat).Quo(percentage, hundred)
 
 	return &Discount{
+		kind:               DiscountKindPercentage,
 		percentage:         percentage,
 		startDate:          startDate,
 		endDate:            endDate,
@@ -58,7 +85,131 @@ func NewDiscount(percentageFloat float64, startDate, endDate time.Time) (*Discou
 	}, nil
 }
 
+// NewFixedAmountDiscount creates a new Discount that takes a fixed amount off the price,
+// e.g. "$10 off". The amount must be positive; prices it exceeds are discounted to zero.
+// Dates follow the same rules as NewDiscount.
+func NewFixedAmountDiscount(amount *Money, startDate, endDate time.Time) (*Discount, error) {
+	if amount == nil || amount.IsNegative() || amount.IsZero() {
+		return nil, ErrInvalidDiscountAmount
+	}
+
+	if err := validateDiscountPeriod(startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	return &Discount{
+		kind:               DiscountKindFixedAmount,
+		percentage:         new(big.Rat),
+		amount:             amount.Copy(),
+		startDate:          startDate,
+		endDate:            endDate,
+		discountMultiplier: new(big.Rat),
+	}, nil
+}
+
+// ParsePercentage parses an exact discount percentage written as a decimal ("12.3") or
+// a fraction ("25/2"). Range and precision are checked by NewDiscountFromRat.
+func ParsePercentage(s string) (*big.Rat, error) {
+	percentage, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("%w, got %q", ErrDiscountPercentPrecision, s)
+	}
+	return percentage, nil
+}
+
+// FormatPercentage renders a percentage as a decimal without trailing zeros, e.g. "12.3".
+// Percentages accepted by NewDiscountFromRat are rendered exactly; finer ones are rounded
+// to MaxPercentageDecimals.
+func FormatPercentage(percentage *big.Rat) string {
+	s := percentage.FloatString(MaxPercentageDecimals)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// hasPercentageScale reports whether the percentage has at most MaxPercentageDecimals
+// fractional digits.
+func hasPercentageScale(percentage *big.Rat) bool {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(MaxPercentageDecimals), nil)
+	return new(big.Rat).Mul(percentage, new(big.Rat).SetInt(scale)).IsInt()
+}
+
+// validateDiscountPeriod checks the discount dates shared by every discount kind.
+func validateDiscountPeriod(startDate, endDate time.Time) error {
+	// Require UTC timezone for consistency
+	if startDate.Location() != time.UTC {
+		return fmt.Errorf("discount start date must be in UTC timezone")
+	}
+	if endDate.Location() != time.UTC {
+		return fmt.Errorf("discount end date must be in UTC timezone")
+	}
+
+	if endDate.Before(startDate) || endDate.Equal(startDate) {
+		return ErrInvalidDiscountPeriod
+	}
+
+	// Limit discount duration to 2 years (extract to const per code review recommendation)
+	const MaxDiscountDuration = 2 * 365 * 24 * time.Hour
+	if endDate.Sub(startDate) > MaxDiscountDuration {
+		return fmt.Errorf("discount duration cannot exceed 2 years")
+	}
+
+	return nil
+}
+
+// WithID returns a copy of the discount identified by id within its product's schedule.
+func (d *Discount) WithID(id string) *Discount {
+	c := d.copy()
+	c.id = id
+	return c
+}
+
+// WithStartedAt returns a copy of the discount recorded as started at the given time.
+// Used when reconstructing a schedule from storage.
+func (d *Discount) WithStartedAt(startedAt time.Time) *Discount {
+	c := d.copy()
+	c.startedAt = &startedAt
+	return c
+}
+
+// copy returns a copy of the discount. The rationals are shared as they are never mutated.
+func (d *Discount) copy() *Discount {
+	return &Discount{
+		id:                 d.id,
+		kind:               d.kind,
+		percentage:         d.percentage,
+		amount:             d.Amount(),
+		startDate:          d.startDate,
+		endDate:            d.endDate,
+		startedAt:          d.startedAt,
+		discountMultiplier: d.discountMultiplier,
+	}
+}
+
+// ID returns the discount's identifier within its product's schedule.
+func (d *Discount) ID() string {
+	return d.id
+}
+
+// Kind returns how the discount reduces the price.
+func (d *Discount) Kind() DiscountKind {
+	return d.kind
+}
+
+// IsFixedAmount returns true for fixed-amount discounts.
+func (d *Discount) IsFixedAmount() bool {
+	return d.kind == DiscountKindFixedAmount
+}
+
+// Amount returns a copy of the fixed amount off the price, or nil for percentage discounts.
+func (d *Discount) Amount() *Money {
+	if d.amount == nil {
+		return nil
+	}
+	return d.amount.Copy()
+}
+
 // Percentage returns the discount percentage as float64 for external interfaces.
+// It is zero for fixed-amount discounts.
 // For precise internal calculations, use PercentageRat() instead.
 func (d *Discount) Percentage() float64 {
 	f, _ := d.percentage.Float64()
@@ -70,6 +221,14 @@ func (d *Discount) PercentageRat() *big.Rat {
 	return new(big.Rat).Set(d.percentage)
 }
 
+// ExactPercentage returns the exact discount percentage, or nil for fixed-amount discounts.
+func (d *Discount) ExactPercentage() *big.Rat {
+	if d.IsFixedAmount() {
+		return nil
+	}
+	return d.PercentageRat()
+}
+
 // StartDate returns the discount start date.
 func (d *Discount) StartDate() time.Time {
 	return d.startDate
@@ -90,21 +249,63 @@ func (d *Discount) IsValidAt(t time.Time) bool {
 	return !t.Before(d.startDate) && !t.After(d.endDate)
 }
 
+// HasStarted returns true if the discount period started at or before t.
+func (d *Discount) HasStarted(t time.Time) bool {
+	return !t.Before(d.startDate)
+}
+
+// StartedAt returns when the product recorded the discount as started: at ApplyDiscount
+// for discounts that are already valid, otherwise when the sweep announced the start.
+// Nil while the start is still pending.
+func (d *Discount) StartedAt() *time.Time {
+	return d.startedAt
+}
+
+// HasEnded returns true if the discount period ended before t.
+func (d *Discount) HasEnded(t time.Time) bool {
+	return t.After(d.endDate)
+}
+
+// Overlaps returns true if the two discount periods share at least one instant.
+// Periods include both their start and end date.
+func (d *Discount) Overlaps(other *Discount) bool {
+	return !d.startDate.After(other.endDate) && !other.startDate.After(d.endDate)
+}
+
+// ActiveDiscount returns the discount of a schedule that is valid at t, or nil.
+// Schedules never hold overlapping discounts, so at most one discount is valid at a time.
+func ActiveDiscount(discounts []*Discount, t time.Time) *Discount {
+	for _, d := range discounts {
+		if d.IsValidAt(t) {
+			return d
+		}
+	}
+	return nil
+}
+
 // Multiplier returns the cached discount multiplier (percentage/100).
-// Exposed for use by domain services.
+// It is zero for fixed-amount discounts. Exposed for use by domain services.
 func (d *Discount) Multiplier() *big.Rat {
 	return d.discountMultiplier
 }
 
+// AppliesTo reports whether the discount can reduce a price. Fixed amounts only apply
+// to prices in their own currency; percentages apply to any price.
+func (d *Discount) AppliesTo(price *Money) bool {
+	return d.kind != DiscountKindFixedAmount || d.amount.Currency() == price.Currency()
+}
+
 // Apply applies the discount to a price and returns the discounted price.
-// Formula: discountedPrice = price - (price * percentage / 100)
+// Formula: discountedPrice = price - (price * percentage / 100) for percentages,
+// max(price - amount, 0) for fixed amounts.
+// Prices the discount does not apply to (see AppliesTo) are returned unchanged.
 // Delegates to PricingCalculator for centralized pricing logic.
 func (d *Discount) Apply(price *Money) *Money {
-	return defaultPricingCalculator.ApplyDiscount(price, d.discountMultiplier)
+	return defaultPricingCalculator.Apply(price, d)
 }
 
 // CalculateDiscountAmount calculates the discount amount (not the final price).
 // Delegates to PricingCalculator for centralized pricing logic.
 func (d *Discount) CalculateDiscountAmount(price *Money) *Money {
-	return defaultPricingCalculator.CalculateDiscountAmount(price, d.discountMultiplier)
+	return defaultPricingCalculator.DiscountAmount(price, d)
 }
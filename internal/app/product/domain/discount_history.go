@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// AppliedDiscount is a discount a product had, with when it actually joined and left the
+// product's schedule. A discount applied after its start date only takes effect once
+// applied, and a removed discount stops at its removal.
+type AppliedDiscount struct {
+	Discount  *Discount
+	AppliedAt *time.Time // When the discount was applied, nil if unknown
+	EndedAt   *time.Time // When the discount was removed before its end date, nil otherwise
+}
+
+// InEffectAt returns true if the discount priced the product at t.
+// The period runs from the later of its start date and AppliedAt through its end date,
+// and stops just before EndedAt.
+func (a *AppliedDiscount) InEffectAt(t time.Time) bool {
+	if !a.Discount.IsValidAt(t) {
+		return false
+	}
+	if a.AppliedAt != nil && t.Before(*a.AppliedAt) {
+		return false
+	}
+	return a.EndedAt == nil || t.Before(*a.EndedAt)
+}
+
+// DiscountInEffectAt returns the discount of a product's history that priced it at t, or nil.
+// Discounts in effect never overlap, so at most one is in effect at a time.
+func DiscountInEffectAt(history []*AppliedDiscount, t time.Time) *Discount {
+	for _, applied := range history {
+		if applied.InEffectAt(t) {
+			return applied.Discount
+		}
+	}
+	return nil
+}
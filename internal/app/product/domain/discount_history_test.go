@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppliedDiscount_InEffectAt(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+	june, _ := NewDiscount(10, day(1), day(10))
+
+	t.Run("unknown application covers the whole period", func(t *testing.T) {
+		applied := &AppliedDiscount{Discount: june}
+		assert.True(t, applied.InEffectAt(day(1)))
+		assert.True(t, applied.InEffectAt(day(10)))
+		assert.False(t, applied.InEffectAt(day(11)))
+	})
+
+	t.Run("applied after the start date", func(t *testing.T) {
+		appliedAt := day(3)
+		applied := &AppliedDiscount{Discount: june, AppliedAt: &appliedAt}
+		assert.False(t, applied.InEffectAt(day(2)))
+		assert.True(t, applied.InEffectAt(day(3)))
+	})
+
+	t.Run("applied before the start date", func(t *testing.T) {
+		appliedAt := time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC)
+		applied := &AppliedDiscount{Discount: june, AppliedAt: &appliedAt}
+		assert.False(t, applied.InEffectAt(appliedAt))
+		assert.True(t, applied.InEffectAt(day(1)))
+	})
+
+	t.Run("removed before the end date", func(t *testing.T) {
+		endedAt := day(5)
+		applied := &AppliedDiscount{Discount: june, EndedAt: &endedAt}
+		assert.True(t, applied.InEffectAt(endedAt.Add(-time.Nanosecond)))
+		assert.False(t, applied.InEffectAt(endedAt))
+	})
+}
+
+func TestDiscountInEffectAt(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+	first, _ := NewDiscount(10, day(1), day(10))
+	second, _ := NewDiscount(20, day(15), day(20))
+	removedAt := day(17)
+	history := []*AppliedDiscount{
+		{Discount: first.WithID("first")},
+		{Discount: second.WithID("second"), EndedAt: &removedAt},
+	}
+
+	assert.Equal(t, "first", DiscountInEffectAt(history, day(5)).ID())
+	assert.Equal(t, "second", DiscountInEffectAt(history, day(16)).ID())
+	assert.Nil(t, DiscountInEffectAt(history, day(18)))
+	assert.Nil(t, DiscountInEffectAt(nil, day(5)))
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -72,6 +73,133 @@ func TestNewDiscount(t *testing.T) {
 	})
 }
 
+func TestNewFixedAmountDiscount(t *testing.T) {
+	startDate := time.Now().UTC()
+	endDate := startDate.Add(24 * time.Hour)
+
+	t.Run("valid discount", func(t *testing.T) {
+		amount, _ := NewMoney(500, 100, "USD")
+		d, err := NewFixedAmountDiscount(amount, startDate, endDate)
+		require.NoError(t, err)
+		assert.Equal(t, DiscountKindFixedAmount, d.Kind())
+		assert.True(t, d.IsFixedAmount())
+		assert.True(t, d.Amount().Equals(amount))
+		assert.Equal(t, 0.0, d.Percentage())
+	})
+
+	t.Run("zero amount returns error", func(t *testing.T) {
+		zero, _ := NewMoney(0, 1, "USD")
+		_, err := NewFixedAmountDiscount(zero, startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountAmount)
+	})
+
+	t.Run("negative amount returns error", func(t *testing.T) {
+		negative, _ := NewMoney(-5, 1, "USD")
+		_, err := NewFixedAmountDiscount(negative, startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountAmount)
+	})
+
+	t.Run("nil amount returns error", func(t *testing.T) {
+		_, err := NewFixedAmountDiscount(nil, startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountAmount)
+	})
+
+	t.Run("end date before start date returns error", func(t *testing.T) {
+		amount, _ := NewMoney(5, 1, "USD")
+		_, err := NewFixedAmountDiscount(amount, endDate, startDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountPeriod)
+	})
+
+	t.Run("percentage discounts have no amount", func(t *testing.T) {
+		d, err := NewDiscount(20, startDate, endDate)
+		require.NoError(t, err)
+		assert.Equal(t, DiscountKindPercentage, d.Kind())
+		assert.Nil(t, d.Amount())
+	})
+}
+
+func TestNewDiscountFromRat(t *testing.T) {
+	startDate := time.Now().UTC()
+	endDate := startDate.Add(24 * time.Hour)
+
+	t.Run("keeps the percentage exact", func(t *testing.T) {
+		d, err := NewDiscountFromRat(big.NewRat(123, 10), startDate, endDate)
+		require.NoError(t, err)
+		assert.Equal(t, "123/10", d.PercentageRat().RatString())
+		assert.Equal(t, "123/10", d.ExactPercentage().RatString())
+		assert.Equal(t, 12.3, d.Percentage())
+
+		price, _ := NewMoney(1000, 1, "USD")
+		expected, _ := NewMoney(877, 1, "USD")
+		assert.True(t, d.Apply(price).Equals(expected))
+	})
+
+	t.Run("float percentages are read as decimals", func(t *testing.T) {
+		d, err := NewDiscount(12.3, startDate, endDate)
+		require.NoError(t, err)
+		assert.Equal(t, "123/10", d.PercentageRat().RatString())
+	})
+
+	t.Run("out of range returns error", func(t *testing.T) {
+		_, err := NewDiscountFromRat(big.NewRat(1001, 10), startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountPercent)
+		_, err = NewDiscountFromRat(big.NewRat(-1, 10), startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountPercent)
+		_, err = NewDiscountFromRat(nil, startDate, endDate)
+		assert.ErrorIs(t, err, ErrInvalidDiscountPercent)
+	})
+
+	t.Run("more than 9 fractional digits returns error", func(t *testing.T) {
+		_, err := NewDiscountFromRat(big.NewRat(100, 3), startDate, endDate)
+		assert.ErrorIs(t, err, ErrDiscountPercentPrecision)
+		_, err = NewDiscountFromRat(big.NewRat(1, 10_000_000_000), startDate, endDate)
+		assert.ErrorIs(t, err, ErrDiscountPercentPrecision)
+		_, err = NewDiscountFromRat(big.NewRat(1, 1_000_000_000), startDate, endDate)
+		assert.NoError(t, err)
+	})
+
+	t.Run("fixed amounts have no exact percentage", func(t *testing.T) {
+		amount, _ := NewMoney(5, 1, "USD")
+		d, err := NewFixedAmountDiscount(amount, startDate, endDate)
+		require.NoError(t, err)
+		assert.Nil(t, d.ExactPercentage())
+	})
+}
+
+func TestParsePercentage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12.3", "123/10"},
+		{"25/2", "25/2"},
+		{" 7.25 ", "29/4"},
+		{"20", "20"},
+		{"0.000000001", "1/1000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			percentage, err := ParsePercentage(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, percentage.RatString())
+		})
+	}
+
+	t.Run("invalid input returns error", func(t *testing.T) {
+		for _, input := range []string{"", "abc", "12,3", "1/0"} {
+			_, err := ParsePercentage(input)
+			assert.ErrorIs(t, err, ErrDiscountPercentPrecision, input)
+		}
+	})
+}
+
+func TestFormatPercentage(t *testing.T) {
+	assert.Equal(t, "12.3", FormatPercentage(big.NewRat(123, 10)))
+	assert.Equal(t, "20", FormatPercentage(big.NewRat(20, 1)))
+	assert.Equal(t, "0", FormatPercentage(new(big.Rat)))
+	assert.Equal(t, "0.000000001", FormatPercentage(big.NewRat(1, 1_000_000_000)))
+}
+
 func TestDiscount_IsValidAt(t *testing.T) {
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
@@ -106,7 +234,7 @@ func TestDiscount_Apply(t *testing.T) {
 	endDate := startDate.Add(24 * time.Hour)
 	discount, _ := NewDiscount(20, startDate, endDate) // 20% off
 
-	price, _ := NewMoney(100, 1) // $100
+	price, _ := NewMoney(100, 1, "USD") // $100
 
 	discountedPrice := discount.Apply(price)
 
@@ -115,6 +243,38 @@ func TestDiscount_Apply(t *testing.T) {
 	assert.Equal(t, 80.0, val)
 }
 
+func TestDiscount_ApplyFixedAmount(t *testing.T) {
+	startDate := time.Now().UTC()
+	endDate := startDate.Add(24 * time.Hour)
+	amount, _ := NewMoney(1250, 100, "USD") // $12.50 off
+	discount, err := NewFixedAmountDiscount(amount, startDate, endDate)
+	require.NoError(t, err)
+
+	t.Run("takes the amount off the price", func(t *testing.T) {
+		price, _ := NewMoney(100, 1, "USD")
+		val, _ := discount.Apply(price).Float64()
+		assert.Equal(t, 87.5, val)
+
+		off, _ := discount.CalculateDiscountAmount(price).Float64()
+		assert.Equal(t, 12.5, off)
+	})
+
+	t.Run("never goes below zero", func(t *testing.T) {
+		price, _ := NewMoney(10, 1, "USD")
+		assert.True(t, discount.Apply(price).IsZero())
+
+		off, _ := discount.CalculateDiscountAmount(price).Float64()
+		assert.Equal(t, 10.0, off)
+	})
+
+	t.Run("does not apply to prices in another currency", func(t *testing.T) {
+		price, _ := NewMoney(100, 1, "EUR")
+		assert.False(t, discount.AppliesTo(price))
+		assert.True(t, discount.Apply(price).Equals(price))
+		assert.True(t, discount.CalculateDiscountAmount(price).IsZero())
+	})
+}
+
 func TestDiscount_IsValidAt_Boundaries(t *testing.T) {
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)
@@ -138,3 +298,37 @@ func TestDiscount_IsValidAt_Boundaries(t *testing.T) {
 		assert.False(t, d.IsValidAt(afterEnd))
 	})
 }
+
+func TestDiscount_Overlaps(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+	june, _ := NewDiscount(10, day(1), day(10))
+
+	t.Run("overlapping periods", func(t *testing.T) {
+		other, _ := NewDiscount(20, day(5), day(15))
+		assert.True(t, june.Overlaps(other))
+		assert.True(t, other.Overlaps(june))
+	})
+
+	t.Run("periods sharing an instant overlap", func(t *testing.T) {
+		other, _ := NewDiscount(20, day(10), day(15))
+		assert.True(t, june.Overlaps(other))
+	})
+
+	t.Run("disjoint periods", func(t *testing.T) {
+		other, _ := NewDiscount(20, day(11), day(15))
+		assert.False(t, june.Overlaps(other))
+		assert.False(t, other.Overlaps(june))
+	})
+}
+
+func TestActiveDiscount(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+	first, _ := NewDiscount(10, day(1), day(10))
+	second, _ := NewDiscount(20, day(15), day(20))
+	discounts := []*Discount{first.WithID("first"), second.WithID("second")}
+
+	assert.Equal(t, "first", ActiveDiscount(discounts, day(5)).ID())
+	assert.Equal(t, "second", ActiveDiscount(discounts, day(15)).ID())
+	assert.Nil(t, ActiveDiscount(discounts, day(12)))
+	assert.Nil(t, ActiveDiscount(nil, day(5)))
+}
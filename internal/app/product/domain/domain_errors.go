@@ -12,16 +12,54 @@ var (
 	ErrInvalidCategory        = errors.New("product category cannot be empty")
 	ErrOptimisticLockConflict = errors.New("product was modified by another transaction")
 	ErrMoneyOverflow          = errors.New("money value exceeds int64 bounds")
+	ErrInvalidCurrency        = errors.New("invalid ISO 4217 currency code")
+	ErrCurrencyMismatch       = errors.New("money values have different currencies")
+	ErrInvalidCostPrice       = errors.New("product cost price cannot be negative")
+
+	// Price list errors
+	ErrInvalidRegion         = errors.New("invalid region code")
+	ErrRegionalPriceNotFound = errors.New("product has no price for this region")
+
+	// Pricing errors
+	ErrInvalidRoundingMode = errors.New("rounding mode must be half_up, half_even, floor or charm")
+	ErrNoPriceAtTime       = errors.New("product has no recorded price at this time")
+
+	// Tax errors
+	ErrInvalidTaxClass = errors.New("invalid tax class")
+	ErrInvalidTaxRate  = errors.New("tax rate must be a percentage between 0 and 100 with at most 9 fractional digits")
+
+	// Scheduled price change errors
+	ErrInvalidPriceChangeTime = errors.New("invalid price change effective time")
+	ErrPriceChangeNotInFuture = errors.New("price change must take effect in the future")
+	ErrPriceChangeConflict    = errors.New("a price change is already scheduled at this time")
+	ErrPriceChangeNotFound    = errors.New("scheduled price change not found")
+
+	// Price guardrail errors
+	ErrInvalidPriceGuardrail      = errors.New("invalid price guardrail")
+	ErrPriceChangeRequestPending  = errors.New("product already has a pending price change request")
+	ErrPriceChangeRequestNotFound = errors.New("price change request not found")
+	ErrPriceChangeRequestStale    = errors.New("base price has changed since the price change was requested")
+	ErrPriceChangeSelfApproval    = errors.New("price change must be approved by someone other than the requester")
 
 	// Discount errors
-	ErrInvalidDiscountPeriod  = errors.New("discount end date must be after start date")
-	ErrDiscountAlreadyActive  = errors.New("product already has an active discount")
-	ErrInvalidDiscountPercent = errors.New("discount percentage must be between 0 and 100")
-	ErrCannotApplyToInactive  = errors.New("cannot apply discount to inactive product")
+	ErrInvalidDiscountPeriod    = errors.New("discount end date must be after start date")
+	ErrDiscountOverlap          = errors.New("discount period overlaps a scheduled discount")
+	ErrDiscountNotFound         = errors.New("discount not found")
+	ErrNoActiveDiscount         = errors.New("product has no active discount")
+	ErrDiscountAlreadyStarted   = errors.New("discount has already started")
+	ErrInvalidDiscountPercent   = errors.New("discount percentage must be between 0 and 100")
+	ErrDiscountPercentPrecision = errors.New("discount percentage must be a decimal with at most 9 fractional digits")
+	ErrCannotApplyToInactive    = errors.New("cannot apply discount to inactive product")
+	ErrInvalidDiscountAmount    = errors.New("discount amount must be positive")
+	ErrDiscountExceedsPrice     = errors.New("discount amount exceeds the product price")
 
 	// Status errors
 	ErrAlreadyActive        = errors.New("product is already active")
 	ErrAlreadyInactive      = errors.New("product is already inactive")
 	ErrAlreadyArchived      = errors.New("product is already archived")
 	ErrCannotModifyArchived = errors.New("cannot modify archived product")
+	ErrNotArchived          = errors.New("product is not archived")
+	ErrArchivalTimeUnknown  = errors.New("archived product has no archival time")
+	ErrRestoreWindowExpired = errors.New("restore window of archived product has expired")
+	ErrRetentionNotElapsed  = errors.New("archived product is still within its retention period")
 )
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 // DomainEvent is the base interface for all domain events.
 type DomainEvent interface {
@@ -14,6 +17,7 @@ type ProductCreatedEvent struct {
 	Name        string
 	Description string
 	Category    string
+	TaxClass    string
 	BasePrice   *Money
 	Status      string
 	CreatedAt   time.Time
@@ -33,6 +37,7 @@ type ProductUpdatedEvent struct {
 	Name        string
 	Description string
 	Category    string
+	TaxClass    string
 	UpdatedAt   time.Time
 }
 
@@ -46,10 +51,13 @@ func (e *ProductUpdatedEvent) AggregateID() string {
 
 // BasePriceChangedEvent is emitted when a product's base price is changed.
 type BasePriceChangedEvent struct {
-	ProductID string
-	OldPrice  *Money
-	NewPrice  *Money
-	ChangedAt time.Time
+	ProductID      string
+	OldPrice       *Money
+	NewPrice       *Money
+	EffectivePrice *RoundedPrice // Effective price at ChangedAt
+	ChangedAt      time.Time
+	PriceChangeID  string // Set when a scheduled price change took effect
+	RequestID      string // Set when an approved price change request took effect
 }
 
 func (e *BasePriceChangedEvent) EventType() string {
@@ -60,6 +68,139 @@ func (e *BasePriceChangedEvent) AggregateID() string {
 	return e.ProductID
 }
 
+// PriceChangeScheduledEvent is emitted when a base price change is scheduled.
+type PriceChangeScheduledEvent struct {
+	ProductID     string
+	PriceChangeID string
+	NewPrice      *Money
+	EffectiveAt   time.Time
+	ScheduledAt   time.Time
+}
+
+func (e *PriceChangeScheduledEvent) EventType() string {
+	return "product.price_change.scheduled"
+}
+
+func (e *PriceChangeScheduledEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// PriceChangeCancelledEvent is emitted when a scheduled price change is dropped before
+// taking effect: cancelled on request, by archiving the product, or because the price could
+// not be applied at its effective time.
+type PriceChangeCancelledEvent struct {
+	ProductID     string
+	PriceChangeID string
+	NewPrice      *Money
+	EffectiveAt   time.Time
+	Reason        string // Why the change was dropped, empty when cancelled on request
+	CancelledAt   time.Time
+}
+
+func (e *PriceChangeCancelledEvent) EventType() string {
+	return "product.price_change.cancelled"
+}
+
+func (e *PriceChangeCancelledEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// PriceChangeRequestedEvent is emitted when a base price change violates the price
+// guardrails and is held for approval instead of taking effect.
+type PriceChangeRequestedEvent struct {
+	ProductID   string
+	RequestID   string
+	OldPrice    *Money
+	NewPrice    *Money
+	RequestedBy string
+	Reason      string
+	Violations  []string // The guardrails the change violates
+	RequestedAt time.Time
+}
+
+func (e *PriceChangeRequestedEvent) EventType() string {
+	return "product.price_change.requested"
+}
+
+func (e *PriceChangeRequestedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// PriceChangeApprovedEvent is emitted when a pending price change request is approved.
+// A BasePriceChangedEvent for the new price follows it.
+type PriceChangeApprovedEvent struct {
+	ProductID   string
+	RequestID   string
+	OldPrice    *Money
+	NewPrice    *Money
+	RequestedBy string
+	ApprovedBy  string
+	ApprovedAt  time.Time
+}
+
+func (e *PriceChangeApprovedEvent) EventType() string {
+	return "product.price_change.approved"
+}
+
+func (e *PriceChangeApprovedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// PriceChangeRejectedEvent is emitted when a pending price change request is rejected,
+// or dropped because the product was archived.
+type PriceChangeRejectedEvent struct {
+	ProductID   string
+	RequestID   string
+	OldPrice    *Money
+	NewPrice    *Money
+	RequestedBy string
+	RejectedBy  string // Empty when the product was archived
+	Reason      string
+	RejectedAt  time.Time
+}
+
+func (e *PriceChangeRejectedEvent) EventType() string {
+	return "product.price_change.rejected"
+}
+
+func (e *PriceChangeRejectedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// RegionalPriceSetEvent is emitted when a regional price is added or changed.
+type RegionalPriceSetEvent struct {
+	ProductID string
+	Region    string
+	OldPrice  *Money // nil when the region had no price
+	NewPrice  *Money
+	ChangedAt time.Time
+}
+
+func (e *RegionalPriceSetEvent) EventType() string {
+	return "product.regional_price.set"
+}
+
+func (e *RegionalPriceSetEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// RegionalPriceRemovedEvent is emitted when a regional price is removed.
+// The region falls back to the base price.
+type RegionalPriceRemovedEvent struct {
+	ProductID string
+	Region    string
+	OldPrice  *Money
+	RemovedAt time.Time
+}
+
+func (e *RegionalPriceRemovedEvent) EventType() string {
+	return "product.regional_price.removed"
+}
+
+func (e *RegionalPriceRemovedEvent) AggregateID() string {
+	return e.ProductID
+}
+
 // ProductActivatedEvent is emitted when a product is activated.
 type ProductActivatedEvent struct {
 	ProductID string
@@ -88,13 +229,18 @@ func (e *ProductDeactivatedEvent) AggregateID() string {
 	return e.ProductID
 }
 
-// DiscountAppliedEvent is emitted when a discount is applied to a product.
+// DiscountAppliedEvent is emitted when a discount is added to a product's schedule.
 type DiscountAppliedEvent struct {
-	ProductID         string
-	DiscountPercent   float64 // Changed from int64 to float64 for fractional percentages
-	DiscountStartDate time.Time
-	DiscountEndDate   time.Time
-	AppliedAt         time.Time
+	ProductID            string
+	DiscountID           string
+	DiscountKind         DiscountKind
+	DiscountPercent      float64  // Changed from int64 to float64 for fractional percentages; zero for fixed amounts
+	DiscountPercentExact *big.Rat // Exact percentage, nil for fixed amounts
+	DiscountAmount       *Money   // Amount off the price for fixed-amount discounts, nil for percentages
+	DiscountStartDate    time.Time
+	DiscountEndDate      time.Time
+	EffectivePrice       *RoundedPrice // Base price with this discount, the effective price while it runs
+	AppliedAt            time.Time
 }
 
 func (e *DiscountAppliedEvent) EventType() string {
@@ -107,8 +253,10 @@ func (e *DiscountAppliedEvent) AggregateID() string {
 
 // DiscountRemovedEvent is emitted when a discount is removed from a product.
 type DiscountRemovedEvent struct {
-	ProductID string
-	RemovedAt time.Time
+	ProductID      string
+	DiscountID     string
+	EffectivePrice *RoundedPrice // Effective price after the removal, nil when archiving
+	RemovedAt      time.Time
 }
 
 func (e *DiscountRemovedEvent) EventType() string {
@@ -119,6 +267,65 @@ func (e *DiscountRemovedEvent) AggregateID() string {
 	return e.ProductID
 }
 
+// DiscountCancelledEvent is emitted when a discount that has not started is cancelled.
+type DiscountCancelledEvent struct {
+	ProductID         string
+	DiscountID        string
+	DiscountStartDate time.Time
+	DiscountEndDate   time.Time
+	CancelledAt       time.Time
+}
+
+func (e *DiscountCancelledEvent) EventType() string {
+	return "product.discount.cancelled"
+}
+
+func (e *DiscountCancelledEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// DiscountStartedEvent is emitted when a scheduled discount takes effect.
+// Discounts that are already valid when applied only emit DiscountAppliedEvent.
+type DiscountStartedEvent struct {
+	ProductID            string
+	DiscountID           string
+	DiscountKind         DiscountKind
+	DiscountPercent      float64  // Zero for fixed amounts
+	DiscountPercentExact *big.Rat // Nil for fixed amounts
+	DiscountAmount       *Money   // Nil for percentages
+	DiscountStartDate    time.Time
+	DiscountEndDate      time.Time
+	EffectivePrice       *RoundedPrice // Effective price at StartedAt
+	StartedAt            time.Time     // When the start was detected, at or after DiscountStartDate
+}
+
+func (e *DiscountStartedEvent) EventType() string {
+	return "product.discount.started"
+}
+
+func (e *DiscountStartedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// DiscountExpiredEvent is emitted when a discount's period has ended and it is removed
+// from the schedule.
+type DiscountExpiredEvent struct {
+	ProductID         string
+	DiscountID        string
+	DiscountStartDate time.Time
+	DiscountEndDate   time.Time
+	EffectivePrice    *RoundedPrice // Effective price at ExpiredAt
+	ExpiredAt         time.Time     // When the expiry was detected, after DiscountEndDate
+}
+
+func (e *DiscountExpiredEvent) EventType() string {
+	return "product.discount.expired"
+}
+
+func (e *DiscountExpiredEvent) AggregateID() string {
+	return e.ProductID
+}
+
 // ProductArchivedEvent is emitted when a product is archived (soft deleted).
 type ProductArchivedEvent struct {
 	ProductID  string
@@ -132,3 +339,33 @@ func (e *ProductArchivedEvent) EventType() string {
 func (e *ProductArchivedEvent) AggregateID() string {
 	return e.ProductID
 }
+
+// ProductRestoredEvent is emitted when an archived product is restored.
+type ProductRestoredEvent struct {
+	ProductID  string
+	ArchivedAt time.Time // When the product had been archived
+	RestoredAt time.Time
+}
+
+func (e *ProductRestoredEvent) EventType() string {
+	return "product.restored"
+}
+
+func (e *ProductRestoredEvent) AggregateID() string {
+	return e.ProductID
+}
+
+// ProductPurgedEvent is the tombstone of an archived product that was permanently deleted.
+type ProductPurgedEvent struct {
+	ProductID  string
+	ArchivedAt time.Time
+	PurgedAt   time.Time
+}
+
+func (e *ProductPurgedEvent) EventType() string {
+	return "product.purged"
+}
+
+func (e *ProductPurgedEvent) AggregateID() string {
+	return e.ProductID
+}
@@ -6,14 +6,17 @@ import (
 )
 
 // Money represents a monetary value with precise decimal arithmetic using big.Rat.
-// It stores the value as a rational number (numerator/denominator) to avoid floating-point precision issues.
+// It stores the value as a rational number (numerator/denominator) to avoid floating-point precision issues,
+// together with the ISO 4217 currency the amount is expressed in.
+// Arithmetic between values of different currencies is rejected with ErrCurrencyMismatch.
 type Money struct {
-	rat *big.Rat
+	rat      *big.Rat
+	currency Currency
 }
 
-// NewMoney creates a new Money instance from numerator and denominator.
-// Example: NewMoney(249900, 100) represents $2499.00
-func NewMoney(numerator, denominator int64) (*Money, error) {
+// NewMoney creates a new Money instance from numerator, denominator and currency code.
+// Example: NewMoney(249900, 100, "USD") represents $2499.00
+func NewMoney(numerator, denominator int64, currency Currency) (*Money, error) {
 	if denominator == 0 {
 		return nil, fmt.Errorf("denominator cannot be zero")
 	}
@@ -21,16 +24,30 @@ func NewMoney(numerator, denominator int64) (*Money, error) {
 		return nil, fmt.Errorf("denominator must be positive, got %d", denominator)
 	}
 
+	code, err := ParseCurrency(string(currency))
+	if err != nil {
+		return nil, err
+	}
+
 	rat := big.NewRat(numerator, denominator)
-	return &Money{rat: rat}, nil
+	return &Money{rat: rat, currency: code}, nil
 }
 
-// NewMoneyFromRat creates a new Money instance from a big.Rat.
-func NewMoneyFromRat(rat *big.Rat) *Money {
+// NewMoneyFromRat creates a new Money instance from a big.Rat and currency code.
+func NewMoneyFromRat(rat *big.Rat, currency Currency) (*Money, error) {
+	code, err := ParseCurrency(string(currency))
+	if err != nil {
+		return nil, err
+	}
 	if rat == nil {
-		return &Money{rat: big.NewRat(0, 1)}
+		return &Money{rat: big.NewRat(0, 1), currency: code}, nil
 	}
-	return &Money{rat: new(big.Rat).Set(rat)}
+	return &Money{rat: new(big.Rat).Set(rat), currency: code}, nil
+}
+
+// Currency returns the ISO 4217 currency of the value.
+func (m *Money) Currency() Currency {
+	return m.currency
 }
 
 // Numerator returns the numerator of the rational number.
@@ -60,36 +77,64 @@ func (m *Money) IsSafeForStorage() bool {
 }
 
 // Add adds two Money values and returns a new Money instance.
-func (m *Money) Add(other *Money) *Money {
-	result := new(big.Rat).Add(m.rat, other.rat)
-	return &Money{rat: result}
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Add(other *Money) (*Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return nil, err
+	}
+	return &Money{rat: new(big.Rat).Add(m.rat, other.rat), currency: m.currency}, nil
 }
 
 // Subtract subtracts another Money value from this one and returns a new Money instance.
-func (m *Money) Subtract(other *Money) *Money {
-	result := new(big.Rat).Sub(m.rat, other.rat)
-	return &Money{rat: result}
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Subtract(other *Money) (*Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return nil, err
+	}
+	return m.sub(other), nil
 }
 
 // Multiply multiplies this Money value by another and returns a new Money instance.
-func (m *Money) Multiply(other *Money) *Money {
-	result := new(big.Rat).Mul(m.rat, other.rat)
-	return &Money{rat: result}
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Multiply(other *Money) (*Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return nil, err
+	}
+	return &Money{rat: new(big.Rat).Mul(m.rat, other.rat), currency: m.currency}, nil
 }
 
 // MultiplyByRat multiplies this Money value by a rational number and returns a new Money instance.
+// The result keeps this value's currency.
 func (m *Money) MultiplyByRat(rat *big.Rat) *Money {
 	result := new(big.Rat).Mul(m.rat, rat)
-	return &Money{rat: result}
+	return &Money{rat: result, currency: m.currency}
 }
 
 // Divide divides this Money value by another and returns a new Money instance.
+// Returns ErrCurrencyMismatch if the currencies differ.
 func (m *Money) Divide(other *Money) (*Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return nil, err
+	}
 	if other.rat.Sign() == 0 {
 		return nil, fmt.Errorf("cannot divide by zero")
 	}
 	result := new(big.Rat).Quo(m.rat, other.rat)
-	return &Money{rat: result}, nil
+	return &Money{rat: result, currency: m.currency}, nil
+}
+
+// sub subtracts without checking currencies. Callers must guarantee both values
+// share a currency, e.g. because other was derived from m.
+func (m *Money) sub(other *Money) *Money {
+	return &Money{rat: new(big.Rat).Sub(m.rat, other.rat), currency: m.currency}
+}
+
+// checkCurrency returns ErrCurrencyMismatch if other is in a different currency.
+func (m *Money) checkCurrency(other *Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return nil
 }
 
 // IsZero returns true if the money value is zero.
@@ -108,18 +153,20 @@ func (m *Money) IsPositive() bool {
 }
 
 // LessThan returns true if this Money value is less than another.
+// Values in different currencies are not comparable; the result is then false.
 func (m *Money) LessThan(other *Money) bool {
-	return m.rat.Cmp(other.rat) < 0
+	return m.currency == other.currency && m.rat.Cmp(other.rat) < 0
 }
 
 // GreaterThan returns true if this Money value is greater than another.
+// Values in different currencies are not comparable; the result is then false.
 func (m *Money) GreaterThan(other *Money) bool {
-	return m.rat.Cmp(other.rat) > 0
+	return m.currency == other.currency && m.rat.Cmp(other.rat) > 0
 }
 
-// Equals returns true if this Money value equals another.
+// Equals returns true if this Money value equals another in the same currency.
 func (m *Money) Equals(other *Money) bool {
-	return m.rat.Cmp(other.rat) == 0
+	return m.currency == other.currency && m.rat.Cmp(other.rat) == 0
 }
 
 // Float64 returns an approximate float64 representation. The second return value indicates
@@ -128,25 +175,26 @@ func (m *Money) Equals(other *Money) bool {
 //
 // Example:
 //
-//	m := NewMoney(100, 3)  // 33.333...
+//	m := NewMoney(100, 3, "USD")  // 33.333...
 //	f, exact := m.Float64()  // f ≈ 33.333..., exact = false (repeating decimal)
 func (m *Money) Float64() (float64, bool) {
 	return m.rat.Float64()
 }
 
-// String returns a string representation of the money value.
+// String returns the amount rounded to the currency's minor unit
+// (e.g. "2499.00" for USD, "2499" for JPY, "2.500" for KWD).
 func (m *Money) String() string {
-	return m.rat.FloatString(2)
+	return m.rat.FloatString(m.currency.MinorUnits())
 }
 
 // Copy creates a deep copy of this Money instance.
 func (m *Money) Copy() *Money {
-	return &Money{rat: new(big.Rat).Set(m.rat)}
+	return &Money{rat: new(big.Rat).Set(m.rat), currency: m.currency}
 }
 
 // Normalize returns a new Money with the fraction reduced to lowest terms.
 // This ensures consistent storage: 200/2 becomes 100/1.
 // big.Rat automatically normalizes, so we just create a new instance.
 func (m *Money) Normalize() *Money {
-	return &Money{rat: new(big.Rat).Set(m.rat)}
+	return &Money{rat: new(big.Rat).Set(m.rat), currency: m.currency}
 }
@@ -11,7 +11,7 @@ import (
 
 func TestNewMoney(t *testing.T) {
 	t.Run("valid money creation", func(t *testing.T) {
-		m, err := NewMoney(100, 1)
+		m, err := NewMoney(100, 1, "USD")
 		require.NoError(t, err)
 		num, err := m.Numerator()
 		require.NoError(t, err)
@@ -22,54 +22,71 @@ func TestNewMoney(t *testing.T) {
 	})
 
 	t.Run("zero denominator returns error", func(t *testing.T) {
-		_, err := NewMoney(100, 0)
+		_, err := NewMoney(100, 0, "USD")
 		assert.Error(t, err)
 	})
 
 	t.Run("negative denominator returns error", func(t *testing.T) {
-		_, err := NewMoney(100, -1)
+		_, err := NewMoney(100, -1, "USD")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "positive")
 	})
 
 	t.Run("negative numerator allowed", func(t *testing.T) {
-		m, err := NewMoney(-100, 1)
+		m, err := NewMoney(-100, 1, "USD")
 		require.NoError(t, err)
 		assert.True(t, m.IsNegative())
 	})
+
+	t.Run("currency is normalized to uppercase", func(t *testing.T) {
+		m, err := NewMoney(100, 1, "eur")
+		require.NoError(t, err)
+		assert.Equal(t, Currency("EUR"), m.Currency())
+	})
+
+	t.Run("unknown currency returns error", func(t *testing.T) {
+		for _, code := range []Currency{"", "US", "USDT", "XYZ", "XAU"} {
+			_, err := NewMoney(100, 1, code)
+			assert.ErrorIs(t, err, ErrInvalidCurrency, code)
+		}
+	})
 }
 
 func TestMoney_Add(t *testing.T) {
-	m1, _ := NewMoney(100, 1) // 100
-	m2, _ := NewMoney(50, 1)  // 50
+	m1, _ := NewMoney(100, 1, "USD") // 100
+	m2, _ := NewMoney(50, 1, "USD")  // 50
 
-	result := m1.Add(m2)
+	result, err := m1.Add(m2)
+	require.NoError(t, err)
 	val, _ := result.Float64()
 	assert.Equal(t, 150.0, val)
+	assert.Equal(t, Currency("USD"), result.Currency())
 }
 
 func TestMoney_Subtract(t *testing.T) {
-	m1, _ := NewMoney(100, 1) // 100
-	m2, _ := NewMoney(30, 1)  // 30
+	m1, _ := NewMoney(100, 1, "USD") // 100
+	m2, _ := NewMoney(30, 1, "USD")  // 30
 
-	result := m1.Subtract(m2)
+	result, err := m1.Subtract(m2)
+	require.NoError(t, err)
 	val, _ := result.Float64()
 	assert.Equal(t, 70.0, val)
 }
 
 func TestMoney_Multiply(t *testing.T) {
-	m1, _ := NewMoney(100, 1) // 100
-	m2, _ := NewMoney(3, 2)   // 1.5
+	m1, _ := NewMoney(100, 1, "USD") // 100
+	m2, _ := NewMoney(3, 2, "USD")   // 1.5
 
-	result := m1.Multiply(m2)
+	result, err := m1.Multiply(m2)
+	require.NoError(t, err)
 	val, _ := result.Float64()
 	assert.Equal(t, 150.0, val)
 }
 
 func TestMoney_Divide(t *testing.T) {
 	t.Run("valid division", func(t *testing.T) {
-		m1, _ := NewMoney(100, 1) // 100
-		m2, _ := NewMoney(2, 1)   // 2
+		m1, _ := NewMoney(100, 1, "USD") // 100
+		m2, _ := NewMoney(2, 1, "USD")   // 2
 
 		result, err := m1.Divide(m2)
 		require.NoError(t, err)
@@ -78,8 +95,8 @@ func TestMoney_Divide(t *testing.T) {
 	})
 
 	t.Run("division by zero returns error", func(t *testing.T) {
-		m1, _ := NewMoney(100, 1)
-		m2, _ := NewMoney(0, 1)
+		m1, _ := NewMoney(100, 1, "USD")
+		m2, _ := NewMoney(0, 1, "USD")
 
 		_, err := m1.Divide(m2)
 		assert.Error(t, err)
@@ -87,9 +104,9 @@ func TestMoney_Divide(t *testing.T) {
 }
 
 func TestMoney_Comparisons(t *testing.T) {
-	m1, _ := NewMoney(100, 1)
-	m2, _ := NewMoney(50, 1)
-	m3, _ := NewMoney(100, 1)
+	m1, _ := NewMoney(100, 1, "USD")
+	m2, _ := NewMoney(50, 1, "USD")
+	m3, _ := NewMoney(100, 1, "USD")
 
 	assert.True(t, m1.GreaterThan(m2))
 	assert.False(t, m2.GreaterThan(m1))
@@ -101,13 +118,55 @@ func TestMoney_Comparisons(t *testing.T) {
 	assert.False(t, m1.Equals(m2))
 }
 
+func TestMoney_CurrencyMismatch(t *testing.T) {
+	usd, _ := NewMoney(100, 1, "USD")
+	eur, _ := NewMoney(100, 1, "EUR")
+
+	_, err := usd.Add(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Subtract(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Multiply(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Divide(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	// Values in different currencies are never equal or ordered
+	assert.False(t, usd.Equals(eur))
+	assert.False(t, usd.LessThan(eur))
+	assert.False(t, usd.GreaterThan(eur))
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		num, den int64
+		currency Currency
+		expected string
+	}{
+		{"two minor digits", 249900, 100, "USD", "2499.00"},
+		{"no minor unit", 2499, 1, "JPY", "2499"},
+		{"three minor digits", 5, 2, "KWD", "2.500"},
+		{"rounds to the minor unit", 1, 3, "EUR", "0.33"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMoney(tt.num, tt.den, tt.currency)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m.String())
+		})
+	}
+}
+
 func TestMoney_Precision(t *testing.T) {
 	// Test precise decimal arithmetic - no floating point errors
-	m1, _ := NewMoney(249900, 100)   // $2499.00
-	discount, _ := NewMoney(20, 100) // 20%
+	m1, _ := NewMoney(249900, 100, "USD")   // $2499.00
+	discount, _ := NewMoney(20, 100, "USD") // 20%
 
 	discountAmount := m1.MultiplyByRat(discount.rat)
-	finalPrice := m1.Subtract(discountAmount)
+	finalPrice, err := m1.Subtract(discountAmount)
+	require.NoError(t, err)
 
 	// 2499.00 - (2499.00 * 0.20) = 2499.00 - 499.80 = 1999.20
 	assert.Equal(t, "1999.20", finalPrice.String())
@@ -116,7 +175,7 @@ func TestMoney_Precision(t *testing.T) {
 func TestMoney_Normalize(t *testing.T) {
 	t.Run("reduces fraction to lowest terms", func(t *testing.T) {
 		// 200/2 should normalize to 100/1
-		m, _ := NewMoney(200, 2)
+		m, _ := NewMoney(200, 2, "USD")
 		normalized := m.Normalize()
 		num, err := normalized.Numerator()
 		require.NoError(t, err)
@@ -128,7 +187,7 @@ func TestMoney_Normalize(t *testing.T) {
 
 	t.Run("normalizes complex fraction", func(t *testing.T) {
 		// 300/6 should normalize to 50/1
-		m, _ := NewMoney(300, 6)
+		m, _ := NewMoney(300, 6, "USD")
 		normalized := m.Normalize()
 		num, err := normalized.Numerator()
 		require.NoError(t, err)
@@ -140,7 +199,7 @@ func TestMoney_Normalize(t *testing.T) {
 
 	t.Run("already normalized value unchanged", func(t *testing.T) {
 		// 100/1 should stay 100/1
-		m, _ := NewMoney(100, 1)
+		m, _ := NewMoney(100, 1, "USD")
 		normalized := m.Normalize()
 		num, err := normalized.Numerator()
 		require.NoError(t, err)
@@ -152,7 +211,7 @@ func TestMoney_Normalize(t *testing.T) {
 
 	t.Run("normalizes negative numerator correctly", func(t *testing.T) {
 		// -200/2 should normalize to -100/1
-		m, _ := NewMoney(-200, 2)
+		m, _ := NewMoney(-200, 2, "USD")
 		normalized := m.Normalize()
 		num, err := normalized.Numerator()
 		require.NoError(t, err)
@@ -164,7 +223,7 @@ func TestMoney_Normalize(t *testing.T) {
 
 	t.Run("normalizes fractional prices", func(t *testing.T) {
 		// 249900/100 should normalize to 2499/1
-		m, _ := NewMoney(249900, 100)
+		m, _ := NewMoney(249900, 100, "USD")
 		normalized := m.Normalize()
 		num, err := normalized.Numerator()
 		require.NoError(t, err)
@@ -176,8 +235,8 @@ func TestMoney_Normalize(t *testing.T) {
 
 	t.Run("preserves value equality after normalization", func(t *testing.T) {
 		// Different representations of the same value should equal after normalization
-		m1, _ := NewMoney(200, 2) // 100
-		m2, _ := NewMoney(400, 4) // 100
+		m1, _ := NewMoney(200, 2, "USD") // 100
+		m2, _ := NewMoney(400, 4, "USD") // 100
 
 		normalized1 := m1.Normalize()
 		normalized2 := m2.Normalize()
@@ -200,7 +259,7 @@ func TestMoney_EdgeCases(t *testing.T) {
 	t.Run("very large price - near MaxInt64", func(t *testing.T) {
 		// MaxInt64 = 9223372036854775807
 		// Test with a very large but safe value
-		largePrice, err := NewMoney(9223372036854775807, 100)
+		largePrice, err := NewMoney(9223372036854775807, 100, "USD")
 		require.NoError(t, err)
 
 		// Verify it can be stored and retrieved
@@ -218,14 +277,14 @@ func TestMoney_EdgeCases(t *testing.T) {
 
 	t.Run("very small price - fractional cents", func(t *testing.T) {
 		// $0.01
-		smallPrice, err := NewMoney(1, 100)
+		smallPrice, err := NewMoney(1, 100, "USD")
 		require.NoError(t, err)
 
 		val, _ := smallPrice.Float64()
 		assert.Equal(t, 0.01, val)
 
 		// Even smaller - $0.001
-		tinyPrice, err := NewMoney(1, 1000)
+		tinyPrice, err := NewMoney(1, 1000, "USD")
 		require.NoError(t, err)
 
 		val, _ = tinyPrice.Float64()
@@ -234,15 +293,16 @@ func TestMoney_EdgeCases(t *testing.T) {
 
 	t.Run("fractional cent handling", func(t *testing.T) {
 		// $10.001 - fractional cent
-		price, err := NewMoney(10001, 1000)
+		price, err := NewMoney(10001, 1000, "USD")
 		require.NoError(t, err)
 
 		val, _ := price.Float64()
 		assert.InDelta(t, 10.001, val, 0.00001)
 
 		// Operations should preserve precision
-		price2, _ := NewMoney(5000, 1000)
-		result := price.Add(price2)
+		price2, _ := NewMoney(5000, 1000, "USD")
+		result, err := price.Add(price2)
+		require.NoError(t, err)
 
 		resultVal, _ := result.Float64()
 		assert.InDelta(t, 15.001, resultVal, 0.00001)
@@ -250,21 +310,23 @@ func TestMoney_EdgeCases(t *testing.T) {
 
 	t.Run("multiple operations preserve precision", func(t *testing.T) {
 		// Start with $100.00
-		price, _ := NewMoney(10000, 100)
+		price, _ := NewMoney(10000, 100, "USD")
 
 		// Apply 20% discount
-		discount, _ := NewMoney(20, 100)
+		discount, _ := NewMoney(20, 100, "USD")
 		discountAmount := price.MultiplyByRat(discount.rat)
-		afterDiscount := price.Subtract(discountAmount)
+		afterDiscount, err := price.Subtract(discountAmount)
+		require.NoError(t, err)
 
 		// Result should be $80.00
 		val, _ := afterDiscount.Float64()
 		assert.Equal(t, 80.0, val)
 
 		// Apply another 10% discount
-		discount2, _ := NewMoney(10, 100)
+		discount2, _ := NewMoney(10, 100, "USD")
 		discountAmount2 := afterDiscount.MultiplyByRat(discount2.rat)
-		final := afterDiscount.Subtract(discountAmount2)
+		final, err := afterDiscount.Subtract(discountAmount2)
+		require.NoError(t, err)
 
 		// Result should be $72.00 (80 - 8)
 		finalVal, _ := final.Float64()
@@ -273,60 +335,64 @@ func TestMoney_EdgeCases(t *testing.T) {
 
 	t.Run("Float64 precision indicator", func(t *testing.T) {
 		// Exact representation
-		exactPrice, _ := NewMoney(100, 1)
+		exactPrice, _ := NewMoney(100, 1, "USD")
 		val, exact := exactPrice.Float64()
 		assert.Equal(t, 100.0, val)
 		assert.True(t, exact, "100/1 should have exact float representation")
 
 		// Non-exact representation (repeating decimal)
-		nonExactPrice, _ := NewMoney(1, 3)
+		nonExactPrice, _ := NewMoney(1, 3, "USD")
 		val, exact = nonExactPrice.Float64()
 		assert.InDelta(t, 0.333333, val, 0.00001)
 		assert.False(t, exact, "1/3 should not have exact float representation")
 	})
 
 	t.Run("zero value operations", func(t *testing.T) {
-		zero, _ := NewMoney(0, 1)
-		price, _ := NewMoney(100, 1)
+		zero, _ := NewMoney(0, 1, "USD")
+		price, _ := NewMoney(100, 1, "USD")
 
 		// Adding zero
-		result := price.Add(zero)
+		result, err := price.Add(zero)
+		require.NoError(t, err)
 		assert.True(t, result.Equals(price))
 
 		// Subtracting zero
-		result = price.Subtract(zero)
+		result, err = price.Subtract(zero)
+		require.NoError(t, err)
 		assert.True(t, result.Equals(price))
 
 		// Multiplying by zero
-		result = price.Multiply(zero)
+		result, err = price.Multiply(zero)
+		require.NoError(t, err)
 		assert.True(t, result.IsZero())
 	})
 
 	t.Run("comparison edge cases", func(t *testing.T) {
 		// Very close but different values
-		price1, _ := NewMoney(10000, 100) // 100.00
-		price2, _ := NewMoney(10001, 100) // 100.01
+		price1, _ := NewMoney(10000, 100, "USD") // 100.00
+		price2, _ := NewMoney(10001, 100, "USD") // 100.01
 
 		assert.True(t, price2.GreaterThan(price1))
 		assert.True(t, price1.LessThan(price2))
 		assert.False(t, price1.Equals(price2))
 
 		// Same value, different representations
-		price3, _ := NewMoney(100, 1)
-		price4, _ := NewMoney(200, 2)
+		price3, _ := NewMoney(100, 1, "USD")
+		price4, _ := NewMoney(200, 2, "USD")
 		assert.True(t, price3.Equals(price4))
 	})
 
 	t.Run("copy independence", func(t *testing.T) {
-		original, _ := NewMoney(100, 1)
+		original, _ := NewMoney(100, 1, "USD")
 		copied := original.Copy()
 
 		// Verify they're equal
 		assert.True(t, original.Equals(copied))
 
 		// Modify copy
-		newPrice, _ := NewMoney(50, 1)
-		modified := copied.Add(newPrice)
+		newPrice, _ := NewMoney(50, 1, "USD")
+		modified, err := copied.Add(newPrice)
+		require.NoError(t, err)
 
 		// Original should be unchanged
 		origVal, _ := original.Float64()
@@ -345,7 +411,7 @@ func TestDiscount_EdgeCases(t *testing.T) {
 		discount, err := NewDiscount(0, now, now.Add(24*time.Hour))
 		require.NoError(t, err)
 
-		price, _ := NewMoney(10000, 100) // $100.00
+		price, _ := NewMoney(10000, 100, "USD") // $100.00
 		discounted := discount.Apply(price)
 
 		val, _ := discounted.Float64()
@@ -356,7 +422,7 @@ func TestDiscount_EdgeCases(t *testing.T) {
 		discount, err := NewDiscount(100, now, now.Add(24*time.Hour))
 		require.NoError(t, err)
 
-		price, _ := NewMoney(10000, 100) // $100.00
+		price, _ := NewMoney(10000, 100, "USD") // $100.00
 		discounted := discount.Apply(price)
 
 		assert.True(t, discounted.IsZero())
@@ -369,7 +435,7 @@ func TestDiscount_EdgeCases(t *testing.T) {
 		require.NoError(t, err)
 
 		// $0.02
-		tinyPrice, _ := NewMoney(2, 100)
+		tinyPrice, _ := NewMoney(2, 100, "USD")
 		discounted := discount.Apply(tinyPrice)
 
 		val, _ := discounted.Float64()
@@ -381,7 +447,7 @@ func TestDiscount_EdgeCases(t *testing.T) {
 		discount, err := NewDiscount(33, now, now.Add(24*time.Hour))
 		require.NoError(t, err)
 
-		price, _ := NewMoney(10000, 100) // $100.00
+		price, _ := NewMoney(10000, 100, "USD") // $100.00
 		discounted := discount.Apply(price)
 
 		// $100 * 0.67 = $67.00
@@ -396,7 +462,7 @@ func TestDiscount_EdgeCases(t *testing.T) {
 		discount2, err := NewDiscount(10, now, now.Add(24*time.Hour))
 		require.NoError(t, err)
 
-		price, _ := NewMoney(10000, 100) // $100.00
+		price, _ := NewMoney(10000, 100, "USD") // $100.00
 
 		// Apply 20% discount: $100 * 0.80 = $80
 		afterFirst := discount1.Apply(price)
@@ -416,9 +482,10 @@ func TestMoney_Overflow(t *testing.T) {
 		// 2^100 is way larger than MaxInt64 (2^63 - 1)
 		huge := new(big.Int).Lsh(big.NewInt(1), 100) // 2^100
 		hugeRat := new(big.Rat).SetInt(huge)
-		m := NewMoneyFromRat(hugeRat)
+		m, err := NewMoneyFromRat(hugeRat, "USD")
+		require.NoError(t, err)
 
-		_, err := m.Numerator()
+		_, err = m.Numerator()
 		assert.ErrorIs(t, err, ErrMoneyOverflow, "should detect numerator overflow")
 		assert.False(t, m.IsSafeForStorage(), "should not be safe for storage")
 	})
@@ -428,16 +495,17 @@ func TestMoney_Overflow(t *testing.T) {
 		// 1 / 2^100
 		hugeDenom := new(big.Int).Lsh(big.NewInt(1), 100)
 		rat := new(big.Rat).SetFrac(big.NewInt(1), hugeDenom)
-		m := NewMoneyFromRat(rat)
+		m, err := NewMoneyFromRat(rat, "USD")
+		require.NoError(t, err)
 
-		_, err := m.Denominator()
+		_, err = m.Denominator()
 		assert.ErrorIs(t, err, ErrMoneyOverflow, "should detect denominator overflow")
 		assert.False(t, m.IsSafeForStorage(), "should not be safe for storage")
 	})
 
 	t.Run("safe values within int64 bounds", func(t *testing.T) {
 		// MaxInt64 = 9223372036854775807
-		safeValue, err := NewMoney(9223372036854775807, 1)
+		safeValue, err := NewMoney(9223372036854775807, 1, "USD")
 		require.NoError(t, err)
 
 		num, err := safeValue.Numerator()
@@ -453,7 +521,7 @@ func TestMoney_Overflow(t *testing.T) {
 
 	t.Run("operations that cause overflow", func(t *testing.T) {
 		// Start with MaxInt64 and multiply by a large factor
-		maxInt64, _ := NewMoney(9223372036854775807, 1)
+		maxInt64, _ := NewMoney(9223372036854775807, 1, "USD")
 		largeFactor := new(big.Rat).SetInt64(1000000)
 
 		result := maxInt64.MultiplyByRat(largeFactor)
@@ -468,10 +536,11 @@ func TestMoney_Overflow(t *testing.T) {
 		// Even after normalization, if the value is too large, it should still overflow
 		huge := new(big.Int).Lsh(big.NewInt(1), 100)
 		hugeRat := new(big.Rat).SetInt(huge)
-		m := NewMoneyFromRat(hugeRat)
+		m, err := NewMoneyFromRat(hugeRat, "USD")
+		require.NoError(t, err)
 
 		normalized := m.Normalize()
-		_, err := normalized.Numerator()
+		_, err = normalized.Numerator()
 		assert.ErrorIs(t, err, ErrMoneyOverflow)
 		assert.False(t, normalized.IsSafeForStorage())
 	})
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ScheduledPriceChange is a base price change that takes effect at a later time, e.g.
+// "raise to $29.99 on Jan 1". It waits in its product's schedule until a worker applies
+// it through ApplyDuePriceChanges.
+type ScheduledPriceChange struct {
+	id            string // Identifies the change in its product's schedule
+	newPrice      *Money
+	effectiveAt   time.Time
+	changedBy     string // Recorded in price history when the change is applied
+	changedReason string
+}
+
+// NewScheduledPriceChange creates a scheduled price change with validation.
+// The new price must be positive and the effective time must be in UTC.
+func NewScheduledPriceChange(newPrice *Money, effectiveAt time.Time, changedBy, changedReason string) (*ScheduledPriceChange, error) {
+	if newPrice == nil || newPrice.IsNegative() || newPrice.IsZero() {
+		return nil, ErrInvalidPrice
+	}
+
+	if effectiveAt.IsZero() {
+		return nil, fmt.Errorf("%w: effective time is required", ErrInvalidPriceChangeTime)
+	}
+	if effectiveAt.Location() != time.UTC {
+		return nil, fmt.Errorf("%w: effective time must be in UTC timezone", ErrInvalidPriceChangeTime)
+	}
+
+	return &ScheduledPriceChange{
+		newPrice:      newPrice.Copy(),
+		effectiveAt:   effectiveAt,
+		changedBy:     changedBy,
+		changedReason: changedReason,
+	}, nil
+}
+
+// WithID returns a copy of the change identified by id within its product's schedule.
+func (c *ScheduledPriceChange) WithID(id string) *ScheduledPriceChange {
+	cp := c.copy()
+	cp.id = id
+	return cp
+}
+
+// copy returns a copy of the change.
+func (c *ScheduledPriceChange) copy() *ScheduledPriceChange {
+	return &ScheduledPriceChange{
+		id:            c.id,
+		newPrice:      c.newPrice.Copy(),
+		effectiveAt:   c.effectiveAt,
+		changedBy:     c.changedBy,
+		changedReason: c.changedReason,
+	}
+}
+
+// ID returns the change's identifier within its product's schedule.
+func (c *ScheduledPriceChange) ID() string {
+	return c.id
+}
+
+// NewPrice returns a copy of the base price the change sets.
+func (c *ScheduledPriceChange) NewPrice() *Money {
+	return c.newPrice.Copy()
+}
+
+// EffectiveAt returns when the change takes effect.
+func (c *ScheduledPriceChange) EffectiveAt() time.Time {
+	return c.effectiveAt
+}
+
+// ChangedBy returns who scheduled the change.
+func (c *ScheduledPriceChange) ChangedBy() string {
+	return c.changedBy
+}
+
+// ChangedReason returns the explanation given for the change.
+func (c *ScheduledPriceChange) ChangedReason() string {
+	return c.changedReason
+}
+
+// IsDue reports whether the change has reached its effective time at t.
+func (c *ScheduledPriceChange) IsDue(t time.Time) bool {
+	return !t.Before(c.effectiveAt)
+}
+
+// sortPriceChanges orders a price change schedule by effective time.
+func sortPriceChanges(changes []*ScheduledPriceChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].effectiveAt.Before(changes[j].effectiveAt)
+	})
+}
@@ -0,0 +1,103 @@
+package domain
+
+import "time"
+
+// PriceChangeDecision is the outcome of a price change request.
+type PriceChangeDecision string
+
+const (
+	PriceChangeApproved PriceChangeDecision = "approved"
+	PriceChangeRejected PriceChangeDecision = "rejected"
+)
+
+// PriceChangeRequest is a base price change held for a second approver because it
+// violates the price guardrails. It takes effect through ApprovePriceChangeRequest.
+type PriceChangeRequest struct {
+	id              string
+	oldPrice        *Money // Base price when requested; approval requires it to be unchanged
+	newPrice        *Money
+	requestedBy     string
+	requestedReason string
+	violations      []string
+	requestedAt     time.Time
+}
+
+// NewPriceChangeRequest creates a request to change the base price from oldPrice to
+// newPrice. The new price must be positive and in the currency of the old one.
+func NewPriceChangeRequest(
+	oldPrice, newPrice *Money,
+	requestedBy, requestedReason string,
+	violations []string,
+	requestedAt time.Time,
+) (*PriceChangeRequest, error) {
+	if newPrice == nil || newPrice.IsNegative() || newPrice.IsZero() {
+		return nil, ErrInvalidPrice
+	}
+	if newPrice.Currency() != oldPrice.Currency() {
+		return nil, ErrCurrencyMismatch
+	}
+
+	return &PriceChangeRequest{
+		oldPrice:        oldPrice.Copy(),
+		newPrice:        newPrice.Copy(),
+		requestedBy:     requestedBy,
+		requestedReason: requestedReason,
+		violations:      append([]string(nil), violations...),
+		requestedAt:     requestedAt,
+	}, nil
+}
+
+// WithID returns a copy of the request with the given identifier.
+func (r *PriceChangeRequest) WithID(id string) *PriceChangeRequest {
+	cp := r.copy()
+	cp.id = id
+	return cp
+}
+
+// copy returns a copy of the request.
+func (r *PriceChangeRequest) copy() *PriceChangeRequest {
+	return &PriceChangeRequest{
+		id:              r.id,
+		oldPrice:        r.oldPrice.Copy(),
+		newPrice:        r.newPrice.Copy(),
+		requestedBy:     r.requestedBy,
+		requestedReason: r.requestedReason,
+		violations:      append([]string(nil), r.violations...),
+		requestedAt:     r.requestedAt,
+	}
+}
+
+// ID returns the request's identifier.
+func (r *PriceChangeRequest) ID() string {
+	return r.id
+}
+
+// OldPrice returns a copy of the base price at the time of the request.
+func (r *PriceChangeRequest) OldPrice() *Money {
+	return r.oldPrice.Copy()
+}
+
+// NewPrice returns a copy of the requested base price.
+func (r *PriceChangeRequest) NewPrice() *Money {
+	return r.newPrice.Copy()
+}
+
+// RequestedBy returns who requested the change.
+func (r *PriceChangeRequest) RequestedBy() string {
+	return r.requestedBy
+}
+
+// RequestedReason returns the explanation given for the change.
+func (r *PriceChangeRequest) RequestedReason() string {
+	return r.requestedReason
+}
+
+// Violations returns the guardrails the change violates.
+func (r *PriceChangeRequest) Violations() []string {
+	return append([]string(nil), r.violations...)
+}
+
+// RequestedAt returns when the change was requested.
+func (r *PriceChangeRequest) RequestedAt() time.Time {
+	return r.requestedAt
+}
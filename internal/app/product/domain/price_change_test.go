@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustPriceChange(t *testing.T, id string, cents int64, effectiveAt time.Time) *ScheduledPriceChange {
+	t.Helper()
+	price, err := NewMoney(cents, 100, "USD")
+	require.NoError(t, err)
+	change, err := NewScheduledPriceChange(price, effectiveAt, "pricing-team", "new year pricing")
+	require.NoError(t, err)
+	return change.WithID(id)
+}
+
+func TestNewScheduledPriceChange(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(2999, 100, "USD")
+
+	t.Run("valid change", func(t *testing.T) {
+		change, err := NewScheduledPriceChange(price, now, "alice", "raise")
+		require.NoError(t, err)
+		assert.True(t, change.NewPrice().Equals(price))
+		assert.Equal(t, now, change.EffectiveAt())
+		assert.Equal(t, "alice", change.ChangedBy())
+		assert.Equal(t, "raise", change.ChangedReason())
+		assert.True(t, change.IsDue(now))
+		assert.False(t, change.IsDue(now.Add(-time.Second)))
+	})
+
+	t.Run("price must be positive", func(t *testing.T) {
+		zero, _ := NewMoney(0, 1, "USD")
+		_, err := NewScheduledPriceChange(zero, now, "alice", "")
+		assert.ErrorIs(t, err, ErrInvalidPrice)
+
+		_, err = NewScheduledPriceChange(nil, now, "alice", "")
+		assert.ErrorIs(t, err, ErrInvalidPrice)
+	})
+
+	t.Run("effective time must be set and in UTC", func(t *testing.T) {
+		_, err := NewScheduledPriceChange(price, time.Time{}, "alice", "")
+		assert.ErrorIs(t, err, ErrInvalidPriceChangeTime)
+
+		_, err = NewScheduledPriceChange(price, now.In(time.FixedZone("CET", 3600)), "alice", "")
+		assert.ErrorIs(t, err, ErrInvalidPriceChangeTime)
+	})
+}
+
+func TestProduct_ScheduleBasePriceChange(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD")
+
+	t.Run("schedules changes in effective order", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		p.ClearEvents()
+
+		later := mustPriceChange(t, "c2", 3499, now.Add(48*time.Hour))
+		sooner := mustPriceChange(t, "c1", 2999, now.Add(24*time.Hour))
+		require.NoError(t, p.ScheduleBasePriceChange(later, now))
+		require.NoError(t, p.ScheduleBasePriceChange(sooner, now))
+
+		changes := p.ScheduledPriceChanges()
+		require.Len(t, changes, 2)
+		assert.Equal(t, "c1", changes[0].ID())
+		assert.Equal(t, "c2", changes[1].ID())
+		assert.True(t, p.BasePrice().Equals(price), "base price is unchanged until the change is applied")
+		assert.True(t, p.Changes().Dirty(FieldPriceChanges))
+
+		require.Len(t, p.DomainEvents(), 2)
+		event, ok := p.DomainEvents()[1].(*PriceChangeScheduledEvent)
+		require.True(t, ok)
+		assert.Equal(t, "c1", event.PriceChangeID)
+		assert.Equal(t, now.Add(24*time.Hour), event.EffectiveAt)
+	})
+
+	t.Run("effective time must be in the future", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		err := p.ScheduleBasePriceChange(mustPriceChange(t, "c1", 2999, now), now)
+		assert.ErrorIs(t, err, ErrPriceChangeNotInFuture)
+	})
+
+	t.Run("price must be in the base price currency", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		eur, _ := NewMoney(2999, 100, "EUR")
+		change, _ := NewScheduledPriceChange(eur, now.Add(time.Hour), "alice", "")
+		assert.ErrorIs(t, p.ScheduleBasePriceChange(change.WithID("c1"), now), ErrCurrencyMismatch)
+	})
+
+	t.Run("one change per effective time", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		require.NoError(t, p.ScheduleBasePriceChange(mustPriceChange(t, "c1", 2999, now.Add(time.Hour)), now))
+		err := p.ScheduleBasePriceChange(mustPriceChange(t, "c2", 3999, now.Add(time.Hour)), now)
+		assert.ErrorIs(t, err, ErrPriceChangeConflict)
+	})
+
+	t.Run("archived products cannot schedule changes", func(t *testing.T) {
+		p := ReconstructProduct("id-2", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil, nil, nil, StatusArchived, 1, now, now, &now, clk)
+		err := p.ScheduleBasePriceChange(mustPriceChange(t, "c1", 2999, now.Add(time.Hour)), now)
+		assert.ErrorIs(t, err, ErrCannotModifyArchived)
+	})
+}
+
+func TestProduct_CancelScheduledPriceChange(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD")
+	change := mustPriceChange(t, "c1", 2999, now.Add(time.Hour))
+
+	t.Run("removes the change", func(t *testing.T) {
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{change}, nil, StatusActive, 1, now, now, nil, clk)
+
+		require.NoError(t, p.CancelScheduledPriceChange("c1", now))
+		assert.Empty(t, p.ScheduledPriceChanges())
+		assert.True(t, p.Changes().Dirty(FieldPriceChanges))
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeCancelledEvent)
+		require.True(t, ok)
+		assert.Equal(t, "c1", event.PriceChangeID)
+		assert.Empty(t, event.Reason)
+	})
+
+	t.Run("unknown change", func(t *testing.T) {
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{change}, nil, StatusActive, 1, now, now, nil, clk)
+		assert.ErrorIs(t, p.CancelScheduledPriceChange("missing", now), ErrPriceChangeNotFound)
+	})
+
+	t.Run("archiving drops pending changes", func(t *testing.T) {
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{change}, nil, StatusActive, 1, now, now, nil, clk)
+
+		require.NoError(t, p.Archive(now))
+		assert.Empty(t, p.ScheduledPriceChanges())
+
+		require.Len(t, p.DomainEvents(), 2)
+		event, ok := p.DomainEvents()[0].(*PriceChangeCancelledEvent)
+		require.True(t, ok)
+		assert.Equal(t, "product archived", event.Reason)
+		assert.IsType(t, &ProductArchivedEvent{}, p.DomainEvents()[1])
+	})
+}
+
+func TestProduct_ApplyDuePriceChanges(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD")
+
+	t.Run("applies the latest due change and supersedes earlier ones", func(t *testing.T) {
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{
+				mustPriceChange(t, "future", 3999, now.Add(time.Hour)),
+				mustPriceChange(t, "second", 3499, now),
+				mustPriceChange(t, "first", 2999, now.Add(-time.Hour)),
+			}, nil, StatusActive, 1, now, now, nil, clk)
+
+		applied, held, dropped := p.ApplyDuePriceChanges(now, nil, testPricing)
+		require.Len(t, applied, 1)
+		assert.Empty(t, held)
+		require.Len(t, dropped, 1)
+		assert.Equal(t, "second", applied[0].ID())
+		assert.Equal(t, "first", dropped[0].ID())
+
+		expected, _ := NewMoney(3499, 100, "USD")
+		assert.True(t, p.BasePrice().Equals(expected))
+		require.Len(t, p.ScheduledPriceChanges(), 1)
+		assert.Equal(t, "future", p.ScheduledPriceChanges()[0].ID())
+
+		require.Len(t, p.DomainEvents(), 2)
+		cancelled, ok := p.DomainEvents()[0].(*PriceChangeCancelledEvent)
+		require.True(t, ok)
+		assert.Equal(t, "first", cancelled.PriceChangeID)
+		assert.Equal(t, "superseded by price change second", cancelled.Reason)
+
+		event, ok := p.DomainEvents()[1].(*BasePriceChangedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "second", event.PriceChangeID)
+		assert.True(t, event.OldPrice.Equals(price))
+		assert.Equal(t, now, event.ChangedAt)
+	})
+
+	t.Run("nothing due", func(t *testing.T) {
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{mustPriceChange(t, "future", 3999, now.Add(time.Hour))},
+			nil, StatusActive, 1, now, now, nil, clk)
+
+		applied, held, dropped := p.ApplyDuePriceChanges(now, nil, testPricing)
+		assert.Empty(t, applied)
+		assert.Empty(t, held)
+		assert.Empty(t, dropped)
+		assert.False(t, p.Changes().HasChanges())
+	})
+
+	t.Run("drops a change a fixed-amount discount exceeds", func(t *testing.T) {
+		amount, _ := NewMoney(2000, 100, "USD")
+		discount, _ := NewFixedAmountDiscount(amount, now.Add(-time.Hour), now.Add(time.Hour))
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil,
+			[]*Discount{discount.WithID("d1")},
+			[]*ScheduledPriceChange{mustPriceChange(t, "c1", 1500, now)},
+			nil, StatusActive, 1, now, now, nil, clk)
+
+		applied, held, dropped := p.ApplyDuePriceChanges(now, nil, testPricing)
+		assert.Empty(t, applied)
+		assert.Empty(t, held)
+		require.Len(t, dropped, 1)
+		assert.True(t, p.BasePrice().Equals(price))
+		assert.Empty(t, p.ScheduledPriceChanges())
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeCancelledEvent)
+		require.True(t, ok)
+		assert.Equal(t, ErrDiscountExceedsPrice.Error(), event.Reason)
+	})
+	t.Run("holds a change that violates the guardrails", func(t *testing.T) {
+		guardrails := &PriceGuardrails{MaxChangePercent: big.NewRat(20, 1)}
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{mustPriceChange(t, "c1", 999, now)},
+			nil, StatusActive, 1, now, now, nil, clk)
+
+		applied, held, dropped := p.ApplyDuePriceChanges(now, guardrails, testPricing)
+		assert.Empty(t, applied)
+		require.Len(t, held, 1)
+		assert.Empty(t, dropped)
+		assert.True(t, p.BasePrice().Equals(price))
+		assert.Empty(t, p.ScheduledPriceChanges())
+
+		request := p.PendingPriceChangeRequest()
+		require.NotNil(t, request)
+		assert.Equal(t, "c1", request.ID())
+		assert.Equal(t, "pricing-team", request.RequestedBy())
+		assert.True(t, request.OldPrice().Equals(price))
+		assert.Len(t, request.Violations(), 1)
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeRequestedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "c1", event.RequestID)
+	})
+
+	t.Run("drops a violating change while another request is pending", func(t *testing.T) {
+		guardrails := &PriceGuardrails{MaxChangePercent: big.NewRat(20, 1)}
+		newPrice, _ := NewMoney(4999, 100, "USD")
+		pending, err := NewPriceChangeRequest(price, newPrice, "alice", "", nil, now)
+		require.NoError(t, err)
+		p := ReconstructProduct("id-1", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil,
+			[]*ScheduledPriceChange{mustPriceChange(t, "c1", 999, now)},
+			pending.WithID("r1"), StatusActive, 1, now, now, nil, clk)
+
+		applied, held, dropped := p.ApplyDuePriceChanges(now, guardrails, testPricing)
+		assert.Empty(t, applied)
+		assert.Empty(t, held)
+		require.Len(t, dropped, 1)
+		assert.Equal(t, "r1", p.PendingPriceChangeRequest().ID())
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeCancelledEvent)
+		require.True(t, ok)
+		assert.Equal(t, ErrPriceChangeRequestPending.Error(), event.Reason)
+	})
+}
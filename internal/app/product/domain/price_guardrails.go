@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// PriceGuardrails decide which base price changes need a second approver. A change that
+// violates any guardrail is held as a PriceChangeRequest instead of taking effect.
+// The zero value lets every change through.
+type PriceGuardrails struct {
+	MaxChangePercent *big.Rat               // Largest change relative to the current price, nil for no limit
+	MinMarginPercent *big.Rat               // Smallest margin over the cost price, nil for no limit
+	ByCategory       map[string]PriceBounds // Absolute price range by category
+}
+
+// PriceBounds is an absolute price range in one currency. Either bound may be nil.
+// A price in another currency cannot be compared and violates the range.
+type PriceBounds struct {
+	Floor   *Money
+	Ceiling *Money
+}
+
+// ParsePriceGuardrails builds guardrails from a maximum change and a minimum margin, both
+// percentages, and a comma-separated list of category=CURRENCY floor..ceiling rules, e.g.
+// "books=USD 1.00..500.00,grocery=EUR 0.10..". Empty strings leave the corresponding
+// guardrail unset, as does an empty bound.
+func ParsePriceGuardrails(maxChangePercent, minMarginPercent, bounds string) (*PriceGuardrails, error) {
+	guardrails := &PriceGuardrails{}
+
+	if maxChangePercent != "" {
+		percent, ok := new(big.Rat).SetString(strings.TrimSpace(maxChangePercent))
+		if !ok || percent.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: maximum change %q is not a positive percentage", ErrInvalidPriceGuardrail, maxChangePercent)
+		}
+		guardrails.MaxChangePercent = percent
+	}
+
+	if minMarginPercent != "" {
+		percent, ok := new(big.Rat).SetString(strings.TrimSpace(minMarginPercent))
+		if !ok || percent.Sign() < 0 || percent.Cmp(big.NewRat(100, 1)) >= 0 {
+			return nil, fmt.Errorf("%w: minimum margin %q is not a percentage below 100", ErrInvalidPriceGuardrail, minMarginPercent)
+		}
+		guardrails.MinMarginPercent = percent
+	}
+
+	for _, rule := range strings.Split(bounds, ",") {
+		if rule = strings.TrimSpace(rule); rule == "" {
+			continue
+		}
+		category, priceRange, ok := strings.Cut(rule, "=")
+		fields := strings.Fields(priceRange)
+		if !ok || strings.TrimSpace(category) == "" || len(fields) != 2 {
+			return nil, fmt.Errorf("%w: rule %q is not category=CURRENCY floor..ceiling", ErrInvalidPriceGuardrail, rule)
+		}
+		currency, err := ParseCurrency(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		floor, ceiling, ok := strings.Cut(fields[1], "..")
+		if !ok {
+			return nil, fmt.Errorf("%w: rule %q is not category=CURRENCY floor..ceiling", ErrInvalidPriceGuardrail, rule)
+		}
+
+		var priceBounds PriceBounds
+		if priceBounds.Floor, err = parseBound(floor, currency, rule); err != nil {
+			return nil, err
+		}
+		if priceBounds.Ceiling, err = parseBound(ceiling, currency, rule); err != nil {
+			return nil, err
+		}
+		if priceBounds.Floor != nil && priceBounds.Ceiling != nil && priceBounds.Floor.GreaterThan(priceBounds.Ceiling) {
+			return nil, fmt.Errorf("%w: rule %q has a floor above its ceiling", ErrInvalidPriceGuardrail, rule)
+		}
+
+		if guardrails.ByCategory == nil {
+			guardrails.ByCategory = make(map[string]PriceBounds)
+		}
+		guardrails.ByCategory[strings.TrimSpace(category)] = priceBounds
+	}
+
+	return guardrails, nil
+}
+
+// parseBound parses one side of a price range, nil if it is empty.
+func parseBound(amount string, currency Currency, rule string) (*Money, error) {
+	if amount == "" {
+		return nil, nil
+	}
+	rat, ok := new(big.Rat).SetString(amount)
+	if !ok || rat.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: rule %q has an invalid bound %q", ErrInvalidPriceGuardrail, rule, amount)
+	}
+	return NewMoneyFromRat(rat, currency)
+}
+
+// Check returns the guardrails that changing the product's base price to newPrice
+// violates, as readable descriptions. No violations means the change may take effect.
+// The margin is only checked for products with a cost price in the new price's currency.
+func (g *PriceGuardrails) Check(p *Product, newPrice *Money) []string {
+	if g == nil {
+		return nil
+	}
+
+	var violations []string
+
+	if g.MaxChangePercent != nil && newPrice.Currency() == p.basePrice.Currency() && p.basePrice.IsPositive() {
+		change := new(big.Rat).Sub(newPrice.rat, p.basePrice.rat)
+		change.Abs(change)
+		change.Quo(change, p.basePrice.rat)
+		change.Mul(change, big.NewRat(100, 1))
+		if change.Cmp(g.MaxChangePercent) > 0 {
+			violations = append(violations, fmt.Sprintf("price changes by %s%%, more than the maximum of %s%%",
+				change.FloatString(2), FormatPercentage(g.MaxChangePercent)))
+		}
+	}
+
+	if bounds, ok := g.ByCategory[p.category]; ok {
+		for _, bound := range []*Money{bounds.Floor, bounds.Ceiling} {
+			if bound != nil && bound.Currency() != newPrice.Currency() {
+				violations = append(violations, fmt.Sprintf("price %s %s is not in the %s bounds currency %s",
+					newPrice, newPrice.Currency(), p.category, bound.Currency()))
+				break
+			}
+		}
+		if bounds.Floor != nil && bounds.Floor.Currency() == newPrice.Currency() && newPrice.LessThan(bounds.Floor) {
+			violations = append(violations, fmt.Sprintf("price %s %s is below the %s floor of %s %s",
+				newPrice, newPrice.Currency(), p.category, bounds.Floor, bounds.Floor.Currency()))
+		}
+		if bounds.Ceiling != nil && bounds.Ceiling.Currency() == newPrice.Currency() && newPrice.GreaterThan(bounds.Ceiling) {
+			violations = append(violations, fmt.Sprintf("price %s %s is above the %s ceiling of %s %s",
+				newPrice, newPrice.Currency(), p.category, bounds.Ceiling, bounds.Ceiling.Currency()))
+		}
+	}
+
+	if g.MinMarginPercent != nil && p.costPrice != nil && p.costPrice.Currency() == newPrice.Currency() && newPrice.IsPositive() {
+		margin := new(big.Rat).Sub(newPrice.rat, p.costPrice.rat)
+		margin.Quo(margin, newPrice.rat)
+		margin.Mul(margin, big.NewRat(100, 1))
+		if margin.Cmp(g.MinMarginPercent) < 0 {
+			violations = append(violations, fmt.Sprintf("margin of %s%% over the cost price is below the minimum of %s%%",
+				margin.FloatString(2), FormatPercentage(g.MinMarginPercent)))
+		}
+	}
+
+	return violations
+}
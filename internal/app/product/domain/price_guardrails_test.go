@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriceGuardrails(t *testing.T) {
+	t.Run("all guardrails", func(t *testing.T) {
+		g, err := ParsePriceGuardrails("50", "12.5", "books=USD 1.00..500.00, grocery=eur 0.10..")
+		require.NoError(t, err)
+		assert.Equal(t, big.NewRat(50, 1), g.MaxChangePercent)
+		assert.Equal(t, big.NewRat(25, 2), g.MinMarginPercent)
+
+		floor, _ := NewMoney(1, 1, "USD")
+		ceiling, _ := NewMoney(500, 1, "USD")
+		require.Contains(t, g.ByCategory, "books")
+		assert.True(t, g.ByCategory["books"].Floor.Equals(floor))
+		assert.True(t, g.ByCategory["books"].Ceiling.Equals(ceiling))
+
+		require.Contains(t, g.ByCategory, "grocery")
+		assert.Equal(t, Currency("EUR"), g.ByCategory["grocery"].Floor.Currency())
+		assert.Nil(t, g.ByCategory["grocery"].Ceiling)
+	})
+
+	t.Run("empty configuration", func(t *testing.T) {
+		g, err := ParsePriceGuardrails("", "", "")
+		require.NoError(t, err)
+		assert.Nil(t, g.MaxChangePercent)
+		assert.Nil(t, g.MinMarginPercent)
+		assert.Empty(t, g.ByCategory)
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		for _, tc := range []struct{ maxChange, minMargin, bounds string }{
+			{"0", "", ""},
+			{"ten", "", ""},
+			{"", "100", ""},
+			{"", "-1", ""},
+			{"", "", "books"},
+			{"", "", "books=1.00..500.00"},
+			{"", "", "books=USD 1.00-500.00"},
+			{"", "", "books=USD 500..1"},
+			{"", "", "books=USD 0..1"},
+		} {
+			_, err := ParsePriceGuardrails(tc.maxChange, tc.minMargin, tc.bounds)
+			assert.ErrorIs(t, err, ErrInvalidPriceGuardrail, "%+v", tc)
+		}
+
+		_, err := ParsePriceGuardrails("", "", "books=XXX 1..2")
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+}
+
+func TestPriceGuardrails_Check(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD") // $24.99
+	usd := func(cents int64) *Money {
+		m, _ := NewMoney(cents, 100, "USD")
+		return m
+	}
+
+	guardrails, err := ParsePriceGuardrails("50", "20", "books=USD 5.00..100.00")
+	require.NoError(t, err)
+
+	t.Run("change within guardrails", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		assert.Empty(t, guardrails.Check(p, usd(2999)))
+	})
+
+	t.Run("maximum change", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "electronics", price, now, clk)
+		violations := guardrails.Check(p, usd(249900))
+		require.Len(t, violations, 1)
+		assert.Equal(t, "price changes by 9900.00%, more than the maximum of 50%", violations[0])
+
+		assert.Len(t, guardrails.Check(p, usd(1000)), 1, "decreases count too")
+	})
+
+	t.Run("category floor and ceiling", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", usd(9000), now, clk)
+		violations := guardrails.Check(p, usd(10100))
+		require.Len(t, violations, 1)
+		assert.Equal(t, "price 101.00 USD is above the books ceiling of 100.00 USD", violations[0])
+
+		p, _ = NewProduct("id-2", "Booklet", "", "books", usd(600), now, clk)
+		violations = guardrails.Check(p, usd(450))
+		require.Len(t, violations, 1)
+		assert.Equal(t, "price 4.50 USD is below the books floor of 5.00 USD", violations[0])
+	})
+
+	t.Run("prices in another currency violate the bounds", func(t *testing.T) {
+		eur, _ := NewMoney(2000, 100, "EUR")
+		p, _ := NewProduct("id-1", "Book", "", "books", eur, now, clk)
+		higher, _ := NewMoney(2500, 100, "EUR")
+		violations := guardrails.Check(p, higher)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "price 25.00 EUR is not in the books bounds currency USD", violations[0])
+
+		p, _ = NewProduct("id-2", "Book", "", "electronics", eur, now, clk)
+		assert.Empty(t, guardrails.Check(p, higher), "categories without bounds are not affected")
+	})
+
+	t.Run("minimum margin", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "electronics", price, now, clk)
+		assert.Empty(t, guardrails.Check(p, usd(2000)), "no cost price, no margin check")
+
+		require.NoError(t, p.SetCostPrice(usd(1800)))
+		violations := guardrails.Check(p, usd(2000))
+		require.Len(t, violations, 1)
+		assert.Equal(t, "margin of 10.00% over the cost price is below the minimum of 20%", violations[0])
+		assert.Empty(t, guardrails.Check(p, usd(2250)))
+	})
+
+	t.Run("several violations", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		require.NoError(t, p.SetCostPrice(usd(2000)))
+		assert.Len(t, guardrails.Check(p, usd(249)), 3)
+	})
+
+	t.Run("nil guardrails let everything through", func(t *testing.T) {
+		var none *PriceGuardrails
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		assert.Empty(t, none.Check(p, usd(1)))
+	})
+}
+
+func TestProduct_SetCostPrice(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD")
+
+	p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+	assert.Nil(t, p.CostPrice())
+
+	cost, _ := NewMoney(1200, 100, "USD")
+	require.NoError(t, p.SetCostPrice(cost))
+	assert.True(t, p.CostPrice().Equals(cost))
+	assert.True(t, p.Changes().Dirty(FieldCostPrice))
+
+	negative, _ := NewMoney(-1, 1, "USD")
+	assert.ErrorIs(t, p.SetCostPrice(negative), ErrInvalidCostPrice)
+	assert.ErrorIs(t, p.SetCostPrice(nil), ErrInvalidCostPrice)
+
+	eur, _ := NewMoney(1200, 100, "EUR")
+	assert.ErrorIs(t, p.SetCostPrice(eur), ErrCurrencyMismatch)
+}
+
+func TestProduct_PriceChangeRequests(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(2499, 100, "USD")
+	typo, _ := NewMoney(249900, 100, "USD")
+
+	newRequest := func(t *testing.T, p *Product) *PriceChangeRequest {
+		t.Helper()
+		request, err := NewPriceChangeRequest(p.BasePrice(), typo, "alice", "relaunch",
+			[]string{"price changes by 9900.00%, more than the maximum of 50%"}, now)
+		require.NoError(t, err)
+		return request.WithID("r1")
+	}
+	requested := func(t *testing.T) *Product {
+		t.Helper()
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		require.NoError(t, p.RequestBasePriceChange(newRequest(t, p), now))
+		p.ClearEvents()
+		return p
+	}
+
+	t.Run("request holds the change", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		p.ClearEvents()
+
+		require.NoError(t, p.RequestBasePriceChange(newRequest(t, p), now))
+		assert.True(t, p.BasePrice().Equals(price))
+		require.NotNil(t, p.PendingPriceChangeRequest())
+		assert.Equal(t, "r1", p.PendingPriceChangeRequest().ID())
+		assert.True(t, p.Changes().Dirty(FieldPriceRequest))
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeRequestedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "r1", event.RequestID)
+		assert.Equal(t, "alice", event.RequestedBy)
+		assert.Len(t, event.Violations, 1)
+	})
+
+	t.Run("only one pending request", func(t *testing.T) {
+		p := requested(t)
+		err := p.RequestBasePriceChange(newRequest(t, p).WithID("r2"), now)
+		assert.ErrorIs(t, err, ErrPriceChangeRequestPending)
+	})
+
+	t.Run("approve changes the base price", func(t *testing.T) {
+		p := requested(t)
+
+		require.NoError(t, p.ApprovePriceChangeRequest("r1", "bob", now, testPricing))
+		assert.True(t, p.BasePrice().Equals(typo))
+		assert.Nil(t, p.PendingPriceChangeRequest())
+
+		require.Len(t, p.DomainEvents(), 2)
+		approved, ok := p.DomainEvents()[0].(*PriceChangeApprovedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "bob", approved.ApprovedBy)
+		assert.Equal(t, "alice", approved.RequestedBy)
+		changed, ok := p.DomainEvents()[1].(*BasePriceChangedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "r1", changed.RequestID)
+		assert.True(t, changed.OldPrice.Equals(price))
+	})
+
+	t.Run("requester cannot approve", func(t *testing.T) {
+		p := requested(t)
+		assert.ErrorIs(t, p.ApprovePriceChangeRequest("r1", "alice", now, testPricing), ErrPriceChangeSelfApproval)
+		assert.True(t, p.BasePrice().Equals(price))
+	})
+
+	t.Run("stale request cannot be approved", func(t *testing.T) {
+		p := requested(t)
+		other, _ := NewMoney(2599, 100, "USD")
+		require.NoError(t, p.SetBasePrice(other, testPricing))
+		assert.ErrorIs(t, p.ApprovePriceChangeRequest("r1", "bob", now, testPricing), ErrPriceChangeRequestStale)
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		p := requested(t)
+		assert.ErrorIs(t, p.ApprovePriceChangeRequest("r2", "bob", now, testPricing), ErrPriceChangeRequestNotFound)
+		assert.ErrorIs(t, p.RejectPriceChangeRequest("r2", "bob", "", now), ErrPriceChangeRequestNotFound)
+	})
+
+	t.Run("reject keeps the base price", func(t *testing.T) {
+		p := requested(t)
+
+		require.NoError(t, p.RejectPriceChangeRequest("r1", "bob", "typo", now))
+		assert.True(t, p.BasePrice().Equals(price))
+		assert.Nil(t, p.PendingPriceChangeRequest())
+
+		require.Len(t, p.DomainEvents(), 1)
+		event, ok := p.DomainEvents()[0].(*PriceChangeRejectedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "bob", event.RejectedBy)
+		assert.Equal(t, "typo", event.Reason)
+	})
+
+	t.Run("archiving rejects the pending request", func(t *testing.T) {
+		p := requested(t)
+
+		require.NoError(t, p.Archive(now))
+		assert.Nil(t, p.PendingPriceChangeRequest())
+
+		require.Len(t, p.DomainEvents(), 2)
+		event, ok := p.DomainEvents()[0].(*PriceChangeRejectedEvent)
+		require.True(t, ok)
+		assert.Empty(t, event.RejectedBy)
+		assert.Equal(t, "product archived", event.Reason)
+	})
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Region codes identify the markets of a product's price list, e.g. "DE", "JP" or "US-CA".
+const (
+	minRegionLength = 2
+	maxRegionLength = 16
+)
+
+// ParseRegion validates a market region code. Codes are 2-16 characters of letters,
+// digits and hyphens, starting with a letter. Lowercase input is normalized to uppercase.
+func ParseRegion(code string) (string, error) {
+	region := strings.ToUpper(code)
+	if len(region) < minRegionLength || len(region) > maxRegionLength || region[0] < 'A' || region[0] > 'Z' {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRegion, code)
+	}
+	for _, c := range region {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidRegion, code)
+		}
+	}
+	return region, nil
+}
+
+// SelectMarketPrice picks the list price that applies in a market.
+//
+// A regional price for region wins. Otherwise, if currency is set, the base price is used
+// when it is in that currency, then the regional price in that currency with the lowest
+// region code. Everything else falls back to the base price, which is the designated
+// price for markets without an entry.
+//
+// It returns the selected price and its region ("" for the base price).
+func SelectMarketPrice(basePrice *Money, regionalPrices map[string]*Money, region string, currency Currency) (*Money, string) {
+	if price, ok := regionalPrices[region]; ok && region != "" {
+		return price.Copy(), region
+	}
+
+	if currency != "" && basePrice.Currency() != currency {
+		regions := make([]string, 0, len(regionalPrices))
+		for r := range regionalPrices {
+			regions = append(regions, r)
+		}
+		sort.Strings(regions)
+		for _, r := range regions {
+			if regionalPrices[r].Currency() == currency {
+				return regionalPrices[r].Copy(), r
+			}
+		}
+	}
+
+	return basePrice.Copy(), ""
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegion(t *testing.T) {
+	t.Run("normalizes valid codes to uppercase", func(t *testing.T) {
+		for input, expected := range map[string]string{"de": "DE", "US-CA": "US-CA", "eu": "EU", "apac2": "APAC2"} {
+			region, err := ParseRegion(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, expected, region)
+		}
+	})
+
+	t.Run("rejects invalid codes", func(t *testing.T) {
+		for _, input := range []string{"", "D", "1DE", "-DE", "DE_BY", "DE BY", "ABCDEFGHIJKLMNOPQ"} {
+			_, err := ParseRegion(input)
+			assert.ErrorIs(t, err, ErrInvalidRegion, input)
+		}
+	})
+}
+
+func TestSelectMarketPrice(t *testing.T) {
+	base, _ := NewMoney(100, 1, "USD")
+	de, _ := NewMoney(95, 1, "EUR")
+	fr, _ := NewMoney(99, 1, "EUR")
+	jp, _ := NewMoney(15000, 1, "JPY")
+	regional := map[string]*Money{"FR": fr, "DE": de, "JP": jp}
+
+	tests := []struct {
+		name           string
+		region         string
+		currency       Currency
+		expectedPrice  *Money
+		expectedRegion string
+	}{
+		{"no market uses base price", "", "", base, ""},
+		{"region with entry", "JP", "", jp, "JP"},
+		{"region entry wins over currency", "JP", "EUR", jp, "JP"},
+		{"region without entry falls back to base price", "GB", "", base, ""},
+		{"currency of base price", "", "USD", base, ""},
+		{"currency picks lowest region with that currency", "", "EUR", de, "DE"},
+		{"region without entry uses currency", "AT", "EUR", de, "DE"},
+		{"currency without any price falls back to base price", "", "GBP", base, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, region := SelectMarketPrice(base, regional, tt.region, tt.currency)
+			assert.True(t, tt.expectedPrice.Equals(price), "got %s", price)
+			assert.Equal(t, tt.expectedPrice.Currency(), price.Currency())
+			assert.Equal(t, tt.expectedRegion, region)
+		})
+	}
+}
@@ -14,14 +14,26 @@ import (
 //  2. Easier to test pricing logic in isolation
 //  3. Simplifies future pricing strategy changes
 //  4. Domain objects delegate to this service for all pricing operations
-type PricingCalculator struct{}
+//
+// Calculations are exact; Round and RoundPrice round results to the currency's minor
+// unit following the calculator's RoundingPolicy.
+type PricingCalculator struct {
+	rounding *RoundingPolicy // nil rounds everything with DefaultRoundingMode
+}
 
 // NewPricingCalculator creates a new PricingCalculator instance.
 func NewPricingCalculator() *PricingCalculator {
 	return &PricingCalculator{}
 }
 
-// Package-level calculator instance for domain object use
+// NewPricingCalculatorWithRounding creates a PricingCalculator that rounds prices
+// following the given policy.
+func NewPricingCalculatorWithRounding(policy *RoundingPolicy) *PricingCalculator {
+	return &PricingCalculator{rounding: policy}
+}
+
+// Package-level calculator instance for domain object use. Its calculations are exact;
+// methods that round take the caller's calculator instead.
 var defaultPricingCalculator = NewPricingCalculator()
 
 // CalculateDiscountAmount calculates the discount amount (not the final price).
@@ -34,18 +46,106 @@ func (pc *PricingCalculator) CalculateDiscountAmount(price *Money, discountMulti
 // Formula: finalPrice = price - (price * discountMultiplier)
 func (pc *PricingCalculator) ApplyDiscount(price *Money, discountMultiplier *big.Rat) *Money {
 	discountAmount := pc.CalculateDiscountAmount(price, discountMultiplier)
-	return price.Subtract(discountAmount)
+	return price.sub(discountAmount) // Same currency: the amount is derived from price
+}
+
+// CalculateFixedDiscountAmount calculates the amount a fixed discount takes off a price.
+// The amount is capped at the price so the discounted price is never negative.
+func (pc *PricingCalculator) CalculateFixedDiscountAmount(price, amount *Money) *Money {
+	if amount.GreaterThan(price) {
+		return price.Copy()
+	}
+	return amount.Copy()
+}
+
+// ApplyFixedDiscount takes a fixed amount off a price.
+// Formula: finalPrice = max(price - amount, 0)
+// Both values must share a currency.
+func (pc *PricingCalculator) ApplyFixedDiscount(price, amount *Money) *Money {
+	return price.sub(pc.CalculateFixedDiscountAmount(price, amount))
+}
+
+// Apply applies a discount of either kind to a price, ignoring its validity period.
+// Prices the discount does not apply to (see Discount.AppliesTo) are returned unchanged.
+func (pc *PricingCalculator) Apply(price *Money, discount *Discount) *Money {
+	if !discount.AppliesTo(price) {
+		return price.Copy()
+	}
+	if discount.IsFixedAmount() {
+		return pc.ApplyFixedDiscount(price, discount.amount)
+	}
+	return pc.ApplyDiscount(price, discount.Multiplier())
+}
+
+// DiscountAmount calculates the amount a discount of either kind takes off a price.
+func (pc *PricingCalculator) DiscountAmount(price *Money, discount *Discount) *Money {
+	if !discount.AppliesTo(price) {
+		return price.sub(price) // Zero in the price currency
+	}
+	if discount.IsFixedAmount() {
+		return pc.CalculateFixedDiscountAmount(price, discount.amount)
+	}
+	return pc.CalculateDiscountAmount(price, discount.Multiplier())
 }
 
 // CalculateEffectivePrice calculates the effective price considering time-bound discounts.
 // Returns the discounted price if the discount is valid at the given time, otherwise returns the base price.
 func (pc *PricingCalculator) CalculateEffectivePrice(basePrice *Money, discount *Discount, now time.Time) *Money {
 	if discount != nil && discount.IsValidAt(now) {
-		return pc.ApplyDiscount(basePrice, discount.Multiplier())
+		return pc.Apply(basePrice, discount)
 	}
 	return basePrice.Copy()
 }
 
+// RoundingPolicy returns the calculator's rounding policy, nil if it rounds half-up.
+func (pc *PricingCalculator) RoundingPolicy() *RoundingPolicy {
+	return pc.rounding
+}
+
+// Round rounds a price to its currency's minor unit with the given mode.
+func (pc *PricingCalculator) Round(price *Money, mode RoundingMode) *Money {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(price.Currency().MinorUnits())), nil)
+	minor := new(big.Rat).Mul(price.rat, new(big.Rat).SetInt(scale))
+	rounded := new(big.Rat).SetFrac(roundRat(minor, mode, scale), scale)
+	return &Money{rat: rounded, currency: price.currency}
+}
+
+// RoundPrice rounds a price of a product in the given category with the mode the
+// rounding policy selects for it, keeping the exact value alongside.
+func (pc *PricingCalculator) RoundPrice(price *Money, category string) *RoundedPrice {
+	mode := pc.rounding.ModeFor(category, price.Currency())
+	return &RoundedPrice{
+		Exact:   price.Copy(),
+		Rounded: pc.Round(price, mode),
+		Mode:    mode,
+	}
+}
+
+// CalculateTax splits a price under a tax rate. A net price has the tax added on top,
+// a gross price (see TaxRate.PricesIncludeTax) has it extracted.
+// Formulas: tax = net * rate/100, and net = gross * 100/(100+rate) for gross prices.
+func (pc *PricingCalculator) CalculateTax(price *Money, rate *TaxRate) *TaxBreakdown {
+	hundred := big.NewRat(100, 1)
+	if rate.pricesIncludeTax {
+		net := price.MultiplyByRat(new(big.Rat).Quo(hundred, new(big.Rat).Add(hundred, rate.percent)))
+		return &TaxBreakdown{
+			Net:   net,
+			Tax:   price.sub(net),
+			Gross: price.Copy(),
+			Rate:  rate,
+		}
+	}
+
+	tax := price.MultiplyByRat(new(big.Rat).Quo(rate.percent, hundred))
+	gross, _ := price.Add(tax) // Same currency: the tax is derived from price
+	return &TaxBreakdown{
+		Net:   price.Copy(),
+		Tax:   tax,
+		Gross: gross,
+		Rate:  rate,
+	}
+}
+
 // Multiplier extracts the discount multiplier from a Discount.
 // This is a helper method that bridges the Discount value object with the PricingCalculator.
 func (pc *PricingCalculator) Multiplier(discount *Discount) *big.Rat {
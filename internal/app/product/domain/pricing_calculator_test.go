@@ -13,7 +13,7 @@ func TestPricingCalculator_CalculateDiscountAmount(t *testing.T) {
 	pc := NewPricingCalculator()
 
 	t.Run("calculates discount amount correctly", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)            // $100.00
+		price, _ := NewMoney(10000, 100, "USD")     // $100.00
 		multiplier := new(big.Rat).SetFloat64(0.20) // 20%
 
 		discountAmount := pc.CalculateDiscountAmount(price, multiplier)
@@ -23,7 +23,7 @@ func TestPricingCalculator_CalculateDiscountAmount(t *testing.T) {
 	})
 
 	t.Run("zero multiplier returns zero discount", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)
+		price, _ := NewMoney(10000, 100, "USD")
 		multiplier := new(big.Rat).SetFloat64(0.0)
 
 		discountAmount := pc.CalculateDiscountAmount(price, multiplier)
@@ -32,7 +32,7 @@ func TestPricingCalculator_CalculateDiscountAmount(t *testing.T) {
 	})
 
 	t.Run("fractional discount percentage", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)             // $100.00
+		price, _ := NewMoney(10000, 100, "USD")      // $100.00
 		multiplier := new(big.Rat).SetFloat64(0.125) // 12.5%
 
 		discountAmount := pc.CalculateDiscountAmount(price, multiplier)
@@ -46,7 +46,7 @@ func TestPricingCalculator_ApplyDiscount(t *testing.T) {
 	pc := NewPricingCalculator()
 
 	t.Run("applies discount correctly", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)            // $100.00
+		price, _ := NewMoney(10000, 100, "USD")     // $100.00
 		multiplier := new(big.Rat).SetFloat64(0.20) // 20%
 
 		finalPrice := pc.ApplyDiscount(price, multiplier)
@@ -56,7 +56,7 @@ func TestPricingCalculator_ApplyDiscount(t *testing.T) {
 	})
 
 	t.Run("100% discount returns zero", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)
+		price, _ := NewMoney(10000, 100, "USD")
 		multiplier := new(big.Rat).SetFloat64(1.0) // 100%
 
 		finalPrice := pc.ApplyDiscount(price, multiplier)
@@ -65,7 +65,7 @@ func TestPricingCalculator_ApplyDiscount(t *testing.T) {
 	})
 
 	t.Run("zero discount returns original price", func(t *testing.T) {
-		price, _ := NewMoney(10000, 100)
+		price, _ := NewMoney(10000, 100, "USD")
 		multiplier := new(big.Rat).SetFloat64(0.0)
 
 		finalPrice := pc.ApplyDiscount(price, multiplier)
@@ -74,12 +74,40 @@ func TestPricingCalculator_ApplyDiscount(t *testing.T) {
 	})
 }
 
+func TestPricingCalculator_ApplyFixedDiscount(t *testing.T) {
+	pc := NewPricingCalculator()
+
+	t.Run("subtracts the amount", func(t *testing.T) {
+		price, _ := NewMoney(10000, 100, "USD") // $100.00
+		amount, _ := NewMoney(1, 3, "USD")      // $1/3 stays exact
+
+		finalPrice := pc.ApplyFixedDiscount(price, amount)
+
+		expected, _ := NewMoney(299, 3, "USD")
+		assert.True(t, finalPrice.Equals(expected))
+	})
+
+	t.Run("amount equal to the price returns zero", func(t *testing.T) {
+		price, _ := NewMoney(10000, 100, "USD")
+
+		assert.True(t, pc.ApplyFixedDiscount(price, price).IsZero())
+	})
+
+	t.Run("amount above the price is capped at zero", func(t *testing.T) {
+		price, _ := NewMoney(10000, 100, "USD")
+		amount, _ := NewMoney(250, 1, "USD")
+
+		assert.True(t, pc.ApplyFixedDiscount(price, amount).IsZero())
+		assert.True(t, pc.CalculateFixedDiscountAmount(price, amount).Equals(price))
+	})
+}
+
 func TestPricingCalculator_CalculateEffectivePrice(t *testing.T) {
 	pc := NewPricingCalculator()
 	now := time.Now().UTC()
 
 	t.Run("applies valid discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(10000, 100) // $100.00
+		basePrice, _ := NewMoney(10000, 100, "USD") // $100.00
 		discount, err := NewDiscount(20, now.Add(-1*time.Hour), now.Add(1*time.Hour))
 		require.NoError(t, err)
 
@@ -90,7 +118,7 @@ func TestPricingCalculator_CalculateEffectivePrice(t *testing.T) {
 	})
 
 	t.Run("ignores expired discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(10000, 100)
+		basePrice, _ := NewMoney(10000, 100, "USD")
 		// Discount expired 2 hours ago
 		discount, err := NewDiscount(20, now.Add(-3*time.Hour), now.Add(-1*time.Hour))
 		require.NoError(t, err)
@@ -102,7 +130,7 @@ func TestPricingCalculator_CalculateEffectivePrice(t *testing.T) {
 	})
 
 	t.Run("ignores future discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(10000, 100)
+		basePrice, _ := NewMoney(10000, 100, "USD")
 		// Discount starts in 1 hour
 		discount, err := NewDiscount(20, now.Add(1*time.Hour), now.Add(3*time.Hour))
 		require.NoError(t, err)
@@ -113,8 +141,20 @@ func TestPricingCalculator_CalculateEffectivePrice(t *testing.T) {
 		assert.True(t, effectivePrice.Equals(basePrice))
 	})
 
+	t.Run("applies valid fixed-amount discount", func(t *testing.T) {
+		basePrice, _ := NewMoney(10000, 100, "USD")
+		amount, _ := NewMoney(15, 1, "USD")
+		discount, err := NewFixedAmountDiscount(amount, now.Add(-1*time.Hour), now.Add(1*time.Hour))
+		require.NoError(t, err)
+
+		effectivePrice := pc.CalculateEffectivePrice(basePrice, discount, now)
+
+		val, _ := effectivePrice.Float64()
+		assert.Equal(t, 85.0, val) // $100 - $15 = $85
+	})
+
 	t.Run("returns base price when no discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(10000, 100)
+		basePrice, _ := NewMoney(10000, 100, "USD")
 
 		effectivePrice := pc.CalculateEffectivePrice(basePrice, nil, now)
 
@@ -122,7 +162,7 @@ func TestPricingCalculator_CalculateEffectivePrice(t *testing.T) {
 	})
 
 	t.Run("preserves precision with fractional discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(249900, 100) // $2499.00
+		basePrice, _ := NewMoney(249900, 100, "USD") // $2499.00
 		discount, err := NewDiscount(12.5, now.Add(-1*time.Hour), now.Add(1*time.Hour))
 		require.NoError(t, err)
 
@@ -140,7 +180,7 @@ func TestPricingCalculator_EdgeCases(t *testing.T) {
 	now := time.Now().UTC()
 
 	t.Run("very small price with discount", func(t *testing.T) {
-		basePrice, _ := NewMoney(1, 100) // $0.01
+		basePrice, _ := NewMoney(1, 100, "USD") // $0.01
 		discount, err := NewDiscount(50, now.Add(-1*time.Hour), now.Add(1*time.Hour))
 		require.NoError(t, err)
 
@@ -151,7 +191,7 @@ func TestPricingCalculator_EdgeCases(t *testing.T) {
 	})
 
 	t.Run("multiple discount applications preserve precision", func(t *testing.T) {
-		basePrice, _ := NewMoney(10000, 100) // $100.00
+		basePrice, _ := NewMoney(10000, 100, "USD") // $100.00
 
 		// Apply 20% discount
 		multiplier1 := new(big.Rat).SetFloat64(0.20)
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"sort"
 	"time"
 
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
@@ -8,14 +9,19 @@ import (
 
 // Field names for change tracking
 const (
-	FieldName        = "name"
-	FieldDescription = "description"
-	FieldCategory    = "category"
-	FieldBasePrice   = "base_price"
-	FieldDiscount    = "discount"
-	FieldStatus      = "status"
-	FieldVersion     = "version"
-	FieldArchivedAt  = "archived_at"
+	FieldName           = "name"
+	FieldDescription    = "description"
+	FieldCategory       = "category"
+	FieldTaxClass       = "tax_class"
+	FieldBasePrice      = "base_price"
+	FieldCostPrice      = "cost_price"
+	FieldRegionalPrices = "regional_prices"
+	FieldDiscount       = "discount"
+	FieldPriceChanges   = "price_changes"
+	FieldPriceRequest   = "price_request"
+	FieldStatus         = "status"
+	FieldVersion        = "version"
+	FieldArchivedAt     = "archived_at"
 )
 
 // ProductStatus represents the lifecycle status of a product
@@ -30,17 +36,22 @@ const (
 // Product is the aggregate root for product management.
 // It encapsulates all business logic related to products, pricing, and discounts.
 type Product struct {
-	id          string
-	name        string
-	description string
-	category    string
-	basePrice   *Money
-	discount    *Discount
-	status      ProductStatus
-	version     int64
-	createdAt   time.Time
-	updatedAt   time.Time
-	archivedAt  *time.Time
+	id             string
+	name           string
+	description    string
+	category       string
+	taxClass       string // Selects the product's tax rate in each region
+	basePrice      *Money
+	costPrice      *Money                  // Unit cost the margin guardrail is checked against, nil if unknown
+	regionalPrices map[string]*Money       // Regional price list, keyed by region code
+	discounts      []*Discount             // Discount schedule, sorted by start date, never overlapping
+	priceChanges   []*ScheduledPriceChange // Pending base price changes, sorted by effective time
+	priceRequest   *PriceChangeRequest     // Base price change awaiting approval, nil if none
+	status         ProductStatus
+	version        int64
+	createdAt      time.Time
+	updatedAt      time.Time
+	archivedAt     *time.Time
 
 	// Clock for time operations (injected for testability).
 	//
@@ -62,8 +73,13 @@ type Product struct {
 	events []DomainEvent
 }
 
-// NewProduct creates a new Product aggregate (for creation).
+// NewProduct creates a new Product aggregate (for creation) in DefaultTaxClass.
 func NewProduct(id, name, description, category string, basePrice *Money, now time.Time, clk clock.Clock) (*Product, error) {
+	return NewProductWithTaxClass(id, name, description, category, DefaultTaxClass, basePrice, now, clk)
+}
+
+// NewProductWithTaxClass creates a new Product aggregate (for creation) in the given tax class.
+func NewProductWithTaxClass(id, name, description, category, taxClass string, basePrice *Money, now time.Time, clk clock.Clock) (*Product, error) {
 	if name == "" {
 		return nil, ErrEmptyName
 	}
@@ -76,25 +92,33 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		return nil, ErrInvalidPrice
 	}
 
+	taxClass, err := ParseTaxClass(taxClass)
+	if err != nil {
+		return nil, err
+	}
+
 	p := &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice.Copy(),
-		status:      StatusInactive,
-		version:     0,
-		createdAt:   now,
-		updatedAt:   now,
-		clock:       clk,
-		changes:     NewChangeTracker(),
-		events:      make([]DomainEvent, 0),
+		id:             id,
+		name:           name,
+		description:    description,
+		category:       category,
+		taxClass:       taxClass,
+		basePrice:      basePrice.Copy(),
+		regionalPrices: make(map[string]*Money),
+		status:         StatusInactive,
+		version:        0,
+		createdAt:      now,
+		updatedAt:      now,
+		clock:          clk,
+		changes:        NewChangeTracker(),
+		events:         make([]DomainEvent, 0),
 	}
 
 	// Mark all fields as dirty for new product
 	p.changes.MarkDirty(FieldName)
 	p.changes.MarkDirty(FieldDescription)
 	p.changes.MarkDirty(FieldCategory)
+	p.changes.MarkDirty(FieldTaxClass)
 	p.changes.MarkDirty(FieldBasePrice)
 	p.changes.MarkDirty(FieldStatus)
 
@@ -104,6 +128,7 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		Name:        p.name,
 		Description: p.description,
 		Category:    p.category,
+		TaxClass:    p.taxClass,
 		BasePrice:   p.basePrice.Copy(),
 		Status:      string(p.status),
 		CreatedAt:   p.createdAt,
@@ -114,30 +139,47 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 
 // ReconstructProduct reconstitutes a Product from database (for loading existing products).
 func ReconstructProduct(
-	id, name, description, category string,
+	id, name, description, category, taxClass string,
 	basePrice *Money,
-	discount *Discount,
+	costPrice *Money,
+	regionalPrices map[string]*Money,
+	discounts []*Discount,
+	priceChanges []*ScheduledPriceChange,
+	priceRequest *PriceChangeRequest,
 	status ProductStatus,
 	version int64,
 	createdAt, updatedAt time.Time,
 	archivedAt *time.Time,
 	clk clock.Clock,
 ) *Product {
+	if regionalPrices == nil {
+		regionalPrices = make(map[string]*Money)
+	}
+	discounts = append([]*Discount(nil), discounts...)
+	sortDiscounts(discounts)
+	priceChanges = append([]*ScheduledPriceChange(nil), priceChanges...)
+	sortPriceChanges(priceChanges)
+
 	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      status,
-		version:     version,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		archivedAt:  archivedAt,
-		clock:       clk,
-		changes:     NewChangeTracker(), // Start with clean slate
-		events:      make([]DomainEvent, 0),
+		id:             id,
+		name:           name,
+		description:    description,
+		category:       category,
+		taxClass:       taxClass,
+		basePrice:      basePrice,
+		costPrice:      costPrice,
+		regionalPrices: regionalPrices,
+		discounts:      discounts,
+		priceChanges:   priceChanges,
+		priceRequest:   priceRequest,
+		status:         status,
+		version:        version,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		archivedAt:     archivedAt,
+		clock:          clk,
+		changes:        NewChangeTracker(), // Start with clean slate
+		events:         make([]DomainEvent, 0),
 	}
 }
 
@@ -146,6 +188,7 @@ func (p *Product) ID() string                  { return p.id }
 func (p *Product) Name() string                { return p.name }
 func (p *Product) Description() string         { return p.description }
 func (p *Product) Category() string            { return p.category }
+func (p *Product) TaxClass() string            { return p.taxClass }
 func (p *Product) BasePrice() *Money           { return p.basePrice.Copy() }
 func (p *Product) Status() ProductStatus       { return p.status }
 func (p *Product) Version() int64              { return p.version }
@@ -155,24 +198,70 @@ func (p *Product) ArchivedAt() *time.Time      { return p.archivedAt }
 func (p *Product) Changes() *ChangeTracker     { return p.changes }
 func (p *Product) DomainEvents() []DomainEvent { return p.events }
 
-// HasDiscount returns true if the product has a discount (not nil).
+// RegionalPrices returns a copy of the regional price list, keyed by region code.
+func (p *Product) RegionalPrices() map[string]*Money {
+	prices := make(map[string]*Money, len(p.regionalPrices))
+	for region, price := range p.regionalPrices {
+		prices[region] = price.Copy()
+	}
+	return prices
+}
+
+// CostPrice returns a copy of the product's unit cost, or nil if it is unknown.
+func (p *Product) CostPrice() *Money {
+	if p.costPrice == nil {
+		return nil
+	}
+	return p.costPrice.Copy()
+}
+
+// PendingPriceChangeRequest returns a copy of the base price change awaiting approval,
+// or nil if there is none.
+func (p *Product) PendingPriceChangeRequest() *PriceChangeRequest {
+	if p.priceRequest == nil {
+		return nil
+	}
+	return p.priceRequest.copy()
+}
+
+// RegionalPrice returns the price for a region, if the product has one.
+func (p *Product) RegionalPrice(region string) (*Money, bool) {
+	price, ok := p.regionalPrices[region]
+	if !ok {
+		return nil, false
+	}
+	return price.Copy(), true
+}
+
+// MarketPrice returns the list price that applies in a market and its region
+// ("" for the base price). See SelectMarketPrice.
+func (p *Product) MarketPrice(region string, currency Currency) (*Money, string) {
+	return SelectMarketPrice(p.basePrice, p.regionalPrices, region, currency)
+}
+
+// HasDiscount returns true if the product has any discount scheduled, active or not.
 func (p *Product) HasDiscount() bool {
-	return p.discount != nil
+	return len(p.discounts) > 0
 }
 
-// DiscountCopy returns a copy of the discount, or nil if no discount exists.
+// DiscountCopy returns a copy of the discount valid now, or nil if none is.
 // This prevents external modification of the internal discount state.
 func (p *Product) DiscountCopy() *Discount {
-	if p.discount == nil {
+	discount := ActiveDiscount(p.discounts, p.clock.Now())
+	if discount == nil {
 		return nil
 	}
-	// Create a copy including the cached multiplier
-	return &Discount{
-		percentage:         p.discount.percentage,
-		startDate:          p.discount.startDate,
-		endDate:            p.discount.endDate,
-		discountMultiplier: p.discount.discountMultiplier, // Share the cached multiplier (immutable)
+	return discount.copy()
+}
+
+// Discounts returns copies of the scheduled discounts, sorted by start date.
+// The schedule includes the active discount and ended discounts not yet cleaned up.
+func (p *Product) Discounts() []*Discount {
+	discounts := make([]*Discount, len(p.discounts))
+	for i, d := range p.discounts {
+		discounts[i] = d.copy()
 	}
+	return discounts
 }
 
 // SetName updates the product name.
@@ -220,6 +309,44 @@ func (p *Product) SetCategory(category string) error {
 	return nil
 }
 
+// SetTaxClass updates the product's tax class. Uppercase input is normalized to lowercase.
+func (p *Product) SetTaxClass(taxClass string) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	taxClass, err := ParseTaxClass(taxClass)
+	if err != nil {
+		return err
+	}
+
+	p.taxClass = taxClass
+	p.changes.MarkDirty(FieldTaxClass)
+
+	return nil
+}
+
+// SetCostPrice updates the product's unit cost, which the margin guardrail is checked
+// against. It must not be negative and must be in the base price currency.
+func (p *Product) SetCostPrice(costPrice *Money) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	if costPrice == nil || costPrice.IsNegative() {
+		return ErrInvalidCostPrice
+	}
+
+	if costPrice.Currency() != p.basePrice.Currency() {
+		return ErrCurrencyMismatch
+	}
+
+	p.costPrice = costPrice.Copy()
+	p.changes.MarkDirty(FieldCostPrice)
+
+	return nil
+}
+
 // MarkUpdated emits a ProductUpdatedEvent with the current product state.
 // This should be called by usecases after making one or more field updates
 // to consolidate multiple changes into a single event emission.
@@ -229,37 +356,396 @@ func (p *Product) MarkUpdated(now time.Time) {
 		Name:        p.name,
 		Description: p.description,
 		Category:    p.category,
+		TaxClass:    p.taxClass,
 		UpdatedAt:   now,
 	})
 }
 
 // SetBasePrice updates the product's base price.
-// This emits a BasePriceChangedEvent to track price history.
-func (p *Product) SetBasePrice(newPrice *Money) error {
+// The new price must be in the product's existing currency.
+// This emits a BasePriceChangedEvent to track price history, with the effective price
+// rounded by pricing.
+func (p *Product) SetBasePrice(newPrice *Money, pricing *PricingCalculator) error {
 	if err := p.checkNotArchived(); err != nil {
 		return err
 	}
+	return p.setBasePrice(newPrice, p.clock.Now(), "", pricing)
+}
 
-	if newPrice.IsNegative() || newPrice.IsZero() {
-		return ErrInvalidPrice
+// setBasePrice changes the base price at now. priceChangeID identifies the scheduled
+// price change being applied, if any.
+func (p *Product) setBasePrice(newPrice *Money, now time.Time, priceChangeID string, pricing *PricingCalculator) error {
+	if err := p.checkBasePrice(newPrice, now); err != nil {
+		return err
 	}
 
+	event := p.changeBasePrice(newPrice, now, pricing)
+	event.PriceChangeID = priceChangeID
+	p.recordEvent(event)
+
+	return nil
+}
+
+// changeBasePrice replaces the base price with a checked newPrice and returns the
+// BasePriceChangedEvent to record, with the effective price rounded by pricing.
+func (p *Product) changeBasePrice(newPrice *Money, now time.Time, pricing *PricingCalculator) *BasePriceChangedEvent {
 	oldPrice := p.basePrice.Copy()
 	p.basePrice = newPrice.Copy()
 	p.changes.MarkDirty(FieldBasePrice)
 
-	p.recordEvent(&BasePriceChangedEvent{
+	return &BasePriceChangedEvent{
+		ProductID:      p.id,
+		OldPrice:       oldPrice,
+		NewPrice:       newPrice.Copy(),
+		EffectivePrice: p.RoundedEffectivePrice(now, pricing),
+		ChangedAt:      now,
+	}
+}
+
+// checkBasePrice returns an error if newPrice cannot become the base price at now.
+func (p *Product) checkBasePrice(newPrice *Money, now time.Time) error {
+	if newPrice.IsNegative() || newPrice.IsZero() {
+		return ErrInvalidPrice
+	}
+
+	if newPrice.Currency() != p.basePrice.Currency() {
+		return ErrCurrencyMismatch
+	}
+
+	// A fixed-amount discount must not take the price below zero
+	for _, d := range p.discounts {
+		if d.IsFixedAmount() && !d.HasEnded(now) && d.amount.GreaterThan(newPrice) {
+			return ErrDiscountExceedsPrice
+		}
+	}
+
+	return nil
+}
+
+// RequestBasePriceChange holds a base price change for approval, typically because it
+// violates the price guardrails. The request must have been made against the current
+// base price, and only one request may be pending at a time.
+func (p *Product) RequestBasePriceChange(request *PriceChangeRequest, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	if p.priceRequest != nil {
+		return ErrPriceChangeRequestPending
+	}
+
+	if !request.oldPrice.Equals(p.basePrice) {
+		return ErrPriceChangeRequestStale
+	}
+
+	if err := p.checkBasePrice(request.newPrice, now); err != nil {
+		return err
+	}
+
+	p.priceRequest = request.copy()
+	p.changes.MarkDirty(FieldPriceRequest)
+
+	p.recordEvent(&PriceChangeRequestedEvent{
+		ProductID:   p.id,
+		RequestID:   request.ID(),
+		OldPrice:    request.OldPrice(),
+		NewPrice:    request.NewPrice(),
+		RequestedBy: request.requestedBy,
+		Reason:      request.requestedReason,
+		Violations:  request.Violations(),
+		RequestedAt: now,
+	})
+
+	return nil
+}
+
+// ApprovePriceChangeRequest approves the pending price change request and changes the
+// base price to the requested one. The approver must not be the requester, and the base
+// price must not have changed since the request was made.
+func (p *Product) ApprovePriceChangeRequest(requestID, approvedBy string, now time.Time, pricing *PricingCalculator) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	request := p.priceRequest
+	if request == nil || request.ID() != requestID {
+		return ErrPriceChangeRequestNotFound
+	}
+
+	if approvedBy == request.requestedBy {
+		return ErrPriceChangeSelfApproval
+	}
+
+	if !request.oldPrice.Equals(p.basePrice) {
+		return ErrPriceChangeRequestStale
+	}
+
+	if err := p.checkBasePrice(request.newPrice, now); err != nil {
+		return err
+	}
+
+	p.priceRequest = nil
+	p.changes.MarkDirty(FieldPriceRequest)
+
+	p.recordEvent(&PriceChangeApprovedEvent{
+		ProductID:   p.id,
+		RequestID:   request.ID(),
+		OldPrice:    request.OldPrice(),
+		NewPrice:    request.NewPrice(),
+		RequestedBy: request.requestedBy,
+		ApprovedBy:  approvedBy,
+		ApprovedAt:  now,
+	})
+
+	event := p.changeBasePrice(request.newPrice, now, pricing)
+	event.RequestID = request.ID()
+	p.recordEvent(event)
+
+	return nil
+}
+
+// RejectPriceChangeRequest rejects the pending price change request, leaving the base
+// price unchanged. The requester may reject their own request to withdraw it.
+func (p *Product) RejectPriceChangeRequest(requestID, rejectedBy, reason string, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	if p.priceRequest == nil || p.priceRequest.ID() != requestID {
+		return ErrPriceChangeRequestNotFound
+	}
+
+	p.dropPriceRequest(rejectedBy, reason, now)
+	return nil
+}
+
+// dropPriceRequest removes the pending price change request with a PriceChangeRejectedEvent.
+func (p *Product) dropPriceRequest(rejectedBy, reason string, now time.Time) {
+	request := p.priceRequest
+	p.priceRequest = nil
+	p.changes.MarkDirty(FieldPriceRequest)
+
+	p.recordEvent(&PriceChangeRejectedEvent{
+		ProductID:   p.id,
+		RequestID:   request.ID(),
+		OldPrice:    request.OldPrice(),
+		NewPrice:    request.NewPrice(),
+		RequestedBy: request.requestedBy,
+		RejectedBy:  rejectedBy,
+		Reason:      reason,
+		RejectedAt:  now,
+	})
+}
+
+// ScheduleBasePriceChange adds a base price change to the product's schedule. It takes
+// effect at its effective time, which must be after now, through ApplyDuePriceChanges.
+// The new price must be in the base price currency, and no other change may be scheduled
+// at the same time.
+func (p *Product) ScheduleBasePriceChange(change *ScheduledPriceChange, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	if !change.effectiveAt.After(now) {
+		return ErrPriceChangeNotInFuture
+	}
+
+	if change.newPrice.Currency() != p.basePrice.Currency() {
+		return ErrCurrencyMismatch
+	}
+
+	for _, scheduled := range p.priceChanges {
+		if scheduled.effectiveAt.Equal(change.effectiveAt) {
+			return ErrPriceChangeConflict
+		}
+	}
+
+	p.priceChanges = append(p.priceChanges, change.copy())
+	sortPriceChanges(p.priceChanges)
+	p.changes.MarkDirty(FieldPriceChanges)
+
+	p.recordEvent(&PriceChangeScheduledEvent{
+		ProductID:     p.id,
+		PriceChangeID: change.ID(),
+		NewPrice:      change.NewPrice(),
+		EffectiveAt:   change.effectiveAt,
+		ScheduledAt:   now,
+	})
+
+	return nil
+}
+
+// CancelScheduledPriceChange removes a price change that has not been applied yet from
+// the schedule.
+func (p *Product) CancelScheduledPriceChange(priceChangeID string, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	for _, change := range p.priceChanges {
+		if change.ID() == priceChangeID {
+			p.dropPriceChange(change, "", now)
+			return nil
+		}
+	}
+	return ErrPriceChangeNotFound
+}
+
+// ApplyDuePriceChanges applies the latest scheduled price change that is due at now with a
+// BasePriceChangedEvent. Earlier due changes never took effect on their own, e.g. after the
+// worker was down, so they are superseded and dropped with a PriceChangeCancelledEvent;
+// this keeps one base price change per run and price history in effective order. A change
+// that violates the guardrails is held for approval instead, as a PriceChangeRequest
+// identified by the change's ID. A change whose price can no longer be applied, e.g.
+// because a fixed-amount discount now exceeds it or another request is already pending,
+// is dropped too. It returns copies of the applied, held and dropped changes so their
+// storage can be updated.
+func (p *Product) ApplyDuePriceChanges(
+	now time.Time,
+	guardrails *PriceGuardrails,
+	pricing *PricingCalculator,
+) (applied, held, dropped []*ScheduledPriceChange) {
+	var due []*ScheduledPriceChange
+	for _, change := range p.priceChanges {
+		if !change.IsDue(now) {
+			break
+		}
+		due = append(due, change)
+	}
+	if len(due) == 0 {
+		return nil, nil, nil
+	}
+
+	latest := due[len(due)-1]
+	for _, change := range due[:len(due)-1] {
+		p.dropPriceChange(change, "superseded by price change "+latest.ID(), now)
+		dropped = append(dropped, change.copy())
+	}
+
+	var err error
+	if violations := guardrails.Check(p, latest.newPrice); len(violations) > 0 {
+		if err = p.holdPriceChange(latest, violations, now); err == nil {
+			p.deletePriceChange(latest)
+			return applied, append(held, latest.copy()), dropped
+		}
+	} else if err = p.setBasePrice(latest.newPrice, now, latest.ID(), pricing); err == nil {
+		p.deletePriceChange(latest)
+		return append(applied, latest.copy()), held, dropped
+	}
+	p.dropPriceChange(latest, err.Error(), now)
+	return applied, held, append(dropped, latest.copy())
+}
+
+// holdPriceChange turns a due price change into a pending price change request.
+func (p *Product) holdPriceChange(change *ScheduledPriceChange, violations []string, now time.Time) error {
+	request, err := NewPriceChangeRequest(p.basePrice, change.newPrice, change.changedBy, change.changedReason, violations, now)
+	if err != nil {
+		return err
+	}
+	return p.RequestBasePriceChange(request.WithID(change.ID()), now)
+}
+
+// ScheduledPriceChanges returns copies of the pending price changes ordered by effective time.
+func (p *Product) ScheduledPriceChanges() []*ScheduledPriceChange {
+	changes := make([]*ScheduledPriceChange, 0, len(p.priceChanges))
+	for _, c := range p.priceChanges {
+		changes = append(changes, c.copy())
+	}
+	return changes
+}
+
+// dropPriceChange removes a price change from the schedule with a PriceChangeCancelledEvent.
+func (p *Product) dropPriceChange(change *ScheduledPriceChange, reason string, now time.Time) {
+	p.deletePriceChange(change)
+	p.recordEvent(&PriceChangeCancelledEvent{
+		ProductID:     p.id,
+		PriceChangeID: change.ID(),
+		NewPrice:      change.NewPrice(),
+		EffectiveAt:   change.effectiveAt,
+		Reason:        reason,
+		CancelledAt:   now,
+	})
+}
+
+// deletePriceChange removes a price change from the schedule.
+func (p *Product) deletePriceChange(change *ScheduledPriceChange) {
+	for i, c := range p.priceChanges {
+		if c == change {
+			p.priceChanges = append(p.priceChanges[:i:i], p.priceChanges[i+1:]...)
+			break
+		}
+	}
+	p.changes.MarkDirty(FieldPriceChanges)
+}
+
+// SetRegionalPrice adds or replaces the price for a region.
+// Regional prices may be in any currency. This emits a RegionalPriceSetEvent.
+func (p *Product) SetRegionalPrice(region string, price *Money, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	region, err := ParseRegion(region)
+	if err != nil {
+		return err
+	}
+
+	if price.IsNegative() || price.IsZero() {
+		return ErrInvalidPrice
+	}
+
+	var oldPrice *Money
+	if existing, ok := p.regionalPrices[region]; ok {
+		oldPrice = existing.Copy()
+	}
+	p.regionalPrices[region] = price.Copy()
+	p.changes.MarkDirty(FieldRegionalPrices)
+
+	p.recordEvent(&RegionalPriceSetEvent{
 		ProductID: p.id,
+		Region:    region,
 		OldPrice:  oldPrice,
-		NewPrice:  newPrice.Copy(),
-		ChangedAt: p.clock.Now(),
+		NewPrice:  price.Copy(),
+		ChangedAt: now,
 	})
 
 	return nil
 }
 
-// ApplyDiscount applies a discount to the product.
-func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
+// RemoveRegionalPrice removes the price for a region, which falls back to the base price.
+// This emits a RegionalPriceRemovedEvent.
+func (p *Product) RemoveRegionalPrice(region string, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	region, err := ParseRegion(region)
+	if err != nil {
+		return err
+	}
+
+	oldPrice, ok := p.regionalPrices[region]
+	if !ok {
+		return ErrRegionalPriceNotFound
+	}
+	delete(p.regionalPrices, region)
+	p.changes.MarkDirty(FieldRegionalPrices)
+
+	p.recordEvent(&RegionalPriceRemovedEvent{
+		ProductID: p.id,
+		Region:    region,
+		OldPrice:  oldPrice,
+		RemovedAt: now,
+	})
+
+	return nil
+}
+
+// ApplyDiscount adds a discount to the product's schedule. It takes effect at its start
+// date, which may be in the future. Its period must not overlap any discount that has not
+// ended yet. A fixed amount must be in the base price currency and cannot exceed the base price.
+// The recorded effective price is rounded by pricing.
+func (p *Product) ApplyDiscount(discount *Discount, now time.Time, pricing *PricingCalculator) error {
 	if err := p.checkNotArchived(); err != nil {
 		return err
 	}
@@ -268,41 +754,153 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 		return ErrCannotApplyToInactive
 	}
 
-	if p.discount != nil {
-		return ErrDiscountAlreadyActive
+	for _, scheduled := range p.discounts {
+		if !scheduled.HasEnded(now) && scheduled.Overlaps(discount) {
+			return ErrDiscountOverlap
+		}
+	}
+
+	if discount.IsFixedAmount() {
+		if discount.amount.Currency() != p.basePrice.Currency() {
+			return ErrCurrencyMismatch
+		}
+		if discount.amount.GreaterThan(p.basePrice) {
+			return ErrDiscountExceedsPrice
+		}
 	}
 
-	p.discount = discount
+	// A discount that is already valid needs no separate start announcement
+	scheduled := discount.copy()
+	if scheduled.HasStarted(now) && scheduled.startedAt == nil {
+		scheduled.startedAt = &now
+	}
+	p.discounts = append(p.discounts, scheduled)
+	sortDiscounts(p.discounts)
 	p.changes.MarkDirty(FieldDiscount)
 
 	p.recordEvent(&DiscountAppliedEvent{
-		ProductID:         p.id,
-		DiscountPercent:   discount.Percentage(),
-		DiscountStartDate: discount.StartDate(),
-		DiscountEndDate:   discount.EndDate(),
-		AppliedAt:         now,
+		ProductID:            p.id,
+		DiscountID:           discount.ID(),
+		DiscountKind:         discount.Kind(),
+		DiscountPercent:      discount.Percentage(),
+		DiscountPercentExact: discount.ExactPercentage(),
+		DiscountAmount:       discount.Amount(),
+		DiscountStartDate:    discount.StartDate(),
+		DiscountEndDate:      discount.EndDate(),
+		EffectivePrice:       pricing.RoundPrice(discount.Apply(p.basePrice), p.category),
+		AppliedAt:            now,
 	})
 
 	return nil
 }
 
-// RemoveDiscount removes the active discount from the product.
-func (p *Product) RemoveDiscount(now time.Time) error {
+// RemoveDiscount removes the discount valid now from the product.
+// Discounts that have not started are cancelled with CancelScheduledDiscount.
+func (p *Product) RemoveDiscount(now time.Time, pricing *PricingCalculator) error {
 	if err := p.checkNotArchived(); err != nil {
 		return err
 	}
 
-	p.discount = nil
-	p.changes.MarkDirty(FieldDiscount)
+	active := ActiveDiscount(p.discounts, now)
+	if active == nil {
+		return ErrNoActiveDiscount
+	}
+	p.deleteDiscount(active)
 
 	p.recordEvent(&DiscountRemovedEvent{
-		ProductID: p.id,
-		RemovedAt: now,
+		ProductID:      p.id,
+		DiscountID:     active.ID(),
+		EffectivePrice: p.RoundedEffectivePrice(now, pricing),
+		RemovedAt:      now,
 	})
 
 	return nil
 }
 
+// CancelScheduledDiscount removes a discount that has not started yet from the schedule.
+func (p *Product) CancelScheduledDiscount(discountID string, now time.Time) error {
+	if err := p.checkNotArchived(); err != nil {
+		return err
+	}
+
+	var discount *Discount
+	for _, d := range p.discounts {
+		if d.ID() == discountID {
+			discount = d
+			break
+		}
+	}
+	if discount == nil {
+		return ErrDiscountNotFound
+	}
+	if discount.HasStarted(now) {
+		return ErrDiscountAlreadyStarted
+	}
+	p.deleteDiscount(discount)
+
+	p.recordEvent(&DiscountCancelledEvent{
+		ProductID:         p.id,
+		DiscountID:        discount.ID(),
+		DiscountStartDate: discount.StartDate(),
+		DiscountEndDate:   discount.EndDate(),
+		CancelledAt:       now,
+	})
+
+	return nil
+}
+
+// SweepDiscounts brings the schedule up to date at now: discounts whose period has ended
+// are removed with a DiscountExpiredEvent, and discounts that have started since they were
+// applied are recorded as started with a DiscountStartedEvent. It returns copies of the
+// started and expired discounts so their storage can be updated.
+// Archived products have no discounts, so sweeping them is a no-op.
+func (p *Product) SweepDiscounts(now time.Time, pricing *PricingCalculator) (started, expired []*Discount) {
+	for _, d := range append([]*Discount(nil), p.discounts...) {
+		switch {
+		case d.HasEnded(now):
+			p.deleteDiscount(d)
+			expired = append(expired, d.copy())
+			p.recordEvent(&DiscountExpiredEvent{
+				ProductID:         p.id,
+				DiscountID:        d.ID(),
+				DiscountStartDate: d.StartDate(),
+				DiscountEndDate:   d.EndDate(),
+				EffectivePrice:    p.RoundedEffectivePrice(now, pricing),
+				ExpiredAt:         now,
+			})
+
+		case d.HasStarted(now) && d.startedAt == nil:
+			d.startedAt = &now
+			p.changes.MarkDirty(FieldDiscount)
+			started = append(started, d.copy())
+			p.recordEvent(&DiscountStartedEvent{
+				ProductID:            p.id,
+				DiscountID:           d.ID(),
+				DiscountKind:         d.Kind(),
+				DiscountPercent:      d.Percentage(),
+				DiscountPercentExact: d.ExactPercentage(),
+				DiscountAmount:       d.Amount(),
+				DiscountStartDate:    d.StartDate(),
+				DiscountEndDate:      d.EndDate(),
+				EffectivePrice:       p.RoundedEffectivePrice(now, pricing),
+				StartedAt:            now,
+			})
+		}
+	}
+	return started, expired
+}
+
+// deleteDiscount removes a discount from the schedule.
+func (p *Product) deleteDiscount(discount *Discount) {
+	for i, d := range p.discounts {
+		if d == discount {
+			p.discounts = append(p.discounts[:i:i], p.discounts[i+1:]...)
+			break
+		}
+	}
+	p.changes.MarkDirty(FieldDiscount)
+}
+
 // Activate activates the product.
 func (p *Product) Activate(now time.Time) error {
 	if err := p.checkNotArchived(); err != nil {
@@ -351,17 +949,27 @@ func (p *Product) Archive(now time.Time) error {
 		return ErrAlreadyArchived
 	}
 
-	// Remove any active discount when archiving
-	// Archived products should not have active discounts
-	hadDiscount := p.discount != nil
-	if hadDiscount {
-		p.discount = nil
-		p.changes.MarkDirty(FieldDiscount)
+	// Remove the whole discount schedule when archiving
+	// Archived products should not have active or upcoming discounts
+	for _, d := range p.discounts {
 		p.recordEvent(&DiscountRemovedEvent{
-			ProductID: p.id,
-			RemovedAt: now,
+			ProductID:  p.id,
+			DiscountID: d.ID(),
+			RemovedAt:  now,
 		})
 	}
+	if len(p.discounts) > 0 {
+		p.discounts = nil
+		p.changes.MarkDirty(FieldDiscount)
+	}
+
+	// Pending price changes are dropped as well
+	for _, c := range append([]*ScheduledPriceChange(nil), p.priceChanges...) {
+		p.dropPriceChange(c, "product archived", now)
+	}
+	if p.priceRequest != nil {
+		p.dropPriceRequest("", "product archived", now)
+	}
 
 	p.status = StatusArchived
 	p.archivedAt = &now
@@ -376,10 +984,70 @@ func (p *Product) Archive(now time.Time) error {
 	return nil
 }
 
-// CalculateEffectivePrice calculates the current price considering active discounts.
-// Delegates to PricingCalculator for centralized pricing logic.
+// Restore returns an archived product to inactive, provided it was archived no longer
+// than window ago. A zero window has no limit. The discounts, price changes and price
+// change request dropped by Archive are not brought back. Like Purge, it refuses an
+// archived product without an archival time, as its window cannot be checked.
+func (p *Product) Restore(now time.Time, window time.Duration) error {
+	if p.status != StatusArchived {
+		return ErrNotArchived
+	}
+	if p.archivedAt == nil {
+		return ErrArchivalTimeUnknown
+	}
+
+	archivedAt := *p.archivedAt
+	if window > 0 && now.After(archivedAt.Add(window)) {
+		return ErrRestoreWindowExpired
+	}
+
+	p.status = StatusInactive
+	p.archivedAt = nil
+	p.changes.MarkDirty(FieldStatus)
+	p.changes.MarkDirty(FieldArchivedAt)
+
+	p.recordEvent(&ProductRestoredEvent{
+		ProductID:  p.id,
+		ArchivedAt: archivedAt,
+		RestoredAt: now,
+	})
+
+	return nil
+}
+
+// Purge prepares the permanent deletion of a product archived before archivedBefore,
+// the retention cutoff, and records its product.purged tombstone. Deleting the product
+// and, by cascade, its child rows is up to the caller.
+func (p *Product) Purge(now, archivedBefore time.Time) error {
+	if p.status != StatusArchived {
+		return ErrNotArchived
+	}
+	if p.archivedAt == nil {
+		return ErrArchivalTimeUnknown
+	}
+	if !p.archivedAt.Before(archivedBefore) {
+		return ErrRetentionNotElapsed
+	}
+
+	p.recordEvent(&ProductPurgedEvent{
+		ProductID:  p.id,
+		ArchivedAt: *p.archivedAt,
+		PurgedAt:   now,
+	})
+
+	return nil
+}
+
+// CalculateEffectivePrice calculates the price at now, applying whichever scheduled
+// discount is valid then. Delegates to PricingCalculator for centralized pricing logic.
 func (p *Product) CalculateEffectivePrice(now time.Time) *Money {
-	return defaultPricingCalculator.CalculateEffectivePrice(p.basePrice, p.discount, now)
+	return defaultPricingCalculator.CalculateEffectivePrice(p.basePrice, ActiveDiscount(p.discounts, now), now)
+}
+
+// RoundedEffectivePrice returns the effective price at now together with its rounding
+// under pricing's rounding policy for the product's category and currency.
+func (p *Product) RoundedEffectivePrice(now time.Time, pricing *PricingCalculator) *RoundedPrice {
+	return pricing.RoundPrice(p.CalculateEffectivePrice(now), p.category)
 }
 
 // IsActive returns true if the product is active.
@@ -394,7 +1062,7 @@ func (p *Product) IsArchived() bool {
 
 // HasActiveDiscount returns true if the product has a discount valid at the given time.
 func (p *Product) HasActiveDiscount(now time.Time) bool {
-	return p.discount != nil && p.discount.IsValidAt(now)
+	return ActiveDiscount(p.discounts, now) != nil
 }
 
 // checkNotArchived returns an error if the product is archived.
@@ -414,3 +1082,10 @@ func (p *Product) recordEvent(event DomainEvent) {
 func (p *Product) ClearEvents() {
 	p.events = make([]DomainEvent, 0)
 }
+
+// sortDiscounts orders a discount schedule by start date.
+func sortDiscounts(discounts []*Discount) {
+	sort.SliceStable(discounts, func(i, j int) bool {
+		return discounts[i].startDate.Before(discounts[j].startDate)
+	})
+}
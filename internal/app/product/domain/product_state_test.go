@@ -13,7 +13,7 @@ import (
 func TestProductStateMachine(t *testing.T) {
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
-	price, _ := NewMoney(10000, 100)
+	price, _ := NewMoney(10000, 100, "USD")
 
 	// State transition matrix:
 	// From\To    | Inactive | Active | Archived
@@ -115,7 +115,7 @@ func TestProductStateMachine(t *testing.T) {
 func TestArchivedProductCannotBeModified(t *testing.T) {
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
-	price, _ := NewMoney(10000, 100)
+	price, _ := NewMoney(10000, 100, "USD")
 
 	t.Run("cannot set name", func(t *testing.T) {
 		p, _ := NewProduct("id-1", "Product", "Desc", "electronics", price, now, clk)
@@ -145,8 +145,8 @@ func TestArchivedProductCannotBeModified(t *testing.T) {
 		p, _ := NewProduct("id-4", "Product", "Desc", "electronics", price, now, clk)
 		p.Archive(now)
 
-		newPrice, _ := NewMoney(15000, 100)
-		err := p.SetBasePrice(newPrice)
+		newPrice, _ := NewMoney(15000, 100, "USD")
+		err := p.SetBasePrice(newPrice, testPricing)
 		assert.ErrorIs(t, err, ErrCannotModifyArchived)
 	})
 
@@ -156,7 +156,7 @@ func TestArchivedProductCannotBeModified(t *testing.T) {
 		p.Archive(now)
 
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		err := p.ApplyDiscount(discount, now)
+		err := p.ApplyDiscount(discount, now, testPricing)
 		assert.ErrorIs(t, err, ErrCannotModifyArchived)
 	})
 
@@ -164,11 +164,11 @@ func TestArchivedProductCannotBeModified(t *testing.T) {
 		p, _ := NewProduct("id-6", "Product", "Desc", "electronics", price, now, clk)
 		p.Activate(now)
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 		p.Archive(now) // This removes the discount
 
 		// Archive already removed discount, but trying to remove again should still fail
-		err := p.RemoveDiscount(now)
+		err := p.RemoveDiscount(now, testPricing)
 		assert.ErrorIs(t, err, ErrCannotModifyArchived)
 	})
 
@@ -194,14 +194,14 @@ func TestArchivedProductCannotBeModified(t *testing.T) {
 func TestDiscountOnlyOnActiveProducts(t *testing.T) {
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
-	price, _ := NewMoney(10000, 100)
+	price, _ := NewMoney(10000, 100, "USD")
 	discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
 
 	t.Run("can apply discount to active product", func(t *testing.T) {
 		p, _ := NewProduct("id-1", "Product", "Desc", "electronics", price, now, clk)
 		p.Activate(now)
 
-		err := p.ApplyDiscount(discount, now)
+		err := p.ApplyDiscount(discount, now, testPricing)
 		require.NoError(t, err)
 		assert.NotNil(t, p.DiscountCopy()) // Use DiscountCopy() instead of deprecated Discount()
 	})
@@ -210,7 +210,7 @@ func TestDiscountOnlyOnActiveProducts(t *testing.T) {
 		p, _ := NewProduct("id-2", "Product", "Desc", "electronics", price, now, clk)
 		assert.Equal(t, StatusInactive, p.Status())
 
-		err := p.ApplyDiscount(discount, now)
+		err := p.ApplyDiscount(discount, now, testPricing)
 		assert.ErrorIs(t, err, ErrCannotApplyToInactive)
 	})
 
@@ -218,28 +218,28 @@ func TestDiscountOnlyOnActiveProducts(t *testing.T) {
 		p, _ := NewProduct("id-3", "Product", "Desc", "electronics", price, now, clk)
 		p.Archive(now)
 
-		err := p.ApplyDiscount(discount, now)
+		err := p.ApplyDiscount(discount, now, testPricing)
 		assert.ErrorIs(t, err, ErrCannotModifyArchived)
 	})
 
 	t.Run("can remove discount from active product", func(t *testing.T) {
 		p, _ := NewProduct("id-4", "Product", "Desc", "electronics", price, now, clk)
 		p.Activate(now)
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 
-		err := p.RemoveDiscount(now)
+		err := p.RemoveDiscount(now, testPricing)
 		require.NoError(t, err)
 		assert.Nil(t, p.DiscountCopy()) // Use DiscountCopy() instead of deprecated Discount()
 	})
 
-	t.Run("cannot apply second discount when one exists", func(t *testing.T) {
+	t.Run("cannot apply overlapping discount", func(t *testing.T) {
 		p, _ := NewProduct("id-5", "Product", "Desc", "electronics", price, now, clk)
 		p.Activate(now)
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 
 		discount2, _ := NewDiscount(30, now, now.Add(24*time.Hour))
-		err := p.ApplyDiscount(discount2, now)
-		assert.ErrorIs(t, err, ErrDiscountAlreadyActive)
+		err := p.ApplyDiscount(discount2, now, testPricing)
+		assert.ErrorIs(t, err, ErrDiscountOverlap)
 	})
 }
 
@@ -247,7 +247,7 @@ func TestDiscountOnlyOnActiveProducts(t *testing.T) {
 func TestStateTransitionEventEmission(t *testing.T) {
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
-	price, _ := NewMoney(10000, 100)
+	price, _ := NewMoney(10000, 100, "USD")
 
 	t.Run("Inactive → Active emits ProductActivatedEvent", func(t *testing.T) {
 		p, _ := NewProduct("id-1", "Product", "Desc", "electronics", price, now, clk)
@@ -292,7 +292,7 @@ func TestStateTransitionEventEmission(t *testing.T) {
 		p, _ := NewProduct("id-4", "Product", "Desc", "electronics", price, now, clk)
 		p.Activate(now)
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 		p.ClearEvents()
 
 		p.Archive(now)
@@ -311,3 +311,118 @@ func TestStateTransitionEventEmission(t *testing.T) {
 		assert.True(t, hasArchived, "Should emit ProductArchivedEvent")
 	})
 }
+
+// TestProductRestore verifies archived products can be restored within the restore window.
+func TestProductRestore(t *testing.T) {
+	now := time.Now().UTC()
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(10000, 100, "USD")
+	window := 7 * 24 * time.Hour
+
+	t.Run("Archived → Inactive within the window", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Product", "Desc", "electronics", price, now, clk)
+		p.Activate(now)
+		p.Archive(now)
+		p.ClearEvents()
+
+		restoredAt := now.Add(window)
+		require.NoError(t, p.Restore(restoredAt, window))
+		assert.Equal(t, StatusInactive, p.Status())
+		assert.Nil(t, p.ArchivedAt())
+		assert.True(t, p.Changes().Dirty(FieldArchivedAt))
+
+		events := p.DomainEvents()
+		require.Len(t, events, 1)
+		restored, ok := events[0].(*ProductRestoredEvent)
+		require.True(t, ok)
+		assert.Equal(t, now, restored.ArchivedAt)
+		assert.Equal(t, restoredAt, restored.RestoredAt)
+
+		// Restored products can be modified again
+		assert.NoError(t, p.Activate(restoredAt))
+	})
+
+	t.Run("window has expired", func(t *testing.T) {
+		p, _ := NewProduct("id-2", "Product", "Desc", "electronics", price, now, clk)
+		p.Archive(now)
+
+		err := p.Restore(now.Add(window+time.Second), window)
+		assert.ErrorIs(t, err, ErrRestoreWindowExpired)
+		assert.Equal(t, StatusArchived, p.Status())
+	})
+
+	t.Run("zero window has no limit", func(t *testing.T) {
+		p, _ := NewProduct("id-3", "Product", "Desc", "electronics", price, now, clk)
+		p.Archive(now)
+
+		require.NoError(t, p.Restore(now.AddDate(5, 0, 0), 0))
+		assert.Equal(t, StatusInactive, p.Status())
+	})
+
+	t.Run("product that is not archived", func(t *testing.T) {
+		p, _ := NewProduct("id-4", "Product", "Desc", "electronics", price, now, clk)
+
+		err := p.Restore(now, window)
+		assert.ErrorIs(t, err, ErrNotArchived)
+	})
+
+	t.Run("archived product without an archival time", func(t *testing.T) {
+		p := ReconstructProduct("id-5", "Product", "Desc", "electronics", DefaultTaxClass, price, nil, nil, nil, nil, nil,
+			StatusArchived, 2, now, now, nil, clk)
+
+		err := p.Restore(now, 0)
+		assert.ErrorIs(t, err, ErrArchivalTimeUnknown)
+		assert.Equal(t, StatusArchived, p.Status())
+		assert.Empty(t, p.DomainEvents())
+	})
+}
+
+// TestProductPurge verifies only products archived before the retention cutoff can be purged.
+func TestProductPurge(t *testing.T) {
+	now := time.Now().UTC()
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(10000, 100, "USD")
+	archivedAt := now.AddDate(-8, 0, 0)
+	cutoff := now.AddDate(-7, 0, 0)
+
+	t.Run("archived before the cutoff", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Product", "Desc", "electronics", price, archivedAt, clk)
+		p.Archive(archivedAt)
+		p.ClearEvents()
+
+		require.NoError(t, p.Purge(now, cutoff))
+
+		events := p.DomainEvents()
+		require.Len(t, events, 1)
+		purged, ok := events[0].(*ProductPurgedEvent)
+		require.True(t, ok)
+		assert.Equal(t, archivedAt, purged.ArchivedAt)
+		assert.Equal(t, now, purged.PurgedAt)
+	})
+
+	t.Run("archived after the cutoff", func(t *testing.T) {
+		p, _ := NewProduct("id-2", "Product", "Desc", "electronics", price, archivedAt, clk)
+		p.Archive(now)
+		p.ClearEvents()
+
+		err := p.Purge(now, cutoff)
+		assert.ErrorIs(t, err, ErrRetentionNotElapsed)
+		assert.Empty(t, p.DomainEvents())
+	})
+
+	t.Run("product that is not archived", func(t *testing.T) {
+		p, _ := NewProduct("id-3", "Product", "Desc", "electronics", price, archivedAt, clk)
+
+		err := p.Purge(now, cutoff)
+		assert.ErrorIs(t, err, ErrNotArchived)
+	})
+
+	t.Run("archived product without an archival time", func(t *testing.T) {
+		p := ReconstructProduct("id-4", "Product", "Desc", "electronics", DefaultTaxClass, price, nil, nil, nil, nil, nil,
+			StatusArchived, 2, archivedAt, archivedAt, nil, clk)
+
+		err := p.Purge(now, cutoff)
+		assert.ErrorIs(t, err, ErrArchivalTimeUnknown)
+		assert.Empty(t, p.DomainEvents())
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testPricing rounds the effective prices recorded in events half-up.
+var testPricing = NewPricingCalculator()
+
 func TestNewProduct(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now()
 	clk := clock.NewMockClock(now)
 
@@ -35,20 +38,20 @@ func TestNewProduct(t *testing.T) {
 	})
 
 	t.Run("negative price returns error", func(t *testing.T) {
-		negativePrice, _ := NewMoney(-100, 1)
+		negativePrice, _ := NewMoney(-100, 1, "USD")
 		_, err := NewProduct("id-1", "Test", "Description", "electronics", negativePrice, now, clk)
 		assert.ErrorIs(t, err, ErrInvalidPrice)
 	})
 
 	t.Run("zero price returns error", func(t *testing.T) {
-		zeroPrice, _ := NewMoney(0, 1)
+		zeroPrice, _ := NewMoney(0, 1, "USD")
 		_, err := NewProduct("id-1", "Test", "Description", "electronics", zeroPrice, now, clk)
 		assert.ErrorIs(t, err, ErrInvalidPrice)
 	})
 }
 
 func TestProduct_SetDescription(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -81,7 +84,7 @@ func TestProduct_SetDescription(t *testing.T) {
 }
 
 func TestProduct_SetBasePrice(t *testing.T) {
-	originalPrice, _ := NewMoney(100, 1)
+	originalPrice, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -89,8 +92,8 @@ func TestProduct_SetBasePrice(t *testing.T) {
 		p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", originalPrice, now, clk)
 		p.Changes().Clear() // Clear initial state
 
-		newPrice, _ := NewMoney(150, 1)
-		err := p.SetBasePrice(newPrice)
+		newPrice, _ := NewMoney(150, 1, "USD")
+		err := p.SetBasePrice(newPrice, testPricing)
 		require.NoError(t, err)
 
 		// Verify price was updated
@@ -120,16 +123,16 @@ func TestProduct_SetBasePrice(t *testing.T) {
 	t.Run("negative price returns error", func(t *testing.T) {
 		p, _ := NewProduct("id-2", "Test Product", "Description", "electronics", originalPrice, now, clk)
 
-		negativePrice, _ := NewMoney(-50, 1)
-		err := p.SetBasePrice(negativePrice)
+		negativePrice, _ := NewMoney(-50, 1, "USD")
+		err := p.SetBasePrice(negativePrice, testPricing)
 		assert.ErrorIs(t, err, ErrInvalidPrice)
 	})
 
 	t.Run("zero price returns error", func(t *testing.T) {
 		p, _ := NewProduct("id-3", "Test Product", "Description", "electronics", originalPrice, now, clk)
 
-		zeroPrice, _ := NewMoney(0, 1)
-		err := p.SetBasePrice(zeroPrice)
+		zeroPrice, _ := NewMoney(0, 1, "USD")
+		err := p.SetBasePrice(zeroPrice, testPricing)
 		assert.ErrorIs(t, err, ErrInvalidPrice)
 	})
 
@@ -137,14 +140,23 @@ func TestProduct_SetBasePrice(t *testing.T) {
 		p, _ := NewProduct("id-4", "Test Product", "Description", "electronics", originalPrice, now, clk)
 		p.Archive(now)
 
-		newPrice, _ := NewMoney(150, 1)
-		err := p.SetBasePrice(newPrice)
+		newPrice, _ := NewMoney(150, 1, "USD")
+		err := p.SetBasePrice(newPrice, testPricing)
 		assert.ErrorIs(t, err, ErrCannotModifyArchived)
 	})
+
+	t.Run("price in another currency returns error", func(t *testing.T) {
+		p, _ := NewProduct("id-5", "Test Product", "Description", "electronics", originalPrice, now, clk)
+
+		euroPrice, _ := NewMoney(150, 1, "EUR")
+		err := p.SetBasePrice(euroPrice, testPricing)
+		assert.ErrorIs(t, err, ErrCurrencyMismatch)
+		assert.Equal(t, Currency("USD"), p.BasePrice().Currency())
+	})
 }
 
 func TestProduct_Activate(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -157,7 +169,7 @@ func TestProduct_Activate(t *testing.T) {
 }
 
 func TestProduct_Deactivate(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -169,7 +181,7 @@ func TestProduct_Deactivate(t *testing.T) {
 }
 
 func TestProduct_ApplyDiscount(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -180,7 +192,7 @@ func TestProduct_ApplyDiscount(t *testing.T) {
 	discount, _ := NewDiscount(20, startDate, endDate)
 
 	t.Run("apply discount to active product", func(t *testing.T) {
-		err := p.ApplyDiscount(discount, now)
+		err := p.ApplyDiscount(discount, now, testPricing)
 		require.NoError(t, err)
 		assert.NotNil(t, p.DiscountCopy()) // Use DiscountCopy() instead of deprecated Discount()
 		assert.True(t, p.Changes().Dirty(FieldDiscount))
@@ -188,19 +200,71 @@ func TestProduct_ApplyDiscount(t *testing.T) {
 
 	t.Run("cannot apply discount to inactive product", func(t *testing.T) {
 		p2, _ := NewProduct("id-2", "Test", "Desc", "electronics", price, now, clk)
-		err := p2.ApplyDiscount(discount, now)
+		err := p2.ApplyDiscount(discount, now, testPricing)
 		assert.ErrorIs(t, err, ErrCannotApplyToInactive)
 	})
 
-	t.Run("cannot apply discount when one already exists", func(t *testing.T) {
+	t.Run("cannot apply overlapping discount", func(t *testing.T) {
 		discount2, _ := NewDiscount(30, startDate, endDate)
-		err := p.ApplyDiscount(discount2, now)
-		assert.ErrorIs(t, err, ErrDiscountAlreadyActive)
+		err := p.ApplyDiscount(discount2, now, testPricing)
+		assert.ErrorIs(t, err, ErrDiscountOverlap)
+	})
+
+	t.Run("apply fixed-amount discount", func(t *testing.T) {
+		p3, _ := NewProduct("id-3", "Test", "Desc", "electronics", price, now, clk)
+		p3.Activate(now)
+		amount, _ := NewMoney(25, 1, "USD")
+		fixed, err := NewFixedAmountDiscount(amount, startDate, endDate)
+		require.NoError(t, err)
+
+		require.NoError(t, p3.ApplyDiscount(fixed, now, testPricing))
+		events := p3.DomainEvents()
+		applied := events[len(events)-1].(*DiscountAppliedEvent)
+		assert.Equal(t, DiscountKindFixedAmount, applied.DiscountKind)
+		assert.True(t, applied.DiscountAmount.Equals(amount))
+
+		val, _ := p3.CalculateEffectivePrice(now).Float64()
+		assert.Equal(t, 75.0, val)
+	})
+
+	t.Run("fixed amount above the price returns error", func(t *testing.T) {
+		p4, _ := NewProduct("id-4", "Test", "Desc", "electronics", price, now, clk)
+		p4.Activate(now)
+		amount, _ := NewMoney(101, 1, "USD")
+		fixed, _ := NewFixedAmountDiscount(amount, startDate, endDate)
+
+		err := p4.ApplyDiscount(fixed, now, testPricing)
+		assert.ErrorIs(t, err, ErrDiscountExceedsPrice)
+		assert.False(t, p4.HasDiscount())
+	})
+
+	t.Run("fixed amount in another currency returns error", func(t *testing.T) {
+		p5, _ := NewProduct("id-5", "Test", "Desc", "electronics", price, now, clk)
+		p5.Activate(now)
+		amount, _ := NewMoney(10, 1, "EUR")
+		fixed, _ := NewFixedAmountDiscount(amount, startDate, endDate)
+
+		err := p5.ApplyDiscount(fixed, now, testPricing)
+		assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	})
+
+	t.Run("cannot lower the price below a fixed-amount discount", func(t *testing.T) {
+		p6, _ := NewProduct("id-6", "Test", "Desc", "electronics", price, now, clk)
+		p6.Activate(now)
+		amount, _ := NewMoney(40, 1, "USD")
+		fixed, _ := NewFixedAmountDiscount(amount, startDate, endDate)
+		require.NoError(t, p6.ApplyDiscount(fixed, now, testPricing))
+
+		lower, _ := NewMoney(30, 1, "USD")
+		assert.ErrorIs(t, p6.SetBasePrice(lower, testPricing), ErrDiscountExceedsPrice)
+
+		exact, _ := NewMoney(40, 1, "USD")
+		assert.NoError(t, p6.SetBasePrice(exact, testPricing))
 	})
 }
 
 func TestProduct_CalculateEffectivePrice(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -216,7 +280,7 @@ func TestProduct_CalculateEffectivePrice(t *testing.T) {
 		startDate := now.Add(-1 * time.Hour)
 		endDate := now.Add(1 * time.Hour)
 		discount, _ := NewDiscount(20, startDate, endDate)
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 
 		effectivePrice := p.CalculateEffectivePrice(now)
 		val, _ := effectivePrice.Float64()
@@ -232,7 +296,7 @@ func TestProduct_CalculateEffectivePrice(t *testing.T) {
 }
 
 func TestProduct_Archive(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -245,7 +309,7 @@ func TestProduct_Archive(t *testing.T) {
 }
 
 func TestProduct_CannotModifyArchived(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
@@ -263,7 +327,7 @@ func TestProduct_CannotModifyArchived(t *testing.T) {
 }
 
 func TestProduct_HasDiscount(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -276,13 +340,13 @@ func TestProduct_HasDiscount(t *testing.T) {
 		p, _ := NewProduct("id-2", "Test Product", "Description", "electronics", price, now, clk)
 		p.Activate(now)
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 		assert.True(t, p.HasDiscount())
 	})
 }
 
 func TestProduct_DiscountCopy(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -297,7 +361,7 @@ func TestProduct_DiscountCopy(t *testing.T) {
 		startDate := now
 		endDate := now.Add(24 * time.Hour)
 		discount, _ := NewDiscount(20, startDate, endDate)
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 
 		copy := p.DiscountCopy()
 		require.NotNil(t, copy)
@@ -310,7 +374,7 @@ func TestProduct_DiscountCopy(t *testing.T) {
 		p, _ := NewProduct("id-3", "Test Product", "Description", "electronics", price, now, clk)
 		p.Activate(now)
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 
 		copy := p.DiscountCopy()
 
@@ -318,7 +382,7 @@ func TestProduct_DiscountCopy(t *testing.T) {
 		assert.Equal(t, p.DiscountCopy().Percentage(), copy.Percentage()) // Use DiscountCopy() instead of deprecated Discount()
 
 		// Remove discount from product
-		p.RemoveDiscount(now)
+		p.RemoveDiscount(now, testPricing)
 
 		// Verify copy is still valid and unchanged
 		assert.Nil(t, p.DiscountCopy())
@@ -327,7 +391,7 @@ func TestProduct_DiscountCopy(t *testing.T) {
 }
 
 func TestProduct_Archive_RemovesDiscount(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -337,7 +401,7 @@ func TestProduct_Archive_RemovesDiscount(t *testing.T) {
 
 		// Apply discount
 		discount, _ := NewDiscount(20, now, now.Add(24*time.Hour))
-		p.ApplyDiscount(discount, now)
+		p.ApplyDiscount(discount, now, testPricing)
 		require.True(t, p.HasDiscount())
 
 		// Archive product
@@ -385,8 +449,185 @@ func TestProduct_Archive_RemovesDiscount(t *testing.T) {
 	})
 }
 
+func TestProduct_DiscountSchedule(t *testing.T) {
+	price, _ := NewMoney(100, 1, "USD")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+
+	newActiveProduct := func(id string) *Product {
+		p, _ := NewProduct(id, "Test Product", "Description", "electronics", price, now, clk)
+		p.Activate(now)
+		return p
+	}
+	current, _ := NewDiscount(20, now.Add(-time.Hour), now.Add(24*time.Hour))
+	future, _ := NewDiscount(50, now.Add(48*time.Hour), now.Add(72*time.Hour))
+
+	t.Run("schedules a future discount next to the active one", func(t *testing.T) {
+		p := newActiveProduct("id-1")
+		require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+		require.NoError(t, p.ApplyDiscount(current.WithID("current"), now, testPricing))
+
+		discounts := p.Discounts()
+		require.Len(t, discounts, 2)
+		assert.Equal(t, "current", discounts[0].ID()) // Sorted by start date
+		assert.Equal(t, "future", discounts[1].ID())
+
+		val, _ := p.CalculateEffectivePrice(now).Float64()
+		assert.Equal(t, 80.0, val)
+		val, _ = p.CalculateEffectivePrice(now.Add(60 * time.Hour)).Float64()
+		assert.Equal(t, 50.0, val)
+		val, _ = p.CalculateEffectivePrice(now.Add(36 * time.Hour)).Float64()
+		assert.Equal(t, 100.0, val)
+	})
+
+	t.Run("rejects a discount overlapping a scheduled one", func(t *testing.T) {
+		p := newActiveProduct("id-2")
+		require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+
+		overlapping, _ := NewDiscount(10, now.Add(70*time.Hour), now.Add(96*time.Hour))
+		assert.ErrorIs(t, p.ApplyDiscount(overlapping, now, testPricing), ErrDiscountOverlap)
+	})
+
+	t.Run("ended discounts do not block new ones", func(t *testing.T) {
+		ended, _ := NewDiscount(10, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+		p := ReconstructProduct("id-3", "Test Product", "Description", "electronics", DefaultTaxClass, price, nil,
+			nil, []*Discount{ended.WithID("ended")}, nil, nil, StatusActive, 1, now, now, nil, clk)
+
+		overlapping, _ := NewDiscount(10, now.Add(-30*time.Hour), now.Add(time.Hour))
+		assert.NoError(t, p.ApplyDiscount(overlapping, now, testPricing))
+	})
+
+	t.Run("cancels a scheduled discount", func(t *testing.T) {
+		p := newActiveProduct("id-4")
+		require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+		p.ClearEvents()
+
+		require.NoError(t, p.CancelScheduledDiscount("future", now))
+		assert.False(t, p.HasDiscount())
+
+		events := p.DomainEvents()
+		require.Len(t, events, 1)
+		cancelled := events[0].(*DiscountCancelledEvent)
+		assert.Equal(t, "future", cancelled.DiscountID)
+		assert.Equal(t, future.StartDate(), cancelled.DiscountStartDate)
+	})
+
+	t.Run("cannot cancel a discount that has started", func(t *testing.T) {
+		p := newActiveProduct("id-5")
+		require.NoError(t, p.ApplyDiscount(current.WithID("current"), now, testPricing))
+
+		assert.ErrorIs(t, p.CancelScheduledDiscount("current", now), ErrDiscountAlreadyStarted)
+		assert.ErrorIs(t, p.CancelScheduledDiscount("missing", now), ErrDiscountNotFound)
+	})
+
+	t.Run("remove only removes the active discount", func(t *testing.T) {
+		p := newActiveProduct("id-6")
+		assert.ErrorIs(t, p.RemoveDiscount(now, testPricing), ErrNoActiveDiscount)
+
+		require.NoError(t, p.ApplyDiscount(current.WithID("current"), now, testPricing))
+		require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+		require.NoError(t, p.RemoveDiscount(now, testPricing))
+
+		discounts := p.Discounts()
+		require.Len(t, discounts, 1)
+		assert.Equal(t, "future", discounts[0].ID())
+	})
+
+	t.Run("archive removes the whole schedule", func(t *testing.T) {
+		p := newActiveProduct("id-7")
+		require.NoError(t, p.ApplyDiscount(current.WithID("current"), now, testPricing))
+		require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+		p.ClearEvents()
+
+		require.NoError(t, p.Archive(now))
+		assert.False(t, p.HasDiscount())
+
+		var removed []string
+		for _, event := range p.DomainEvents() {
+			if e, ok := event.(*DiscountRemovedEvent); ok {
+				removed = append(removed, e.DiscountID)
+			}
+		}
+		assert.Equal(t, []string{"current", "future"}, removed)
+	})
+}
+
+func TestProduct_SweepDiscounts(t *testing.T) {
+	price, _ := NewMoney(100, 1, "USD")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+
+	p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", price, now, clk)
+	p.Activate(now)
+	current, _ := NewDiscount(20, now.Add(-time.Hour), now.Add(24*time.Hour))
+	future, _ := NewDiscount(50, now.Add(48*time.Hour), now.Add(72*time.Hour))
+	require.NoError(t, p.ApplyDiscount(current.WithID("current"), now, testPricing))
+	require.NoError(t, p.ApplyDiscount(future.WithID("future"), now, testPricing))
+	p.ClearEvents()
+
+	t.Run("discounts valid when applied count as started", func(t *testing.T) {
+		discounts := p.Discounts()
+		require.NotNil(t, discounts[0].StartedAt())
+		assert.Equal(t, now, *discounts[0].StartedAt())
+		assert.Nil(t, discounts[1].StartedAt())
+
+		started, expired := p.SweepDiscounts(now, testPricing)
+		assert.Empty(t, started)
+		assert.Empty(t, expired)
+		assert.Empty(t, p.DomainEvents())
+	})
+
+	t.Run("ended discount expires", func(t *testing.T) {
+		sweepAt := now.Add(30 * time.Hour)
+		started, expired := p.SweepDiscounts(sweepAt, testPricing)
+		assert.Empty(t, started)
+		require.Len(t, expired, 1)
+		assert.Equal(t, "current", expired[0].ID())
+		assert.Len(t, p.Discounts(), 1)
+		assert.True(t, p.Changes().Dirty(FieldDiscount))
+
+		events := p.DomainEvents()
+		require.Len(t, events, 1)
+		expiredEvent := events[0].(*DiscountExpiredEvent)
+		assert.Equal(t, "current", expiredEvent.DiscountID)
+		assert.Equal(t, sweepAt, expiredEvent.ExpiredAt)
+		p.ClearEvents()
+	})
+
+	t.Run("scheduled discount starts once", func(t *testing.T) {
+		sweepAt := now.Add(50 * time.Hour)
+		started, expired := p.SweepDiscounts(sweepAt, testPricing)
+		assert.Empty(t, expired)
+		require.Len(t, started, 1)
+		assert.Equal(t, "future", started[0].ID())
+		assert.Equal(t, sweepAt, *started[0].StartedAt())
+
+		events := p.DomainEvents()
+		require.Len(t, events, 1)
+		startedEvent := events[0].(*DiscountStartedEvent)
+		assert.Equal(t, 50.0, startedEvent.DiscountPercent)
+		p.ClearEvents()
+
+		started, _ = p.SweepDiscounts(sweepAt.Add(time.Hour), testPricing)
+		assert.Empty(t, started)
+		assert.Empty(t, p.DomainEvents())
+	})
+
+	t.Run("discount missed entirely only expires", func(t *testing.T) {
+		q, _ := NewProduct("id-2", "Test Product", "Description", "electronics", price, now, clk)
+		q.Activate(now)
+		require.NoError(t, q.ApplyDiscount(future.WithID("future"), now, testPricing))
+		q.ClearEvents()
+
+		started, expired := q.SweepDiscounts(now.Add(100*time.Hour), testPricing)
+		assert.Empty(t, started)
+		assert.Len(t, expired, 1)
+		assert.False(t, q.HasDiscount())
+	})
+}
+
 func TestProduct_MarkUpdated(t *testing.T) {
-	price, _ := NewMoney(100, 1)
+	price, _ := NewMoney(100, 1, "USD")
 	now := time.Now().UTC()
 	clk := clock.NewMockClock(now)
 
@@ -440,3 +681,90 @@ func TestProduct_MarkUpdated(t *testing.T) {
 		assert.Len(t, p.DomainEvents(), 1)
 	})
 }
+
+func TestProduct_RegionalPrices(t *testing.T) {
+	basePrice, _ := NewMoney(100, 1, "USD")
+	euroPrice, _ := NewMoney(9499, 100, "EUR")
+	now := time.Now().UTC()
+	clk := clock.NewMockClock(now)
+
+	t.Run("set regional price in another currency", func(t *testing.T) {
+		p, _ := NewProduct("id-1", "Test Product", "Description", "electronics", basePrice, now, clk)
+		p.Changes().Clear()
+		p.ClearEvents()
+
+		require.NoError(t, p.SetRegionalPrice("de", euroPrice, now))
+
+		price, ok := p.RegionalPrice("DE")
+		require.True(t, ok)
+		assert.True(t, euroPrice.Equals(price))
+		assert.True(t, p.Changes().Dirty(FieldRegionalPrices))
+		assert.False(t, p.Changes().Dirty(FieldBasePrice))
+
+		require.Len(t, p.DomainEvents(), 1)
+		event := p.DomainEvents()[0].(*RegionalPriceSetEvent)
+		assert.Equal(t, "DE", event.Region)
+		assert.Nil(t, event.OldPrice)
+		assert.True(t, euroPrice.Equals(event.NewPrice))
+
+		marketPrice, region := p.MarketPrice("DE", "")
+		assert.True(t, euroPrice.Equals(marketPrice))
+		assert.Equal(t, "DE", region)
+	})
+
+	t.Run("replacing a regional price records the old price", func(t *testing.T) {
+		p, _ := NewProduct("id-2", "Test Product", "Description", "electronics", basePrice, now, clk)
+		require.NoError(t, p.SetRegionalPrice("DE", euroPrice, now))
+		p.ClearEvents()
+
+		newPrice, _ := NewMoney(89, 1, "EUR")
+		require.NoError(t, p.SetRegionalPrice("DE", newPrice, now))
+
+		event := p.DomainEvents()[0].(*RegionalPriceSetEvent)
+		assert.True(t, euroPrice.Equals(event.OldPrice))
+		assert.True(t, newPrice.Equals(event.NewPrice))
+	})
+
+	t.Run("invalid region and price are rejected", func(t *testing.T) {
+		p, _ := NewProduct("id-3", "Test Product", "Description", "electronics", basePrice, now, clk)
+
+		assert.ErrorIs(t, p.SetRegionalPrice("x", euroPrice, now), ErrInvalidRegion)
+
+		zeroPrice, _ := NewMoney(0, 1, "EUR")
+		assert.ErrorIs(t, p.SetRegionalPrice("DE", zeroPrice, now), ErrInvalidPrice)
+		assert.Empty(t, p.RegionalPrices())
+	})
+
+	t.Run("remove regional price", func(t *testing.T) {
+		p, _ := NewProduct("id-4", "Test Product", "Description", "electronics", basePrice, now, clk)
+		require.NoError(t, p.SetRegionalPrice("DE", euroPrice, now))
+		p.ClearEvents()
+
+		require.NoError(t, p.RemoveRegionalPrice("de", now))
+
+		_, ok := p.RegionalPrice("DE")
+		assert.False(t, ok)
+		event := p.DomainEvents()[0].(*RegionalPriceRemovedEvent)
+		assert.Equal(t, "DE", event.Region)
+		assert.True(t, euroPrice.Equals(event.OldPrice))
+
+		// The market falls back to the base price
+		marketPrice, region := p.MarketPrice("DE", "")
+		assert.True(t, basePrice.Equals(marketPrice))
+		assert.Empty(t, region)
+	})
+
+	t.Run("remove missing regional price returns error", func(t *testing.T) {
+		p, _ := NewProduct("id-5", "Test Product", "Description", "electronics", basePrice, now, clk)
+		assert.ErrorIs(t, p.RemoveRegionalPrice("DE", now), ErrRegionalPriceNotFound)
+	})
+
+	t.Run("cannot change regional prices of archived product", func(t *testing.T) {
+		p, _ := NewProduct("id-6", "Test Product", "Description", "electronics", basePrice, now, clk)
+		require.NoError(t, p.SetRegionalPrice("DE", euroPrice, now))
+		require.NoError(t, p.Archive(now))
+
+		assert.ErrorIs(t, p.SetRegionalPrice("FR", euroPrice, now), ErrCannotModifyArchived)
+		assert.ErrorIs(t, p.RemoveRegionalPrice("DE", now), ErrCannotModifyArchived)
+	})
+}
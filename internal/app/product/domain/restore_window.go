@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRestoreWindow is how long an archived product can be restored when no restore
+// window is configured.
+const DefaultRestoreWindow = 30 * 24 * time.Hour
+
+// ParseRestoreWindow parses a restore window given as a Go duration ("720h") or a number
+// of days ("30d"). An empty string gives DefaultRestoreWindow and "0" lifts the limit.
+func ParseRestoreWindow(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DefaultRestoreWindow, nil
+	}
+
+	var window time.Duration
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid restore window %q", value)
+		}
+		window = time.Duration(n) * 24 * time.Hour
+	} else {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid restore window %q", value)
+		}
+		window = d
+	}
+	if window < 0 {
+		return 0, fmt.Errorf("restore window %q must not be negative", value)
+	}
+	return window, nil
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRestoreWindow(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", DefaultRestoreWindow},
+		{"720h", 720 * time.Hour},
+		{"14d", 14 * 24 * time.Hour},
+		{" 90m ", 90 * time.Minute},
+		{"0", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			window, err := ParseRestoreWindow(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, window)
+		})
+	}
+
+	for _, value := range []string{"soon", "1.5d", "-1h"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseRestoreWindow(value)
+			assert.Error(t, err)
+		})
+	}
+}
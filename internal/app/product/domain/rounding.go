@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RoundingMode is a strategy for rounding an exact price to the currency's minor unit.
+type RoundingMode string
+
+const (
+	// RoundingHalfUp rounds to the nearest minor unit, halves away from zero (87.125 → 87.13).
+	RoundingHalfUp RoundingMode = "half_up"
+	// RoundingHalfEven rounds to the nearest minor unit, halves to the even neighbour (87.125 → 87.12).
+	RoundingHalfEven RoundingMode = "half_even"
+	// RoundingFloor rounds down to the minor unit (87.129 → 87.12).
+	RoundingFloor RoundingMode = "floor"
+	// RoundingCharm rounds down to the closest price one minor unit below a whole unit
+	// (87.49 → 86.99, 87.995 → 87.99). Prices below that, and currencies without a minor
+	// unit, are floored instead.
+	RoundingCharm RoundingMode = "charm"
+)
+
+// DefaultRoundingMode applies when no rounding policy matches a price.
+const DefaultRoundingMode = RoundingHalfUp
+
+// ParseRoundingMode validates a rounding mode name.
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	switch mode := RoundingMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case RoundingHalfUp, RoundingHalfEven, RoundingFloor, RoundingCharm:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidRoundingMode, name)
+	}
+}
+
+// RoundingPolicy selects the rounding mode of a price by product category, then by
+// currency, falling back to Default. The zero value rounds everything half-up.
+type RoundingPolicy struct {
+	Default    RoundingMode
+	ByCategory map[string]RoundingMode
+	ByCurrency map[Currency]RoundingMode
+}
+
+// ParseRoundingPolicy builds a policy from a default mode and comma-separated
+// key=mode lists, e.g. "grocery=charm,books=floor" and "JPY=floor,EUR=half_even".
+// Empty strings leave the corresponding part unset.
+func ParseRoundingPolicy(defaultMode, byCategory, byCurrency string) (*RoundingPolicy, error) {
+	policy := &RoundingPolicy{}
+	if defaultMode != "" {
+		mode, err := ParseRoundingMode(defaultMode)
+		if err != nil {
+			return nil, err
+		}
+		policy.Default = mode
+	}
+
+	categories, err := parseRoundingRules(byCategory)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) > 0 {
+		policy.ByCategory = categories
+	}
+
+	currencies, err := parseRoundingRules(byCurrency)
+	if err != nil {
+		return nil, err
+	}
+	for code, mode := range currencies {
+		currency, err := ParseCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+		if policy.ByCurrency == nil {
+			policy.ByCurrency = make(map[Currency]RoundingMode)
+		}
+		policy.ByCurrency[currency] = mode
+	}
+
+	return policy, nil
+}
+
+// parseRoundingRules parses a comma-separated key=mode list.
+func parseRoundingRules(rules string) (map[string]RoundingMode, error) {
+	parsed := make(map[string]RoundingMode)
+	for _, rule := range strings.Split(rules, ",") {
+		if rule = strings.TrimSpace(rule); rule == "" {
+			continue
+		}
+		key, name, ok := strings.Cut(rule, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("%w: rule %q is not key=mode", ErrInvalidRoundingMode, rule)
+		}
+		mode, err := ParseRoundingMode(name)
+		if err != nil {
+			return nil, err
+		}
+		parsed[strings.TrimSpace(key)] = mode
+	}
+	return parsed, nil
+}
+
+// ModeFor returns the rounding mode of a price in the given category and currency.
+// A category rule wins over a currency rule.
+func (p *RoundingPolicy) ModeFor(category string, currency Currency) RoundingMode {
+	if p == nil {
+		return DefaultRoundingMode
+	}
+	if mode, ok := p.ByCategory[category]; ok {
+		return mode
+	}
+	if mode, ok := p.ByCurrency[currency]; ok {
+		return mode
+	}
+	if p.Default != "" {
+		return p.Default
+	}
+	return DefaultRoundingMode
+}
+
+// RoundedPrice is an exact price together with its rounding for display and checkout.
+type RoundedPrice struct {
+	Exact   *Money
+	Rounded *Money
+	Mode    RoundingMode
+}
+
+// roundRat rounds r, a price in minor units, to a whole number of minor units.
+// scale is the number of minor units per whole unit.
+func roundRat(r *big.Rat, mode RoundingMode, scale *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	// QuoRem truncates toward zero, floor needs the next integer down for negatives
+	floor := new(big.Int).Set(quo)
+	if rem.Sign() < 0 {
+		floor.Sub(floor, big.NewInt(1))
+	}
+
+	switch mode {
+	case RoundingFloor:
+		return floor
+
+	case RoundingCharm:
+		// Largest n <= r with n ≡ scale-1 (mod scale): floor((r+1)/scale)*scale - 1
+		whole := new(big.Rat).Add(r, big.NewRat(1, 1))
+		whole.Quo(whole, new(big.Rat).SetInt(scale))
+		units := new(big.Int).Quo(whole.Num(), whole.Denom())
+		charm := units.Mul(units, scale)
+		charm.Sub(charm, big.NewInt(1))
+		if charm.Sign() < 0 {
+			return floor
+		}
+		return charm
+
+	default:
+		// Compare the fractional part with one half
+		frac := new(big.Rat).Sub(r, new(big.Rat).SetInt(floor))
+		switch frac.Cmp(big.NewRat(1, 2)) {
+		case -1:
+			return floor
+		case 1:
+			return floor.Add(floor, big.NewInt(1))
+		}
+		// Exactly half
+		if mode == RoundingHalfEven {
+			if floor.Bit(0) == 0 {
+				return floor
+			}
+			return floor.Add(floor, big.NewInt(1))
+		}
+		if r.Sign() < 0 {
+			return floor
+		}
+		return floor.Add(floor, big.NewInt(1))
+	}
+}
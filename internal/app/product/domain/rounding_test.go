@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricingCalculator_Round(t *testing.T) {
+	pc := NewPricingCalculator()
+
+	tests := []struct {
+		name     string
+		num, den int64
+		currency Currency
+		mode     RoundingMode
+		expected string
+	}{
+		{"half-up rounds halves up", 87125, 1000, "USD", RoundingHalfUp, "87.13"},
+		{"half-up rounds below half down", 87124, 1000, "USD", RoundingHalfUp, "87.12"},
+		{"half-even rounds halves to even", 87125, 1000, "USD", RoundingHalfEven, "87.12"},
+		{"half-even rounds odd halves up", 87135, 1000, "USD", RoundingHalfEven, "87.14"},
+		{"half-even rounds above half up", 871251, 10000, "USD", RoundingHalfEven, "87.13"},
+		{"floor rounds down", 87129, 1000, "USD", RoundingFloor, "87.12"},
+		{"charm rounds down to .99", 8749, 100, "USD", RoundingCharm, "86.99"},
+		{"charm keeps .99", 8799, 100, "USD", RoundingCharm, "87.99"},
+		{"charm rounds down within .99", 87995, 1000, "USD", RoundingCharm, "87.99"},
+		{"charm floors prices below .99", 50, 100, "USD", RoundingCharm, "0.50"},
+		{"JPY has no minor unit", 12345, 10, "JPY", RoundingHalfUp, "1235"},
+		{"charm floors currencies without minor unit", 12345, 10, "JPY", RoundingCharm, "1234"},
+		{"KWD has three digits", 12345, 10000, "KWD", RoundingHalfUp, "1.235"},
+		{"charm in KWD rounds down to .999", 25, 10, "KWD", RoundingCharm, "1.999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := NewMoney(tt.num, tt.den, tt.currency)
+			require.NoError(t, err)
+			rounded := pc.Round(price, tt.mode)
+			assert.Equal(t, tt.expected, rounded.String())
+			assert.Equal(t, tt.currency, rounded.Currency())
+		})
+	}
+
+	t.Run("99.99 with 12.5% off", func(t *testing.T) {
+		price, _ := NewMoney(9999, 100, "USD")
+		discount, err := NewDiscount(12.5, time.Now().UTC(), time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		exact := discount.Apply(price) // 87.49125
+
+		assert.Equal(t, "87.49", pc.Round(exact, RoundingHalfUp).String())
+		assert.Equal(t, "87.49", pc.Round(exact, RoundingHalfEven).String())
+		assert.Equal(t, "87.49", pc.Round(exact, RoundingFloor).String())
+		assert.Equal(t, "86.99", pc.Round(exact, RoundingCharm).String())
+	})
+}
+
+func TestRoundingPolicy(t *testing.T) {
+	policy, err := ParseRoundingPolicy("half_even", "grocery=charm, books=floor", "JPY=floor")
+	require.NoError(t, err)
+
+	t.Run("category wins over currency", func(t *testing.T) {
+		assert.Equal(t, RoundingCharm, policy.ModeFor("grocery", "JPY"))
+		assert.Equal(t, RoundingFloor, policy.ModeFor("books", "USD"))
+	})
+
+	t.Run("currency applies without a category rule", func(t *testing.T) {
+		assert.Equal(t, RoundingFloor, policy.ModeFor("electronics", "JPY"))
+	})
+
+	t.Run("default applies otherwise", func(t *testing.T) {
+		assert.Equal(t, RoundingHalfEven, policy.ModeFor("electronics", "USD"))
+	})
+
+	t.Run("empty policy rounds half-up", func(t *testing.T) {
+		empty, err := ParseRoundingPolicy("", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, RoundingHalfUp, empty.ModeFor("grocery", "USD"))
+
+		var none *RoundingPolicy
+		assert.Equal(t, RoundingHalfUp, none.ModeFor("grocery", "USD"))
+	})
+
+	t.Run("invalid configuration returns error", func(t *testing.T) {
+		_, err := ParseRoundingPolicy("up", "", "")
+		assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+		_, err = ParseRoundingPolicy("", "grocery", "")
+		assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+		_, err = ParseRoundingPolicy("", "", "XXX=floor")
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+
+	t.Run("RoundPrice keeps the exact price", func(t *testing.T) {
+		pc := NewPricingCalculatorWithRounding(policy)
+		price, _ := NewMoney(87125, 1000, "USD")
+		rounded := pc.RoundPrice(price, "electronics")
+		assert.True(t, rounded.Exact.Equals(price))
+		assert.Equal(t, "87.12", rounded.Rounded.String())
+		assert.Equal(t, RoundingHalfEven, rounded.Mode)
+	})
+}
+
+func TestProduct_EffectivePriceEvents(t *testing.T) {
+	policy, err := ParseRoundingPolicy("", "grocery=charm", "")
+	require.NoError(t, err)
+	pricing := NewPricingCalculatorWithRounding(policy)
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(9999, 100, "USD")
+	p, err := NewProduct("id-1", "Apples", "Fresh", "grocery", price, now, clock.NewMockClock(now))
+	require.NoError(t, err)
+	require.NoError(t, p.Activate(now))
+	p.ClearEvents()
+
+	discount, _ := NewDiscount(12.5, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, p.ApplyDiscount(discount.WithID("d1"), now, pricing))
+
+	applied := p.DomainEvents()[0].(*DiscountAppliedEvent)
+	require.NotNil(t, applied.EffectivePrice)
+	assert.Equal(t, "87.49125", applied.EffectivePrice.Exact.rat.FloatString(5))
+	assert.Equal(t, "86.99", applied.EffectivePrice.Rounded.String())
+	assert.Equal(t, RoundingCharm, applied.EffectivePrice.Mode)
+
+	require.NoError(t, p.RemoveDiscount(now, pricing))
+	removed := p.DomainEvents()[1].(*DiscountRemovedEvent)
+	require.NotNil(t, removed.EffectivePrice)
+	assert.Equal(t, "99.99", removed.EffectivePrice.Rounded.String())
+}
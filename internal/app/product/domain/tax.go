@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Tax classes group products taxed alike, e.g. "standard", "reduced" or "zero".
+// Which rate a class carries is decided per region by its tax rates.
+const (
+	// DefaultTaxClass is assigned to products created without a tax class.
+	DefaultTaxClass = "standard"
+
+	maxTaxClassLength = 32
+)
+
+// ParseTaxClass validates a tax class. Classes are 1-32 characters of letters, digits,
+// hyphens and underscores, starting with a letter. Uppercase input is normalized to lowercase.
+func ParseTaxClass(class string) (string, error) {
+	taxClass := strings.ToLower(class)
+	if taxClass == "" || len(taxClass) > maxTaxClassLength || taxClass[0] < 'a' || taxClass[0] > 'z' {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaxClass, class)
+	}
+	for _, c := range taxClass {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidTaxClass, class)
+		}
+	}
+	return taxClass, nil
+}
+
+// TaxRate is the rate of one tax class in one region, in force from EffectiveFrom until
+// the next rate of the same region and class takes effect.
+//
+// When PricesIncludeTax is set, list prices in the region are gross (tax-inclusive) and
+// the tax is extracted from them; otherwise they are net and the tax is added on top.
+type TaxRate struct {
+	region           string
+	taxClass         string
+	percent          *big.Rat // 0-100, with at most MaxPercentageDecimals fractional digits
+	effectiveFrom    time.Time
+	pricesIncludeTax bool
+}
+
+// NewTaxRate creates a tax rate with validation. The region and class are normalized,
+// the percentage must be between 0 and 100 with at most MaxPercentageDecimals fractional
+// digits, and effectiveFrom must be set and in UTC.
+func NewTaxRate(region, taxClass string, percent *big.Rat, effectiveFrom time.Time, pricesIncludeTax bool) (*TaxRate, error) {
+	region, err := ParseRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	taxClass, err = ParseTaxClass(taxClass)
+	if err != nil {
+		return nil, err
+	}
+
+	if percent == nil {
+		return nil, ErrInvalidTaxRate
+	}
+	if percent.Sign() < 0 || percent.Cmp(big.NewRat(100, 1)) > 0 || !hasPercentageScale(percent) {
+		return nil, fmt.Errorf("%w, got %s", ErrInvalidTaxRate, percent.RatString())
+	}
+
+	if effectiveFrom.IsZero() {
+		return nil, fmt.Errorf("%w: effective date is required", ErrInvalidTaxRate)
+	}
+	if effectiveFrom.Location() != time.UTC {
+		return nil, fmt.Errorf("%w: effective date must be in UTC timezone", ErrInvalidTaxRate)
+	}
+
+	return &TaxRate{
+		region:           region,
+		taxClass:         taxClass,
+		percent:          new(big.Rat).Set(percent),
+		effectiveFrom:    effectiveFrom,
+		pricesIncludeTax: pricesIncludeTax,
+	}, nil
+}
+
+// Region returns the region the rate applies in.
+func (r *TaxRate) Region() string {
+	return r.region
+}
+
+// TaxClass returns the tax class the rate applies to.
+func (r *TaxRate) TaxClass() string {
+	return r.taxClass
+}
+
+// Percent returns a copy of the rate as a percentage, e.g. 19 for 19%.
+func (r *TaxRate) Percent() *big.Rat {
+	return new(big.Rat).Set(r.percent)
+}
+
+// EffectiveFrom returns when the rate takes effect.
+func (r *TaxRate) EffectiveFrom() time.Time {
+	return r.effectiveFrom
+}
+
+// PricesIncludeTax reports whether list prices in the region include the tax.
+func (r *TaxRate) PricesIncludeTax() bool {
+	return r.pricesIncludeTax
+}
+
+// IsEffectiveAt reports whether the rate has taken effect at t.
+// A later rate of the same region and class may have replaced it, see SelectTaxRates.
+func (r *TaxRate) IsEffectiveAt(t time.Time) bool {
+	return !t.Before(r.effectiveFrom)
+}
+
+// SelectTaxRates returns the rate in force at t for each tax class, keyed by class: the
+// rate with the latest effective date not after t. Rates are expected to share a region.
+func SelectTaxRates(rates []*TaxRate, t time.Time) map[string]*TaxRate {
+	sorted := append([]*TaxRate(nil), rates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].effectiveFrom.Before(sorted[j].effectiveFrom)
+	})
+
+	selected := make(map[string]*TaxRate)
+	for _, rate := range sorted {
+		if rate.IsEffectiveAt(t) {
+			selected[rate.taxClass] = rate
+		}
+	}
+	return selected
+}
+
+// TaxBreakdown splits a price into its net amount, tax and gross amount under a tax rate.
+// Net + Tax = Gross holds exactly.
+type TaxBreakdown struct {
+	Net   *Money
+	Tax   *Money
+	Gross *Money
+	Rate  *TaxRate
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxClass(t *testing.T) {
+	valid := map[string]string{
+		"standard":     "standard",
+		"Reduced":      "reduced",
+		"zero-rated":   "zero-rated",
+		"books_2024":   "books_2024",
+		"a":            "a",
+		"ABCDEFGHIJKL": "abcdefghijkl",
+	}
+	for input, expected := range valid {
+		t.Run("valid "+input, func(t *testing.T) {
+			class, err := ParseTaxClass(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected, class)
+		})
+	}
+
+	for _, input := range []string{"", "1st", "-reduced", "super reduced", "ermäßigt", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTaxClass(input)
+			assert.ErrorIs(t, err, ErrInvalidTaxClass)
+		})
+	}
+}
+
+func TestNewTaxRate(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("normalizes region and class", func(t *testing.T) {
+		rate, err := NewTaxRate("de", "Standard", big.NewRat(19, 1), from, true)
+		require.NoError(t, err)
+		assert.Equal(t, "DE", rate.Region())
+		assert.Equal(t, "standard", rate.TaxClass())
+		assert.Equal(t, "19", FormatPercentage(rate.Percent()))
+		assert.Equal(t, from, rate.EffectiveFrom())
+		assert.True(t, rate.PricesIncludeTax())
+	})
+
+	t.Run("accepts fractional and zero rates", func(t *testing.T) {
+		_, err := NewTaxRate("CH", "standard", big.NewRat(77, 10), from, true)
+		assert.NoError(t, err)
+		_, err = NewTaxRate("GB", "zero", new(big.Rat), from, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("copies the percentage", func(t *testing.T) {
+		percent := big.NewRat(19, 1)
+		rate, err := NewTaxRate("DE", "standard", percent, from, true)
+		require.NoError(t, err)
+		percent.SetInt64(50)
+		rate.Percent().SetInt64(60)
+		assert.Equal(t, "19", FormatPercentage(rate.Percent()))
+	})
+
+	tests := []struct {
+		name    string
+		region  string
+		class   string
+		percent *big.Rat
+		from    time.Time
+		err     error
+	}{
+		{"invalid region", "1", "standard", big.NewRat(19, 1), from, ErrInvalidRegion},
+		{"invalid class", "DE", "", big.NewRat(19, 1), from, ErrInvalidTaxClass},
+		{"missing rate", "DE", "standard", nil, from, ErrInvalidTaxRate},
+		{"negative rate", "DE", "standard", big.NewRat(-1, 1), from, ErrInvalidTaxRate},
+		{"rate above 100", "DE", "standard", big.NewRat(101, 1), from, ErrInvalidTaxRate},
+		{"rate too precise", "DE", "standard", big.NewRat(100, 3), from, ErrInvalidTaxRate},
+		{"missing effective date", "DE", "standard", big.NewRat(19, 1), time.Time{}, ErrInvalidTaxRate},
+		{"effective date not in UTC", "DE", "standard", big.NewRat(19, 1), from.In(time.FixedZone("CET", 3600)), ErrInvalidTaxRate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTaxRate(tt.region, tt.class, tt.percent, tt.from, false)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestSelectTaxRates(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jul := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	mustRate := func(class string, percent int64, from time.Time) *TaxRate {
+		rate, err := NewTaxRate("DE", class, big.NewRat(percent, 1), from, true)
+		require.NoError(t, err)
+		return rate
+	}
+	// Deliberately out of order
+	rates := []*TaxRate{
+		mustRate("standard", 16, jul),
+		mustRate("reduced", 7, jan),
+		mustRate("standard", 19, jan),
+	}
+
+	t.Run("before any rate", func(t *testing.T) {
+		assert.Empty(t, SelectTaxRates(rates, jan.Add(-time.Second)))
+	})
+
+	t.Run("latest rate in effect wins", func(t *testing.T) {
+		selected := SelectTaxRates(rates, jul.Add(-time.Second))
+		require.Len(t, selected, 2)
+		assert.Equal(t, "19", FormatPercentage(selected["standard"].Percent()))
+		assert.Equal(t, "7", FormatPercentage(selected["reduced"].Percent()))
+
+		selected = SelectTaxRates(rates, jul)
+		assert.Equal(t, "16", FormatPercentage(selected["standard"].Percent()))
+	})
+}
+
+func TestPricingCalculator_CalculateTax(t *testing.T) {
+	pc := NewPricingCalculator()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("adds tax to net prices", func(t *testing.T) {
+		rate, err := NewTaxRate("US-CA", "standard", big.NewRat(725, 100), from, false)
+		require.NoError(t, err)
+		price, _ := NewMoney(9999, 100, "USD")
+
+		breakdown := pc.CalculateTax(price, rate)
+		assert.True(t, price.Equals(breakdown.Net))
+		// 99.99 * 0.0725 = 7.249275
+		assert.Equal(t, "7.249275", breakdown.Tax.rat.FloatString(6))
+		assert.Equal(t, "107.239275", breakdown.Gross.rat.FloatString(6))
+		assert.Same(t, rate, breakdown.Rate)
+	})
+
+	t.Run("extracts tax from gross prices", func(t *testing.T) {
+		rate, err := NewTaxRate("DE", "standard", big.NewRat(19, 1), from, true)
+		require.NoError(t, err)
+		price, _ := NewMoney(9999, 100, "EUR")
+
+		breakdown := pc.CalculateTax(price, rate)
+		assert.True(t, price.Equals(breakdown.Gross))
+		// 99.99 / 1.19 = 9999/119, exactly
+		assert.Equal(t, "9999/119", breakdown.Net.rat.RatString())
+
+		sum, err := breakdown.Net.Add(breakdown.Tax)
+		require.NoError(t, err)
+		assert.True(t, sum.Equals(breakdown.Gross), "net + tax must equal gross exactly")
+		assert.Equal(t, Currency("EUR"), breakdown.Tax.Currency())
+	})
+
+	t.Run("zero rate", func(t *testing.T) {
+		rate, err := NewTaxRate("GB", "zero", new(big.Rat), from, true)
+		require.NoError(t, err)
+		price, _ := NewMoney(500, 1, "GBP")
+
+		breakdown := pc.CalculateTax(price, rate)
+		assert.True(t, breakdown.Tax.IsZero())
+		assert.True(t, price.Equals(breakdown.Net))
+	})
+}
+
+func TestProduct_SetTaxClass(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	price, _ := NewMoney(100, 1, "USD")
+
+	t.Run("new products default to the standard class", func(t *testing.T) {
+		p, err := NewProduct("id-1", "Book", "", "books", price, now, clk)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTaxClass, p.TaxClass())
+		assert.True(t, p.Changes().Dirty(FieldTaxClass))
+	})
+
+	t.Run("creation event carries the class", func(t *testing.T) {
+		p, err := NewProductWithTaxClass("id-2", "Book", "", "books", "Reduced", price, now, clk)
+		require.NoError(t, err)
+		assert.Equal(t, "reduced", p.TaxClass())
+		require.Len(t, p.DomainEvents(), 1)
+		assert.Equal(t, "reduced", p.DomainEvents()[0].(*ProductCreatedEvent).TaxClass)
+
+		_, err = NewProductWithTaxClass("id-3", "Book", "", "books", "", price, now, clk)
+		assert.ErrorIs(t, err, ErrInvalidTaxClass)
+	})
+
+	t.Run("updates the class", func(t *testing.T) {
+		p := ReconstructProduct("id-4", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil, nil, nil, StatusActive, 1, now, now, nil, clk)
+		require.NoError(t, p.SetTaxClass("zero"))
+		assert.Equal(t, "zero", p.TaxClass())
+		assert.True(t, p.Changes().Dirty(FieldTaxClass))
+
+		p.MarkUpdated(now)
+		require.Len(t, p.DomainEvents(), 1)
+		assert.Equal(t, "zero", p.DomainEvents()[0].(*ProductUpdatedEvent).TaxClass)
+
+		assert.ErrorIs(t, p.SetTaxClass("not valid"), ErrInvalidTaxClass)
+		assert.Equal(t, "zero", p.TaxClass())
+	})
+
+	t.Run("archived products cannot change class", func(t *testing.T) {
+		p := ReconstructProduct("id-5", "Book", "", "books", DefaultTaxClass, price, nil, nil, nil, nil, nil, StatusArchived, 1, now, now, &now, clk)
+		assert.ErrorIs(t, p.SetTaxClass("zero"), ErrCannotModifyArchived)
+	})
+}
@@ -0,0 +1,342 @@
+// Package events defines the versioned wire format of product domain events.
+//
+// Domain events are plain Go structs owned by the domain layer; their field names and
+// types are free to change. This package maps them onto an explicit, stable JSON
+// envelope that is written to outbox_events.payload and published by the relay.
+// Every event type has a JSON Schema under schemas/ that consumers can validate against.
+//
+// Breaking changes to a payload require bumping SchemaVersion and adding new schema files.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// SchemaVersion is the version of the envelope and payload schemas produced by Marshal.
+const SchemaVersion = 3
+
+// Envelope is the outer JSON object for every product event.
+type Envelope struct {
+	SchemaVersion int         `json:"schema_version"`
+	EventType     string      `json:"event_type"`
+	AggregateID   string      `json:"aggregate_id"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	Data          interface{} `json:"data"`
+}
+
+// NewEnvelope wraps a domain event in a versioned envelope.
+func NewEnvelope(event domain.DomainEvent) (*Envelope, error) {
+	data, occurredAt, err := encodeData(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		SchemaVersion: SchemaVersion,
+		EventType:     event.EventType(),
+		AggregateID:   event.AggregateID(),
+		OccurredAt:    occurredAt.UTC(),
+		Data:          data,
+	}, nil
+}
+
+// Marshal serializes a domain event to its versioned JSON envelope.
+func Marshal(event domain.DomainEvent) (string, error) {
+	envelope, err := NewEnvelope(event)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+	}
+	return string(data), nil
+}
+
+// encodeData maps a domain event to its payload struct and occurrence time.
+func encodeData(event domain.DomainEvent) (interface{}, time.Time, error) {
+	switch e := event.(type) {
+	case *domain.ProductCreatedEvent:
+		price, err := NewMoney(e.BasePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &ProductCreatedData{
+			ProductID:   e.ProductID,
+			Name:        e.Name,
+			Description: e.Description,
+			Category:    e.Category,
+			TaxClass:    e.TaxClass,
+			BasePrice:   price,
+			Status:      e.Status,
+			CreatedAt:   e.CreatedAt.UTC(),
+		}, e.CreatedAt, nil
+
+	case *domain.ProductUpdatedEvent:
+		return &ProductUpdatedData{
+			ProductID:   e.ProductID,
+			Name:        e.Name,
+			Description: e.Description,
+			Category:    e.Category,
+			TaxClass:    e.TaxClass,
+			UpdatedAt:   e.UpdatedAt.UTC(),
+		}, e.UpdatedAt, nil
+
+	case *domain.BasePriceChangedEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		effectivePrice, err := NewRoundedPrice(e.EffectivePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangedData{
+			ProductID:      e.ProductID,
+			OldPrice:       oldPrice,
+			NewPrice:       newPrice,
+			EffectivePrice: effectivePrice,
+			ChangedAt:      e.ChangedAt.UTC(),
+			PriceChangeID:  e.PriceChangeID,
+			RequestID:      e.RequestID,
+		}, e.ChangedAt, nil
+
+	case *domain.PriceChangeScheduledEvent:
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangeScheduledData{
+			ProductID:     e.ProductID,
+			PriceChangeID: e.PriceChangeID,
+			NewPrice:      newPrice,
+			EffectiveAt:   e.EffectiveAt.UTC(),
+			ScheduledAt:   e.ScheduledAt.UTC(),
+		}, e.ScheduledAt, nil
+
+	case *domain.PriceChangeCancelledEvent:
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangeCancelledData{
+			ProductID:     e.ProductID,
+			PriceChangeID: e.PriceChangeID,
+			NewPrice:      newPrice,
+			EffectiveAt:   e.EffectiveAt.UTC(),
+			Reason:        e.Reason,
+			CancelledAt:   e.CancelledAt.UTC(),
+		}, e.CancelledAt, nil
+
+	case *domain.PriceChangeRequestedEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangeRequestedData{
+			ProductID:   e.ProductID,
+			RequestID:   e.RequestID,
+			OldPrice:    oldPrice,
+			NewPrice:    newPrice,
+			RequestedBy: e.RequestedBy,
+			Reason:      e.Reason,
+			Violations:  e.Violations,
+			RequestedAt: e.RequestedAt.UTC(),
+		}, e.RequestedAt, nil
+
+	case *domain.PriceChangeApprovedEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangeApprovedData{
+			ProductID:   e.ProductID,
+			RequestID:   e.RequestID,
+			OldPrice:    oldPrice,
+			NewPrice:    newPrice,
+			RequestedBy: e.RequestedBy,
+			ApprovedBy:  e.ApprovedBy,
+			ApprovedAt:  e.ApprovedAt.UTC(),
+		}, e.ApprovedAt, nil
+
+	case *domain.PriceChangeRejectedEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &PriceChangeRejectedData{
+			ProductID:   e.ProductID,
+			RequestID:   e.RequestID,
+			OldPrice:    oldPrice,
+			NewPrice:    newPrice,
+			RequestedBy: e.RequestedBy,
+			RejectedBy:  e.RejectedBy,
+			Reason:      e.Reason,
+			RejectedAt:  e.RejectedAt.UTC(),
+		}, e.RejectedAt, nil
+
+	case *domain.RegionalPriceSetEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		newPrice, err := NewMoney(e.NewPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &RegionalPriceSetData{
+			ProductID: e.ProductID,
+			Region:    e.Region,
+			OldPrice:  oldPrice,
+			NewPrice:  newPrice,
+			ChangedAt: e.ChangedAt.UTC(),
+		}, e.ChangedAt, nil
+
+	case *domain.RegionalPriceRemovedEvent:
+		oldPrice, err := NewMoney(e.OldPrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &RegionalPriceRemovedData{
+			ProductID: e.ProductID,
+			Region:    e.Region,
+			OldPrice:  oldPrice,
+			RemovedAt: e.RemovedAt.UTC(),
+		}, e.RemovedAt, nil
+
+	case *domain.ProductActivatedEvent:
+		return &ProductActivatedData{
+			ProductID:   e.ProductID,
+			ActivatedAt: e.Timestamp.UTC(),
+		}, e.Timestamp, nil
+
+	case *domain.ProductDeactivatedEvent:
+		return &ProductDeactivatedData{
+			ProductID:     e.ProductID,
+			DeactivatedAt: e.Timestamp.UTC(),
+		}, e.Timestamp, nil
+
+	case *domain.DiscountAppliedEvent:
+		amount, err := NewMoney(e.DiscountAmount)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		effectivePrice, err := NewRoundedPrice(e.EffectivePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &DiscountAppliedData{
+			ProductID:            e.ProductID,
+			DiscountID:           e.DiscountID,
+			DiscountKind:         string(e.DiscountKind),
+			DiscountPercent:      e.DiscountPercent,
+			DiscountPercentExact: percentString(e.DiscountPercentExact),
+			DiscountAmount:       amount,
+			StartDate:            e.DiscountStartDate.UTC(),
+			EndDate:              e.DiscountEndDate.UTC(),
+			EffectivePrice:       effectivePrice,
+			AppliedAt:            e.AppliedAt.UTC(),
+		}, e.AppliedAt, nil
+
+	case *domain.DiscountRemovedEvent:
+		effectivePrice, err := NewRoundedPrice(e.EffectivePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &DiscountRemovedData{
+			ProductID:      e.ProductID,
+			DiscountID:     e.DiscountID,
+			EffectivePrice: effectivePrice,
+			RemovedAt:      e.RemovedAt.UTC(),
+		}, e.RemovedAt, nil
+
+	case *domain.DiscountCancelledEvent:
+		return &DiscountCancelledData{
+			ProductID:   e.ProductID,
+			DiscountID:  e.DiscountID,
+			StartDate:   e.DiscountStartDate.UTC(),
+			EndDate:     e.DiscountEndDate.UTC(),
+			CancelledAt: e.CancelledAt.UTC(),
+		}, e.CancelledAt, nil
+
+	case *domain.DiscountStartedEvent:
+		amount, err := NewMoney(e.DiscountAmount)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		effectivePrice, err := NewRoundedPrice(e.EffectivePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &DiscountStartedData{
+			ProductID:            e.ProductID,
+			DiscountID:           e.DiscountID,
+			DiscountKind:         string(e.DiscountKind),
+			DiscountPercent:      e.DiscountPercent,
+			DiscountPercentExact: percentString(e.DiscountPercentExact),
+			DiscountAmount:       amount,
+			StartDate:            e.DiscountStartDate.UTC(),
+			EndDate:              e.DiscountEndDate.UTC(),
+			EffectivePrice:       effectivePrice,
+			StartedAt:            e.StartedAt.UTC(),
+		}, e.StartedAt, nil
+
+	case *domain.DiscountExpiredEvent:
+		effectivePrice, err := NewRoundedPrice(e.EffectivePrice)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &DiscountExpiredData{
+			ProductID:      e.ProductID,
+			DiscountID:     e.DiscountID,
+			StartDate:      e.DiscountStartDate.UTC(),
+			EndDate:        e.DiscountEndDate.UTC(),
+			EffectivePrice: effectivePrice,
+			ExpiredAt:      e.ExpiredAt.UTC(),
+		}, e.ExpiredAt, nil
+
+	case *domain.ProductArchivedEvent:
+		return &ProductArchivedData{
+			ProductID:  e.ProductID,
+			ArchivedAt: e.ArchivedAt.UTC(),
+		}, e.ArchivedAt, nil
+
+	case *domain.ProductRestoredEvent:
+		return &ProductRestoredData{
+			ProductID:  e.ProductID,
+			ArchivedAt: e.ArchivedAt.UTC(),
+			RestoredAt: e.RestoredAt.UTC(),
+		}, e.RestoredAt, nil
+
+	case *domain.ProductPurgedEvent:
+		return &ProductPurgedData{
+			ProductID:  e.ProductID,
+			ArchivedAt: e.ArchivedAt.UTC(),
+			PurgedAt:   e.PurgedAt.UTC(),
+		}, e.PurgedAt, nil
+
+	default:
+		return nil, time.Time{}, fmt.Errorf("no schema registered for event type %q", event.EventType())
+	}
+}
@@ -0,0 +1,325 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+func mustMoney(t *testing.T, num, den int64) *domain.Money {
+	t.Helper()
+	m, err := domain.NewMoney(num, den, "USD")
+	require.NoError(t, err)
+	return m
+}
+
+// sampleEvents returns one instance of every domain event type.
+func sampleEvents(t *testing.T) []domain.DomainEvent {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return []domain.DomainEvent{
+		&domain.ProductCreatedEvent{ProductID: "p1", Name: "Widget", Description: "d", Category: "tools", TaxClass: "standard", BasePrice: mustMoney(t, 9999, 100), Status: "inactive", CreatedAt: now},
+		&domain.ProductUpdatedEvent{ProductID: "p1", Name: "Widget 2", Description: "d", Category: "tools", TaxClass: "reduced", UpdatedAt: now},
+		&domain.BasePriceChangedEvent{ProductID: "p1", OldPrice: mustMoney(t, 9999, 100), NewPrice: mustMoney(t, 1, 3), EffectivePrice: &domain.RoundedPrice{Exact: mustMoney(t, 1, 3), Rounded: mustMoney(t, 33, 100), Mode: domain.RoundingHalfUp}, ChangedAt: now, PriceChangeID: "c1"},
+		&domain.PriceChangeScheduledEvent{ProductID: "p1", PriceChangeID: "c1", NewPrice: mustMoney(t, 2999, 100), EffectiveAt: now.Add(time.Hour), ScheduledAt: now},
+		&domain.PriceChangeCancelledEvent{ProductID: "p1", PriceChangeID: "c2", NewPrice: mustMoney(t, 2999, 100), EffectiveAt: now.Add(time.Hour), Reason: "product archived", CancelledAt: now},
+		&domain.PriceChangeRequestedEvent{ProductID: "p1", RequestID: "r1", OldPrice: mustMoney(t, 2499, 100), NewPrice: mustMoney(t, 249900, 100), RequestedBy: "alice", Violations: []string{"price changes by 9900.00%, more than the maximum of 50%"}, RequestedAt: now},
+		&domain.PriceChangeApprovedEvent{ProductID: "p1", RequestID: "r1", OldPrice: mustMoney(t, 2499, 100), NewPrice: mustMoney(t, 249900, 100), RequestedBy: "alice", ApprovedBy: "bob", ApprovedAt: now},
+		&domain.PriceChangeRejectedEvent{ProductID: "p1", RequestID: "r2", OldPrice: mustMoney(t, 2499, 100), NewPrice: mustMoney(t, 249900, 100), RequestedBy: "alice", Reason: "product archived", RejectedAt: now},
+		&domain.RegionalPriceSetEvent{ProductID: "p1", Region: "DE", NewPrice: mustMoney(t, 8999, 100), ChangedAt: now},
+		&domain.RegionalPriceRemovedEvent{ProductID: "p1", Region: "DE", OldPrice: mustMoney(t, 8999, 100), RemovedAt: now},
+		&domain.ProductActivatedEvent{ProductID: "p1", Timestamp: now},
+		&domain.ProductDeactivatedEvent{ProductID: "p1", Timestamp: now},
+		&domain.DiscountAppliedEvent{ProductID: "p1", DiscountID: "d1", DiscountKind: domain.DiscountKindPercentage, DiscountPercent: 12.5, DiscountPercentExact: big.NewRat(25, 2), DiscountStartDate: now, DiscountEndDate: now.Add(time.Hour), AppliedAt: now},
+		&domain.DiscountRemovedEvent{ProductID: "p1", DiscountID: "d1", RemovedAt: now},
+		&domain.DiscountCancelledEvent{ProductID: "p1", DiscountID: "d2", DiscountStartDate: now.Add(time.Hour), DiscountEndDate: now.Add(2 * time.Hour), CancelledAt: now},
+		&domain.DiscountStartedEvent{ProductID: "p1", DiscountID: "d3", DiscountKind: domain.DiscountKindFixedAmount, DiscountAmount: mustMoney(t, 500, 100), DiscountStartDate: now, DiscountEndDate: now.Add(time.Hour), StartedAt: now},
+		&domain.DiscountExpiredEvent{ProductID: "p1", DiscountID: "d3", DiscountStartDate: now, DiscountEndDate: now.Add(time.Hour), ExpiredAt: now.Add(time.Hour + time.Second)},
+		&domain.ProductArchivedEvent{ProductID: "p1", ArchivedAt: now},
+		&domain.ProductRestoredEvent{ProductID: "p1", ArchivedAt: now.Add(-time.Hour), RestoredAt: now},
+		&domain.ProductPurgedEvent{ProductID: "p1", ArchivedAt: now.AddDate(-7, 0, 0), PurgedAt: now},
+	}
+}
+
+func TestMarshal_Envelope(t *testing.T) {
+	t.Run("wraps payload with schema version and snake_case fields", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		payload, err := Marshal(&domain.ProductActivatedEvent{ProductID: "p1", Timestamp: now})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"schema_version": 3,
+			"event_type": "product.activated",
+			"aggregate_id": "p1",
+			"occurred_at": "2026-03-01T12:00:00Z",
+			"data": {"product_id": "p1", "activated_at": "2026-03-01T12:00:00Z"}
+		}`, payload)
+	})
+
+	t.Run("encodes money exactly", func(t *testing.T) {
+		payload, err := Marshal(&domain.BasePriceChangedEvent{
+			ProductID: "p1",
+			OldPrice:  mustMoney(t, 9999, 100),
+			NewPrice:  mustMoney(t, 1, 3),
+		})
+		require.NoError(t, err)
+
+		var decoded struct {
+			Data PriceChangedData `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(payload), &decoded))
+
+		assert.Equal(t, &Money{Numerator: 9999, Denominator: 100, Currency: "USD", Amount: "99.99"}, decoded.Data.OldPrice)
+		assert.Equal(t, int64(1), decoded.Data.NewPrice.Numerator)
+		assert.Equal(t, int64(3), decoded.Data.NewPrice.Denominator)
+	})
+
+	t.Run("renders amounts with the currency's minor unit", func(t *testing.T) {
+		yen, err := domain.NewMoney(2499, 1, "JPY")
+		require.NoError(t, err)
+
+		encoded, err := NewMoney(yen)
+		require.NoError(t, err)
+		assert.Equal(t, &Money{Numerator: 2499, Denominator: 1, Currency: "JPY", Amount: "2499"}, encoded)
+	})
+
+	t.Run("rejects unknown event types", func(t *testing.T) {
+		_, err := Marshal(&unknownEvent{})
+		assert.Error(t, err)
+	})
+}
+
+type unknownEvent struct{}
+
+func (e *unknownEvent) EventType() string   { return "product.unknown" }
+func (e *unknownEvent) AggregateID() string { return "p1" }
+
+func TestDecimalString(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     *big.Rat
+		minDigits int
+		expected  string
+	}{
+		{"whole amount", big.NewRat(100, 1), 2, "100.00"},
+		{"cents", big.NewRat(9999, 100), 2, "99.99"},
+		{"more than two digits stays exact", new(big.Rat).Mul(big.NewRat(9999, 100), big.NewRat(7, 8)), 2, "87.49125"},
+		{"non-terminating is rounded", big.NewRat(1, 3), 2, "0.333333333333"},
+		{"negative", big.NewRat(-5, 2), 2, "-2.50"},
+		{"no minor unit", big.NewRat(2499, 1), 0, "2499"},
+		{"no minor unit keeps exact fraction", big.NewRat(5, 2), 0, "2.5"},
+		{"three minor digits", big.NewRat(5, 2), 3, "2.500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DecimalString(tt.value, tt.minDigits))
+		})
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	t.Run("every domain event has a schema", func(t *testing.T) {
+		var types []string
+		for _, event := range sampleEvents(t) {
+			types = append(types, event.EventType())
+		}
+		assert.ElementsMatch(t, types, EventTypes())
+	})
+
+	t.Run("every schema file declares the version in its name", func(t *testing.T) {
+		entries, err := schemaFS.ReadDir("schemas")
+		require.NoError(t, err)
+
+		name := regexp.MustCompile(`^(.+)\.v(\d+)\.json$`)
+		for _, entry := range entries {
+			m := name.FindStringSubmatch(entry.Name())
+			require.NotNil(t, m, entry.Name())
+
+			raw, err := schemaFS.ReadFile("schemas/" + entry.Name())
+			require.NoError(t, err)
+			var schema struct {
+				ID         string `json:"$id"`
+				Properties struct {
+					SchemaVersion struct {
+						Const json.Number `json:"const"`
+					} `json:"schema_version"`
+				} `json:"properties"`
+			}
+			require.NoError(t, json.Unmarshal(raw, &schema), entry.Name())
+			assert.Equal(t, m[2], schema.Properties.SchemaVersion.Const.String(), entry.Name())
+			assert.Equal(t, "urn:procat:events:"+m[1]+":v"+m[2], schema.ID, entry.Name())
+		}
+	})
+
+	t.Run("payloads validate against their schema", func(t *testing.T) {
+		for _, event := range sampleEvents(t) {
+			t.Run(event.EventType(), func(t *testing.T) {
+				assertValidPayload(t, event)
+			})
+		}
+	})
+
+	t.Run("effective price carries exact and rounded values", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		payload, err := Marshal(&domain.DiscountRemovedEvent{
+			ProductID:  "p1",
+			DiscountID: "d1",
+			EffectivePrice: &domain.RoundedPrice{
+				Exact:   mustMoney(t, 174983, 2000),
+				Rounded: mustMoney(t, 8699, 100),
+				Mode:    domain.RoundingCharm,
+			},
+			RemovedAt: now,
+		})
+		require.NoError(t, err)
+
+		var envelope struct {
+			Data DiscountRemovedData `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(payload), &envelope))
+		require.NotNil(t, envelope.Data.EffectivePrice)
+		assert.Equal(t, "87.4915", envelope.Data.EffectivePrice.Exact.Amount)
+		assert.Equal(t, "86.99", envelope.Data.EffectivePrice.Rounded.Amount)
+		assert.Equal(t, "charm", envelope.Data.EffectivePrice.RoundingMode)
+		assertValidPayload(t, &domain.DiscountRemovedEvent{ProductID: "p1", DiscountID: "d1", RemovedAt: now})
+	})
+
+	t.Run("exact discount percentage is rendered as a decimal", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		payload, err := Marshal(&domain.DiscountAppliedEvent{
+			ProductID:            "p1",
+			DiscountID:           "d1",
+			DiscountKind:         domain.DiscountKindPercentage,
+			DiscountPercent:      12.3,
+			DiscountPercentExact: big.NewRat(123, 10),
+			DiscountStartDate:    now,
+			DiscountEndDate:      now.Add(time.Hour),
+			AppliedAt:            now,
+		})
+		require.NoError(t, err)
+
+		var envelope struct {
+			Data DiscountAppliedData `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(payload), &envelope))
+		assert.Equal(t, "12.3", envelope.Data.DiscountPercentExact)
+	})
+
+	t.Run("fixed-amount discount payload validates", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		assertValidPayload(t, &domain.DiscountAppliedEvent{
+			ProductID:         "p1",
+			DiscountID:        "d1",
+			DiscountKind:      domain.DiscountKindFixedAmount,
+			DiscountAmount:    mustMoney(t, 500, 100),
+			DiscountStartDate: now,
+			DiscountEndDate:   now.Add(time.Hour),
+			AppliedAt:         now,
+		})
+	})
+}
+
+// assertValidPayload marshals the event and validates it against its schema.
+func assertValidPayload(t *testing.T, event domain.DomainEvent) {
+	t.Helper()
+
+	payload, err := Marshal(event)
+	require.NoError(t, err)
+
+	raw, ok := Schema(event.EventType())
+	require.True(t, ok)
+
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &schema))
+
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(payload), &doc))
+
+	assert.NoError(t, validate(schema, schema, doc, "$"))
+}
+
+// validate checks doc against the subset of JSON Schema used by the published schemas.
+func validate(root, schema map[string]interface{}, doc interface{}, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		var name string
+		if _, err := fmt.Sscanf(ref, "#/$defs/%s", &name); err != nil {
+			return fmt.Errorf("%s: unsupported $ref %q", path, ref)
+		}
+		def, ok := root["$defs"].(map[string]interface{})[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %q", path, ref)
+		}
+		return validate(root, def, doc, path)
+	}
+
+	if c, ok := schema["const"]; ok && c != doc {
+		return fmt.Errorf("%s: expected const %v, got %v", path, c, doc)
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object", path)
+		}
+		props, _ := schema["properties"].(map[string]interface{})
+		reqs, _ := schema["required"].([]interface{})
+		for _, req := range reqs {
+			if _, ok := obj[req.(string)]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, req)
+			}
+		}
+		for key, value := range obj {
+			propSchema, ok := props[key].(map[string]interface{})
+			if !ok {
+				if schema["additionalProperties"] == false {
+					return fmt.Errorf("%s: unexpected property %q", path, key)
+				}
+				continue
+			}
+			if err := validate(root, propSchema, value, path+"."+key); err != nil {
+				return err
+			}
+		}
+	case "string":
+		s, ok := doc.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected string", path)
+		}
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(s) {
+			return fmt.Errorf("%s: %q does not match %s", path, s, pattern)
+		}
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+				return fmt.Errorf("%s: invalid date-time %q", path, s)
+			}
+		}
+	case "integer", "number":
+		n, ok := doc.(float64)
+		if !ok {
+			return fmt.Errorf("%s: expected number", path)
+		}
+		if schema["type"] == "integer" && n != float64(int64(n)) {
+			return fmt.Errorf("%s: expected integer", path)
+		}
+		if min, ok := schema["minimum"].(float64); ok && n < min {
+			return fmt.Errorf("%s: %v below minimum %v", path, n, min)
+		}
+		if min, ok := schema["exclusiveMinimum"].(float64); ok && n <= min {
+			return fmt.Errorf("%s: %v not above %v", path, n, min)
+		}
+		if max, ok := schema["maximum"].(float64); ok && n > max {
+			return fmt.Errorf("%s: %v above maximum %v", path, n, max)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// maxAmountDigits caps the fractional digits for amounts that have no finite decimal
+// expansion (e.g. 1/3). Numerator/denominator remain exact in that case.
+const maxAmountDigits = 12
+
+// Money is the exact wire encoding of domain.Money.
+// Numerator/Denominator are authoritative; Amount is a decimal rendering for display
+// with at least the currency's minor unit digits, and is exact whenever the value has
+// a finite decimal expansion.
+type Money struct {
+	Numerator   int64  `json:"numerator"`
+	Denominator int64  `json:"denominator"`
+	Currency    string `json:"currency"`
+	Amount      string `json:"amount"`
+}
+
+// NewMoney encodes a domain Money value. A nil value encodes as nil.
+func NewMoney(m *domain.Money) (*Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	num, err := m.Numerator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode money: %w", err)
+	}
+	den, err := m.Denominator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode money: %w", err)
+	}
+
+	return &Money{
+		Numerator:   num,
+		Denominator: den,
+		Currency:    string(m.Currency()),
+		Amount:      DecimalString(big.NewRat(num, den), m.Currency().MinorUnits()),
+	}, nil
+}
+
+// RoundedPrice is the wire encoding of domain.RoundedPrice: an exact price, the price
+// rounded to the currency's minor unit, and the rounding mode used.
+type RoundedPrice struct {
+	Exact        *Money `json:"exact"`
+	Rounded      *Money `json:"rounded"`
+	RoundingMode string `json:"rounding_mode"`
+}
+
+// NewRoundedPrice encodes a domain RoundedPrice. A nil value encodes as nil.
+func NewRoundedPrice(p *domain.RoundedPrice) (*RoundedPrice, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	exact, err := NewMoney(p.Exact)
+	if err != nil {
+		return nil, err
+	}
+	rounded, err := NewMoney(p.Rounded)
+	if err != nil {
+		return nil, err
+	}
+	return &RoundedPrice{Exact: exact, Rounded: rounded, RoundingMode: string(p.Mode)}, nil
+}
+
+// DecimalString renders r as a decimal string with at least minDigits fractional digits.
+// Terminating decimals are rendered exactly; others are rounded to maxAmountDigits.
+func DecimalString(r *big.Rat, minDigits int) string {
+	digits, exact := decimalDigits(r.Denom())
+	if !exact {
+		digits = maxAmountDigits
+	}
+	if digits < minDigits {
+		digits = minDigits
+	}
+	return r.FloatString(digits)
+}
+
+// percentString renders an exact discount percentage, or "" for fixed-amount discounts.
+func percentString(r *big.Rat) string {
+	if r == nil {
+		return ""
+	}
+	return DecimalString(r, 0)
+}
+
+// decimalDigits returns the number of fractional digits needed to render 1/den exactly,
+// and whether den has a finite decimal expansion (only factors 2 and 5).
+func decimalDigits(den *big.Int) (int, bool) {
+	d := new(big.Int).Set(den)
+	two, five := big.NewInt(2), big.NewInt(5)
+	rem := new(big.Int)
+
+	twos, fives := 0, 0
+	for {
+		q, r := new(big.Int).QuoRem(d, two, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		d, twos = q, twos+1
+	}
+	for {
+		q, r := new(big.Int).QuoRem(d, five, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		d, fives = q, fives+1
+	}
+
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
@@ -0,0 +1,194 @@
+package events
+
+import "time"
+
+// ProductCreatedData is the payload of product.created.
+type ProductCreatedData struct {
+	ProductID   string    `json:"product_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	TaxClass    string    `json:"tax_class,omitempty"`
+	BasePrice   *Money    `json:"base_price"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ProductUpdatedData is the payload of product.updated.
+type ProductUpdatedData struct {
+	ProductID   string    `json:"product_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	TaxClass    string    `json:"tax_class,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// PriceChangedData is the payload of product.price.changed.
+type PriceChangedData struct {
+	ProductID      string        `json:"product_id"`
+	OldPrice       *Money        `json:"old_price"`
+	NewPrice       *Money        `json:"new_price"`
+	EffectivePrice *RoundedPrice `json:"effective_price,omitempty"` // Effective price at changed_at
+	ChangedAt      time.Time     `json:"changed_at"`
+	PriceChangeID  string        `json:"price_change_id,omitempty"` // Set when a scheduled price change took effect
+	RequestID      string        `json:"request_id,omitempty"`      // Set when an approved price change request took effect
+}
+
+// PriceChangeScheduledData is the payload of product.price_change.scheduled.
+type PriceChangeScheduledData struct {
+	ProductID     string    `json:"product_id"`
+	PriceChangeID string    `json:"price_change_id"`
+	NewPrice      *Money    `json:"new_price"`
+	EffectiveAt   time.Time `json:"effective_at"`
+	ScheduledAt   time.Time `json:"scheduled_at"`
+}
+
+// PriceChangeCancelledData is the payload of product.price_change.cancelled.
+type PriceChangeCancelledData struct {
+	ProductID     string    `json:"product_id"`
+	PriceChangeID string    `json:"price_change_id"`
+	NewPrice      *Money    `json:"new_price"`
+	EffectiveAt   time.Time `json:"effective_at"`
+	Reason        string    `json:"reason,omitempty"` // Omitted when cancelled on request
+	CancelledAt   time.Time `json:"cancelled_at"`
+}
+
+// PriceChangeRequestedData is the payload of product.price_change.requested.
+type PriceChangeRequestedData struct {
+	ProductID   string    `json:"product_id"`
+	RequestID   string    `json:"request_id"`
+	OldPrice    *Money    `json:"old_price"`
+	NewPrice    *Money    `json:"new_price"`
+	RequestedBy string    `json:"requested_by"`
+	Reason      string    `json:"reason,omitempty"`
+	Violations  []string  `json:"violations"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// PriceChangeApprovedData is the payload of product.price_change.approved.
+type PriceChangeApprovedData struct {
+	ProductID   string    `json:"product_id"`
+	RequestID   string    `json:"request_id"`
+	OldPrice    *Money    `json:"old_price"`
+	NewPrice    *Money    `json:"new_price"`
+	RequestedBy string    `json:"requested_by"`
+	ApprovedBy  string    `json:"approved_by"`
+	ApprovedAt  time.Time `json:"approved_at"`
+}
+
+// PriceChangeRejectedData is the payload of product.price_change.rejected.
+type PriceChangeRejectedData struct {
+	ProductID   string    `json:"product_id"`
+	RequestID   string    `json:"request_id"`
+	OldPrice    *Money    `json:"old_price"`
+	NewPrice    *Money    `json:"new_price"`
+	RequestedBy string    `json:"requested_by"`
+	RejectedBy  string    `json:"rejected_by,omitempty"` // Omitted when dropped by archiving the product
+	Reason      string    `json:"reason,omitempty"`
+	RejectedAt  time.Time `json:"rejected_at"`
+}
+
+// RegionalPriceSetData is the payload of product.regional_price.set.
+type RegionalPriceSetData struct {
+	ProductID string    `json:"product_id"`
+	Region    string    `json:"region"`
+	OldPrice  *Money    `json:"old_price,omitempty"` // Omitted when the region had no price
+	NewPrice  *Money    `json:"new_price"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// RegionalPriceRemovedData is the payload of product.regional_price.removed.
+type RegionalPriceRemovedData struct {
+	ProductID string    `json:"product_id"`
+	Region    string    `json:"region"`
+	OldPrice  *Money    `json:"old_price"`
+	RemovedAt time.Time `json:"removed_at"`
+}
+
+// ProductActivatedData is the payload of product.activated.
+type ProductActivatedData struct {
+	ProductID   string    `json:"product_id"`
+	ActivatedAt time.Time `json:"activated_at"`
+}
+
+// ProductDeactivatedData is the payload of product.deactivated.
+type ProductDeactivatedData struct {
+	ProductID     string    `json:"product_id"`
+	DeactivatedAt time.Time `json:"deactivated_at"`
+}
+
+// DiscountAppliedData is the payload of product.discount.applied.
+type DiscountAppliedData struct {
+	ProductID            string        `json:"product_id"`
+	DiscountID           string        `json:"discount_id"`
+	DiscountKind         string        `json:"discount_kind"`
+	DiscountPercent      float64       `json:"discount_percent"`                 // 0 for fixed-amount discounts
+	DiscountPercentExact string        `json:"discount_percent_exact,omitempty"` // Exact decimal, omitted for fixed-amount discounts
+	DiscountAmount       *Money        `json:"discount_amount,omitempty"`        // Omitted for percentage discounts
+	StartDate            time.Time     `json:"start_date"`
+	EndDate              time.Time     `json:"end_date"`
+	EffectivePrice       *RoundedPrice `json:"effective_price,omitempty"` // Price while the discount runs
+	AppliedAt            time.Time     `json:"applied_at"`
+}
+
+// DiscountRemovedData is the payload of product.discount.removed.
+type DiscountRemovedData struct {
+	ProductID      string        `json:"product_id"`
+	DiscountID     string        `json:"discount_id"`
+	EffectivePrice *RoundedPrice `json:"effective_price,omitempty"` // Effective price after the removal, omitted when archiving
+	RemovedAt      time.Time     `json:"removed_at"`
+}
+
+// DiscountCancelledData is the payload of product.discount.cancelled.
+type DiscountCancelledData struct {
+	ProductID   string    `json:"product_id"`
+	DiscountID  string    `json:"discount_id"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	CancelledAt time.Time `json:"cancelled_at"`
+}
+
+// DiscountStartedData is the payload of product.discount.started.
+type DiscountStartedData struct {
+	ProductID            string        `json:"product_id"`
+	DiscountID           string        `json:"discount_id"`
+	DiscountKind         string        `json:"discount_kind"`
+	DiscountPercent      float64       `json:"discount_percent"`                 // 0 for fixed-amount discounts
+	DiscountPercentExact string        `json:"discount_percent_exact,omitempty"` // Exact decimal, omitted for fixed-amount discounts
+	DiscountAmount       *Money        `json:"discount_amount,omitempty"`        // Omitted for percentage discounts
+	StartDate            time.Time     `json:"start_date"`
+	EndDate              time.Time     `json:"end_date"`
+	EffectivePrice       *RoundedPrice `json:"effective_price,omitempty"` // Effective price at started_at
+	StartedAt            time.Time     `json:"started_at"`
+}
+
+// DiscountExpiredData is the payload of product.discount.expired.
+type DiscountExpiredData struct {
+	ProductID      string        `json:"product_id"`
+	DiscountID     string        `json:"discount_id"`
+	StartDate      time.Time     `json:"start_date"`
+	EndDate        time.Time     `json:"end_date"`
+	EffectivePrice *RoundedPrice `json:"effective_price,omitempty"` // Effective price at expired_at
+	ExpiredAt      time.Time     `json:"expired_at"`
+}
+
+// ProductArchivedData is the payload of product.archived.
+type ProductArchivedData struct {
+	ProductID  string    `json:"product_id"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// ProductRestoredData is the payload of product.restored.
+type ProductRestoredData struct {
+	ProductID  string    `json:"product_id"`
+	ArchivedAt time.Time `json:"archived_at"` // When the product had been archived
+	RestoredAt time.Time `json:"restored_at"`
+}
+
+// ProductPurgedData is the payload of product.purged.
+type ProductPurgedData struct {
+	ProductID  string    `json:"product_id"`
+	ArchivedAt time.Time `json:"archived_at"`
+	PurgedAt   time.Time `json:"purged_at"`
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// schemaFS holds the published JSON Schema for each event type and schema version.
+// Files are named <event_type>.v<schema_version>.json.
+//
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// SchemaFileName returns the schema file name for an event type at the current SchemaVersion.
+func SchemaFileName(eventType string) string {
+	return fmt.Sprintf("%s.v%d.json", eventType, SchemaVersion)
+}
+
+// Schema returns the JSON Schema for an event type at the current SchemaVersion.
+func Schema(eventType string) ([]byte, bool) {
+	data, err := schemaFS.ReadFile("schemas/" + SchemaFileName(eventType))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// EventTypes returns all event types with a published schema, sorted.
+func EventTypes() []string {
+	entries, _ := schemaFS.ReadDir("schemas")
+
+	suffix := fmt.Sprintf(".v%d.json", SchemaVersion)
+	types := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name := entry.Name(); strings.HasSuffix(name, suffix) {
+			types = append(types, strings.TrimSuffix(name, suffix))
+		}
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,31 @@
+package get_price_at
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+)
+
+// Request contains the product ID and the point in time to price it at.
+type Request struct {
+	ProductID string
+	At        time.Time
+}
+
+// Query handles the get price at query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new get price at query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a product's base and effective price at a point in time.
+func (q *Query) Execute(ctx context.Context, req *Request) (*contracts.PriceAtDTO, error) {
+	return q.readModel.GetPriceAt(ctx, req.ProductID, req.At)
+}
@@ -0,0 +1,87 @@
+package get_price_history
+
+import (
+	"context"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+)
+
+// Request contains the product ID and the time range and pagination of its price history.
+type Request struct {
+	ProductID     string
+	ChangedAfter  *time.Time // Only changes at or after this time
+	ChangedBefore *time.Time // Only changes before this time
+	Limit         int        // Max number of records to return (default: 100)
+	PageToken     string     // Token from a previous Result.NextPageToken
+}
+
+// Result is one page of price history, newest first.
+type Result struct {
+	Records       []contracts.PriceHistoryRecord
+	NextPageToken string // Empty on the last page
+	TotalCount    int64  // Records matching the filters across all pages
+}
+
+// PriceHistoryReadModel defines the interface for reading price history.
+type PriceHistoryReadModel interface {
+	// ListPriceHistory returns up to limit records of the product matching the filters
+	// that sort before the cursor, in descending (changed_at, history_id) order. A nil
+	// cursor starts at the newest record. Returns domain.ErrProductNotFound for unknown products.
+	ListPriceHistory(ctx context.Context, req *Request, before *query.Cursor, limit int) ([]contracts.PriceHistoryRecord, error)
+
+	// CountPriceHistory counts all records of the product matching the filters.
+	CountPriceHistory(ctx context.Context, req *Request) (int64, error)
+}
+
+// Query handles the get price history query use case.
+type Query struct {
+	readModel PriceHistoryReadModel
+}
+
+// NewQuery creates a new get price history query.
+func NewQuery(readModel PriceHistoryReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a page of a product's price history.
+func (q *Query) Execute(ctx context.Context, req *Request) (*Result, error) {
+	if req.Limit <= 0 {
+		req.Limit = 100 // Default limit
+	}
+	if req.Limit > 1000 {
+		req.Limit = 1000 // Max limit
+	}
+
+	cursor, err := query.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether another page follows
+	records, err := q.readModel.ListPriceHistory(ctx, req, cursor, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if len(records) > req.Limit {
+		records = records[:req.Limit]
+		last := records[len(records)-1]
+		nextPageToken = query.EncodePageToken(&query.Cursor{Time: last.ChangedAt, ID: last.HistoryID})
+	}
+
+	totalCount, err := q.readModel.CountPriceHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Records:       records,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package get_prices
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+)
+
+// Request contains the product ID whose prices to retrieve.
+type Request struct {
+	ProductID string
+}
+
+// Query handles the get prices query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new get prices query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a product's base price and price list.
+func (q *Query) Execute(ctx context.Context, req *Request) (*contracts.PricesDTO, error) {
+	return q.readModel.GetPrices(ctx, req.ProductID)
+}
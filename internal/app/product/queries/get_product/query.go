@@ -6,9 +6,11 @@ import (
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 )
 
-// Request contains the product ID to retrieve.
+// Request contains the product ID to retrieve and the market to price it for.
 type Request struct {
 	ProductID string
+	Region    string // Optional price list region
+	Currency  string // Optional preferred ISO 4217 currency
 }
 
 // Query handles the get product query use case.
@@ -25,5 +27,9 @@ func NewQuery(readModel contracts.ReadModel) *Query {
 
 // Execute retrieves a product by ID.
 func (q *Query) Execute(ctx context.Context, req *Request) (*contracts.ProductDTO, error) {
-	return q.readModel.GetProductByID(ctx, req.ProductID)
+	market, err := contracts.NewMarket(req.Region, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return q.readModel.GetProductByID(ctx, req.ProductID, market)
 }
@@ -0,0 +1,29 @@
+package list_discounts
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+)
+
+// Request contains the product ID whose discount schedule to retrieve.
+type Request struct {
+	ProductID string
+}
+
+// Query handles the list discounts query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new list discounts query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a product's discount schedule ordered by start date.
+func (q *Query) Execute(ctx context.Context, req *Request) ([]*contracts.DiscountDTO, error) {
+	return q.readModel.ListDiscounts(ctx, req.ProductID)
+}
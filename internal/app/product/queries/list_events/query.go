@@ -2,21 +2,39 @@ package list_events
 
 import (
 	"context"
+	"time"
 
 	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
 )
 
-// Request contains filtering parameters for listing events.
+// Request contains filtering and pagination parameters for listing events.
 type Request struct {
-	EventType   *string // Filter by event type (e.g., "product.created")
-	AggregateID *string // Filter by aggregate ID
-	Status      *string // Filter by status ("pending", "processed", "failed")
-	Limit       int     // Max number of events to return (default: 100)
+	EventType     *string    // Filter by event type (e.g., "product.created")
+	AggregateID   *string    // Filter by aggregate ID
+	Status        *string    // Filter by status ("pending", "processed", "failed")
+	CreatedAfter  *time.Time // Only events created at or after this time
+	CreatedBefore *time.Time // Only events created before this time
+	Limit         int        // Max number of events to return (default: 100)
+	PageToken     string     // Token from a previous Result.NextPageToken
+}
+
+// Result is one page of events, newest first.
+type Result struct {
+	Events        []*m_outbox.Data
+	NextPageToken string // Empty on the last page
+	TotalCount    int64  // Events matching the filters across all pages
 }
 
 // EventsReadModel defines the interface for reading events.
 type EventsReadModel interface {
-	ListEvents(ctx context.Context, req *Request) ([]*m_outbox.Data, int64, error)
+	// ListEvents returns up to limit events matching the filters that sort before
+	// the cursor, in descending (created_at, event_id) order. A nil cursor starts
+	// at the newest event.
+	ListEvents(ctx context.Context, req *Request, before *query.Cursor, limit int) ([]*m_outbox.Data, error)
+
+	// CountEvents counts all events matching the filters.
+	CountEvents(ctx context.Context, req *Request) (int64, error)
 }
 
 // Query handles the list events query use case.
@@ -31,8 +49,8 @@ func NewQuery(readModel EventsReadModel) *Query {
 	}
 }
 
-// Execute retrieves a list of events with filtering.
-func (q *Query) Execute(ctx context.Context, req *Request) ([]*m_outbox.Data, int64, error) {
+// Execute retrieves a page of events with filtering.
+func (q *Query) Execute(ctx context.Context, req *Request) (*Result, error) {
 	if req.Limit <= 0 {
 		req.Limit = 100 // Default limit
 	}
@@ -40,5 +58,32 @@ func (q *Query) Execute(ctx context.Context, req *Request) ([]*m_outbox.Data, in
 		req.Limit = 1000 // Max limit
 	}
 
-	return q.readModel.ListEvents(ctx, req)
+	cursor, err := query.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether another page follows
+	events, err := q.readModel.ListEvents(ctx, req, cursor, req.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if len(events) > req.Limit {
+		events = events[:req.Limit]
+		last := events[len(events)-1]
+		nextPageToken = query.EncodePageToken(&query.Cursor{Time: last.CreatedAt, ID: last.EventID})
+	}
+
+	totalCount, err := q.readModel.CountEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Events:        events,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
 }
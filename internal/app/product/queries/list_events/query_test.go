@@ -0,0 +1,79 @@
+package list_events
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+)
+
+// fakeReadModel holds events newest first, as the Spanner read model returns them.
+type fakeReadModel struct {
+	events []*m_outbox.Data
+}
+
+func (f *fakeReadModel) ListEvents(ctx context.Context, req *Request, before *query.Cursor, limit int) ([]*m_outbox.Data, error) {
+	var events []*m_outbox.Data
+	for _, e := range f.events {
+		if before != nil && !e.CreatedAt.Before(before.Time) &&
+			!(e.CreatedAt.Equal(before.Time) && e.EventID < before.ID) {
+			continue
+		}
+		events = append(events, e)
+		if len(events) == limit {
+			break
+		}
+	}
+	return events, nil
+}
+
+func (f *fakeReadModel) CountEvents(ctx context.Context, req *Request) (int64, error) {
+	return int64(len(f.events)), nil
+}
+
+func TestQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	readModel := &fakeReadModel{}
+	for i := 4; i >= 0; i-- {
+		readModel.events = append(readModel.events, &m_outbox.Data{
+			EventID:   fmt.Sprintf("e%d", i),
+			CreatedAt: base.Add(time.Duration(i) * time.Second),
+		})
+	}
+	query := NewQuery(readModel)
+
+	t.Run("pages through all events with tokens", func(t *testing.T) {
+		var seen []string
+		token := ""
+		for pages := 0; pages < 10; pages++ {
+			result, err := query.Execute(ctx, &Request{Limit: 2, PageToken: token})
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), result.TotalCount)
+
+			for _, e := range result.Events {
+				seen = append(seen, e.EventID)
+			}
+			if result.NextPageToken == "" {
+				break
+			}
+			token = result.NextPageToken
+		}
+
+		assert.Equal(t, []string{"e4", "e3", "e2", "e1", "e0"}, seen)
+	})
+
+	t.Run("last page has no token", func(t *testing.T) {
+		result, err := query.Execute(ctx, &Request{Limit: 5})
+		require.NoError(t, err)
+		assert.Len(t, result.Events, 5)
+		assert.Empty(t, result.NextPageToken)
+	})
+}
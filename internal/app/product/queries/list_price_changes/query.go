@@ -0,0 +1,29 @@
+package list_price_changes
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+)
+
+// Request contains the product ID whose scheduled price changes to retrieve.
+type Request struct {
+	ProductID string
+}
+
+// Query handles the list price changes query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new list price changes query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a product's pending base price changes ordered by effective time.
+func (q *Query) Execute(ctx context.Context, req *Request) ([]*contracts.PriceChangeDTO, error) {
+	return q.readModel.ListPriceChanges(ctx, req.ProductID)
+}
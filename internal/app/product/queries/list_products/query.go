@@ -10,6 +10,8 @@ import (
 type Request struct {
 	Category  string
 	Status    string
+	Region    string // Optional price list region
+	Currency  string // Optional preferred ISO 4217 currency
 	PageSize  int
 	PageToken string
 }
@@ -28,9 +30,15 @@ func NewQuery(readModel contracts.ReadModel) *Query {
 
 // Execute retrieves a paginated list of products with filtering.
 func (q *Query) Execute(ctx context.Context, req *Request) (*contracts.ListResult, error) {
+	market, err := contracts.NewMarket(req.Region, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	filter := &contracts.ListFilter{
 		Category:  req.Category,
 		Status:    req.Status,
+		Market:    market,
 		PageSize:  req.PageSize,
 		PageToken: req.PageToken,
 	}
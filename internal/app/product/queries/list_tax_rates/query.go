@@ -0,0 +1,47 @@
+package list_tax_rates
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+)
+
+// Request contains the optional region and tax class to list rates for.
+type Request struct {
+	Region   string
+	TaxClass string
+}
+
+// Query handles the list tax rates query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new list tax rates query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves the matching tax rates, ordered by region and tax class, latest
+// effective date first.
+func (q *Query) Execute(ctx context.Context, req *Request) ([]*contracts.TaxRateDTO, error) {
+	filter := &contracts.TaxRateFilter{}
+	if req.Region != "" {
+		region, err := domain.ParseRegion(req.Region)
+		if err != nil {
+			return nil, err
+		}
+		filter.Region = region
+	}
+	if req.TaxClass != "" {
+		taxClass, err := domain.ParseTaxClass(req.TaxClass)
+		if err != nil {
+			return nil, err
+		}
+		filter.TaxClass = taxClass
+	}
+	return q.readModel.ListTaxRates(ctx, filter)
+}
@@ -0,0 +1,113 @@
+package watch_events
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+)
+
+// batchSize is the maximum number of events read per poll.
+const batchSize = 100
+
+// ErrEventNotFound is returned when the resume event does not exist
+// (for example because cleanup_outbox already deleted it).
+var ErrEventNotFound = errors.New("resume event not found")
+
+// Cursor is a position in the outbox, ordered by (created_at, event_id).
+type Cursor struct {
+	CreatedAt time.Time
+	EventID   string
+}
+
+// Request contains filtering and resume parameters for watching events.
+type Request struct {
+	EventType    *string    // Filter by event type (e.g., "product.created")
+	AggregateID  *string    // Filter by aggregate ID
+	Status       *string    // Filter by status when the event is first observed
+	AfterEventID *string    // Resume after this event
+	StartTime    *time.Time // Start at events created at or after this time
+}
+
+// EventsReadModel defines the reads needed to tail the outbox.
+type EventsReadModel interface {
+	// GetEventCursor returns the position of an event, or ErrEventNotFound.
+	GetEventCursor(ctx context.Context, eventID string) (*Cursor, error)
+
+	// LatestEventCursor returns the position of the newest event, or nil if there are none.
+	LatestEventCursor(ctx context.Context) (*Cursor, error)
+
+	// ListEventsAfter returns up to limit events matching the filters that sort after
+	// the cursor, in (created_at, event_id) order.
+	ListEventsAfter(ctx context.Context, req *Request, after *Cursor, limit int) ([]*m_outbox.Data, error)
+}
+
+// Query handles the watch events query use case.
+type Query struct {
+	readModel    EventsReadModel
+	pollInterval time.Duration
+}
+
+// NewQuery creates a new watch events query.
+func NewQuery(readModel EventsReadModel, pollInterval time.Duration) *Query {
+	return &Query{
+		readModel:    readModel,
+		pollInterval: pollInterval,
+	}
+}
+
+// Execute streams matching events to send until ctx is cancelled or send fails.
+//
+// created_at is the Spanner commit timestamp, and a strong read observes every commit
+// with a timestamp at or below the read timestamp, so a later commit can never sort before
+// an event that was already sent. Tailing by (created_at, event_id) is therefore gap-free,
+// and resuming after the last received event_id delivers each event exactly once.
+func (q *Query) Execute(ctx context.Context, req *Request, send func(*m_outbox.Data) error) error {
+	cursor, err := q.startCursor(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		events, err := q.readModel.ListEventsAfter(ctx, req, cursor, batchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+			cursor = &Cursor{CreatedAt: event.CreatedAt, EventID: event.EventID}
+		}
+
+		// Keep reading while there is a backlog
+		if len(events) == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(q.pollInterval):
+		}
+	}
+}
+
+// startCursor resolves where the stream begins.
+func (q *Query) startCursor(ctx context.Context, req *Request) (*Cursor, error) {
+	switch {
+	case req.AfterEventID != nil:
+		return q.readModel.GetEventCursor(ctx, *req.AfterEventID)
+	case req.StartTime != nil:
+		// The empty event ID sorts before every ID, making the start time inclusive
+		return &Cursor{CreatedAt: *req.StartTime}, nil
+	default:
+		// Only events committed after the call
+		return q.readModel.LatestEventCursor(ctx)
+	}
+}
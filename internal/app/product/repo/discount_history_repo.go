@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_discount"
+	"github.com/light-bringer/procat-service/internal/models/m_discount_history"
+)
+
+// DiscountHistoryRepo implements DiscountHistoryRepository for Spanner.
+type DiscountHistoryRepo struct {
+	model *m_discount_history.Model
+}
+
+// NewDiscountHistoryRepo creates a new DiscountHistoryRepo.
+func NewDiscountHistoryRepo() contracts.DiscountHistoryRepository {
+	return &DiscountHistoryRepo{
+		model: m_discount_history.NewModel(),
+	}
+}
+
+// InsertMut creates a mutation that records a discount as it is applied at appliedAt.
+func (r *DiscountHistoryRepo) InsertMut(productID string, discount *domain.Discount, appliedAt time.Time) (*spanner.Mutation, error) {
+	data, err := discountToHistoryData(productID, discount)
+	if err != nil {
+		return nil, err
+	}
+	data.AppliedAt = spanner.NullTime{Time: appliedAt, Valid: true}
+	return r.model.InsertMut(data), nil
+}
+
+// EndMut creates a mutation that records a discount leaving the schedule after taking effect.
+func (r *DiscountHistoryRepo) EndMut(productID string, discount *domain.Discount, endedAt *time.Time) (*spanner.Mutation, error) {
+	data, err := discountToHistoryData(productID, discount)
+	if err != nil {
+		return nil, err
+	}
+	if endedAt != nil {
+		data.EndedAt = spanner.NullTime{Time: *endedAt, Valid: true}
+	}
+	return r.model.UpsertEndMut(data), nil
+}
+
+// DeleteMut creates a mutation that forgets a discount that never took effect.
+func (r *DiscountHistoryRepo) DeleteMut(productID, discountID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID, discountID)
+}
+
+// discountToHistoryData converts a discount to its discount_history row.
+func discountToHistoryData(productID string, discount *domain.Discount) (*m_discount_history.Data, error) {
+	data := &m_discount_history.Data{
+		ProductID:  productID,
+		DiscountID: discount.ID(),
+		StartDate:  discount.StartDate(),
+		EndDate:    discount.EndDate(),
+	}
+
+	if amount := discount.Amount(); amount != nil {
+		num, denom, err := storedMoney(amount)
+		if err != nil {
+			return nil, fmt.Errorf("discount amount exceeds storage capacity: %w", err)
+		}
+		data.AmountNumerator = spanner.NullInt64{Int64: num, Valid: true}
+		data.AmountDenominator = spanner.NullInt64{Int64: denom, Valid: true}
+		data.AmountCurrency = spanner.NullString{StringVal: string(amount.Currency()), Valid: true}
+	} else {
+		data.DiscountPercent = spanner.NullNumeric{Numeric: *discount.PercentageRat(), Valid: true}
+	}
+
+	return data, nil
+}
+
+// dataToAppliedDiscount reconstructs a discount stored in the discount_history table.
+func dataToAppliedDiscount(data *m_discount_history.Data) (*domain.AppliedDiscount, error) {
+	discount, err := dataToScheduledDiscount(&m_discount.Data{
+		ProductID:         data.ProductID,
+		DiscountID:        data.DiscountID,
+		DiscountPercent:   data.DiscountPercent,
+		AmountNumerator:   data.AmountNumerator,
+		AmountDenominator: data.AmountDenominator,
+		AmountCurrency:    data.AmountCurrency,
+		StartDate:         data.StartDate,
+		EndDate:           data.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	applied := &domain.AppliedDiscount{Discount: discount}
+	if data.AppliedAt.Valid {
+		applied.AppliedAt = &data.AppliedAt.Time
+	}
+	if data.EndedAt.Valid {
+		applied.EndedAt = &data.EndedAt.Time
+	}
+	return applied, nil
+}
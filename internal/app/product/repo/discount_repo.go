@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_discount"
+)
+
+// DiscountRepo implements DiscountRepository for Spanner.
+type DiscountRepo struct {
+	model *m_discount.Model
+}
+
+// NewDiscountRepo creates a new DiscountRepo.
+func NewDiscountRepo() contracts.DiscountRepository {
+	return &DiscountRepo{
+		model: m_discount.NewModel(),
+	}
+}
+
+// InsertMut creates a mutation that adds a discount to the product's schedule.
+func (r *DiscountRepo) InsertMut(productID string, discount *domain.Discount) (*spanner.Mutation, error) {
+	data := &m_discount.Data{
+		ProductID:  productID,
+		DiscountID: discount.ID(),
+		StartDate:  discount.StartDate(),
+		EndDate:    discount.EndDate(),
+	}
+	if startedAt := discount.StartedAt(); startedAt != nil {
+		data.StartedAt = spanner.NullTime{Time: *startedAt, Valid: true}
+	}
+
+	if amount := discount.Amount(); amount != nil {
+		num, denom, err := storedMoney(amount)
+		if err != nil {
+			return nil, fmt.Errorf("discount amount exceeds storage capacity: %w", err)
+		}
+		data.AmountNumerator = spanner.NullInt64{Int64: num, Valid: true}
+		data.AmountDenominator = spanner.NullInt64{Int64: denom, Valid: true}
+		data.AmountCurrency = spanner.NullString{StringVal: string(amount.Currency()), Valid: true}
+	} else {
+		data.DiscountPercent = spanner.NullNumeric{Numeric: *discount.PercentageRat(), Valid: true}
+	}
+
+	return r.model.InsertMut(data), nil
+}
+
+// MarkStartedMut creates a mutation that records when a scheduled discount took effect.
+func (r *DiscountRepo) MarkStartedMut(productID, discountID string, startedAt time.Time) *spanner.Mutation {
+	return r.model.UpdateStartedAtMut(productID, discountID, startedAt)
+}
+
+// DeleteMut creates a mutation that removes a discount from the product's schedule.
+func (r *DiscountRepo) DeleteMut(productID, discountID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID, discountID)
+}
+
+// DeleteAllMut creates a mutation that removes the product's whole schedule.
+func (r *DiscountRepo) DeleteAllMut(productID string) *spanner.Mutation {
+	return r.model.DeleteAllMut(productID)
+}
+
+// dataToScheduledDiscount reconstructs a discount stored in the discounts table.
+func dataToScheduledDiscount(data *m_discount.Data) (*domain.Discount, error) {
+	var (
+		discount *domain.Discount
+		err      error
+	)
+	if data.AmountNumerator.Valid {
+		var amount *domain.Money
+		amount, err = domain.NewMoney(data.AmountNumerator.Int64, data.AmountDenominator.Int64, domain.Currency(data.AmountCurrency.StringVal))
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for discount %s: %w", data.DiscountID, err)
+		}
+		discount, err = domain.NewFixedAmountDiscount(amount, data.StartDate, data.EndDate)
+	} else {
+		discount, err = domain.NewDiscountFromRat(&data.DiscountPercent.Numeric, data.StartDate, data.EndDate)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid discount %s: %w", data.DiscountID, err)
+	}
+	discount = discount.WithID(data.DiscountID)
+	if data.StartedAt.Valid {
+		discount = discount.WithStartedAt(data.StartedAt.Time)
+	}
+	return discount, nil
+}
@@ -3,13 +3,33 @@ package repo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_events"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/watch_events"
 	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
 )
 
+// eventColumns lists the outbox columns in the order scanEvents expects.
+var eventColumns = []string{
+	m_outbox.EventID,
+	m_outbox.EventType,
+	m_outbox.AggregateID,
+	m_outbox.Payload,
+	m_outbox.Status,
+	m_outbox.CreatedAt,
+	m_outbox.ProcessedAt,
+	m_outbox.RetryCount,
+	m_outbox.ErrorMessage,
+}
+
+// eventOrder is the stable (created_at, event_id) order used by both listing and tailing.
+var eventOrder = []string{m_outbox.CreatedAt, m_outbox.EventID}
+
 // EventsReadModel implements the EventsReadModel interface for Spanner.
 type EventsReadModel struct {
 	client *spanner.Client
@@ -22,36 +42,133 @@ func NewEventsReadModel(client *spanner.Client) *EventsReadModel {
 	}
 }
 
-// ListEvents retrieves events from the outbox_events table with filtering.
-func (r *EventsReadModel) ListEvents(ctx context.Context, req *list_events.Request) ([]*m_outbox.Data, int64, error) {
-	// Build query with filters - select all columns needed by m_outbox.Data
-	query := "SELECT event_id, event_type, aggregate_id, payload, status, created_at, processed_at, retry_count, error_message FROM outbox_events WHERE 1=1"
-	params := make(map[string]interface{})
+// ListEvents retrieves a page of events, newest first, that sort before the cursor.
+func (r *EventsReadModel) ListEvents(ctx context.Context, req *list_events.Request, before *query.Cursor, limit int) ([]*m_outbox.Data, error) {
+	builder := listEventsQuery(req).Select(eventColumns...)
+	if before != nil {
+		builder = builder.Where(query.Before(eventOrder, before.Time, before.ID))
+	}
+
+	stmt := builder.
+		OrderBy(m_outbox.CreatedAt, query.Desc).
+		ThenBy(m_outbox.EventID, query.Desc).
+		Limit(int64(limit)).
+		Build()
+
+	return r.scanEvents(ctx, stmt)
+}
+
+// CountEvents counts all events matching the filters, ignoring pagination.
+func (r *EventsReadModel) CountEvents(ctx context.Context, req *list_events.Request) (int64, error) {
+	stmt := listEventsQuery(req).Count().Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse count: %w", err)
+	}
+	return count, nil
+}
+
+// GetEventCursor returns the (created_at, event_id) position of an event.
+func (r *EventsReadModel) GetEventCursor(ctx context.Context, eventID string) (*watch_events.Cursor, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_outbox.TableName, spanner.Key{eventID}, []string{m_outbox.CreatedAt})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, watch_events.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to read event: %w", err)
+	}
+
+	var createdAt time.Time
+	if err := row.Columns(&createdAt); err != nil {
+		return nil, fmt.Errorf("failed to scan event: %w", err)
+	}
+
+	return &watch_events.Cursor{CreatedAt: createdAt, EventID: eventID}, nil
+}
+
+// LatestEventCursor returns the position of the newest event, or nil if the outbox is empty.
+func (r *EventsReadModel) LatestEventCursor(ctx context.Context) (*watch_events.Cursor, error) {
+	stmt := query.From(m_outbox.TableName).
+		Select(eventOrder...).
+		OrderBy(m_outbox.CreatedAt, query.Desc).
+		ThenBy(m_outbox.EventID, query.Desc).
+		Limit(1).
+		Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
 
-	if req.EventType != nil {
-		query += " AND event_type = @eventType"
-		params["eventType"] = *req.EventType
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest event: %w", err)
 	}
 
-	if req.AggregateID != nil {
-		query += " AND aggregate_id = @aggregateID"
-		params["aggregateID"] = *req.AggregateID
+	var cursor watch_events.Cursor
+	if err := row.Columns(&cursor.CreatedAt, &cursor.EventID); err != nil {
+		return nil, fmt.Errorf("failed to scan event: %w", err)
 	}
 
-	if req.Status != nil {
-		query += " AND status = @status"
-		params["status"] = *req.Status
+	return &cursor, nil
+}
+
+// ListEventsAfter retrieves events that sort after the cursor in (created_at, event_id) order.
+// A nil cursor starts at the oldest event.
+func (r *EventsReadModel) ListEventsAfter(ctx context.Context, req *watch_events.Request, after *watch_events.Cursor, limit int) ([]*m_outbox.Data, error) {
+	builder := eventsQuery(req.EventType, req.AggregateID, req.Status).Select(eventColumns...)
+	if after != nil {
+		builder = builder.Where(query.After(eventOrder, after.CreatedAt, after.EventID))
 	}
 
-	query += " ORDER BY created_at DESC LIMIT @limit"
-	params["limit"] = req.Limit
+	stmt := builder.
+		OrderBy(m_outbox.CreatedAt, query.Asc).
+		ThenBy(m_outbox.EventID, query.Asc).
+		Limit(int64(limit)).
+		Build()
 
-	// Execute query
-	stmt := spanner.Statement{
-		SQL:    query,
-		Params: params,
+	return r.scanEvents(ctx, stmt)
+}
+
+// listEventsQuery adds the ListEvents time range to the shared filters.
+func listEventsQuery(req *list_events.Request) *query.Builder {
+	builder := eventsQuery(req.EventType, req.AggregateID, req.Status)
+	if req.CreatedAfter != nil {
+		builder = builder.Where(query.Gte(m_outbox.CreatedAt, *req.CreatedAfter))
+	}
+	if req.CreatedBefore != nil {
+		builder = builder.Where(query.Lt(m_outbox.CreatedAt, *req.CreatedBefore))
+	}
+	return builder
+}
+
+// eventsQuery builds the FROM/WHERE clauses shared by the event queries.
+func eventsQuery(eventType, aggregateID, status *string) *query.Builder {
+	builder := query.From(m_outbox.TableName)
+	if eventType != nil {
+		builder = builder.Where(query.Eq(m_outbox.EventType, *eventType))
+	}
+	if aggregateID != nil {
+		builder = builder.Where(query.Eq(m_outbox.AggregateID, *aggregateID))
+	}
+	if status != nil {
+		builder = builder.Where(query.Eq(m_outbox.Status, *status))
 	}
+	return builder
+}
 
+// scanEvents runs stmt and scans rows selected with eventColumns.
+func (r *EventsReadModel) scanEvents(ctx context.Context, stmt spanner.Statement) ([]*m_outbox.Data, error) {
 	iter := r.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
 
@@ -62,7 +179,7 @@ func (r *EventsReadModel) ListEvents(ctx context.Context, req *list_events.Reque
 			break
 		}
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to iterate events: %w", err)
+			return nil, fmt.Errorf("failed to iterate events: %w", err)
 		}
 
 		var event m_outbox.Data
@@ -78,14 +195,11 @@ func (r *EventsReadModel) ListEvents(ctx context.Context, req *list_events.Reque
 			&event.RetryCount,
 			&event.ErrorMessage,
 		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan event: %w", err)
+			return nil, fmt.Errorf("failed to scan event: %w", err)
 		}
 
 		events = append(events, &event)
 	}
 
-	// Get total count (simplified - in production, use a separate count query)
-	totalCount := int64(len(events))
-
-	return events, totalCount, nil
+	return events, nil
 }
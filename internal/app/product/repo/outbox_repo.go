@@ -28,24 +28,26 @@ func (r *OutboxRepo) InsertMut(event *contracts.OutboxEvent) *spanner.Mutation {
 	payload := spanner.NullJSON{Value: event.Payload, Valid: event.Payload != ""}
 
 	data := &m_outbox.Data{
-		EventID:     event.EventID,
-		EventType:   event.EventType,
-		AggregateID: event.AggregateID,
-		Payload:     payload,
-		Status:      event.Status,
-		RetryCount:  0,
+		EventID:        event.EventID,
+		EventType:      event.EventType,
+		AggregateID:    event.AggregateID,
+		SequenceNumber: spanner.NullInt64{Int64: event.SequenceNumber, Valid: true},
+		Payload:        payload,
+		Status:         event.Status,
+		RetryCount:     0,
 	}
 
 	return r.model.InsertMut(data)
 }
 
 // EnrichEvent converts a domain event to an outbox event with metadata.
-func (r *OutboxRepo) EnrichEvent(event domain.DomainEvent, payload string) *contracts.OutboxEvent {
+func (r *OutboxRepo) EnrichEvent(event domain.DomainEvent, payload string, sequenceNumber int64) *contracts.OutboxEvent {
 	return &contracts.OutboxEvent{
-		EventID:     uuid.New().String(),
-		EventType:   event.EventType(),
-		AggregateID: event.AggregateID(),
-		Payload:     payload,
-		Status:      m_outbox.StatusPending,
+		EventID:        uuid.New().String(),
+		EventType:      event.EventType(),
+		AggregateID:    event.AggregateID(),
+		SequenceNumber: sequenceNumber,
+		Payload:        payload,
+		Status:         m_outbox.StatusPending,
 	}
 }
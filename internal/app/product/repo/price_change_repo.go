@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change"
+)
+
+// PriceChangeRepo implements PriceChangeRepository for Spanner.
+type PriceChangeRepo struct {
+	model *m_price_change.Model
+}
+
+// NewPriceChangeRepo creates a new PriceChangeRepo.
+func NewPriceChangeRepo() contracts.PriceChangeRepository {
+	return &PriceChangeRepo{
+		model: m_price_change.NewModel(),
+	}
+}
+
+// InsertMut creates a mutation that adds a price change to the product's schedule.
+func (r *PriceChangeRepo) InsertMut(productID string, change *domain.ScheduledPriceChange) (*spanner.Mutation, error) {
+	newPrice := change.NewPrice()
+	num, denom, err := storedMoney(newPrice)
+	if err != nil {
+		return nil, fmt.Errorf("new price exceeds storage capacity: %w", err)
+	}
+
+	data := &m_price_change.Data{
+		ProductID:           productID,
+		PriceChangeID:       change.ID(),
+		NewPriceNumerator:   num,
+		NewPriceDenominator: denom,
+		NewPriceCurrency:    string(newPrice.Currency()),
+		EffectiveAt:         change.EffectiveAt(),
+		ChangedBy:           change.ChangedBy(),
+	}
+	if reason := change.ChangedReason(); reason != "" {
+		data.ChangedReason = spanner.NullString{StringVal: reason, Valid: true}
+	}
+
+	return r.model.InsertMut(data), nil
+}
+
+// DeleteMut creates a mutation that removes a price change from the product's schedule.
+func (r *PriceChangeRepo) DeleteMut(productID, priceChangeID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID, priceChangeID)
+}
+
+// DeleteAllMut creates a mutation that removes all of the product's scheduled price changes.
+func (r *PriceChangeRepo) DeleteAllMut(productID string) *spanner.Mutation {
+	return r.model.DeleteAllMut(productID)
+}
+
+// dataToPriceChange reconstructs a price change stored in the scheduled_price_changes table.
+func dataToPriceChange(data *m_price_change.Data) (*domain.ScheduledPriceChange, error) {
+	newPrice, err := domain.NewMoney(data.NewPriceNumerator, data.NewPriceDenominator, domain.Currency(data.NewPriceCurrency))
+	if err != nil {
+		return nil, fmt.Errorf("invalid price for price change %s: %w", data.PriceChangeID, err)
+	}
+
+	change, err := domain.NewScheduledPriceChange(newPrice, data.EffectiveAt.UTC(), data.ChangedBy, data.ChangedReason.StringVal)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price change %s: %w", data.PriceChangeID, err)
+	}
+	return change.WithID(data.PriceChangeID), nil
+}
@@ -0,0 +1,83 @@
+package repo
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change_request"
+)
+
+// PriceChangeRequestRepo implements PriceChangeRequestRepository for Spanner.
+type PriceChangeRequestRepo struct {
+	model *m_price_change_request.Model
+}
+
+// NewPriceChangeRequestRepo creates a new PriceChangeRequestRepo.
+func NewPriceChangeRequestRepo() contracts.PriceChangeRequestRepository {
+	return &PriceChangeRequestRepo{
+		model: m_price_change_request.NewModel(),
+	}
+}
+
+// InsertMut creates a mutation that stores a product's pending price change request.
+func (r *PriceChangeRequestRepo) InsertMut(productID string, request *domain.PriceChangeRequest) (*spanner.Mutation, error) {
+	oldNum, oldDenom, err := storedMoney(request.OldPrice())
+	if err != nil {
+		return nil, fmt.Errorf("old price exceeds storage capacity: %w", err)
+	}
+	newPrice := request.NewPrice()
+	newNum, newDenom, err := storedMoney(newPrice)
+	if err != nil {
+		return nil, fmt.Errorf("new price exceeds storage capacity: %w", err)
+	}
+
+	data := &m_price_change_request.Data{
+		ProductID:           productID,
+		RequestID:           request.ID(),
+		OldPriceNumerator:   oldNum,
+		OldPriceDenominator: oldDenom,
+		NewPriceNumerator:   newNum,
+		NewPriceDenominator: newDenom,
+		Currency:            string(newPrice.Currency()),
+		RequestedBy:         request.RequestedBy(),
+		Violations:          request.Violations(),
+		RequestedAt:         request.RequestedAt(),
+	}
+	if reason := request.RequestedReason(); reason != "" {
+		data.RequestedReason = spanner.NullString{StringVal: reason, Valid: true}
+	}
+
+	return r.model.InsertMut(data), nil
+}
+
+// DeleteMut creates a mutation that removes a decided price change request.
+func (r *PriceChangeRequestRepo) DeleteMut(productID, requestID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID, requestID)
+}
+
+// DeleteAllMut creates a mutation that removes all of the product's price change requests.
+func (r *PriceChangeRequestRepo) DeleteAllMut(productID string) *spanner.Mutation {
+	return r.model.DeleteAllMut(productID)
+}
+
+// dataToPriceChangeRequest reconstructs a request stored in the price_change_requests table.
+func dataToPriceChangeRequest(data *m_price_change_request.Data) (*domain.PriceChangeRequest, error) {
+	currency := domain.Currency(data.Currency)
+	oldPrice, err := domain.NewMoney(data.OldPriceNumerator, data.OldPriceDenominator, currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid old price for price change request %s: %w", data.RequestID, err)
+	}
+	newPrice, err := domain.NewMoney(data.NewPriceNumerator, data.NewPriceDenominator, currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new price for price change request %s: %w", data.RequestID, err)
+	}
+
+	request, err := domain.NewPriceChangeRequest(oldPrice, newPrice, data.RequestedBy, data.RequestedReason.StringVal,
+		data.Violations, data.RequestedAt.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("invalid price change request %s: %w", data.RequestID, err)
+	}
+	return request.WithID(data.RequestID), nil
+}
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/models/m_price_history"
+	"github.com/light-bringer/procat-service/internal/models/m_product"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// priceHistoryOrder is the stable (changed_at, history_id) order of price history pages.
+var priceHistoryOrder = []string{m_price_history.ChangedAt, m_price_history.HistoryID}
+
+// PriceHistoryReadModel implements the PriceHistoryReadModel interface for Spanner.
+type PriceHistoryReadModel struct {
+	client *spanner.Client
+}
+
+// NewPriceHistoryReadModel creates a new PriceHistoryReadModel.
+func NewPriceHistoryReadModel(client *spanner.Client) *PriceHistoryReadModel {
+	return &PriceHistoryReadModel{
+		client: client,
+	}
+}
+
+// ListPriceHistory retrieves a page of a product's price history, newest first, that sorts
+// before the cursor.
+func (r *PriceHistoryReadModel) ListPriceHistory(ctx context.Context, req *get_price_history.Request, before *query.Cursor, limit int) ([]contracts.PriceHistoryRecord, error) {
+	if _, err := r.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{req.ProductID}, []string{m_product.ProductID}); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	builder := priceHistoryQuery(req).Select(priceHistoryColumns...)
+	if before != nil {
+		builder = builder.Where(query.Before(priceHistoryOrder, before.Time, before.ID))
+	}
+
+	stmt := builder.
+		OrderBy(m_price_history.ChangedAt, query.Desc).
+		ThenBy(m_price_history.HistoryID, query.Desc).
+		Limit(int64(limit)).
+		Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var records []contracts.PriceHistoryRecord
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate price history: %w", err)
+		}
+
+		var data m_price_history.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse price history: %w", err)
+		}
+
+		record, err := dataToPriceHistoryRecord(&data)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// CountPriceHistory counts all records of the product matching the filters, ignoring pagination.
+func (r *PriceHistoryReadModel) CountPriceHistory(ctx context.Context, req *get_price_history.Request) (int64, error) {
+	stmt := priceHistoryQuery(req).Count().Build()
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count price history: %w", err)
+	}
+
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse count: %w", err)
+	}
+	return count, nil
+}
+
+// priceHistoryQuery builds the FROM/WHERE clauses shared by listing and counting.
+func priceHistoryQuery(req *get_price_history.Request) *query.Builder {
+	builder := query.From(m_price_history.TableName).
+		Where(query.Eq(m_price_history.ProductID, req.ProductID))
+	if req.ChangedAfter != nil {
+		builder = builder.Where(query.Gte(m_price_history.ChangedAt, *req.ChangedAfter))
+	}
+	if req.ChangedBefore != nil {
+		builder = builder.Where(query.Lt(m_price_history.ChangedAt, *req.ChangedBefore))
+	}
+	return builder
+}
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -30,51 +31,101 @@ func NewPriceHistoryRepo(client *spanner.Client) contracts.PriceHistoryRepositor
 func (r *PriceHistoryRepo) InsertMut(
 	historyID string,
 	productID string,
+	region string,
 	oldPrice *domain.Money,
 	newPrice *domain.Money,
 	changedBy string,
 	changedReason string,
 	changedAt time.Time,
 ) (*spanner.Mutation, error) {
-	// Normalize prices for consistent storage
-	normalizedNewPrice := newPrice.Normalize()
+	data, err := r.buildData(historyID, productID, region, oldPrice, newPrice, changedBy, changedReason, changedAt)
+	if err != nil {
+		return nil, err
+	}
+	return r.model.InsertMut(data), nil
+}
 
-	// Check if new price values fit within int64 bounds
-	if !normalizedNewPrice.IsSafeForStorage() {
-		return nil, fmt.Errorf("new price exceeds storage capacity: %w", domain.ErrMoneyOverflow)
+// InsertDecisionMut creates a mutation recording the decision on a price change request.
+// The row carries the requested change with the requester in changed_by, so an approved
+// request reads like any other base price change.
+func (r *PriceHistoryRepo) InsertDecisionMut(
+	historyID string,
+	productID string,
+	request *domain.PriceChangeRequest,
+	decision domain.PriceChangeDecision,
+	decidedBy string,
+	decisionReason string,
+	decidedAt time.Time,
+) (*spanner.Mutation, error) {
+	data, err := r.buildData(historyID, productID, "", request.OldPrice(), request.NewPrice(),
+		request.RequestedBy(), request.RequestedReason(), decidedAt)
+	if err != nil {
+		return nil, err
 	}
 
-	newNum, _ := normalizedNewPrice.Numerator()
-	newDenom, _ := normalizedNewPrice.Denominator()
+	data.RequestID = spanner.NullString{StringVal: request.ID(), Valid: true}
+	data.Decision = spanner.NullString{StringVal: string(decision), Valid: true}
 
-	data := &m_price_history.Data{
-		HistoryID:           historyID,
-		ProductID:           productID,
-		NewPriceNumerator:   newNum,
-		NewPriceDenominator: newDenom,
-		ChangedAt:           changedAt,
+	// decidedBy is empty when archiving the product dropped the request
+	if decidedBy != "" {
+		data.DecidedBy = spanner.NullString{StringVal: decidedBy, Valid: true}
 	}
 
-	// oldPrice is nil for initial product creation
-	if oldPrice != nil {
-		normalizedOldPrice := oldPrice.Normalize()
+	// decisionReason is optional
+	if decisionReason != "" {
+		data.DecisionReason = spanner.NullString{StringVal: decisionReason, Valid: true}
+	}
 
-		// Check if old price values fit within int64 bounds
-		if !normalizedOldPrice.IsSafeForStorage() {
-			return nil, fmt.Errorf("old price exceeds storage capacity: %w", domain.ErrMoneyOverflow)
-		}
+	return r.model.InsertMut(data), nil
+}
+
+// buildData converts a price change into a price history row.
+func (r *PriceHistoryRepo) buildData(
+	historyID string,
+	productID string,
+	region string,
+	oldPrice *domain.Money,
+	newPrice *domain.Money,
+	changedBy string,
+	changedReason string,
+	changedAt time.Time,
+) (*m_price_history.Data, error) {
+	// Both prices are stored under a single currency column
+	if oldPrice != nil && newPrice != nil && oldPrice.Currency() != newPrice.Currency() {
+		return nil, fmt.Errorf("price history: %w", domain.ErrCurrencyMismatch)
+	}
 
-		oldNum, _ := normalizedOldPrice.Numerator()
-		oldDenom, _ := normalizedOldPrice.Denominator()
+	data := &m_price_history.Data{
+		HistoryID: historyID,
+		ProductID: productID,
+		ChangedAt: changedAt,
+	}
+
+	// region is empty for the base price
+	if region != "" {
+		data.Region = spanner.NullString{StringVal: region, Valid: true}
+	}
 
-		data.OldPriceNumerator = spanner.NullInt64{
-			Int64: oldNum,
-			Valid: true,
+	// newPrice is nil when a regional price is removed
+	if newPrice != nil {
+		num, denom, err := storedMoney(newPrice)
+		if err != nil {
+			return nil, fmt.Errorf("new price exceeds storage capacity: %w", err)
 		}
-		data.OldPriceDenominator = spanner.NullInt64{
-			Int64: oldDenom,
-			Valid: true,
+		data.NewPriceNumerator = spanner.NullInt64{Int64: num, Valid: true}
+		data.NewPriceDenominator = spanner.NullInt64{Int64: denom, Valid: true}
+		data.Currency = string(newPrice.Currency())
+	}
+
+	// oldPrice is nil for initial product creation
+	if oldPrice != nil {
+		num, denom, err := storedMoney(oldPrice)
+		if err != nil {
+			return nil, fmt.Errorf("old price exceeds storage capacity: %w", err)
 		}
+		data.OldPriceNumerator = spanner.NullInt64{Int64: num, Valid: true}
+		data.OldPriceDenominator = spanner.NullInt64{Int64: denom, Valid: true}
+		data.Currency = string(oldPrice.Currency())
 	}
 
 	// changedBy is optional
@@ -87,7 +138,7 @@ func (r *PriceHistoryRepo) InsertMut(
 		data.ChangedReason = spanner.NullString{StringVal: changedReason, Valid: true}
 	}
 
-	return r.model.InsertMut(data), nil
+	return data, nil
 }
 
 // GetByProductID retrieves price history for a product, ordered by time (most recent first).
@@ -98,7 +149,7 @@ func (r *PriceHistoryRepo) GetByProductID(ctx context.Context, productID string,
 		WHERE product_id = @productID
 		ORDER BY changed_at DESC
 		LIMIT @limit
-	`, r.buildColumnList(), m_price_history.TableName)
+	`, priceHistoryColumnList(), m_price_history.TableName)
 
 	stmt := spanner.Statement{
 		SQL: query,
@@ -126,7 +177,7 @@ func (r *PriceHistoryRepo) GetByProductID(ctx context.Context, productID string,
 			return nil, fmt.Errorf("failed to parse price history: %w", err)
 		}
 
-		record, err := r.dataToRecord(&data)
+		record, err := dataToPriceHistoryRecord(&data)
 		if err != nil {
 			return nil, err
 		}
@@ -137,39 +188,55 @@ func (r *PriceHistoryRepo) GetByProductID(ctx context.Context, productID string,
 	return records, nil
 }
 
-// buildColumnList returns comma-separated column names for SELECT queries.
-func (r *PriceHistoryRepo) buildColumnList() string {
-	return fmt.Sprintf("%s, %s, %s, %s, %s, %s, %s, %s, %s",
-		m_price_history.HistoryID,
-		m_price_history.ProductID,
-		m_price_history.OldPriceNumerator,
-		m_price_history.OldPriceDenominator,
-		m_price_history.NewPriceNumerator,
-		m_price_history.NewPriceDenominator,
-		m_price_history.ChangedBy,
-		m_price_history.ChangedReason,
-		m_price_history.ChangedAt,
-	)
+// priceHistoryColumns lists the price history columns read into m_price_history.Data.
+var priceHistoryColumns = []string{
+	m_price_history.HistoryID,
+	m_price_history.ProductID,
+	m_price_history.Region,
+	m_price_history.OldPriceNumerator,
+	m_price_history.OldPriceDenominator,
+	m_price_history.NewPriceNumerator,
+	m_price_history.NewPriceDenominator,
+	m_price_history.Currency,
+	m_price_history.ChangedBy,
+	m_price_history.ChangedReason,
+	m_price_history.ChangedAt,
+	m_price_history.RequestID,
+	m_price_history.Decision,
+	m_price_history.DecidedBy,
+	m_price_history.DecisionReason,
 }
 
-// dataToRecord converts database Data to domain PriceHistoryRecord.
-func (r *PriceHistoryRepo) dataToRecord(data *m_price_history.Data) (*contracts.PriceHistoryRecord, error) {
-	// newPrice is always present
-	newPrice, err := domain.NewMoney(data.NewPriceNumerator, data.NewPriceDenominator)
-	if err != nil {
-		return nil, fmt.Errorf("invalid new price: %w", err)
-	}
+// priceHistoryColumnList returns comma-separated column names for SELECT queries.
+func priceHistoryColumnList() string {
+	return strings.Join(priceHistoryColumns, ", ")
+}
 
+// dataToPriceHistoryRecord converts database Data to domain PriceHistoryRecord.
+func dataToPriceHistoryRecord(data *m_price_history.Data) (*contracts.PriceHistoryRecord, error) {
 	record := &contracts.PriceHistoryRecord{
 		HistoryID: data.HistoryID,
 		ProductID: data.ProductID,
-		NewPrice:  newPrice,
 		ChangedAt: data.ChangedAt,
 	}
 
+	// region is NULL for the base price
+	if data.Region.Valid {
+		record.Region = data.Region.StringVal
+	}
+
+	// newPrice is NULL when a regional price was removed
+	if data.NewPriceNumerator.Valid && data.NewPriceDenominator.Valid {
+		newPrice, err := domain.NewMoney(data.NewPriceNumerator.Int64, data.NewPriceDenominator.Int64, domain.Currency(data.Currency))
+		if err != nil {
+			return nil, fmt.Errorf("invalid new price: %w", err)
+		}
+		record.NewPrice = newPrice
+	}
+
 	// oldPrice is nil for initial creation
 	if data.OldPriceNumerator.Valid && data.OldPriceDenominator.Valid {
-		oldPrice, err := domain.NewMoney(data.OldPriceNumerator.Int64, data.OldPriceDenominator.Int64)
+		oldPrice, err := domain.NewMoney(data.OldPriceNumerator.Int64, data.OldPriceDenominator.Int64, domain.Currency(data.Currency))
 		if err != nil {
 			return nil, fmt.Errorf("invalid old price: %w", err)
 		}
@@ -186,5 +253,23 @@ func (r *PriceHistoryRepo) dataToRecord(data *m_price_history.Data) (*contracts.
 		record.ChangedReason = data.ChangedReason.StringVal
 	}
 
+	// Decision columns are only set for price change requests
+	record.RequestID = data.RequestID.StringVal
+	record.Decision = domain.PriceChangeDecision(data.Decision.StringVal)
+	record.DecidedBy = data.DecidedBy.StringVal
+	record.DecisionReason = data.DecisionReason.StringVal
+
 	return record, nil
 }
+
+// storedMoney normalizes a price and returns the numerator and denominator to store.
+// Returns ErrMoneyOverflow if either exceeds int64 bounds.
+func storedMoney(price *domain.Money) (int64, int64, error) {
+	normalized := price.Normalize()
+	if !normalized.IsSafeForStorage() {
+		return 0, 0, domain.ErrMoneyOverflow
+	}
+	num, _ := normalized.Numerator()
+	denom, _ := normalized.Denominator()
+	return num, denom, nil
+}
@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_price_list"
+)
+
+// PriceListRepo implements PriceListRepository for Spanner.
+type PriceListRepo struct {
+	model *m_price_list.Model
+}
+
+// NewPriceListRepo creates a new PriceListRepo.
+func NewPriceListRepo() contracts.PriceListRepository {
+	return &PriceListRepo{
+		model: m_price_list.NewModel(),
+	}
+}
+
+// UpsertMut creates a mutation that sets the product's price in a region.
+func (r *PriceListRepo) UpsertMut(productID, region string, price *domain.Money) (*spanner.Mutation, error) {
+	num, denom, err := storedMoney(price)
+	if err != nil {
+		return nil, fmt.Errorf("regional price exceeds storage capacity: %w", err)
+	}
+
+	return r.model.UpsertMut(&m_price_list.Data{
+		ProductID:        productID,
+		Region:           region,
+		PriceNumerator:   num,
+		PriceDenominator: denom,
+		Currency:         string(price.Currency()),
+	}), nil
+}
+
+// DeleteMut creates a mutation that removes the product's price in a region.
+func (r *PriceListRepo) DeleteMut(productID, region string) *spanner.Mutation {
+	return r.model.DeleteMut(productID, region)
+}
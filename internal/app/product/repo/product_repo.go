@@ -8,8 +8,14 @@ import (
 	"cloud.google.com/go/spanner"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_discount"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change_request"
+	"github.com/light-bringer/procat-service/internal/models/m_price_history"
+	"github.com/light-bringer/procat-service/internal/models/m_price_list"
 	"github.com/light-bringer/procat-service/internal/models/m_product"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -59,6 +65,10 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) (*spanner.Mutation, err
 		updates[m_product.Category] = product.Category()
 	}
 
+	if changes.Dirty(domain.FieldTaxClass) {
+		updates[m_product.TaxClass] = product.TaxClass()
+	}
+
 	if changes.Dirty(domain.FieldBasePrice) {
 		basePrice := product.BasePrice().Normalize()
 		if !basePrice.IsSafeForStorage() {
@@ -68,20 +78,28 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) (*spanner.Mutation, err
 		denom, _ := basePrice.Denominator()
 		updates[m_product.BasePriceNumerator] = num
 		updates[m_product.BasePriceDenominator] = denom
+		updates[m_product.BasePriceCurrency] = string(basePrice.Currency())
 	}
 
-	if changes.Dirty(domain.FieldDiscount) {
-		discount := product.DiscountCopy() // Use DiscountCopy() instead of deprecated Discount()
-		if discount != nil {
-			percentRat := discount.PercentageRat() // Get *big.Rat directly for precision
-			updates[m_product.DiscountPercent] = spanner.NullNumeric{Numeric: *percentRat, Valid: true}
-			updates[m_product.DiscountStartDate] = discount.StartDate()
-			updates[m_product.DiscountEndDate] = discount.EndDate()
-		} else {
-			updates[m_product.DiscountPercent] = spanner.NullNumeric{}
-			updates[m_product.DiscountStartDate] = spanner.NullTime{}
-			updates[m_product.DiscountEndDate] = spanner.NullTime{}
+	if changes.Dirty(domain.FieldCostPrice) {
+		num, denom, currency, err := costPriceColumns(product.CostPrice())
+		if err != nil {
+			return nil, err
 		}
+		updates[m_product.CostPriceNumerator] = num
+		updates[m_product.CostPriceDenominator] = denom
+		updates[m_product.CostPriceCurrency] = currency
+	}
+
+	// The schedule lives in the discounts table. A legacy discount on the products row is
+	// cleared once it has left the schedule.
+	if changes.Dirty(domain.FieldDiscount) && !hasLegacyDiscount(product) {
+		updates[m_product.DiscountPercent] = spanner.NullNumeric{}
+		updates[m_product.DiscountAmountNumerator] = spanner.NullInt64{}
+		updates[m_product.DiscountAmountDenominator] = spanner.NullInt64{}
+		updates[m_product.DiscountAmountCurrency] = spanner.NullString{}
+		updates[m_product.DiscountStartDate] = spanner.NullTime{}
+		updates[m_product.DiscountEndDate] = spanner.NullTime{}
 	}
 
 	if changes.Dirty(domain.FieldStatus) {
@@ -96,7 +114,10 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) (*spanner.Mutation, err
 		}
 	}
 
-	if len(updates) == 0 {
+	// Regional prices, discounts, scheduled price changes and price change requests live in
+	// their own tables, but changing them still bumps the product version
+	if len(updates) == 0 && !changes.Dirty(domain.FieldRegionalPrices) && !changes.Dirty(domain.FieldDiscount) &&
+		!changes.Dirty(domain.FieldPriceChanges) && !changes.Dirty(domain.FieldPriceRequest) {
 		return nil, nil
 	}
 
@@ -109,6 +130,12 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) (*spanner.Mutation, err
 	return r.model.UpdateMut(product.ID(), updates), nil
 }
 
+// DeleteMut creates a mutation for permanently deleting a product.
+// Interleaved child rows are deleted by ON DELETE CASCADE.
+func (r *ProductRepo) DeleteMut(productID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID)
+}
+
 // GetByID retrieves a product by ID, reconstructing the domain aggregate.
 func (r *ProductRepo) GetByID(ctx context.Context, productID string) (*domain.Product, error) {
 	row, err := r.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{
@@ -116,9 +143,17 @@ func (r *ProductRepo) GetByID(ctx context.Context, productID string) (*domain.Pr
 		m_product.Name,
 		m_product.Description,
 		m_product.Category,
+		m_product.TaxClass,
 		m_product.BasePriceNumerator,
 		m_product.BasePriceDenominator,
+		m_product.BasePriceCurrency,
+		m_product.CostPriceNumerator,
+		m_product.CostPriceDenominator,
+		m_product.CostPriceCurrency,
 		m_product.DiscountPercent,
+		m_product.DiscountAmountNumerator,
+		m_product.DiscountAmountDenominator,
+		m_product.DiscountAmountCurrency,
 		m_product.DiscountStartDate,
 		m_product.DiscountEndDate,
 		m_product.Status,
@@ -139,7 +174,135 @@ func (r *ProductRepo) GetByID(ctx context.Context, productID string) (*domain.Pr
 		return nil, fmt.Errorf("failed to parse product: %w", err)
 	}
 
-	return r.dataToDomain(&data)
+	regionalPrices, err := r.readRegionalPrices(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts, err := r.readDiscounts(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceChanges, err := r.readPriceChanges(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceRequest, err := r.readPriceRequest(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.dataToDomain(&data, regionalPrices, discounts, priceChanges, priceRequest)
+}
+
+// readPriceRequest reads the product's pending price change request, nil if there is none.
+func (r *ProductRepo) readPriceRequest(ctx context.Context, productID string) (*domain.PriceChangeRequest, error) {
+	iter := r.client.Single().Read(ctx, m_price_change_request.TableName, spanner.Key{productID}.AsPrefix(),
+		m_price_change_request.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price change request: %w", err)
+	}
+
+	var data m_price_change_request.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse price change request: %w", err)
+	}
+	return dataToPriceChangeRequest(&data)
+}
+
+// readPriceChanges reads the product's pending base price changes.
+func (r *ProductRepo) readPriceChanges(ctx context.Context, productID string) ([]*domain.ScheduledPriceChange, error) {
+	iter := r.client.Single().Read(ctx, m_price_change.TableName, spanner.Key{productID}.AsPrefix(), m_price_change.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	var changes []*domain.ScheduledPriceChange
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read scheduled price changes: %w", err)
+		}
+
+		var data m_price_change.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse scheduled price change: %w", err)
+		}
+		change, err := dataToPriceChange(&data)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// readDiscounts reads the product's discount schedule from the discounts table.
+func (r *ProductRepo) readDiscounts(ctx context.Context, productID string) ([]*domain.Discount, error) {
+	iter := r.client.Single().Read(ctx, m_discount.TableName, spanner.Key{productID}.AsPrefix(), m_discount.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	var discounts []*domain.Discount
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read discounts: %w", err)
+		}
+
+		var data m_discount.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse discount: %w", err)
+		}
+		discount, err := dataToScheduledDiscount(&data)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+
+	return discounts, nil
+}
+
+// readRegionalPrices reads the product's price list keyed by region.
+func (r *ProductRepo) readRegionalPrices(ctx context.Context, productID string) (map[string]*domain.Money, error) {
+	iter := r.client.Single().Read(ctx, m_price_list.TableName, spanner.Key{productID}.AsPrefix(), m_price_list.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	prices := make(map[string]*domain.Money)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price list: %w", err)
+		}
+
+		var data m_price_list.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse price list entry: %w", err)
+		}
+		price, err := domain.NewMoney(data.PriceNumerator, data.PriceDenominator, domain.Currency(data.Currency))
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for region %s: %w", data.Region, err)
+		}
+		prices[data.Region] = price
+	}
+
+	return prices, nil
 }
 
 // Exists checks if a product exists.
@@ -154,6 +317,164 @@ func (r *ProductRepo) Exists(ctx context.Context, productID string) (bool, error
 	return row != nil, nil
 }
 
+// ListWithDueDiscounts returns up to limit IDs of products whose discount schedule is out
+// of date at now. Legacy discounts on the products row count as started, so only their
+// end date is checked.
+func (r *ProductRepo) ListWithDueDiscounts(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT product_id FROM (
+			SELECT %[1]s AS product_id FROM %[2]s
+			WHERE %[3]s < @now OR (%[4]s IS NULL AND %[5]s <= @now)
+			UNION DISTINCT
+			SELECT %[6]s AS product_id FROM %[7]s
+			WHERE %[8]s < @now
+		) ORDER BY product_id LIMIT @limit`,
+			m_discount.ProductID, m_discount.TableName,
+			m_discount.EndDate, m_discount.StartedAt, m_discount.StartDate,
+			m_product.ProductID, m_product.TableName,
+			m_product.DiscountEndDate,
+		),
+		Params: map[string]interface{}{
+			"now":   now,
+			"limit": int64(limit),
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var productIDs []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query due discounts: %w", err)
+		}
+
+		var productID string
+		if err := row.Columns(&productID); err != nil {
+			return nil, fmt.Errorf("failed to parse product ID: %w", err)
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	return productIDs, nil
+}
+
+// ListWithDuePriceChanges returns up to limit IDs of products with a scheduled price
+// change whose effective time has been reached at now.
+func (r *ProductRepo) ListWithDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT DISTINCT %s FROM %s WHERE %s <= @now ORDER BY %s LIMIT @limit`,
+			m_price_change.ProductID, m_price_change.TableName,
+			m_price_change.EffectiveAt,
+			m_price_change.ProductID,
+		),
+		Params: map[string]interface{}{
+			"now":   now,
+			"limit": int64(limit),
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var productIDs []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query due price changes: %w", err)
+		}
+
+		var productID string
+		if err := row.Columns(&productID); err != nil {
+			return nil, fmt.Errorf("failed to parse product ID: %w", err)
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	return productIDs, nil
+}
+
+// ListArchivedBefore returns up to limit archived products archived before cutoff,
+// oldest first, with the number of price history rows each would take with it.
+func (r *ProductRepo) ListArchivedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*contracts.PurgeCandidate, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT p.%[1]s,
+			(SELECT COUNT(*) FROM %[5]s h WHERE h.%[6]s = p.%[1]s) AS price_history_count
+			FROM %[2]s p
+			WHERE p.%[3]s = @status AND p.%[4]s < @cutoff
+			ORDER BY p.%[4]s, p.%[1]s LIMIT @limit`,
+			m_product.ProductID, m_product.TableName,
+			m_product.Status, m_product.ArchivedAt,
+			m_price_history.TableName, m_price_history.ProductID,
+		),
+		Params: map[string]interface{}{
+			"status": string(domain.StatusArchived),
+			"cutoff": cutoff,
+			"limit":  int64(limit),
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var candidates []*contracts.PurgeCandidate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query archived products: %w", err)
+		}
+
+		candidate := &contracts.PurgeCandidate{}
+		if err := row.Columns(&candidate.ProductID, &candidate.PriceHistoryCount); err != nil {
+			return nil, fmt.Errorf("failed to parse archived product: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// CountArchivedBefore counts the products archived before cutoff and their price history rows.
+func (r *ProductRepo) CountArchivedBefore(ctx context.Context, cutoff time.Time) (int64, int64, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT
+			(SELECT COUNT(*) FROM %[1]s WHERE %[2]s = @status AND %[3]s < @cutoff),
+			(SELECT COUNT(*) FROM %[4]s h JOIN %[1]s p ON h.%[5]s = p.%[6]s
+				WHERE p.%[2]s = @status AND p.%[3]s < @cutoff)`,
+			m_product.TableName, m_product.Status, m_product.ArchivedAt,
+			m_price_history.TableName, m_price_history.ProductID, m_product.ProductID,
+		),
+		Params: map[string]interface{}{
+			"status": string(domain.StatusArchived),
+			"cutoff": cutoff,
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count archived products: %w", err)
+	}
+
+	var products, priceHistory int64
+	if err := row.Columns(&products, &priceHistory); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse counts: %w", err)
+	}
+	return products, priceHistory, nil
+}
+
 // domainToData converts a domain Product to database Data.
 func (r *ProductRepo) domainToData(product *domain.Product) (*m_product.Data, error) {
 	// Normalize price to ensure consistent storage (200/2 → 100/1)
@@ -172,20 +493,21 @@ func (r *ProductRepo) domainToData(product *domain.Product) (*m_product.Data, er
 		Name:                 product.Name(),
 		Description:          product.Description(),
 		Category:             product.Category(),
+		TaxClass:             product.TaxClass(),
 		BasePriceNumerator:   num,
 		BasePriceDenominator: denom,
+		BasePriceCurrency:    string(normalizedPrice.Currency()),
 		Status:               string(product.Status()),
 		Version:              product.Version(),
 		CreatedAt:            product.CreatedAt(),
 		UpdatedAt:            product.UpdatedAt(),
 	}
 
-	// Handle discount (nullable)
-	if discount := product.DiscountCopy(); discount != nil { // Use DiscountCopy() instead of deprecated Discount()
-		percentRat := discount.PercentageRat() // Get *big.Rat directly for precision
-		data.DiscountPercent = spanner.NullNumeric{Numeric: *percentRat, Valid: true}
-		data.DiscountStartDate = spanner.NullTime{Time: discount.StartDate(), Valid: true}
-		data.DiscountEndDate = spanner.NullTime{Time: discount.EndDate(), Valid: true}
+	// Handle cost_price (nullable)
+	var err error
+	data.CostPriceNumerator, data.CostPriceDenominator, data.CostPriceCurrency, err = costPriceColumns(product.CostPrice())
+	if err != nil {
+		return nil, err
 	}
 
 	// Handle archived_at (nullable)
@@ -197,25 +519,35 @@ func (r *ProductRepo) domainToData(product *domain.Product) (*m_product.Data, er
 }
 
 // dataToDomain converts database Data to a domain Product.
-func (r *ProductRepo) dataToDomain(data *m_product.Data) (*domain.Product, error) {
-	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator)
+// discounts is the schedule from the discounts table; a legacy discount on the row is added to it.
+func (r *ProductRepo) dataToDomain(
+	data *m_product.Data,
+	regionalPrices map[string]*domain.Money,
+	discounts []*domain.Discount,
+	priceChanges []*domain.ScheduledPriceChange,
+	priceRequest *domain.PriceChangeRequest,
+) (*domain.Product, error) {
+	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator, domain.Currency(data.BasePriceCurrency))
 	if err != nil {
 		return nil, fmt.Errorf("invalid base price: %w", err)
 	}
 
-	var discount *domain.Discount
-	if data.DiscountPercent.Valid {
-		percent, _ := data.DiscountPercent.Numeric.Float64()
-		discount, err = domain.NewDiscount(
-			percent,
-			data.DiscountStartDate.Time,
-			data.DiscountEndDate.Time,
-		)
+	var costPrice *domain.Money
+	if data.CostPriceNumerator.Valid {
+		costPrice, err = domain.NewMoney(data.CostPriceNumerator.Int64, data.CostPriceDenominator.Int64, domain.Currency(data.CostPriceCurrency.StringVal))
 		if err != nil {
-			return nil, fmt.Errorf("invalid discount: %w", err)
+			return nil, fmt.Errorf("invalid cost price: %w", err)
 		}
 	}
 
+	legacy, err := dataToDiscount(data)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil {
+		discounts = append(discounts, legacy)
+	}
+
 	var archivedAt *time.Time
 	if data.ArchivedAt.Valid {
 		archivedAt = &data.ArchivedAt.Time
@@ -227,8 +559,13 @@ func (r *ProductRepo) dataToDomain(data *m_product.Data) (*domain.Product, error
 		data.Name,
 		data.Description,
 		data.Category,
+		data.TaxClass,
 		basePrice,
-		discount,
+		costPrice,
+		regionalPrices,
+		discounts,
+		priceChanges,
+		priceRequest,
 		domain.ProductStatus(data.Status),
 		data.Version,
 		data.CreatedAt,
@@ -237,3 +574,61 @@ func (r *ProductRepo) dataToDomain(data *m_product.Data) (*domain.Product, error
 		r.clock,
 	), nil
 }
+
+// costPriceColumns returns the cost_price column values for a cost price, NULL if it is nil.
+func costPriceColumns(cost *domain.Money) (spanner.NullInt64, spanner.NullInt64, spanner.NullString, error) {
+	if cost == nil {
+		return spanner.NullInt64{}, spanner.NullInt64{}, spanner.NullString{}, nil
+	}
+	num, denom, err := storedMoney(cost)
+	if err != nil {
+		return spanner.NullInt64{}, spanner.NullInt64{}, spanner.NullString{}, fmt.Errorf("cost price exceeds storage capacity: %w", err)
+	}
+	return spanner.NullInt64{Int64: num, Valid: true},
+		spanner.NullInt64{Int64: denom, Valid: true},
+		spanner.NullString{StringVal: string(cost.Currency()), Valid: true},
+		nil
+}
+
+// hasLegacyDiscount reports whether the product's schedule still holds the discount
+// stored on its products row, see dataToDiscount.
+func hasLegacyDiscount(product *domain.Product) bool {
+	for _, d := range product.Discounts() {
+		if d.ID() == product.ID() {
+			return true
+		}
+	}
+	return false
+}
+
+// dataToDiscount reconstructs the discount stored on the products row before discounts
+// moved to their own table, or nil if there is none. Its discount ID is the product ID, and
+// it counts as started at its start date: product.discount.applied announced it.
+func dataToDiscount(data *m_product.Data) (*domain.Discount, error) {
+	switch {
+	case data.DiscountPercent.Valid:
+		discount, err := domain.NewDiscountFromRat(&data.DiscountPercent.Numeric, data.DiscountStartDate.Time, data.DiscountEndDate.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discount: %w", err)
+		}
+		return discount.WithID(data.ProductID).WithStartedAt(data.DiscountStartDate.Time), nil
+
+	case data.DiscountAmountNumerator.Valid:
+		amount, err := domain.NewMoney(
+			data.DiscountAmountNumerator.Int64,
+			data.DiscountAmountDenominator.Int64,
+			domain.Currency(data.DiscountAmountCurrency.StringVal),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discount amount: %w", err)
+		}
+		discount, err := domain.NewFixedAmountDiscount(amount, data.DiscountStartDate.Time, data.DiscountEndDate.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discount: %w", err)
+		}
+		return discount.WithID(data.ProductID).WithStartedAt(data.DiscountStartDate.Time), nil
+
+	default:
+		return nil, nil
+	}
+}
@@ -3,13 +3,22 @@ package repo
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_discount"
+	"github.com/light-bringer/procat-service/internal/models/m_discount_history"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change"
+	"github.com/light-bringer/procat-service/internal/models/m_price_change_request"
+	"github.com/light-bringer/procat-service/internal/models/m_price_history"
+	"github.com/light-bringer/procat-service/internal/models/m_price_list"
 	"github.com/light-bringer/procat-service/internal/models/m_product"
+	"github.com/light-bringer/procat-service/internal/models/m_tax_rate"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/query"
 	"google.golang.org/api/iterator"
@@ -18,28 +27,39 @@ import (
 
 // ReadModelImpl implements ReadModel for Spanner.
 type ReadModelImpl struct {
-	client *spanner.Client
-	clock  clock.Clock
+	client  *spanner.Client
+	clock   clock.Clock
+	pricing *domain.PricingCalculator
 }
 
 // NewReadModel creates a new ReadModel implementation.
-func NewReadModel(client *spanner.Client, clk clock.Clock) contracts.ReadModel {
+// pricing rounds the effective prices of the DTOs.
+func NewReadModel(client *spanner.Client, clk clock.Clock, pricing *domain.PricingCalculator) contracts.ReadModel {
 	return &ReadModelImpl{
-		client: client,
-		clock:  clk,
+		client:  client,
+		clock:   clk,
+		pricing: pricing,
 	}
 }
 
-// GetProductByID retrieves a product DTO by ID.
-func (rm *ReadModelImpl) GetProductByID(ctx context.Context, productID string) (*contracts.ProductDTO, error) {
+// GetProductByID retrieves a product DTO by ID, priced for the market.
+func (rm *ReadModelImpl) GetProductByID(ctx context.Context, productID string, market contracts.Market) (*contracts.ProductDTO, error) {
 	row, err := rm.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{
 		m_product.ProductID,
 		m_product.Name,
 		m_product.Description,
 		m_product.Category,
+		m_product.TaxClass,
 		m_product.BasePriceNumerator,
 		m_product.BasePriceDenominator,
+		m_product.BasePriceCurrency,
+		m_product.CostPriceNumerator,
+		m_product.CostPriceDenominator,
+		m_product.CostPriceCurrency,
 		m_product.DiscountPercent,
+		m_product.DiscountAmountNumerator,
+		m_product.DiscountAmountDenominator,
+		m_product.DiscountAmountCurrency,
 		m_product.DiscountStartDate,
 		m_product.DiscountEndDate,
 		m_product.Status,
@@ -60,7 +80,50 @@ func (rm *ReadModelImpl) GetProductByID(ctx context.Context, productID string) (
 		return nil, fmt.Errorf("failed to parse product: %w", err)
 	}
 
-	return rm.dataToDTO(&data, rm.clock.Now())
+	var regionalPrices map[string]*domain.Money
+	if !market.IsZero() {
+		priceLists, err := rm.readPriceLists(ctx, []string{productID})
+		if err != nil {
+			return nil, err
+		}
+		regionalPrices = priceLists[productID]
+	}
+
+	now := rm.clock.Now()
+	activeDiscounts, err := rm.readActiveDiscounts(ctx, []string{productID}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	taxRates, err := rm.readTaxRates(ctx, market.Region, now)
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := rm.dataToDTO(&data, regionalPrices, activeDiscounts[productID], taxRates, market, now)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := rm.client.Single().Read(ctx, m_price_change_request.TableName, spanner.Key{productID}.AsPrefix(),
+		m_price_change_request.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	row, err = iter.Next()
+	if err != nil && err != iterator.Done {
+		return nil, fmt.Errorf("failed to read price change request: %w", err)
+	}
+	if err == nil {
+		var entry m_price_change_request.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse price change request: %w", err)
+		}
+		if dto.PendingPriceChange, err = dataToPriceChangeRequest(&entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return dto, nil
 }
 
 // ListProducts retrieves a paginated list of products with filtering.
@@ -77,9 +140,17 @@ func (rm *ReadModelImpl) ListProducts(ctx context.Context, filter *contracts.Lis
 			m_product.Name,
 			m_product.Description,
 			m_product.Category,
+			m_product.TaxClass,
 			m_product.BasePriceNumerator,
 			m_product.BasePriceDenominator,
+			m_product.BasePriceCurrency,
+			m_product.CostPriceNumerator,
+			m_product.CostPriceDenominator,
+			m_product.CostPriceCurrency,
 			m_product.DiscountPercent,
+			m_product.DiscountAmountNumerator,
+			m_product.DiscountAmountDenominator,
+			m_product.DiscountAmountCurrency,
 			m_product.DiscountStartDate,
 			m_product.DiscountEndDate,
 			m_product.Status,
@@ -116,8 +187,7 @@ func (rm *ReadModelImpl) ListProducts(ctx context.Context, filter *contracts.Lis
 	iter := rm.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
 
-	now := rm.clock.Now()
-	products := make([]*contracts.ProductDTO, 0, pageSize+1)
+	rows := make([]*m_product.Data, 0, pageSize+1)
 
 	for {
 		row, err := iter.Next()
@@ -132,19 +202,53 @@ func (rm *ReadModelImpl) ListProducts(ctx context.Context, filter *contracts.Lis
 		if err := row.ToStruct(&data); err != nil {
 			return nil, fmt.Errorf("failed to parse product: %w", err)
 		}
+		rows = append(rows, &data)
+	}
 
-		dto, err := rm.dataToDTO(&data, now)
+	nextPageToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextPageToken = strconv.Itoa(offset + pageSize)
+	}
+
+	productIDs := make([]string, len(rows))
+	for idx, data := range rows {
+		productIDs[idx] = data.ProductID
+	}
+
+	// Price lists are only needed to price the page for a market
+	var priceLists map[string]map[string]*domain.Money
+	if !filter.Market.IsZero() && len(rows) > 0 {
+		priceLists, err = rm.readPriceLists(ctx, productIDs)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert to DTO: %w", err)
+			return nil, err
 		}
+	}
 
-		products = append(products, dto)
+	now := rm.clock.Now()
+	var activeDiscounts map[string]*domain.Discount
+	if len(rows) > 0 {
+		activeDiscounts, err = rm.readActiveDiscounts(ctx, productIDs, now)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	nextPageToken := ""
-	if len(products) > pageSize {
-		products = products[:pageSize]
-		nextPageToken = strconv.Itoa(offset + pageSize)
+	var taxRates map[string]*domain.TaxRate
+	if len(rows) > 0 {
+		taxRates, err = rm.readTaxRates(ctx, filter.Market.Region, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	products := make([]*contracts.ProductDTO, 0, len(rows))
+	for _, data := range rows {
+		dto, err := rm.dataToDTO(data, priceLists[data.ProductID], activeDiscounts[data.ProductID], taxRates, filter.Market, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to DTO: %w", err)
+		}
+		products = append(products, dto)
 	}
 
 	totalCount, err := rm.countProducts(ctx, builder)
@@ -159,6 +263,602 @@ func (rm *ReadModelImpl) ListProducts(ctx context.Context, filter *contracts.Lis
 	}, nil
 }
 
+// GetPrices retrieves a product's base price and price list.
+func (rm *ReadModelImpl) GetPrices(ctx context.Context, productID string) (*contracts.PricesDTO, error) {
+	row, err := rm.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{
+		m_product.BasePriceNumerator,
+		m_product.BasePriceDenominator,
+		m_product.BasePriceCurrency,
+	})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	var data m_product.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse product: %w", err)
+	}
+
+	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator, domain.Currency(data.BasePriceCurrency))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base price: %w", err)
+	}
+
+	// Rows are read in primary key order, which sorts them by region
+	iter := rm.client.Single().Read(ctx, m_price_list.TableName, spanner.Key{productID}.AsPrefix(), m_price_list.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	prices := &contracts.PricesDTO{
+		ProductID:      productID,
+		BasePrice:      basePrice,
+		RegionalPrices: []*contracts.RegionalPriceDTO{},
+	}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price list: %w", err)
+		}
+
+		var entry m_price_list.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse price list entry: %w", err)
+		}
+		price, err := domain.NewMoney(entry.PriceNumerator, entry.PriceDenominator, domain.Currency(entry.Currency))
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for region %s: %w", entry.Region, err)
+		}
+
+		prices.RegionalPrices = append(prices.RegionalPrices, &contracts.RegionalPriceDTO{
+			Region:    entry.Region,
+			Price:     price,
+			UpdatedAt: entry.UpdatedAt,
+		})
+	}
+
+	return prices, nil
+}
+
+// ListDiscounts retrieves a product's discount schedule ordered by start date.
+func (rm *ReadModelImpl) ListDiscounts(ctx context.Context, productID string) ([]*contracts.DiscountDTO, error) {
+	row, err := rm.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{
+		m_product.ProductID,
+		m_product.DiscountPercent,
+		m_product.DiscountAmountNumerator,
+		m_product.DiscountAmountDenominator,
+		m_product.DiscountAmountCurrency,
+		m_product.DiscountStartDate,
+		m_product.DiscountEndDate,
+	})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	var data m_product.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse product: %w", err)
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @product_id ORDER BY %s, %s",
+			strings.Join(m_discount.NewModel().ReadColumns(), ", "),
+			m_discount.TableName,
+			m_discount.ProductID,
+			m_discount.StartDate,
+			m_discount.DiscountID,
+		),
+		Params: map[string]interface{}{"product_id": productID},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var discounts []*domain.Discount
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read discounts: %w", err)
+		}
+
+		var entry m_discount.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse discount: %w", err)
+		}
+		discount, err := dataToScheduledDiscount(&entry)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+
+	legacy, err := dataToDiscount(&data)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil {
+		discounts = append(discounts, legacy)
+		sort.SliceStable(discounts, func(i, j int) bool {
+			return discounts[i].StartDate().Before(discounts[j].StartDate())
+		})
+	}
+
+	now := rm.clock.Now()
+	dtos := make([]*contracts.DiscountDTO, 0, len(discounts))
+	for _, discount := range discounts {
+		dtos = append(dtos, contracts.NewDiscountDTO(discount, now))
+	}
+
+	return dtos, nil
+}
+
+// ListPriceChanges retrieves a product's pending base price changes ordered by effective time.
+func (rm *ReadModelImpl) ListPriceChanges(ctx context.Context, productID string) ([]*contracts.PriceChangeDTO, error) {
+	if _, err := rm.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{m_product.ProductID}); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @product_id ORDER BY %s, %s",
+			strings.Join(m_price_change.NewModel().ReadColumns(), ", "),
+			m_price_change.TableName,
+			m_price_change.ProductID,
+			m_price_change.EffectiveAt,
+			m_price_change.PriceChangeID,
+		),
+		Params: map[string]interface{}{"product_id": productID},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	dtos := make([]*contracts.PriceChangeDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read scheduled price changes: %w", err)
+		}
+
+		var data m_price_change.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse scheduled price change: %w", err)
+		}
+		change, err := dataToPriceChange(&data)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, &contracts.PriceChangeDTO{Change: change, CreatedAt: data.CreatedAt})
+	}
+
+	return dtos, nil
+}
+
+// ListTaxRates retrieves the tax rates matching the filter, ordered by region and tax
+// class, latest effective date first.
+func (rm *ReadModelImpl) ListTaxRates(ctx context.Context, filter *contracts.TaxRateFilter) ([]*contracts.TaxRateDTO, error) {
+	builder := query.From(m_tax_rate.TableName).
+		Select(m_tax_rate.NewModel().ReadColumns()...).
+		OrderBy(m_tax_rate.Region, query.Asc).
+		ThenBy(m_tax_rate.TaxClass, query.Asc).
+		ThenBy(m_tax_rate.EffectiveFrom, query.Desc)
+
+	if filter.Region != "" {
+		builder = builder.Where(query.Eq(m_tax_rate.Region, filter.Region))
+	}
+	if filter.TaxClass != "" {
+		builder = builder.Where(query.Eq(m_tax_rate.TaxClass, filter.TaxClass))
+	}
+
+	iter := rm.client.Single().Query(ctx, builder.Build())
+	defer iter.Stop()
+
+	now := rm.clock.Now()
+	current := make(map[string]bool) // Region and tax class pairs whose current rate was seen
+	rates := []*contracts.TaxRateDTO{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tax rates: %w", err)
+		}
+
+		var entry m_tax_rate.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse tax rate: %w", err)
+		}
+		rate, err := dataToTaxRate(&entry)
+		if err != nil {
+			return nil, err
+		}
+
+		// Rates are ordered latest first, so the first one in effect is the current one
+		dto := &contracts.TaxRateDTO{Rate: rate, UpdatedAt: entry.UpdatedAt}
+		key := rate.Region() + "/" + rate.TaxClass()
+		if !current[key] && rate.IsEffectiveAt(now) {
+			dto.Current = true
+			current[key] = true
+		}
+		rates = append(rates, dto)
+	}
+
+	return rates, nil
+}
+
+// GetPriceAt retrieves a product's base and effective price at a point in time. The base
+// price is reconstructed from the price history, the discount from the discount history
+// and, for discounts applied before the history existed, the current schedule. The
+// effective price is rounded under the current rounding policy.
+func (rm *ReadModelImpl) GetPriceAt(ctx context.Context, productID string, at time.Time) (*contracts.PriceAtDTO, error) {
+	row, err := rm.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{
+		m_product.ProductID,
+		m_product.Category,
+		m_product.BasePriceNumerator,
+		m_product.BasePriceDenominator,
+		m_product.BasePriceCurrency,
+		m_product.DiscountPercent,
+		m_product.DiscountAmountNumerator,
+		m_product.DiscountAmountDenominator,
+		m_product.DiscountAmountCurrency,
+		m_product.DiscountStartDate,
+		m_product.DiscountEndDate,
+		m_product.CreatedAt,
+	})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	var data m_product.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse product: %w", err)
+	}
+
+	basePrice, since, err := rm.readBasePriceAt(ctx, &data, at)
+	if err != nil {
+		return nil, err
+	}
+	history, err := rm.readDiscountsAt(ctx, &data, at)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &contracts.PriceAtDTO{
+		ProductID:      productID,
+		At:             at,
+		BasePrice:      basePrice,
+		BasePriceSince: since,
+	}
+
+	// Fixed amounts only apply to base prices in their currency
+	effectivePrice := basePrice
+	if discount := domain.DiscountInEffectAt(history, at); discount != nil && discount.AppliesTo(basePrice) {
+		dto.Discount = contracts.NewDiscountDTO(discount, at)
+		effectivePrice = discount.Apply(basePrice)
+	}
+	dto.EffectivePrice = rm.pricing.RoundPrice(effectivePrice, data.Category)
+
+	return dto, nil
+}
+
+// readBasePriceAt reads the base price a product had at `at` and when it took effect.
+// Rejected changes never took effect and are skipped. Before the first recorded change
+// the product had that change's old price since an unknown time, returned as zero. A
+// product without recorded changes has had its current price since it was created.
+func (rm *ReadModelImpl) readBasePriceAt(ctx context.Context, data *m_product.Data, at time.Time) (*domain.Money, time.Time, error) {
+	latest, err := rm.readBasePriceChange(ctx, data.ProductID, at, false)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if latest != nil {
+		return latest.NewPrice, latest.ChangedAt, nil
+	}
+
+	next, err := rm.readBasePriceChange(ctx, data.ProductID, at, true)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	switch {
+	case next != nil && next.OldPrice != nil:
+		return next.OldPrice, time.Time{}, nil
+	case next != nil, at.Before(data.CreatedAt):
+		// The first change after at set the initial price, so the product did not exist yet
+		return nil, time.Time{}, domain.ErrNoPriceAtTime
+	}
+
+	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator, domain.Currency(data.BasePriceCurrency))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid base price: %w", err)
+	}
+	return basePrice, data.CreatedAt, nil
+}
+
+// readBasePriceChange reads the last base price change that took effect at or before at,
+// or with after set the first one after at. Returns nil if there is none.
+func (rm *ReadModelImpl) readBasePriceChange(ctx context.Context, productID string, at time.Time, after bool) (*contracts.PriceHistoryRecord, error) {
+	cmp, direction := "<=", "DESC"
+	if after {
+		cmp, direction = ">", "ASC"
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @product_id AND %s IS NULL AND (%s IS NULL OR %s != @rejected) AND %s %s @at ORDER BY %s %s, %s %s LIMIT 1",
+			priceHistoryColumnList(),
+			m_price_history.TableName,
+			m_price_history.ProductID,
+			m_price_history.Region,
+			m_price_history.Decision,
+			m_price_history.Decision,
+			m_price_history.ChangedAt, cmp,
+			m_price_history.ChangedAt, direction,
+			m_price_history.HistoryID, direction,
+		),
+		Params: map[string]interface{}{
+			"product_id": productID,
+			"rejected":   string(domain.PriceChangeRejected),
+			"at":         at,
+		},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price history: %w", err)
+	}
+
+	var entry m_price_history.Data
+	if err := row.ToStruct(&entry); err != nil {
+		return nil, fmt.Errorf("failed to parse price history: %w", err)
+	}
+	return dataToPriceHistoryRecord(&entry)
+}
+
+// readDiscountsAt reads the discounts whose period covers at, with when they were in
+// effect. The discount history takes precedence; discounts applied before it existed
+// are taken from the discounts table and the legacy discount on the product row.
+func (rm *ReadModelImpl) readDiscountsAt(ctx context.Context, data *m_product.Data, at time.Time) ([]*domain.AppliedDiscount, error) {
+	params := map[string]interface{}{"product_id": data.ProductID, "at": at}
+
+	historyStmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @product_id AND %s <= @at AND %s >= @at",
+			strings.Join(m_discount_history.NewModel().ReadColumns(), ", "),
+			m_discount_history.TableName,
+			m_discount_history.ProductID,
+			m_discount_history.StartDate,
+			m_discount_history.EndDate,
+		),
+		Params: params,
+	}
+
+	iter := rm.client.Single().Query(ctx, historyStmt)
+	defer iter.Stop()
+
+	var history []*domain.AppliedDiscount
+	recorded := make(map[string]bool)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read discount history: %w", err)
+		}
+
+		var entry m_discount_history.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse discount history: %w", err)
+		}
+		applied, err := dataToAppliedDiscount(&entry)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, applied)
+		recorded[entry.DiscountID] = true
+	}
+
+	scheduleStmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @product_id AND %s <= @at AND %s >= @at",
+			strings.Join(m_discount.NewModel().ReadColumns(), ", "),
+			m_discount.TableName,
+			m_discount.ProductID,
+			m_discount.StartDate,
+			m_discount.EndDate,
+		),
+		Params: params,
+	}
+
+	scheduleIter := rm.client.Single().Query(ctx, scheduleStmt)
+	defer scheduleIter.Stop()
+
+	for {
+		row, err := scheduleIter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read discounts: %w", err)
+		}
+
+		var entry m_discount.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse discount: %w", err)
+		}
+		if recorded[entry.DiscountID] {
+			continue
+		}
+		discount, err := dataToScheduledDiscount(&entry)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, &domain.AppliedDiscount{Discount: discount, AppliedAt: &entry.CreatedAt})
+	}
+
+	legacy, err := dataToDiscount(data)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil && !recorded[legacy.ID()] {
+		history = append(history, &domain.AppliedDiscount{Discount: legacy})
+	}
+
+	return history, nil
+}
+
+// readTaxRates reads the tax rates of a region in force at now, keyed by tax class.
+// It returns nil when no region is given.
+func (rm *ReadModelImpl) readTaxRates(ctx context.Context, region string, now time.Time) (map[string]*domain.TaxRate, error) {
+	if region == "" {
+		return nil, nil
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s = @region AND %s <= @now",
+			strings.Join(m_tax_rate.NewModel().ReadColumns(), ", "),
+			m_tax_rate.TableName,
+			m_tax_rate.Region,
+			m_tax_rate.EffectiveFrom,
+		),
+		Params: map[string]interface{}{"region": region, "now": now},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var rates []*domain.TaxRate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tax rates: %w", err)
+		}
+
+		var entry m_tax_rate.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse tax rate: %w", err)
+		}
+		rate, err := dataToTaxRate(&entry)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return domain.SelectTaxRates(rates, now), nil
+}
+
+// readActiveDiscounts reads the discounts of the given products that are valid at now,
+// keyed by product ID. Schedules never overlap, so each product has at most one.
+func (rm *ReadModelImpl) readActiveDiscounts(ctx context.Context, productIDs []string, now time.Time) (map[string]*domain.Discount, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s FROM %s WHERE %s IN UNNEST(@product_ids) AND %s <= @now AND %s >= @now",
+			strings.Join(m_discount.NewModel().ReadColumns(), ", "),
+			m_discount.TableName,
+			m_discount.ProductID,
+			m_discount.StartDate,
+			m_discount.EndDate,
+		),
+		Params: map[string]interface{}{"product_ids": productIDs, "now": now},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	discounts := make(map[string]*domain.Discount, len(productIDs))
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read discounts: %w", err)
+		}
+
+		var entry m_discount.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse discount: %w", err)
+		}
+		discount, err := dataToScheduledDiscount(&entry)
+		if err != nil {
+			return nil, err
+		}
+		discounts[entry.ProductID] = discount
+	}
+
+	return discounts, nil
+}
+
+// readPriceLists reads the price lists of the given products, keyed by product ID and region.
+func (rm *ReadModelImpl) readPriceLists(ctx context.Context, productIDs []string) (map[string]map[string]*domain.Money, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s WHERE %s IN UNNEST(@product_ids)",
+			m_price_list.ProductID,
+			m_price_list.Region,
+			m_price_list.PriceNumerator,
+			m_price_list.PriceDenominator,
+			m_price_list.Currency,
+			m_price_list.TableName,
+			m_price_list.ProductID,
+		),
+		Params: map[string]interface{}{"product_ids": productIDs},
+	}
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	priceLists := make(map[string]map[string]*domain.Money, len(productIDs))
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price lists: %w", err)
+		}
+
+		var entry m_price_list.Data
+		if err := row.ToStruct(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse price list entry: %w", err)
+		}
+		price, err := domain.NewMoney(entry.PriceNumerator, entry.PriceDenominator, domain.Currency(entry.Currency))
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for region %s: %w", entry.Region, err)
+		}
+
+		if priceLists[entry.ProductID] == nil {
+			priceLists[entry.ProductID] = make(map[string]*domain.Money)
+		}
+		priceLists[entry.ProductID][entry.Region] = price
+	}
+
+	return priceLists, nil
+}
+
 func parsePageToken(token string) (int, error) {
 	if token == "" {
 		return 0, nil
@@ -193,22 +893,29 @@ func (rm *ReadModelImpl) countProducts(ctx context.Context, builder *query.Build
 	return total, nil
 }
 
-// dataToDTO converts database Data to a ProductDTO.
-func (rm *ReadModelImpl) dataToDTO(data *m_product.Data, now time.Time) (*contracts.ProductDTO, error) {
-	// Convert base price to float for display
-	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator)
+// dataToDTO converts database Data to a ProductDTO priced for the market.
+// regionalPrices may be nil when no market is requested. discount is the product's
+// scheduled discount valid at now, if any; a legacy discount on the row is used otherwise.
+// taxRates are the market region's rates in force at now, keyed by tax class.
+func (rm *ReadModelImpl) dataToDTO(data *m_product.Data, regionalPrices map[string]*domain.Money, discount *domain.Discount, taxRates map[string]*domain.TaxRate, market contracts.Market, now time.Time) (*contracts.ProductDTO, error) {
+	basePrice, err := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator, domain.Currency(data.BasePriceCurrency))
 	if err != nil {
 		return nil, fmt.Errorf("invalid base price: %w", err)
 	}
 
-	basePriceFloat, _ := basePrice.Float64()
+	// Convert the market's list price to float for display
+	listPrice, priceRegion := domain.SelectMarketPrice(basePrice, regionalPrices, market.Region, market.Currency)
+	listPriceFloat, _ := listPrice.Float64()
 	dto := &contracts.ProductDTO{
 		ProductID:      data.ProductID,
 		Name:           data.Name,
 		Description:    data.Description,
 		Category:       data.Category,
-		BasePrice:      basePriceFloat,
-		EffectivePrice: basePriceFloat,
+		TaxClass:       data.TaxClass,
+		BasePrice:      listPriceFloat,
+		EffectivePrice: listPriceFloat,
+		Currency:       string(listPrice.Currency()),
+		PriceRegion:    priceRegion,
 		Status:         data.Status,
 		Version:        data.Version,
 		CreatedAt:      data.CreatedAt,
@@ -220,22 +927,36 @@ func (rm *ReadModelImpl) dataToDTO(data *m_product.Data, now time.Time) (*contra
 		dto.ArchivedAt = &data.ArchivedAt.Time
 	}
 
-	// Handle discount
-	if data.DiscountPercent.Valid {
-		percent, _ := data.DiscountPercent.Numeric.Float64()
-		discount, err := domain.NewDiscount(
-			percent, // Changed from Int64 to Float64
-			data.DiscountStartDate.Time,
-			data.DiscountEndDate.Time,
-		)
-		if err == nil && discount.IsValidAt(now) {
-			dto.DiscountPercent = &percent // Changed from Int64 to Float64
-			dto.DiscountActive = true
-			effectivePrice := discount.Apply(basePrice)
-			effectivePriceFloat, _ := effectivePrice.Float64()
-			dto.EffectivePrice = effectivePriceFloat
+	// Handle cost_price
+	if data.CostPriceNumerator.Valid {
+		dto.CostPrice, err = domain.NewMoney(data.CostPriceNumerator.Int64, data.CostPriceDenominator.Int64, domain.Currency(data.CostPriceCurrency.StringVal))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost price: %w", err)
 		}
 	}
 
+	// Handle discount. Fixed amounts only apply to list prices in their currency.
+	if discount == nil {
+		discount, _ = dataToDiscount(data)
+	}
+	effectivePrice := listPrice
+	if discount != nil && discount.IsValidAt(now) && discount.AppliesTo(listPrice) {
+		if discount.IsFixedAmount() {
+			dto.DiscountAmount = discount.Amount()
+		} else {
+			percent := discount.Percentage()
+			dto.DiscountPercent = &percent
+			dto.DiscountPercentExact = discount.ExactPercentage()
+		}
+		dto.DiscountActive = true
+		effectivePrice = discount.Apply(listPrice)
+		effectivePriceFloat, _ := effectivePrice.Float64()
+		dto.EffectivePrice = effectivePriceFloat
+	}
+	dto.RoundedEffectivePrice = rm.pricing.RoundPrice(effectivePrice, data.Category)
+	if rate, ok := taxRates[data.TaxClass]; ok {
+		dto.Tax = rm.pricing.CalculateTax(effectivePrice, rate)
+	}
+
 	return dto, nil
 }
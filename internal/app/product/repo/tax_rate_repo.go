@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_tax_rate"
+)
+
+// TaxRateRepo implements TaxRateRepository for Spanner.
+type TaxRateRepo struct {
+	model *m_tax_rate.Model
+}
+
+// NewTaxRateRepo creates a new TaxRateRepo.
+func NewTaxRateRepo() contracts.TaxRateRepository {
+	return &TaxRateRepo{
+		model: m_tax_rate.NewModel(),
+	}
+}
+
+// UpsertMut creates a mutation that sets a rate.
+func (r *TaxRateRepo) UpsertMut(rate *domain.TaxRate) *spanner.Mutation {
+	return r.model.UpsertMut(&m_tax_rate.Data{
+		Region:           rate.Region(),
+		TaxClass:         rate.TaxClass(),
+		EffectiveFrom:    rate.EffectiveFrom(),
+		RatePercent:      *rate.Percent(),
+		PricesIncludeTax: rate.PricesIncludeTax(),
+	})
+}
+
+// dataToTaxRate converts a stored tax rate to its domain value.
+func dataToTaxRate(data *m_tax_rate.Data) (*domain.TaxRate, error) {
+	rate, err := domain.NewTaxRate(data.Region, data.TaxClass, &data.RatePercent, data.EffectiveFrom.UTC(), data.PricesIncludeTax)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tax rate for %s/%s: %w", data.Region, data.TaxClass, err)
+	}
+	return rate, nil
+}
@@ -2,11 +2,11 @@ package activate_product
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
@@ -70,12 +70,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	}
 
 	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
 			return fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
@@ -91,11 +91,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	return nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
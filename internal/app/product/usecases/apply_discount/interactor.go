@@ -2,110 +2,144 @@ package apply_discount
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
 
-// Request contains the data to apply a discount.
+// Request contains the data to apply a discount. The discount is added to the product's
+// schedule and takes effect at StartDate, which may be in the future.
+// Set DiscountAmount for a fixed-amount discount, DiscountPercentExact for an exact
+// percentage, otherwise DiscountPercent applies.
 type Request struct {
-	ProductID       string
-	Version         int64   // For optimistic locking
-	DiscountPercent float64 // Supports fractional values (e.g., 12.5 for 12.5%)
-	StartDate       time.Time
-	EndDate         time.Time
+	ProductID            string
+	Version              int64         // For optimistic locking
+	DiscountPercent      float64       // Supports fractional values (e.g., 12.5 for 12.5%)
+	DiscountPercentExact *big.Rat      // Exact percentage (e.g., 123/10 for 12.3%), takes precedence over DiscountPercent
+	DiscountAmount       *domain.Money // Fixed amount off the price (e.g., $10 off)
+	StartDate            time.Time
+	EndDate              time.Time
 }
 
 // Interactor handles the apply discount use case.
 type Interactor struct {
-	repo       contracts.ProductRepository
-	outboxRepo contracts.OutboxRepository
-	committer  *committer.Committer
-	clock      clock.Clock
+	repo         contracts.ProductRepository
+	discountRepo contracts.DiscountRepository
+	historyRepo  contracts.DiscountHistoryRepository
+	outboxRepo   contracts.OutboxRepository
+	committer    *committer.Committer
+	clock        clock.Clock
+	pricing      *domain.PricingCalculator // Rounds the effective prices recorded in events
 }
 
 // NewInteractor creates a new apply discount interactor.
 func NewInteractor(
 	repo contracts.ProductRepository,
+	discountRepo contracts.DiscountRepository,
+	historyRepo contracts.DiscountHistoryRepository,
 	outboxRepo contracts.OutboxRepository,
 	committer *committer.Committer,
 	clock clock.Clock,
+	pricing *domain.PricingCalculator,
 ) *Interactor {
 	return &Interactor{
-		repo:       repo,
-		outboxRepo: outboxRepo,
-		committer:  committer,
-		clock:      clock,
+		repo:         repo,
+		discountRepo: discountRepo,
+		historyRepo:  historyRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+		pricing:      pricing,
 	}
 }
 
 // Execute applies a discount to a product following the Golden Mutation Pattern.
-func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+// Returns the ID of the scheduled discount.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error) {
 	// 1. Load aggregate
 	product, err := i.repo.GetByID(ctx, req.ProductID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Note: ClearEvents() is called after successful commit, not in defer
 	// This prevents event loss if the commit fails and the operation is retried
 
 	// 2. Create discount value object
-	discount, err := domain.NewDiscount(req.DiscountPercent, req.StartDate, req.EndDate)
+	var discount *domain.Discount
+	switch {
+	case req.DiscountAmount != nil:
+		discount, err = domain.NewFixedAmountDiscount(req.DiscountAmount, req.StartDate, req.EndDate)
+	case req.DiscountPercentExact != nil:
+		discount, err = domain.NewDiscountFromRat(req.DiscountPercentExact, req.StartDate, req.EndDate)
+	default:
+		discount, err = domain.NewDiscount(req.DiscountPercent, req.StartDate, req.EndDate)
+	}
 	if err != nil {
-		return err
+		return "", err
 	}
+	discount = discount.WithID(uuid.New().String())
 
 	// 3. Call domain method
 	now := i.clock.Now()
-	if err := product.ApplyDiscount(discount, now); err != nil {
-		return err
+	if err := product.ApplyDiscount(discount, now, i.pricing); err != nil {
+		return "", err
 	}
 
 	// 4. Create commit plan
 	plan := committer.NewPlan()
 
-	// 5. Add repository mutation
+	// 5. Add product mutation (bumps version) and discount mutations
 	mut, err := i.repo.UpdateMut(product)
 	if err != nil {
-		return fmt.Errorf("failed to create update mutation: %w", err)
+		return "", fmt.Errorf("failed to create update mutation: %w", err)
 	}
 	if mut != nil {
 		plan.Add(mut)
 	}
 
+	discountMut, err := i.discountRepo.InsertMut(req.ProductID, discount)
+	if err != nil {
+		return "", fmt.Errorf("failed to create discount mutation: %w", err)
+	}
+	plan.Add(discountMut)
+
+	historyMut, err := i.historyRepo.InsertMut(req.ProductID, discount, now)
+	if err != nil {
+		return "", fmt.Errorf("failed to create discount history mutation: %w", err)
+	}
+	plan.Add(historyMut)
+
 	// 6. Add outbox events
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
-			return fmt.Errorf("failed to serialize event: %w", err)
+			return "", fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
 	// 7. Apply plan with optimistic locking
 	// Always enforce version checking to prevent concurrent modification issues
 	if err := i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	// Clear events only after successful commit to prevent loss on retry
 	product.ClearEvents()
 
-	return nil
+	return discount.ID(), nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
@@ -0,0 +1,184 @@
+package apply_price_changes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// DefaultBatchSize bounds the number of products processed per Execute.
+const DefaultBatchSize = 100
+
+// Request configures one run.
+type Request struct {
+	BatchSize int // Maximum products processed; DefaultBatchSize if zero
+}
+
+// Response reports what one run did.
+type Response struct {
+	ProductCount int // Products found with a due price change
+	AppliedCount int // Price changes applied, each with a product.price.changed event
+	HeldCount    int // Price changes that violate the guardrails, held as price change requests
+	DroppedCount int // Price changes superseded or no longer applicable, see Product.ApplyDuePriceChanges
+	FailedCount  int // Products left for the next run, e.g. after a concurrent modification
+}
+
+// Interactor handles the apply price changes use case: it applies scheduled base price
+// changes whose effective time has been reached.
+type Interactor struct {
+	repo             contracts.ProductRepository
+	priceChangeRepo  contracts.PriceChangeRepository
+	priceRequestRepo contracts.PriceChangeRequestRepository
+	priceHistoryRepo contracts.PriceHistoryRepository
+	outboxRepo       contracts.OutboxRepository
+	committer        *committer.Committer
+	clock            clock.Clock
+	pricing          *domain.PricingCalculator // Rounds the effective prices recorded in events
+	guardrails       *domain.PriceGuardrails   // Changes that violate them are held for approval, nil for none
+}
+
+// NewInteractor creates a new apply price changes interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	priceChangeRepo contracts.PriceChangeRepository,
+	priceRequestRepo contracts.PriceChangeRequestRepository,
+	priceHistoryRepo contracts.PriceHistoryRepository,
+	outboxRepo contracts.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+	pricing *domain.PricingCalculator,
+	guardrails *domain.PriceGuardrails,
+) *Interactor {
+	return &Interactor{
+		repo:             repo,
+		priceChangeRepo:  priceChangeRepo,
+		priceRequestRepo: priceRequestRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		outboxRepo:       outboxRepo,
+		committer:        committer,
+		clock:            clock,
+		pricing:          pricing,
+		guardrails:       guardrails,
+	}
+}
+
+// Execute applies the due price changes of one batch of products. Each product is
+// committed on its own with optimistic locking; a product that fails is counted and
+// retried on the next run. The returned error joins the failures.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	// 1. Find products with due price changes
+	now := i.clock.Now()
+	productIDs, err := i.repo.ListWithDuePriceChanges(ctx, now, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Apply each product's changes in its own transaction
+	resp := &Response{ProductCount: len(productIDs)}
+	var errs []error
+	for _, productID := range productIDs {
+		applied, held, dropped, err := i.apply(ctx, productID)
+		if err != nil {
+			resp.FailedCount++
+			errs = append(errs, fmt.Errorf("product %s: %w", productID, err))
+			continue
+		}
+		resp.AppliedCount += applied
+		resp.HeldCount += held
+		resp.DroppedCount += dropped
+	}
+
+	return resp, errors.Join(errs...)
+}
+
+// apply applies one product's due price changes following the Golden Mutation Pattern,
+// writing price history like UpdatePrice and storing held changes as price change requests.
+func (i *Interactor) apply(ctx context.Context, productID string) (int, int, int, error) {
+	// 1. Load aggregate
+	product, err := i.repo.GetByID(ctx, productID)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	oldPrice := product.BasePrice() // Capture old price before change
+	applied, held, dropped := product.ApplyDuePriceChanges(now, i.guardrails, i.pricing)
+	if len(applied) == 0 && len(held) == 0 && len(dropped) == 0 {
+		return 0, 0, 0, nil
+	}
+
+	// 3. Create commit plan
+	plan := committer.NewPlan()
+
+	// 4. Add product mutation (bumps version) and price change mutations
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+	for _, change := range append(append(applied, held...), dropped...) {
+		plan.Add(i.priceChangeRepo.DeleteMut(productID, change.ID()))
+	}
+	if len(held) > 0 {
+		requestMut, err := i.priceRequestRepo.InsertMut(productID, product.PendingPriceChangeRequest())
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to create price change request mutation: %w", err)
+		}
+		plan.Add(requestMut)
+	}
+
+	// 5. Add a price history record per applied change
+	for _, change := range applied {
+		newPrice := change.NewPrice()
+		historyMut, err := i.priceHistoryRepo.InsertMut(
+			uuid.New().String(),
+			productID,
+			"", // base price
+			oldPrice,
+			newPrice,
+			change.ChangedBy(),
+			change.ChangedReason(),
+			now,
+		)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to create price history mutation: %w", err)
+		}
+		plan.Add(historyMut)
+		oldPrice = newPrice
+	}
+
+	// 6. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 7. Apply plan with optimistic locking against the version that was loaded
+	if err := i.committer.ApplyWithVersionCheck(ctx, productID, product.Version(), plan); err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to apply price changes: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return len(applied), len(held), len(dropped), nil
+}
@@ -2,12 +2,14 @@ package archive_product
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
@@ -20,24 +22,39 @@ type Request struct {
 
 // Interactor handles the archive product use case.
 type Interactor struct {
-	repo       contracts.ProductRepository
-	outboxRepo contracts.OutboxRepository
-	committer  *committer.Committer
-	clock      clock.Clock
+	repo             contracts.ProductRepository
+	discountRepo     contracts.DiscountRepository
+	historyRepo      contracts.DiscountHistoryRepository
+	priceChangeRepo  contracts.PriceChangeRepository
+	priceRequestRepo contracts.PriceChangeRequestRepository
+	priceHistoryRepo contracts.PriceHistoryRepository
+	outboxRepo       contracts.OutboxRepository
+	committer        *committer.Committer
+	clock            clock.Clock
 }
 
 // NewInteractor creates a new archive product interactor.
 func NewInteractor(
 	repo contracts.ProductRepository,
+	discountRepo contracts.DiscountRepository,
+	historyRepo contracts.DiscountHistoryRepository,
+	priceChangeRepo contracts.PriceChangeRepository,
+	priceRequestRepo contracts.PriceChangeRequestRepository,
+	priceHistoryRepo contracts.PriceHistoryRepository,
 	outboxRepo contracts.OutboxRepository,
 	committer *committer.Committer,
 	clock clock.Clock,
 ) *Interactor {
 	return &Interactor{
-		repo:       repo,
-		outboxRepo: outboxRepo,
-		committer:  committer,
-		clock:      clock,
+		repo:             repo,
+		discountRepo:     discountRepo,
+		historyRepo:      historyRepo,
+		priceChangeRepo:  priceChangeRepo,
+		priceRequestRepo: priceRequestRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		outboxRepo:       outboxRepo,
+		committer:        committer,
+		clock:            clock,
 	}
 }
 
@@ -53,8 +70,10 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (time.Time, erro
 	// Note: ClearEvents() is called after successful commit, not in defer
 	// This prevents event loss if the commit fails and the operation is retried
 
-	// 2. Call domain method
+	// 2. Call domain method, which rejects a pending price change request
 	now := i.clock.Now()
+	pending := product.PendingPriceChangeRequest()
+	discounts := product.Discounts()
 	if err := product.Archive(now); err != nil {
 		return time.Time{}, err
 	}
@@ -62,7 +81,8 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (time.Time, erro
 	// 3. Create commit plan
 	plan := committer.NewPlan()
 
-	// 4. Add repository mutation
+	// 4. Add product mutation and drop the discount and price change schedules and
+	// the pending price change request, recording the discounts and the rejection
 	mut, err := i.repo.UpdateMut(product)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to create update mutation: %w", err)
@@ -70,14 +90,32 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (time.Time, erro
 	if mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(i.discountRepo.DeleteAllMut(req.ProductID))
+	for _, discount := range discounts {
+		historyMut, err := i.endDiscountMut(req.ProductID, discount, now)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to create discount history mutation: %w", err)
+		}
+		plan.Add(historyMut)
+	}
+	plan.Add(i.priceChangeRepo.DeleteAllMut(req.ProductID))
+	plan.Add(i.priceRequestRepo.DeleteAllMut(req.ProductID))
+	if pending != nil {
+		historyMut, err := i.priceHistoryRepo.InsertDecisionMut(uuid.New().String(), req.ProductID, pending,
+			domain.PriceChangeRejected, "", "product archived", now)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to create price history mutation: %w", err)
+		}
+		plan.Add(historyMut)
+	}
 
 	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
 			return time.Time{}, fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
@@ -93,11 +131,21 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (time.Time, erro
 	return now, nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
-func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
+// endDiscountMut records in the discount history how archiving at now ends a discount:
+// an active discount ends early, one that has not started is forgotten and one awaiting
+// cleanup ran its course.
+func (i *Interactor) endDiscountMut(productID string, discount *domain.Discount, now time.Time) (*spanner.Mutation, error) {
+	switch {
+	case !discount.HasStarted(now):
+		return i.historyRepo.DeleteMut(productID, discount.ID()), nil
+	case discount.HasEnded(now):
+		return i.historyRepo.EndMut(productID, discount, nil)
+	default:
+		return i.historyRepo.EndMut(productID, discount, &now)
 	}
-	return string(data), nil
+}
+
+// serializeEvent converts a domain event to its versioned JSON envelope.
+func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
+	return events.Marshal(event)
 }
@@ -0,0 +1,115 @@
+package cancel_scheduled_discount
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the discount to cancel.
+type Request struct {
+	ProductID  string
+	DiscountID string
+	Version    int64 // For optimistic locking
+}
+
+// Interactor handles the cancel scheduled discount use case.
+type Interactor struct {
+	repo         contracts.ProductRepository
+	discountRepo contracts.DiscountRepository
+	historyRepo  contracts.DiscountHistoryRepository
+	outboxRepo   contracts.OutboxRepository
+	committer    *committer.Committer
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new cancel scheduled discount interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	discountRepo contracts.DiscountRepository,
+	historyRepo contracts.DiscountHistoryRepository,
+	outboxRepo contracts.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:         repo,
+		discountRepo: discountRepo,
+		historyRepo:  historyRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute cancels a discount that has not started yet following the Golden Mutation Pattern.
+func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+	// 1. Validate request
+	if err := i.validate(req); err != nil {
+		return err
+	}
+
+	// 2. Load aggregate
+	product, err := i.repo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Note: ClearEvents() is called after successful commit, not in defer
+	// This prevents event loss if the commit fails and the operation is retried
+
+	// 3. Call domain method
+	if err := product.CancelScheduledDiscount(req.DiscountID, i.clock.Now()); err != nil {
+		return err
+	}
+
+	// 4. Create commit plan
+	plan := committer.NewPlan()
+
+	// 5. Add product mutation (bumps version) and discount mutations. The discount never
+	// took effect, so it is dropped from the discount history too
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(i.discountRepo.DeleteMut(req.ProductID, req.DiscountID))
+	plan.Add(i.historyRepo.DeleteMut(req.ProductID, req.DiscountID))
+
+	// 6. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 7. Apply plan with optimistic locking
+	if err := i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan); err != nil {
+		return fmt.Errorf("failed to cancel discount: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return nil
+}
+
+// validate validates the request.
+func (i *Interactor) validate(req *Request) error {
+	if req.ProductID == "" {
+		return fmt.Errorf("product ID is required")
+	}
+	if req.DiscountID == "" {
+		return fmt.Errorf("discount ID is required")
+	}
+	return nil
+}
@@ -2,12 +2,12 @@ package create_product
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
@@ -17,7 +17,9 @@ type Request struct {
 	Name        string
 	Description string
 	Category    string
+	TaxClass    string // Optional, defaults to domain.DefaultTaxClass
 	BasePrice   *domain.Money
+	CostPrice   *domain.Money // Optional, for the minimum margin price guardrail
 }
 
 // Interactor handles the create product use case.
@@ -57,11 +59,17 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error)
 	productID := uuid.New().String()
 	now := i.clock.Now()
 
-	product, err := domain.NewProduct(
+	taxClass := req.TaxClass
+	if taxClass == "" {
+		taxClass = domain.DefaultTaxClass
+	}
+
+	product, err := domain.NewProductWithTaxClass(
 		productID,
 		req.Name,
 		req.Description,
 		req.Category,
+		taxClass,
 		req.BasePrice,
 		now,
 		i.clock,
@@ -69,6 +77,11 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error)
 	if err != nil {
 		return "", fmt.Errorf("failed to create product: %w", err)
 	}
+	if req.CostPrice != nil {
+		if err := product.SetCostPrice(req.CostPrice); err != nil {
+			return "", fmt.Errorf("failed to create product: %w", err)
+		}
+	}
 
 	// Note: ClearEvents() is called after successful commit, not in defer
 	// This prevents event loss if the commit fails and the operation is retried
@@ -88,6 +101,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error)
 	historyMut, err := i.priceHistoryRepo.InsertMut(
 		historyID,
 		productID,
+		"",  // base price
 		nil, // oldPrice is nil for initial creation
 		req.BasePrice,
 		"system",        // changedBy - system for initial creation
@@ -100,12 +114,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error)
 	plan.Add(historyMut)
 
 	// 6. Add outbox events
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
 			return "", fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version(), idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
@@ -134,11 +148,7 @@ func (i *Interactor) validate(req *Request) error {
 	return nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
@@ -0,0 +1,135 @@
+package purge_archived_products
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// DefaultBatchSize bounds the number of products purged per Execute.
+const DefaultBatchSize = 100
+
+// Request configures one purge.
+type Request struct {
+	ArchivedBefore time.Time // Retention cutoff: products archived before it are purged
+	DryRun         bool      // Count what would be purged without deleting anything
+	BatchSize      int       // Maximum products purged; DefaultBatchSize if zero
+}
+
+// Response reports what one purge did, or would do on a dry run.
+type Response struct {
+	ProductCount      int   // Products found past the cutoff; all of them on a dry run
+	PriceHistoryCount int64 // Price history rows deleted with the purged products, or that would be
+	PurgedCount       int   // Products deleted, zero on a dry run
+	FailedCount       int   // Products left for the next purge, e.g. after a concurrent restore
+}
+
+// Interactor handles the purge archived products use case: it permanently deletes
+// products that were archived before a retention cutoff.
+type Interactor struct {
+	repo       contracts.ProductRepository
+	outboxRepo contracts.OutboxRepository
+	committer  *committer.Committer
+	clock      clock.Clock
+}
+
+// NewInteractor creates a new purge archived products interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	outboxRepo contracts.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:       repo,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+	}
+}
+
+// Execute purges one batch of products archived before the cutoff. Each product is
+// deleted in its own transaction together with its product.purged tombstone; its price
+// history and other child rows go with it through ON DELETE CASCADE. A product that
+// fails is counted and retried on the next purge. The returned error joins the failures.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if req.DryRun {
+		products, priceHistory, err := i.repo.CountArchivedBefore(ctx, req.ArchivedBefore)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{ProductCount: int(products), PriceHistoryCount: priceHistory}, nil
+	}
+
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	// 1. Find products past the retention cutoff
+	candidates, err := i.repo.ListArchivedBefore(ctx, req.ArchivedBefore, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Purge each product in its own transaction
+	resp := &Response{ProductCount: len(candidates)}
+	var errs []error
+	for _, candidate := range candidates {
+		if err := i.purge(ctx, candidate.ProductID, req.ArchivedBefore); err != nil {
+			resp.FailedCount++
+			errs = append(errs, fmt.Errorf("product %s: %w", candidate.ProductID, err))
+			continue
+		}
+		resp.PurgedCount++
+		resp.PriceHistoryCount += candidate.PriceHistoryCount
+	}
+
+	return resp, errors.Join(errs...)
+}
+
+// purge deletes one product following the Golden Mutation Pattern.
+func (i *Interactor) purge(ctx context.Context, productID string, archivedBefore time.Time) error {
+	// 1. Load aggregate
+	product, err := i.repo.GetByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Call domain method, which checks the product is still archived past the cutoff
+	if err := product.Purge(i.clock.Now(), archivedBefore); err != nil {
+		return err
+	}
+
+	// 3. Create commit plan
+	plan := committer.NewPlan()
+
+	// 4. Add delete mutation; child rows are deleted by cascade
+	plan.Add(i.repo.DeleteMut(productID))
+
+	// 5. Add the tombstone event
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 6. Apply plan with optimistic locking, so a product restored meanwhile is kept
+	if err := i.committer.ApplyWithVersionCheck(ctx, productID, product.Version(), plan); err != nil {
+		return fmt.Errorf("failed to purge product: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return nil
+}
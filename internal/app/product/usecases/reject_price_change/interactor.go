@@ -0,0 +1,131 @@
+package reject_price_change
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the price change request to reject.
+type Request struct {
+	ProductID  string
+	RequestID  string
+	RejectedBy string // The requester may reject their own request to withdraw it
+	Reason     string // Optional explanation for the rejection
+	Version    int64  // For optimistic locking
+}
+
+// Interactor handles the reject price change use case.
+type Interactor struct {
+	repo             contracts.ProductRepository
+	priceRequestRepo contracts.PriceChangeRequestRepository
+	outboxRepo       contracts.OutboxRepository
+	priceHistoryRepo contracts.PriceHistoryRepository
+	committer        *committer.Committer
+	clock            clock.Clock
+}
+
+// NewInteractor creates a new reject price change interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	priceRequestRepo contracts.PriceChangeRequestRepository,
+	outboxRepo contracts.OutboxRepository,
+	priceHistoryRepo contracts.PriceHistoryRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:             repo,
+		priceRequestRepo: priceRequestRepo,
+		outboxRepo:       outboxRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		committer:        committer,
+		clock:            clock,
+	}
+}
+
+// Execute discards a pending price change request following the Golden Mutation Pattern.
+// The base price is left unchanged.
+func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+	// 1. Validate request
+	if err := i.validate(req); err != nil {
+		return err
+	}
+
+	// 2. Load aggregate
+	product, err := i.repo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Note: ClearEvents() is called after successful commit, not in defer
+	// This prevents event loss if the commit fails and the operation is retried
+
+	// 3. Call domain method
+	now := i.clock.Now()
+	request := product.PendingPriceChangeRequest() // Capture the request before it is cleared
+	if err := product.RejectPriceChangeRequest(req.RequestID, req.RejectedBy, req.Reason, now); err != nil {
+		return err
+	}
+
+	// 4. Create commit plan
+	plan := committer.NewPlan()
+
+	// 5. Add product mutation (bumps version) and remove the decided request
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(i.priceRequestRepo.DeleteMut(req.ProductID, req.RequestID))
+
+	// 6. Add price history record of the decision
+	historyMut, err := i.priceHistoryRepo.InsertDecisionMut(uuid.New().String(), req.ProductID, request,
+		domain.PriceChangeRejected, req.RejectedBy, req.Reason, now)
+	if err != nil {
+		return fmt.Errorf("failed to create price history mutation: %w", err)
+	}
+	plan.Add(historyMut)
+
+	// 7. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 8. Apply plan with optimistic locking
+	if err := i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan); err != nil {
+		return fmt.Errorf("failed to reject price change: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return nil
+}
+
+// validate validates the request.
+func (i *Interactor) validate(req *Request) error {
+	if req.ProductID == "" {
+		return fmt.Errorf("product ID is required")
+	}
+	if req.RequestID == "" {
+		return fmt.Errorf("request ID is required")
+	}
+	if req.RejectedBy == "" {
+		return fmt.Errorf("rejectedBy is required")
+	}
+	return nil
+}
@@ -2,16 +2,16 @@ package remove_discount
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
 
-// Request contains the product ID to remove discount from.
+// Request contains the product ID to remove the active discount from.
 type Request struct {
 	ProductID string
 	Version   int64 // For optimistic locking
@@ -19,24 +19,33 @@ type Request struct {
 
 // Interactor handles the remove discount use case.
 type Interactor struct {
-	repo       contracts.ProductRepository
-	outboxRepo contracts.OutboxRepository
-	committer  *committer.Committer
-	clock      clock.Clock
+	repo         contracts.ProductRepository
+	discountRepo contracts.DiscountRepository
+	historyRepo  contracts.DiscountHistoryRepository
+	outboxRepo   contracts.OutboxRepository
+	committer    *committer.Committer
+	clock        clock.Clock
+	pricing      *domain.PricingCalculator // Rounds the effective prices recorded in events
 }
 
 // NewInteractor creates a new remove discount interactor.
 func NewInteractor(
 	repo contracts.ProductRepository,
+	discountRepo contracts.DiscountRepository,
+	historyRepo contracts.DiscountHistoryRepository,
 	outboxRepo contracts.OutboxRepository,
 	committer *committer.Committer,
 	clock clock.Clock,
+	pricing *domain.PricingCalculator,
 ) *Interactor {
 	return &Interactor{
-		repo:       repo,
-		outboxRepo: outboxRepo,
-		committer:  committer,
-		clock:      clock,
+		repo:         repo,
+		discountRepo: discountRepo,
+		historyRepo:  historyRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+		pricing:      pricing,
 	}
 }
 
@@ -53,14 +62,16 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 
 	// 2. Call domain method
 	now := i.clock.Now()
-	if err := product.RemoveDiscount(now); err != nil {
+	active := domain.ActiveDiscount(product.Discounts(), now)
+	if err := product.RemoveDiscount(now, i.pricing); err != nil {
 		return err
 	}
 
 	// 3. Create commit plan
 	plan := committer.NewPlan()
 
-	// 4. Add repository mutation
+	// 4. Add product mutation (bumps version) and discount mutations, recording the
+	// removal in the discount history
 	mut, err := i.repo.UpdateMut(product)
 	if err != nil {
 		return fmt.Errorf("failed to create update mutation: %w", err)
@@ -68,14 +79,21 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	if mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(i.discountRepo.DeleteMut(req.ProductID, active.ID()))
+
+	historyMut, err := i.historyRepo.EndMut(req.ProductID, active, &now)
+	if err != nil {
+		return fmt.Errorf("failed to create discount history mutation: %w", err)
+	}
+	plan.Add(historyMut)
 
 	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
 			return fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
@@ -91,11 +109,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	return nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
@@ -0,0 +1,129 @@
+package schedule_price_change
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the data needed to schedule a base price change.
+type Request struct {
+	ProductID     string
+	Version       int64 // For optimistic locking
+	NewPrice      *domain.Money
+	EffectiveAt   time.Time
+	ChangedBy     string // User/system identifier, recorded in price history when applied
+	ChangedReason string // Optional explanation for price change
+}
+
+// Interactor handles the schedule price change use case.
+type Interactor struct {
+	repo            contracts.ProductRepository
+	priceChangeRepo contracts.PriceChangeRepository
+	outboxRepo      contracts.OutboxRepository
+	committer       *committer.Committer
+	clock           clock.Clock
+}
+
+// NewInteractor creates a new schedule price change interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	priceChangeRepo contracts.PriceChangeRepository,
+	outboxRepo contracts.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:            repo,
+		priceChangeRepo: priceChangeRepo,
+		outboxRepo:      outboxRepo,
+		committer:       committer,
+		clock:           clock,
+	}
+}
+
+// Execute schedules a base price change following the Golden Mutation Pattern.
+// Returns the ID of the scheduled price change.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (string, error) {
+	// 1. Validate request
+	if err := i.validate(req); err != nil {
+		return "", err
+	}
+
+	// 2. Load aggregate
+	product, err := i.repo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	// Note: ClearEvents() is called after successful commit, not in defer
+	// This prevents event loss if the commit fails and the operation is retried
+
+	// 3. Create price change value object
+	change, err := domain.NewScheduledPriceChange(req.NewPrice, req.EffectiveAt, req.ChangedBy, req.ChangedReason)
+	if err != nil {
+		return "", err
+	}
+	change = change.WithID(uuid.New().String())
+
+	// 4. Call domain method
+	if err := product.ScheduleBasePriceChange(change, i.clock.Now()); err != nil {
+		return "", err
+	}
+
+	// 5. Create commit plan
+	plan := committer.NewPlan()
+
+	// 6. Add product mutation (bumps version) and price change mutation
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return "", fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+
+	changeMut, err := i.priceChangeRepo.InsertMut(req.ProductID, change)
+	if err != nil {
+		return "", fmt.Errorf("failed to create price change mutation: %w", err)
+	}
+	plan.Add(changeMut)
+
+	// 7. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return "", fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 8. Apply plan with optimistic locking
+	if err := i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan); err != nil {
+		return "", fmt.Errorf("failed to schedule price change: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return change.ID(), nil
+}
+
+// validate validates the request.
+func (i *Interactor) validate(req *Request) error {
+	if req.ProductID == "" {
+		return fmt.Errorf("product ID is required")
+	}
+	if req.ChangedBy == "" {
+		return fmt.Errorf("changedBy is required")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package set_regional_price
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the data needed to set a product's price in a region.
+type Request struct {
+	ProductID     string
+	Version       int64  // For optimistic locking
+	Region        string // Market code, e.g. "DE" or "EU"
+	Price         *domain.Money
+	ChangedBy     string // User/system identifier
+	ChangedReason string // Optional explanation for price change
+}
+
+// Interactor handles the set regional price use case.
+type Interactor struct {
+	repo             contracts.ProductRepository
+	priceListRepo    contracts.PriceListRepository
+	outboxRepo       contracts.OutboxRepository
+	priceHistoryRepo contracts.PriceHistoryRepository
+	committer        *committer.Committer
+	clock            clock.Clock
+}
+
+// NewInteractor creates a new set regional price interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	priceListRepo contracts.PriceListRepository,
+	outboxRepo contracts.OutboxRepository,
+	priceHistoryRepo contracts.PriceHistoryRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:             repo,
+		priceListRepo:    priceListRepo,
+		outboxRepo:       outboxRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		committer:        committer,
+		clock:            clock,
+	}
+}
+
+// Execute sets a product's regional price following the Golden Mutation Pattern.
+func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+	// 1. Validate request
+	region, err := i.validate(req)
+	if err != nil {
+		return err
+	}
+
+	// 2. Load aggregate
+	product, err := i.repo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Note: ClearEvents() is called after successful commit, not in defer
+	// This prevents event loss if the commit fails and the operation is retried
+
+	// 3. Call domain method
+	oldPrice, _ := product.RegionalPrice(region) // nil for a new region
+	now := i.clock.Now()
+	if err := product.SetRegionalPrice(region, req.Price, now); err != nil {
+		return err
+	}
+
+	// 4. Create commit plan
+	plan := committer.NewPlan()
+
+	// 5. Add product mutation (bumps version) and price list mutation
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+
+	priceMut, err := i.priceListRepo.UpsertMut(req.ProductID, region, req.Price)
+	if err != nil {
+		return fmt.Errorf("failed to create price list mutation: %w", err)
+	}
+	plan.Add(priceMut)
+
+	// 6. Add price history record
+	historyMut, err := i.priceHistoryRepo.InsertMut(
+		uuid.New().String(),
+		req.ProductID,
+		region,
+		oldPrice,
+		req.Price,
+		req.ChangedBy,
+		req.ChangedReason,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create price history mutation: %w", err)
+	}
+	plan.Add(historyMut)
+
+	// 7. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 8. Apply plan with optimistic locking
+	if err := i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan); err != nil {
+		return fmt.Errorf("failed to set regional price: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return nil
+}
+
+// validate validates the request and returns the normalized region code.
+func (i *Interactor) validate(req *Request) (string, error) {
+	if req.ProductID == "" {
+		return "", fmt.Errorf("product ID is required")
+	}
+	region, err := domain.ParseRegion(req.Region)
+	if err != nil {
+		return "", err
+	}
+	if req.Price == nil || req.Price.IsNegative() || req.Price.IsZero() {
+		return "", domain.ErrInvalidPrice
+	}
+	if req.ChangedBy == "" {
+		return "", fmt.Errorf("changedBy is required")
+	}
+	return region, nil
+}
@@ -0,0 +1,68 @@
+package set_tax_rate
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the data needed to set a regional tax rate.
+type Request struct {
+	Region           string   // Market code, e.g. "DE" or "US-CA"
+	TaxClass         string   // e.g. "standard" or "reduced"
+	RatePercent      *big.Rat // 0-100, e.g. 19 for 19%
+	EffectiveFrom    time.Time
+	PricesIncludeTax bool // List prices in the region are gross
+}
+
+// Interactor handles the set tax rate use case.
+type Interactor struct {
+	taxRateRepo contracts.TaxRateRepository
+	committer   *committer.Committer
+	clock       clock.Clock
+}
+
+// NewInteractor creates a new set tax rate interactor.
+func NewInteractor(
+	taxRateRepo contracts.TaxRateRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		taxRateRepo: taxRateRepo,
+		committer:   committer,
+		clock:       clock,
+	}
+}
+
+// Execute sets a tax rate and returns it. A zero EffectiveFrom takes effect now.
+// Setting a rate for an existing region, tax class and effective date replaces it;
+// rates with other effective dates are kept so past prices stay explainable.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*domain.TaxRate, error) {
+	// 1. Validate request
+	effectiveFrom := req.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = i.clock.Now().UTC()
+	}
+	rate, err := domain.NewTaxRate(req.Region, req.TaxClass, req.RatePercent, effectiveFrom, req.PricesIncludeTax)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Create commit plan
+	plan := committer.NewPlan()
+	plan.Add(i.taxRateRepo.UpsertMut(rate))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return rate, nil
+}
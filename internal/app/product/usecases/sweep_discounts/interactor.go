@@ -0,0 +1,155 @@
+package sweep_discounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// DefaultBatchSize bounds the number of products swept per Execute.
+const DefaultBatchSize = 100
+
+// Request configures one sweep.
+type Request struct {
+	BatchSize int // Maximum products swept; DefaultBatchSize if zero
+}
+
+// Response reports what one sweep did.
+type Response struct {
+	ProductCount int // Products found with an out-of-date schedule
+	StartedCount int // product.discount.started events emitted
+	ExpiredCount int // product.discount.expired events emitted
+	FailedCount  int // Products left for the next sweep, e.g. after a concurrent modification
+}
+
+// Interactor handles the sweep discounts use case: it announces discounts that have
+// taken effect and removes discounts that have ended.
+type Interactor struct {
+	repo         contracts.ProductRepository
+	discountRepo contracts.DiscountRepository
+	historyRepo  contracts.DiscountHistoryRepository
+	outboxRepo   contracts.OutboxRepository
+	committer    *committer.Committer
+	clock        clock.Clock
+	pricing      *domain.PricingCalculator // Rounds the effective prices recorded in events
+}
+
+// NewInteractor creates a new sweep discounts interactor.
+func NewInteractor(
+	repo contracts.ProductRepository,
+	discountRepo contracts.DiscountRepository,
+	historyRepo contracts.DiscountHistoryRepository,
+	outboxRepo contracts.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+	pricing *domain.PricingCalculator,
+) *Interactor {
+	return &Interactor{
+		repo:         repo,
+		discountRepo: discountRepo,
+		historyRepo:  historyRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+		pricing:      pricing,
+	}
+}
+
+// Execute sweeps one batch of products with due discounts. Each product is committed on
+// its own with optimistic locking; a product that fails is counted and retried on the
+// next sweep. The returned error joins the failures.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	// 1. Find products whose schedule is out of date
+	now := i.clock.Now()
+	productIDs, err := i.repo.ListWithDueDiscounts(ctx, now, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Sweep each product in its own transaction
+	resp := &Response{ProductCount: len(productIDs)}
+	var errs []error
+	for _, productID := range productIDs {
+		started, expired, err := i.sweep(ctx, productID)
+		if err != nil {
+			resp.FailedCount++
+			errs = append(errs, fmt.Errorf("product %s: %w", productID, err))
+			continue
+		}
+		resp.StartedCount += started
+		resp.ExpiredCount += expired
+	}
+
+	return resp, errors.Join(errs...)
+}
+
+// sweep brings one product's schedule up to date following the Golden Mutation Pattern.
+func (i *Interactor) sweep(ctx context.Context, productID string) (int, int, error) {
+	// 1. Load aggregate
+	product, err := i.repo.GetByID(ctx, productID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	started, expired := product.SweepDiscounts(now, i.pricing)
+	if len(started) == 0 && len(expired) == 0 {
+		return 0, 0, nil
+	}
+
+	// 3. Create commit plan
+	plan := committer.NewPlan()
+
+	// 4. Add product mutation (bumps version) and discount mutations, keeping expired
+	// discounts in the discount history
+	mut, err := i.repo.UpdateMut(product)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create update mutation: %w", err)
+	}
+	if mut != nil {
+		plan.Add(mut)
+	}
+	for _, discount := range started {
+		plan.Add(i.discountRepo.MarkStartedMut(productID, discount.ID(), *discount.StartedAt()))
+	}
+	for _, discount := range expired {
+		historyMut, err := i.historyRepo.EndMut(productID, discount, nil)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to create discount history mutation: %w", err)
+		}
+		plan.Add(i.discountRepo.DeleteMut(productID, discount.ID()))
+		plan.Add(historyMut)
+	}
+
+	// 5. Add outbox events
+	for idx, event := range product.DomainEvents() {
+		payload, err := events.Marshal(event)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to serialize event: %w", err)
+		}
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
+		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
+	}
+
+	// 6. Apply plan with optimistic locking against the version that was swept
+	if err := i.committer.ApplyWithVersionCheck(ctx, productID, product.Version(), plan); err != nil {
+		return 0, 0, fmt.Errorf("failed to sweep discounts: %w", err)
+	}
+
+	// Clear events only after successful commit to prevent loss on retry
+	product.ClearEvents()
+
+	return len(started), len(expired), nil
+}
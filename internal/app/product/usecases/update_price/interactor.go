@@ -2,12 +2,12 @@ package update_price
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
@@ -21,109 +21,142 @@ type Request struct {
 	ChangedReason string // Optional explanation for price change
 }
 
+// Response reports whether the price change took effect. A change that violates the price
+// guardrails is held as a price change request for a second approver instead.
+type Response struct {
+	PendingRequestID string   // Set when the change awaits approval
+	Violations       []string // Guardrails the held change violates
+}
+
 // Interactor handles the update price use case.
 type Interactor struct {
 	repo             contracts.ProductRepository
+	priceRequestRepo contracts.PriceChangeRequestRepository
 	outboxRepo       contracts.OutboxRepository
 	priceHistoryRepo contracts.PriceHistoryRepository
 	committer        *committer.Committer
 	clock            clock.Clock
+	pricing          *domain.PricingCalculator // Rounds the effective prices recorded in events
+	guardrails       *domain.PriceGuardrails   // Changes that violate them need approval, nil for none
 }
 
 // NewInteractor creates a new update price interactor.
 func NewInteractor(
 	repo contracts.ProductRepository,
+	priceRequestRepo contracts.PriceChangeRequestRepository,
 	outboxRepo contracts.OutboxRepository,
 	priceHistoryRepo contracts.PriceHistoryRepository,
 	committer *committer.Committer,
 	clock clock.Clock,
+	pricing *domain.PricingCalculator,
+	guardrails *domain.PriceGuardrails,
 ) *Interactor {
 	return &Interactor{
 		repo:             repo,
+		priceRequestRepo: priceRequestRepo,
 		outboxRepo:       outboxRepo,
 		priceHistoryRepo: priceHistoryRepo,
 		committer:        committer,
 		clock:            clock,
+		pricing:          pricing,
+		guardrails:       guardrails,
 	}
 }
 
-// Execute updates a product's price following the Golden Mutation Pattern.
-func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+// Execute updates a product's price following the Golden Mutation Pattern. Changes that
+// violate the price guardrails are stored as a pending price change request instead.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
 	// 1. Validate request
 	if err := i.validate(req); err != nil {
-		return err
+		return nil, err
 	}
 
 	// 2. Load aggregate
 	product, err := i.repo.GetByID(ctx, req.ProductID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Note: ClearEvents() is called after successful commit, not in defer
 	// This prevents event loss if the commit fails and the operation is retried
 
-	// 3. Call domain method
+	// 3. Create commit plan
+	plan := committer.NewPlan()
+	resp := &Response{}
+	now := i.clock.Now()
+
+	// 4. Call domain method, holding the change for approval if it breaks a guardrail
 	oldPrice := product.BasePrice() // Capture old price before change
-	if err := product.SetBasePrice(req.NewPrice); err != nil {
-		return err
-	}
+	if violations := i.guardrails.Check(product, req.NewPrice); len(violations) > 0 {
+		request, err := domain.NewPriceChangeRequest(oldPrice, req.NewPrice, req.ChangedBy, req.ChangedReason, violations, now)
+		if err != nil {
+			return nil, err
+		}
+		request = request.WithID(uuid.New().String())
+		if err := product.RequestBasePriceChange(request, now); err != nil {
+			return nil, err
+		}
 
-	// 4. Create commit plan
-	plan := committer.NewPlan()
+		requestMut, err := i.priceRequestRepo.InsertMut(req.ProductID, request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create price change request mutation: %w", err)
+		}
+		plan.Add(requestMut)
+
+		resp.PendingRequestID = request.ID()
+		resp.Violations = violations
+	} else {
+		if err := product.SetBasePrice(req.NewPrice, i.pricing); err != nil {
+			return nil, err
+		}
+
+		// Add price history record
+		historyMut, err := i.priceHistoryRepo.InsertMut(
+			uuid.New().String(),
+			req.ProductID,
+			"", // base price
+			oldPrice,
+			req.NewPrice,
+			req.ChangedBy,
+			req.ChangedReason,
+			now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create price history mutation: %w", err)
+		}
+		plan.Add(historyMut)
+	}
 
 	// 5. Add repository mutation (only if changes exist)
 	mut, err := i.repo.UpdateMut(product)
 	if err != nil {
-		return fmt.Errorf("failed to create update mutation: %w", err)
+		return nil, fmt.Errorf("failed to create update mutation: %w", err)
 	}
 	if mut != nil {
 		plan.Add(mut)
 	}
 
-	// 6. Add price history record
-	historyID := uuid.New().String()
-	now := i.clock.Now()
-	historyMut, err := i.priceHistoryRepo.InsertMut(
-		historyID,
-		req.ProductID,
-		oldPrice,
-		req.NewPrice,
-		req.ChangedBy,
-		req.ChangedReason,
-		now,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create price history mutation: %w", err)
-	}
-	plan.Add(historyMut)
-
-	// 7. Add outbox events
-	for _, event := range product.DomainEvents() {
+	// 6. Add outbox events
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
-			return fmt.Errorf("failed to serialize event: %w", err)
+			return nil, fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
-	// 8. Apply plan
-	if plan.IsEmpty() {
-		return nil // No changes
-	}
-
-	// 6. Execute transaction with optimistic locking
+	// 7. Execute transaction with optimistic locking
 	// Always enforce optimistic locking for UpdatePrice
 	err = i.committer.ApplyWithVersionCheck(ctx, req.ProductID, req.Version, plan)
 	if err != nil {
-		return fmt.Errorf("failed to update price: %w", err)
+		return nil, fmt.Errorf("failed to update price: %w", err)
 	}
 
 	// Clear events only after successful commit to prevent loss on retry
 	product.ClearEvents()
 
-	return nil
+	return resp, nil
 }
 
 // validate validates the request.
@@ -140,11 +173,7 @@ func (i *Interactor) validate(req *Request) error {
 	return nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
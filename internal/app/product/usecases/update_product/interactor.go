@@ -2,11 +2,11 @@ package update_product
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/events"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 )
@@ -14,10 +14,12 @@ import (
 // Request contains the data to update a product.
 type Request struct {
 	ProductID   string
-	Version     int64   // For optimistic locking
-	Name        *string // nil = no change
-	Description *string // nil = no change
-	Category    *string // nil = no change
+	Version     int64         // For optimistic locking
+	Name        *string       // nil = no change
+	Description *string       // nil = no change
+	Category    *string       // nil = no change
+	TaxClass    *string       // nil = no change
+	CostPrice   *domain.Money // nil = no change
 }
 
 // Interactor handles the update product use case.
@@ -78,6 +80,20 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 		hasChanges = true
 	}
 
+	if req.TaxClass != nil {
+		if err := product.SetTaxClass(*req.TaxClass); err != nil {
+			return err
+		}
+		hasChanges = true
+	}
+
+	if req.CostPrice != nil {
+		if err := product.SetCostPrice(req.CostPrice); err != nil {
+			return err
+		}
+		hasChanges = true
+	}
+
 	// Emit a single ProductUpdatedEvent for all changes
 	if hasChanges {
 		product.MarkUpdated(i.clock.Now())
@@ -96,12 +112,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	}
 
 	// 5. Add outbox events (standard pattern - same as activate, apply_discount, etc.)
-	for _, event := range product.DomainEvents() {
+	for idx, event := range product.DomainEvents() {
 		payload, err := i.serializeEvent(event)
 		if err != nil {
 			return fmt.Errorf("failed to serialize event: %w", err)
 		}
-		outboxEvent := i.outboxRepo.EnrichEvent(event, payload)
+		outboxEvent := i.outboxRepo.EnrichEvent(event, payload, contracts.EventSequence(product.Version()+1, idx))
 		plan.Add(i.outboxRepo.InsertMut(outboxEvent))
 	}
 
@@ -117,11 +133,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) error {
 	return nil
 }
 
-// serializeEvent converts a domain event to JSON payload.
+// serializeEvent converts a domain event to its versioned JSON envelope.
 func (i *Interactor) serializeEvent(event domain.DomainEvent) (string, error) {
-	data, err := json.Marshal(event)
-	if err != nil {
-		return "", err
-	}
-	return string(data), nil
+	return events.Marshal(event)
 }
@@ -0,0 +1,46 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+)
+
+// Delivery is a due webhook delivery with everything needed to send it.
+type Delivery struct {
+	SubscriptionID string
+	URL            string
+	Secret         string
+	AttemptCount   int64 // Attempts made before this one
+	Message        *outboxcontracts.Message
+}
+
+// AttemptResult is one entry of the delivery-attempt log.
+type AttemptResult struct {
+	AttemptNumber int64
+	AttemptedAt   time.Time
+	StatusCode    int // 0 if no response was received
+	Error         string
+	Duration      time.Duration
+}
+
+// DeliveryRepository defines the webhook delivery queue operations.
+type DeliveryRepository interface {
+	// Enqueue creates pending deliveries of an event for the given subscriptions.
+	// Deliveries that already exist are left untouched, so re-publishing an event
+	// (the outbox relay is at-least-once) never duplicates a delivery.
+	Enqueue(ctx context.Context, msg *outboxcontracts.Message, subscriptionIDs []string, now time.Time) error
+
+	// ClaimDue leases up to limit due deliveries of active subscriptions until leaseUntil.
+	ClaimDue(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*Delivery, error)
+
+	// MarkDelivered records a successful attempt.
+	MarkDelivered(ctx context.Context, delivery *Delivery, attempt *AttemptResult) error
+
+	// ScheduleRetry records a failed attempt and schedules the next one.
+	ScheduleRetry(ctx context.Context, delivery *Delivery, attempt *AttemptResult, nextAttemptAt time.Time) error
+
+	// MarkFailed records the final failed attempt.
+	MarkFailed(ctx context.Context, delivery *Delivery, attempt *AttemptResult) error
+}
@@ -0,0 +1,60 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// SubscriptionDTO is a data transfer object for subscription queries.
+// The signing secret is never exposed by queries.
+type SubscriptionDTO struct {
+	SubscriptionID string
+	URL            string
+	EventTypes     []string
+	Active         bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// AttemptDTO is a data transfer object for one delivery attempt.
+type AttemptDTO struct {
+	AttemptNumber int64
+	AttemptedAt   time.Time
+	StatusCode    int64 // 0 if no response was received
+	Error         string
+	DurationMs    int64
+}
+
+// DeliveryDTO is a data transfer object for a delivery and its attempt log.
+type DeliveryDTO struct {
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Status         string
+	AttemptCount   int64
+	NextAttemptAt  *time.Time
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+	Attempts       []*AttemptDTO
+}
+
+// DeliveryFilter defines filtering options for listing deliveries.
+type DeliveryFilter struct {
+	SubscriptionID string
+	EventID        string // Optional
+	Status         string // Optional
+	Limit          int
+}
+
+// ReadModel defines the interface for webhook queries.
+type ReadModel interface {
+	// GetSubscription retrieves a subscription DTO by ID
+	GetSubscription(ctx context.Context, subscriptionID string) (*SubscriptionDTO, error)
+
+	// ListSubscriptions retrieves all subscriptions
+	ListSubscriptions(ctx context.Context) ([]*SubscriptionDTO, error)
+
+	// ListDeliveries retrieves deliveries of a subscription with their attempt log
+	ListDeliveries(ctx context.Context, filter *DeliveryFilter) ([]*DeliveryDTO, error)
+}
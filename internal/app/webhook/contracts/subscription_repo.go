@@ -0,0 +1,26 @@
+package contracts
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+)
+
+// SubscriptionRepository defines the interface for subscription persistence.
+type SubscriptionRepository interface {
+	// InsertMut creates a mutation for inserting a new subscription
+	InsertMut(sub *domain.Subscription) *spanner.Mutation
+
+	// UpdateMut creates a mutation for updating an existing subscription
+	UpdateMut(sub *domain.Subscription) *spanner.Mutation
+
+	// DeleteMut creates a mutation for deleting a subscription and its deliveries
+	DeleteMut(subscriptionID string) *spanner.Mutation
+
+	// GetByID retrieves a subscription by ID
+	GetByID(ctx context.Context, subscriptionID string) (*domain.Subscription, error)
+
+	// ListActive retrieves all active subscriptions
+	ListActive(ctx context.Context) ([]*domain.Subscription, error)
+}
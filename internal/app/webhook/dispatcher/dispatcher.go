@@ -0,0 +1,193 @@
+// Package dispatcher sends queued webhook deliveries to subscriber endpoints.
+package dispatcher
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	"github.com/light-bringer/procat-service/internal/app/outbox/relay"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// Delivery request headers.
+const (
+	HeaderSignature = "X-Procat-Signature"
+	HeaderTimestamp = "X-Procat-Timestamp"
+	HeaderEventID   = "X-Procat-Event-Id"
+	HeaderEventType = "X-Procat-Event-Type"
+	HeaderAttempt   = "X-Procat-Delivery-Attempt"
+)
+
+// maxErrorLength matches the error and last_error column sizes.
+const maxErrorLength = 1000
+
+// Config holds dispatcher settings.
+type Config struct {
+	BatchSize      int           // Maximum deliveries claimed per poll
+	PollInterval   time.Duration // Sleep between polls when nothing is due
+	LeaseDuration  time.Duration // How long a claimed delivery stays reserved; must exceed RequestTimeout
+	RequestTimeout time.Duration // Per-request timeout
+	Source         string        // CloudEvents source attribute
+	Retry          relay.RetryPolicy
+}
+
+// DefaultConfig returns sensible defaults for the dispatcher.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:      50,
+		PollInterval:   time.Second,
+		LeaseDuration:  time.Minute,
+		RequestTimeout: 10 * time.Second,
+		Source:         cloudevents.DefaultSource,
+		Retry: relay.RetryPolicy{
+			MaxRetries:     12,
+			InitialBackoff: 5 * time.Second,
+			MaxBackoff:     time.Hour,
+		},
+	}
+}
+
+// Dispatcher POSTs due deliveries as signed CloudEvents and records every attempt.
+type Dispatcher struct {
+	repo   contracts.DeliveryRepository
+	client *http.Client
+	clock  clock.Clock
+	config Config
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(repo contracts.DeliveryRepository, clk clock.Clock, config Config) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: config.RequestTimeout},
+		clock:  clk,
+		config: config,
+	}
+}
+
+// Run polls for due deliveries until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		processed, err := d.ProcessBatch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Webhook dispatcher batch failed: %v", err)
+		}
+
+		if err == nil && processed > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(d.config.PollInterval):
+		}
+	}
+}
+
+// ProcessBatch claims one batch of due deliveries and attempts each of them.
+// Returns the number of deliveries claimed.
+func (d *Dispatcher) ProcessBatch(ctx context.Context) (int, error) {
+	now := d.clock.Now()
+
+	deliveries, err := d.repo.ClaimDue(ctx, d.config.BatchSize, now, now.Add(d.config.LeaseDuration))
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := d.deliver(ctx, delivery); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(deliveries), errors.Join(errs...)
+}
+
+// deliver makes one attempt and records its outcome.
+// The returned error is only for bookkeeping failures; HTTP failures are recorded
+// in the attempt log.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *contracts.Delivery) error {
+	attempt := &contracts.AttemptResult{
+		AttemptNumber: delivery.AttemptCount + 1,
+		AttemptedAt:   d.clock.Now(),
+	}
+
+	start := time.Now()
+	statusCode, err := d.send(ctx, delivery, attempt)
+	attempt.Duration = time.Since(start)
+	attempt.StatusCode = statusCode
+
+	if err == nil {
+		return d.repo.MarkDelivered(ctx, delivery, attempt)
+	}
+
+	attempt.Error = truncate(err.Error(), maxErrorLength)
+	msg := delivery.Message
+
+	if attempt.AttemptNumber >= d.config.Retry.MaxRetries {
+		log.Printf("Webhook delivery of %s to subscription %s failed permanently after %d attempts: %v",
+			msg.EventID, delivery.SubscriptionID, attempt.AttemptNumber, err)
+		return d.repo.MarkFailed(ctx, delivery, attempt)
+	}
+
+	nextAttempt := d.clock.Now().Add(d.config.Retry.Backoff(attempt.AttemptNumber))
+	log.Printf("Webhook delivery of %s to subscription %s failed (attempt %d), retrying at %s: %v",
+		msg.EventID, delivery.SubscriptionID, attempt.AttemptNumber, nextAttempt.Format(time.RFC3339), err)
+
+	return d.repo.ScheduleRetry(ctx, delivery, attempt, nextAttempt)
+}
+
+// send POSTs the signed CloudEvent and returns the response status code, if any.
+func (d *Dispatcher) send(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult) (int, error) {
+	body, err := cloudevents.FromMessage(delivery.Message, d.config.Source).MarshalStructured()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode CloudEvent: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := attempt.AttemptedAt
+	req.Header.Set("Content-Type", cloudevents.StructuredContentType)
+	req.Header.Set(HeaderSignature, domain.Sign(delivery.Secret, timestamp, body))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderEventID, delivery.Message.EventID)
+	req.Header.Set(HeaderEventType, delivery.Message.EventType)
+	req.Header.Set(HeaderAttempt, strconv.FormatInt(attempt.AttemptNumber, 10))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to deliver event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
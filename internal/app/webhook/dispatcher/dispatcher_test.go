@@ -0,0 +1,248 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/cloudevents"
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/relay"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// fakeDelivery is the in-memory state of one delivery.
+type fakeDelivery struct {
+	delivery      *contracts.Delivery
+	status        string
+	nextAttemptAt time.Time
+	attempts      []*contracts.AttemptResult
+}
+
+// fakeRepo is an in-memory DeliveryRepository.
+type fakeRepo struct {
+	deliveries []*fakeDelivery
+}
+
+func (r *fakeRepo) add(url, eventID string) *fakeDelivery {
+	d := &fakeDelivery{
+		delivery: &contracts.Delivery{
+			SubscriptionID: "sub-1",
+			URL:            url,
+			Secret:         testSecret,
+			Message: &outboxcontracts.Message{
+				EventID:     eventID,
+				EventType:   "product.created",
+				AggregateID: "product-1",
+				Payload:     []byte(`{"schema_version":1}`),
+				CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		status: "pending",
+	}
+	r.deliveries = append(r.deliveries, d)
+	return d
+}
+
+func (r *fakeRepo) get(delivery *contracts.Delivery) *fakeDelivery {
+	for _, d := range r.deliveries {
+		if d.delivery.Message.EventID == delivery.Message.EventID {
+			return d
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) Enqueue(ctx context.Context, msg *outboxcontracts.Message, subscriptionIDs []string, now time.Time) error {
+	return nil
+}
+
+func (r *fakeRepo) ClaimDue(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*contracts.Delivery, error) {
+	var claimed []*contracts.Delivery
+	for _, d := range r.deliveries {
+		if d.status == "pending" && !d.nextAttemptAt.After(now) && len(claimed) < limit {
+			d.nextAttemptAt = leaseUntil
+			copied := *d.delivery
+			copied.AttemptCount = int64(len(d.attempts))
+			claimed = append(claimed, &copied)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *fakeRepo) MarkDelivered(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult) error {
+	d := r.get(delivery)
+	d.status = "delivered"
+	d.attempts = append(d.attempts, attempt)
+	return nil
+}
+
+func (r *fakeRepo) ScheduleRetry(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult, nextAttemptAt time.Time) error {
+	d := r.get(delivery)
+	d.nextAttemptAt = nextAttemptAt
+	d.attempts = append(d.attempts, attempt)
+	return nil
+}
+
+func (r *fakeRepo) MarkFailed(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult) error {
+	d := r.get(delivery)
+	d.status = "failed"
+	d.attempts = append(d.attempts, attempt)
+	return nil
+}
+
+// receiver is an httptest endpoint that verifies signatures.
+type receiver struct {
+	mu       sync.Mutex
+	status   int // Response status for valid requests
+	received []*cloudevents.Event
+	invalid  int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	unix, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if !domain.VerifySignature(testSecret, time.Unix(unix, 0), body, r.Header.Get(HeaderSignature)) {
+		rc.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var event cloudevents.Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	rc.received = append(rc.received, &event)
+	w.WriteHeader(rc.status)
+}
+
+func testConfig() Config {
+	config := DefaultConfig()
+	config.Retry = relay.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+	return config
+}
+
+func TestDispatcher_ProcessBatch(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("delivers signed CloudEvent", func(t *testing.T) {
+		rc := &receiver{status: http.StatusOK}
+		server := httptest.NewServer(rc)
+		defer server.Close()
+
+		repo := &fakeRepo{}
+		d := repo.add(server.URL, "event-1")
+		clk := clock.NewMockClock(start)
+
+		n, err := NewDispatcher(repo, clk, testConfig()).ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		require.Len(t, rc.received, 1)
+		assert.Zero(t, rc.invalid)
+		assert.Equal(t, "event-1", rc.received[0].ID)
+		assert.Equal(t, "product.created", rc.received[0].Type)
+		assert.Equal(t, "product-1", rc.received[0].Subject)
+
+		assert.Equal(t, "delivered", d.status)
+		require.Len(t, d.attempts, 1)
+		assert.Equal(t, int64(1), d.attempts[0].AttemptNumber)
+		assert.Equal(t, http.StatusOK, d.attempts[0].StatusCode)
+		assert.Empty(t, d.attempts[0].Error)
+	})
+
+	t.Run("retries with exponential backoff then fails", func(t *testing.T) {
+		rc := &receiver{status: http.StatusServiceUnavailable}
+		server := httptest.NewServer(rc)
+		defer server.Close()
+
+		repo := &fakeRepo{}
+		d := repo.add(server.URL, "event-1")
+		clk := clock.NewMockClock(start)
+		dispatcher := NewDispatcher(repo, clk, testConfig())
+
+		_, err := dispatcher.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", d.status)
+		assert.Equal(t, start.Add(time.Second), d.nextAttemptAt)
+
+		// Not due yet
+		n, err := dispatcher.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+
+		clk.Advance(time.Second)
+		_, err = dispatcher.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, start.Add(3*time.Second), d.nextAttemptAt)
+
+		clk.Advance(2 * time.Second)
+		_, err = dispatcher.ProcessBatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "failed", d.status)
+
+		require.Len(t, d.attempts, 3)
+		for i, attempt := range d.attempts {
+			assert.Equal(t, int64(i+1), attempt.AttemptNumber)
+			assert.Equal(t, http.StatusServiceUnavailable, attempt.StatusCode)
+			assert.Contains(t, attempt.Error, "503")
+		}
+	})
+
+	t.Run("records connection errors", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		repo := &fakeRepo{}
+		d := repo.add(url, "event-1")
+
+		_, err := NewDispatcher(repo, clock.NewMockClock(start), testConfig()).ProcessBatch(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, d.attempts, 1)
+		assert.Zero(t, d.attempts[0].StatusCode)
+		assert.Contains(t, d.attempts[0].Error, "failed to deliver event")
+	})
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	rc := &receiver{status: http.StatusNoContent}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	repo := &fakeRepo{}
+	repo.add(server.URL, "event-1")
+	repo.add(server.URL, "event-2")
+
+	config := testConfig()
+	config.PollInterval = 10 * time.Millisecond
+	dispatcher := NewDispatcher(repo, clock.NewMockClock(time.Now()), config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, dispatcher.Run(ctx))
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	assert.Len(t, rc.received, 2)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+// Domain errors for webhook subscriptions.
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidURL           = errors.New("webhook url must be an absolute http or https url")
+	ErrInvalidEventFilter   = errors.New("invalid event type filter")
+	ErrSecretTooShort       = errors.New("webhook secret must be at least 16 characters")
+)
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignaturePrefix identifies the signing algorithm in the signature header value.
+const SignaturePrefix = "sha256="
+
+// Sign computes the HMAC-SHA256 signature of a delivery.
+// The signed content is "<unix seconds>.<body>", so receivers can reject replays
+// by checking the timestamp header against their own clock.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature produced by Sign in constant time.
+func VerifySignature(secret string, timestamp time.Time, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, SignaturePrefix) {
+		return false
+	}
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	timestamp := time.Unix(1767225600, 0)
+	body := []byte(`{"id":"event-1"}`)
+
+	t.Run("produces stable sha256 signature", func(t *testing.T) {
+		sig := Sign("0123456789abcdef", timestamp, body)
+		assert.Equal(t, "sha256=", sig[:7])
+		assert.Len(t, sig, 7+64)
+		assert.Equal(t, sig, Sign("0123456789abcdef", timestamp, body))
+	})
+
+	t.Run("verifies matching signature", func(t *testing.T) {
+		sig := Sign("0123456789abcdef", timestamp, body)
+		assert.True(t, VerifySignature("0123456789abcdef", timestamp, body, sig))
+	})
+
+	t.Run("rejects tampered body, timestamp or secret", func(t *testing.T) {
+		sig := Sign("0123456789abcdef", timestamp, body)
+		assert.False(t, VerifySignature("0123456789abcdef", timestamp, []byte(`{"id":"event-2"}`), sig))
+		assert.False(t, VerifySignature("0123456789abcdef", timestamp.Add(time.Second), body, sig))
+		assert.False(t, VerifySignature("fedcba9876543210", timestamp, body, sig))
+		assert.False(t, VerifySignature("0123456789abcdef", timestamp, body, sig[7:]))
+	})
+}
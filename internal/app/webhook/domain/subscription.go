@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MinSecretLength is the minimum length of a caller-supplied signing secret.
+const MinSecretLength = 16
+
+// wildcardSuffix marks a prefix filter, e.g. "product.discount.*".
+const wildcardSuffix = ".*"
+
+// Subscription is a partner endpoint receiving product events over HTTP.
+type Subscription struct {
+	id         string
+	url        string
+	eventTypes []string // Empty = all event types
+	secret     string
+	active     bool
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewSubscription creates an active subscription.
+// If secret is empty a random one is generated.
+func NewSubscription(id, rawURL string, eventTypes []string, secret string, now time.Time) (*Subscription, error) {
+	s := &Subscription{
+		id:        id,
+		active:    true,
+		createdAt: now,
+		updatedAt: now,
+	}
+
+	if err := s.SetURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := s.SetEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		generated, err := GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+	if err := s.SetSecret(secret); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ReconstructSubscription rebuilds a subscription from storage (used by the repository).
+func ReconstructSubscription(id, rawURL string, eventTypes []string, secret string, active bool, createdAt, updatedAt time.Time) *Subscription {
+	return &Subscription{
+		id:         id,
+		url:        rawURL,
+		eventTypes: eventTypes,
+		secret:     secret,
+		active:     active,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// GenerateSecret returns a random 32-byte hex signing secret.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Getters
+func (s *Subscription) ID() string           { return s.id }
+func (s *Subscription) URL() string          { return s.url }
+func (s *Subscription) EventTypes() []string { return append([]string(nil), s.eventTypes...) }
+func (s *Subscription) Secret() string       { return s.secret }
+func (s *Subscription) Active() bool         { return s.active }
+func (s *Subscription) CreatedAt() time.Time { return s.createdAt }
+func (s *Subscription) UpdatedAt() time.Time { return s.updatedAt }
+
+// SetURL validates and sets the endpoint URL.
+func (s *Subscription) SetURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	s.url = rawURL
+	return nil
+}
+
+// SetEventTypes validates and sets the event type filters.
+// Filters are exact event types ("product.created") or prefixes ending in ".*"
+// ("product.discount.*"). An empty list matches every event.
+func (s *Subscription) SetEventTypes(eventTypes []string) error {
+	filters := make([]string, 0, len(eventTypes))
+	seen := make(map[string]bool, len(eventTypes))
+	for _, filter := range eventTypes {
+		filter = strings.TrimSpace(filter)
+		if filter == "" || filter == wildcardSuffix || strings.Contains(strings.TrimSuffix(filter, wildcardSuffix), "*") {
+			return fmt.Errorf("%w: %q", ErrInvalidEventFilter, filter)
+		}
+		if !seen[filter] {
+			seen[filter] = true
+			filters = append(filters, filter)
+		}
+	}
+	s.eventTypes = filters
+	return nil
+}
+
+// SetSecret validates and sets the signing secret.
+func (s *Subscription) SetSecret(secret string) error {
+	if len(secret) < MinSecretLength {
+		return ErrSecretTooShort
+	}
+	s.secret = secret
+	return nil
+}
+
+// SetActive pauses or resumes deliveries.
+func (s *Subscription) SetActive(active bool) {
+	s.active = active
+}
+
+// MarkUpdated sets the update timestamp.
+func (s *Subscription) MarkUpdated(now time.Time) {
+	s.updatedAt = now
+}
+
+// Matches reports whether the subscription wants events of the given type.
+func (s *Subscription) Matches(eventType string) bool {
+	if len(s.eventTypes) == 0 {
+		return true
+	}
+	for _, filter := range s.eventTypes {
+		if strings.HasSuffix(filter, wildcardSuffix) {
+			if strings.HasPrefix(eventType, strings.TrimSuffix(filter, "*")) {
+				return true
+			}
+		} else if filter == eventType {
+			return true
+		}
+	}
+	return false
+}
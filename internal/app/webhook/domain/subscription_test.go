@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscription(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates active subscription", func(t *testing.T) {
+		sub, err := NewSubscription("s1", "https://partner.example/hooks", []string{"product.created"}, "0123456789abcdef", now)
+		require.NoError(t, err)
+
+		assert.Equal(t, "s1", sub.ID())
+		assert.Equal(t, "https://partner.example/hooks", sub.URL())
+		assert.Equal(t, []string{"product.created"}, sub.EventTypes())
+		assert.Equal(t, "0123456789abcdef", sub.Secret())
+		assert.True(t, sub.Active())
+	})
+
+	t.Run("generates secret when empty", func(t *testing.T) {
+		sub, err := NewSubscription("s1", "https://partner.example/hooks", nil, "", now)
+		require.NoError(t, err)
+		assert.Len(t, sub.Secret(), 64)
+	})
+
+	t.Run("rejects invalid url", func(t *testing.T) {
+		for _, rawURL := range []string{"", "partner.example/hooks", "ftp://partner.example", "https://"} {
+			_, err := NewSubscription("s1", rawURL, nil, "", now)
+			assert.ErrorIs(t, err, ErrInvalidURL, rawURL)
+		}
+	})
+
+	t.Run("rejects short secret", func(t *testing.T) {
+		_, err := NewSubscription("s1", "https://partner.example", nil, "short", now)
+		assert.ErrorIs(t, err, ErrSecretTooShort)
+	})
+
+	t.Run("rejects malformed filters", func(t *testing.T) {
+		for _, filter := range []string{"", " ", ".*", "product.*.applied", "product*"} {
+			_, err := NewSubscription("s1", "https://partner.example", []string{filter}, "", now)
+			assert.ErrorIs(t, err, ErrInvalidEventFilter, filter)
+		}
+	})
+
+	t.Run("deduplicates filters", func(t *testing.T) {
+		sub, err := NewSubscription("s1", "https://partner.example", []string{"product.created", "product.created"}, "", now)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"product.created"}, sub.EventTypes())
+	})
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		filters   []string
+		eventType string
+		expected  bool
+	}{
+		{"no filters match everything", nil, "product.archived", true},
+		{"exact match", []string{"product.created"}, "product.created", true},
+		{"exact mismatch", []string{"product.created"}, "product.updated", false},
+		{"prefix match", []string{"product.discount.*"}, "product.discount.applied", true},
+		{"prefix mismatch", []string{"product.discount.*"}, "product.price.changed", false},
+		{"prefix requires segment boundary", []string{"product.discount.*"}, "product.discounted", false},
+		{"any filter matches", []string{"product.created", "product.archived"}, "product.archived", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := NewSubscription("s1", "https://partner.example", tt.filters, "", now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sub.Matches(tt.eventType))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Package fanout feeds webhook deliveries from the outbox relay.
+package fanout
+
+import (
+	"context"
+	"fmt"
+
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// Publisher is an outbox Publisher that enqueues one webhook delivery per matching
+// active subscription. The HTTP calls themselves are made by the dispatcher, so a slow
+// or failing partner endpoint never holds up the outbox.
+type Publisher struct {
+	subscriptions contracts.SubscriptionRepository
+	deliveries    contracts.DeliveryRepository
+	clock         clock.Clock
+}
+
+// NewPublisher creates a new fan-out Publisher.
+func NewPublisher(subscriptions contracts.SubscriptionRepository, deliveries contracts.DeliveryRepository, clk clock.Clock) outboxcontracts.Publisher {
+	return &Publisher{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		clock:         clk,
+	}
+}
+
+// Publish enqueues deliveries of the event for every subscription whose filters match.
+func (p *Publisher) Publish(ctx context.Context, msg *outboxcontracts.Message) error {
+	subs, err := p.subscriptions.ListActive(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	var ids []string
+	for _, sub := range subs {
+		if sub.Matches(msg.EventType) {
+			ids = append(ids, sub.ID())
+		}
+	}
+
+	return p.deliveries.Enqueue(ctx, msg, ids, p.clock.Now())
+}
+
+// Close is a no-op.
+func (p *Publisher) Close() error {
+	return nil
+}
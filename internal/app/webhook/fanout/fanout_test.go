@@ -0,0 +1,78 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// fakeSubscriptions serves ListActive from memory.
+type fakeSubscriptions struct {
+	contracts.SubscriptionRepository
+	subs []*domain.Subscription
+	err  error
+}
+
+func (f *fakeSubscriptions) ListActive(ctx context.Context) ([]*domain.Subscription, error) {
+	return f.subs, f.err
+}
+
+// fakeDeliveries records Enqueue calls.
+type fakeDeliveries struct {
+	contracts.DeliveryRepository
+	enqueued map[string][]string // event ID -> subscription IDs
+}
+
+func (f *fakeDeliveries) Enqueue(ctx context.Context, msg *outboxcontracts.Message, subscriptionIDs []string, now time.Time) error {
+	if f.enqueued == nil {
+		f.enqueued = make(map[string][]string)
+	}
+	f.enqueued[msg.EventID] = subscriptionIDs
+	return nil
+}
+
+func newSubscription(t *testing.T, id string, eventTypes ...string) *domain.Subscription {
+	t.Helper()
+	sub, err := domain.NewSubscription(id, "https://partner.example.com/hook", eventTypes, "", time.Now())
+	require.NoError(t, err)
+	return sub
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("enqueues matching subscriptions only", func(t *testing.T) {
+		subs := &fakeSubscriptions{subs: []*domain.Subscription{
+			newSubscription(t, "all"),
+			newSubscription(t, "discounts", "product.discount.*"),
+			newSubscription(t, "created", "product.created"),
+		}}
+		deliveries := &fakeDeliveries{}
+		pub := NewPublisher(subs, deliveries, clk)
+
+		require.NoError(t, pub.Publish(context.Background(), &outboxcontracts.Message{EventID: "e1", EventType: "product.created"}))
+		require.NoError(t, pub.Publish(context.Background(), &outboxcontracts.Message{EventID: "e2", EventType: "product.discount.applied"}))
+		require.NoError(t, pub.Publish(context.Background(), &outboxcontracts.Message{EventID: "e3", EventType: "product.archived"}))
+
+		assert.Equal(t, []string{"all", "created"}, deliveries.enqueued["e1"])
+		assert.Equal(t, []string{"all", "discounts"}, deliveries.enqueued["e2"])
+		assert.Equal(t, []string{"all"}, deliveries.enqueued["e3"])
+	})
+
+	t.Run("subscription lookup failure is returned for relay retry", func(t *testing.T) {
+		subs := &fakeSubscriptions{err: errors.New("spanner unavailable")}
+		pub := NewPublisher(subs, &fakeDeliveries{}, clk)
+
+		err := pub.Publish(context.Background(), &outboxcontracts.Message{EventID: "e1", EventType: "product.created"})
+		assert.ErrorContains(t, err, "spanner unavailable")
+	})
+}
@@ -0,0 +1,29 @@
+package get_subscription
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+)
+
+// Request contains the subscription ID to retrieve.
+type Request struct {
+	SubscriptionID string
+}
+
+// Query handles the get subscription query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new get subscription query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a subscription by ID.
+func (q *Query) Execute(ctx context.Context, req *Request) (*contracts.SubscriptionDTO, error) {
+	return q.readModel.GetSubscription(ctx, req.SubscriptionID)
+}
@@ -0,0 +1,50 @@
+package list_deliveries
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+)
+
+// Request contains filtering parameters for listing deliveries.
+type Request struct {
+	SubscriptionID string
+	EventID        string // Optional
+	Status         string // Optional ("pending", "delivered", "failed")
+	Limit          int    // Max number of deliveries to return (default: 50)
+}
+
+// Query handles the list deliveries query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new list deliveries query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves deliveries of a subscription with their attempt log.
+func (q *Query) Execute(ctx context.Context, req *Request) ([]*contracts.DeliveryDTO, error) {
+	// Surface unknown subscriptions as not found rather than an empty list
+	if _, err := q.readModel.GetSubscription(ctx, req.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50 // Default limit
+	}
+	if limit > 500 {
+		limit = 500 // Max limit
+	}
+
+	return q.readModel.ListDeliveries(ctx, &contracts.DeliveryFilter{
+		SubscriptionID: req.SubscriptionID,
+		EventID:        req.EventID,
+		Status:         req.Status,
+		Limit:          limit,
+	})
+}
@@ -0,0 +1,24 @@
+package list_subscriptions
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+)
+
+// Query handles the list subscriptions query use case.
+type Query struct {
+	readModel contracts.ReadModel
+}
+
+// NewQuery creates a new list subscriptions query.
+func NewQuery(readModel contracts.ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves all subscriptions.
+func (q *Query) Execute(ctx context.Context) ([]*contracts.SubscriptionDTO, error) {
+	return q.readModel.ListSubscriptions(ctx)
+}
@@ -0,0 +1,239 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_delivery"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_delivery_attempt"
+	"google.golang.org/api/iterator"
+)
+
+// claimDueQuery selects due deliveries of active subscriptions, joined with the
+// subscription endpoint and the outbox event they deliver.
+const claimDueQuery = `
+	SELECT d.subscription_id, d.attempt_count, s.url, s.secret,
+	       e.event_id, e.event_type, e.aggregate_id, e.sequence_number, e.payload, e.created_at
+	FROM webhook_deliveries@{FORCE_INDEX=idx_webhook_deliveries_due} AS d
+	JOIN webhook_subscriptions AS s ON s.subscription_id = d.subscription_id
+	JOIN outbox_events AS e ON e.event_id = d.event_id
+	WHERE d.status = @pending
+	  AND d.next_attempt_at <= @now
+	  AND s.active = TRUE
+	ORDER BY d.next_attempt_at ASC
+	LIMIT @limit
+`
+
+// DeliveryRepo implements DeliveryRepository for Spanner.
+type DeliveryRepo struct {
+	client       *spanner.Client
+	model        *m_webhook_delivery.Model
+	attemptModel *m_webhook_delivery_attempt.Model
+}
+
+// NewDeliveryRepo creates a new DeliveryRepo.
+func NewDeliveryRepo(client *spanner.Client) contracts.DeliveryRepository {
+	return &DeliveryRepo{
+		client:       client,
+		model:        m_webhook_delivery.NewModel(),
+		attemptModel: m_webhook_delivery_attempt.NewModel(),
+	}
+}
+
+// Enqueue inserts pending deliveries that do not exist yet.
+func (r *DeliveryRepo) Enqueue(ctx context.Context, msg *outboxcontracts.Message, subscriptionIDs []string, now time.Time) error {
+	if len(subscriptionIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		keys := make([]spanner.KeySet, 0, len(subscriptionIDs))
+		for _, id := range subscriptionIDs {
+			keys = append(keys, r.model.Key(id, msg.EventID))
+		}
+
+		existing := make(map[string]bool)
+		iter := txn.Read(ctx, m_webhook_delivery.TableName, spanner.KeySets(keys...), []string{m_webhook_delivery.SubscriptionID})
+		defer iter.Stop()
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read deliveries: %w", err)
+			}
+			var id string
+			if err := row.Columns(&id); err != nil {
+				return fmt.Errorf("failed to parse delivery: %w", err)
+			}
+			existing[id] = true
+		}
+
+		var mutations []*spanner.Mutation
+		for _, id := range subscriptionIDs {
+			if existing[id] {
+				continue
+			}
+			mutations = append(mutations, r.model.InsertMut(&m_webhook_delivery.Data{
+				SubscriptionID: id,
+				EventID:        msg.EventID,
+				EventType:      msg.EventType,
+				Status:         m_webhook_delivery.StatusPending,
+				NextAttemptAt:  spanner.NullTime{Time: now, Valid: true},
+			}))
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue deliveries for event %s: %w", msg.EventID, err)
+	}
+	return nil
+}
+
+// ClaimDue selects due deliveries and leases them in a single read-write transaction.
+func (r *DeliveryRepo) ClaimDue(ctx context.Context, limit int, now, leaseUntil time.Time) ([]*contracts.Delivery, error) {
+	var claimed []*contracts.Delivery
+
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Reset on retry of the transaction function
+		claimed = nil
+
+		stmt := spanner.Statement{
+			SQL: claimDueQuery,
+			Params: map[string]interface{}{
+				"pending": m_webhook_delivery.StatusPending,
+				"now":     now,
+				"limit":   int64(limit),
+			},
+		}
+
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to query due deliveries: %w", err)
+			}
+
+			delivery, err := scanDelivery(row)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, delivery)
+		}
+
+		mutations := make([]*spanner.Mutation, 0, len(claimed))
+		for _, d := range claimed {
+			mutations = append(mutations, r.model.UpdateMut(d.SubscriptionID, d.Message.EventID, map[string]interface{}{
+				m_webhook_delivery.NextAttemptAt: leaseUntil,
+			}))
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	return claimed, nil
+}
+
+// MarkDelivered records a successful attempt.
+func (r *DeliveryRepo) MarkDelivered(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult) error {
+	return r.record(ctx, delivery, attempt, map[string]interface{}{
+		m_webhook_delivery.Status:        m_webhook_delivery.StatusDelivered,
+		m_webhook_delivery.AttemptCount:  attempt.AttemptNumber,
+		m_webhook_delivery.NextAttemptAt: spanner.NullTime{},
+		m_webhook_delivery.LastError:     spanner.NullString{},
+		m_webhook_delivery.DeliveredAt:   attempt.AttemptedAt,
+	})
+}
+
+// ScheduleRetry records a failed attempt and schedules the next one.
+func (r *DeliveryRepo) ScheduleRetry(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult, nextAttemptAt time.Time) error {
+	return r.record(ctx, delivery, attempt, map[string]interface{}{
+		m_webhook_delivery.AttemptCount:  attempt.AttemptNumber,
+		m_webhook_delivery.NextAttemptAt: nextAttemptAt,
+		m_webhook_delivery.LastError:     attempt.Error,
+	})
+}
+
+// MarkFailed records the final failed attempt.
+func (r *DeliveryRepo) MarkFailed(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult) error {
+	return r.record(ctx, delivery, attempt, map[string]interface{}{
+		m_webhook_delivery.Status:        m_webhook_delivery.StatusFailed,
+		m_webhook_delivery.AttemptCount:  attempt.AttemptNumber,
+		m_webhook_delivery.NextAttemptAt: spanner.NullTime{},
+		m_webhook_delivery.LastError:     attempt.Error,
+	})
+}
+
+// record appends the attempt to the log and updates the delivery atomically.
+func (r *DeliveryRepo) record(ctx context.Context, delivery *contracts.Delivery, attempt *contracts.AttemptResult, updates map[string]interface{}) error {
+	attemptData := &m_webhook_delivery_attempt.Data{
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.Message.EventID,
+		AttemptNumber:  attempt.AttemptNumber,
+		AttemptedAt:    attempt.AttemptedAt,
+		StatusCode:     spanner.NullInt64{Int64: int64(attempt.StatusCode), Valid: attempt.StatusCode != 0},
+		Error:          spanner.NullString{StringVal: attempt.Error, Valid: attempt.Error != ""},
+		DurationMs:     attempt.Duration.Milliseconds(),
+	}
+
+	mutations := []*spanner.Mutation{
+		r.attemptModel.InsertMut(attemptData),
+		r.model.UpdateMut(delivery.SubscriptionID, delivery.Message.EventID, updates),
+	}
+
+	if _, err := r.client.Apply(ctx, mutations); err != nil {
+		return fmt.Errorf("failed to record delivery attempt: %w", err)
+	}
+	return nil
+}
+
+func scanDelivery(row *spanner.Row) (*contracts.Delivery, error) {
+	var (
+		delivery contracts.Delivery
+		msg      outboxcontracts.Message
+		sequence spanner.NullInt64
+		payload  spanner.NullJSON
+	)
+
+	if err := row.Columns(
+		&delivery.SubscriptionID,
+		&delivery.AttemptCount,
+		&delivery.URL,
+		&delivery.Secret,
+		&msg.EventID,
+		&msg.EventType,
+		&msg.AggregateID,
+		&sequence,
+		&payload,
+		&msg.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan delivery: %w", err)
+	}
+
+	msg.SequenceNumber = sequence.Int64
+	if payload.Valid {
+		data, err := json.Marshal(payload.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode payload: %w", err)
+		}
+		msg.Payload = data
+	}
+
+	delivery.Message = &msg
+	return &delivery, nil
+}
@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_delivery"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_delivery_attempt"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_subscription"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// ReadModelImpl implements the webhook ReadModel for Spanner.
+type ReadModelImpl struct {
+	client *spanner.Client
+}
+
+// NewReadModel creates a new ReadModel implementation.
+func NewReadModel(client *spanner.Client) contracts.ReadModel {
+	return &ReadModelImpl{
+		client: client,
+	}
+}
+
+// GetSubscription retrieves a subscription DTO by ID.
+func (rm *ReadModelImpl) GetSubscription(ctx context.Context, subscriptionID string) (*contracts.SubscriptionDTO, error) {
+	row, err := rm.client.Single().ReadRow(ctx, m_webhook_subscription.TableName, spanner.Key{subscriptionID}, m_webhook_subscription.NewModel().ReadColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to read subscription: %w", err)
+	}
+
+	var data m_webhook_subscription.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription: %w", err)
+	}
+
+	return subscriptionToDTO(&data), nil
+}
+
+// ListSubscriptions retrieves all subscriptions, oldest first.
+func (rm *ReadModelImpl) ListSubscriptions(ctx context.Context) ([]*contracts.SubscriptionDTO, error) {
+	stmt := query.From(m_webhook_subscription.TableName).
+		Select(m_webhook_subscription.NewModel().ReadColumns()...).
+		OrderBy(m_webhook_subscription.CreatedAt, query.Asc).
+		Build()
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var subs []*contracts.SubscriptionDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query subscriptions: %w", err)
+		}
+
+		var data m_webhook_subscription.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse subscription: %w", err)
+		}
+		subs = append(subs, subscriptionToDTO(&data))
+	}
+
+	return subs, nil
+}
+
+// ListDeliveries retrieves the most recent deliveries of a subscription with their attempt log.
+func (rm *ReadModelImpl) ListDeliveries(ctx context.Context, filter *contracts.DeliveryFilter) ([]*contracts.DeliveryDTO, error) {
+	builder := query.From(m_webhook_delivery.TableName).
+		Select(
+			m_webhook_delivery.SubscriptionID,
+			m_webhook_delivery.EventID,
+			m_webhook_delivery.EventType,
+			m_webhook_delivery.Status,
+			m_webhook_delivery.AttemptCount,
+			m_webhook_delivery.NextAttemptAt,
+			m_webhook_delivery.LastError,
+			m_webhook_delivery.CreatedAt,
+			m_webhook_delivery.DeliveredAt,
+		).
+		Where(query.Eq(m_webhook_delivery.SubscriptionID, filter.SubscriptionID))
+
+	if filter.EventID != "" {
+		builder = builder.Where(query.Eq(m_webhook_delivery.EventID, filter.EventID))
+	}
+	if filter.Status != "" {
+		builder = builder.Where(query.Eq(m_webhook_delivery.Status, filter.Status))
+	}
+
+	stmt := builder.
+		OrderBy(m_webhook_delivery.CreatedAt, query.Desc).
+		Limit(int64(filter.Limit)).
+		Build()
+
+	iter := rm.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var deliveries []*contracts.DeliveryDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query deliveries: %w", err)
+		}
+
+		var data m_webhook_delivery.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse delivery: %w", err)
+		}
+
+		dto := deliveryToDTO(&data)
+		attempts, err := rm.listAttempts(ctx, data.SubscriptionID, data.EventID)
+		if err != nil {
+			return nil, err
+		}
+		dto.Attempts = attempts
+
+		deliveries = append(deliveries, dto)
+	}
+
+	return deliveries, nil
+}
+
+// listAttempts reads the attempt log of a delivery in attempt order.
+func (rm *ReadModelImpl) listAttempts(ctx context.Context, subscriptionID, eventID string) ([]*contracts.AttemptDTO, error) {
+	keys := spanner.Key{subscriptionID, eventID}.AsPrefix()
+	iter := rm.client.Single().Read(ctx, m_webhook_delivery_attempt.TableName, keys, m_webhook_delivery_attempt.NewModel().ReadColumns())
+	defer iter.Stop()
+
+	var attempts []*contracts.AttemptDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read delivery attempts: %w", err)
+		}
+
+		var data m_webhook_delivery_attempt.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse delivery attempt: %w", err)
+		}
+
+		attempts = append(attempts, &contracts.AttemptDTO{
+			AttemptNumber: data.AttemptNumber,
+			AttemptedAt:   data.AttemptedAt,
+			StatusCode:    data.StatusCode.Int64,
+			Error:         data.Error.StringVal,
+			DurationMs:    data.DurationMs,
+		})
+	}
+
+	return attempts, nil
+}
+
+func subscriptionToDTO(data *m_webhook_subscription.Data) *contracts.SubscriptionDTO {
+	return &contracts.SubscriptionDTO{
+		SubscriptionID: data.SubscriptionID,
+		URL:            data.URL,
+		EventTypes:     data.EventTypes,
+		Active:         data.Active,
+		CreatedAt:      data.CreatedAt,
+		UpdatedAt:      data.UpdatedAt,
+	}
+}
+
+func deliveryToDTO(data *m_webhook_delivery.Data) *contracts.DeliveryDTO {
+	dto := &contracts.DeliveryDTO{
+		SubscriptionID: data.SubscriptionID,
+		EventID:        data.EventID,
+		EventType:      data.EventType,
+		Status:         data.Status,
+		AttemptCount:   data.AttemptCount,
+		LastError:      data.LastError.StringVal,
+		CreatedAt:      data.CreatedAt,
+	}
+	if data.NextAttemptAt.Valid {
+		t := data.NextAttemptAt.Time
+		dto.NextAttemptAt = &t
+	}
+	if data.DeliveredAt.Valid {
+		t := data.DeliveredAt.Time
+		dto.DeliveredAt = &t
+	}
+	return dto
+}
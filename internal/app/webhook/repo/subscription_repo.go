@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_subscription"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SubscriptionRepo implements SubscriptionRepository for Spanner.
+type SubscriptionRepo struct {
+	client *spanner.Client
+	model  *m_webhook_subscription.Model
+}
+
+// NewSubscriptionRepo creates a new SubscriptionRepo.
+func NewSubscriptionRepo(client *spanner.Client) contracts.SubscriptionRepository {
+	return &SubscriptionRepo{
+		client: client,
+		model:  m_webhook_subscription.NewModel(),
+	}
+}
+
+// InsertMut creates a mutation for inserting a new subscription.
+func (r *SubscriptionRepo) InsertMut(sub *domain.Subscription) *spanner.Mutation {
+	return r.model.InsertMut(&m_webhook_subscription.Data{
+		SubscriptionID: sub.ID(),
+		URL:            sub.URL(),
+		EventTypes:     sub.EventTypes(),
+		Secret:         sub.Secret(),
+		Active:         sub.Active(),
+	})
+}
+
+// UpdateMut creates a mutation that overwrites the mutable subscription fields.
+func (r *SubscriptionRepo) UpdateMut(sub *domain.Subscription) *spanner.Mutation {
+	return r.model.UpdateMut(sub.ID(), map[string]interface{}{
+		m_webhook_subscription.URL:        sub.URL(),
+		m_webhook_subscription.EventTypes: sub.EventTypes(),
+		m_webhook_subscription.Secret:     sub.Secret(),
+		m_webhook_subscription.Active:     sub.Active(),
+	})
+}
+
+// DeleteMut creates a mutation for deleting a subscription.
+func (r *SubscriptionRepo) DeleteMut(subscriptionID string) *spanner.Mutation {
+	return r.model.DeleteMut(subscriptionID)
+}
+
+// GetByID retrieves a subscription by ID.
+func (r *SubscriptionRepo) GetByID(ctx context.Context, subscriptionID string) (*domain.Subscription, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_webhook_subscription.TableName, spanner.Key{subscriptionID}, r.model.ReadColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to read subscription: %w", err)
+	}
+
+	var data m_webhook_subscription.Data
+	if err := row.ToStruct(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse subscription: %w", err)
+	}
+
+	return dataToDomain(&data), nil
+}
+
+// ListActive retrieves all active subscriptions.
+func (r *SubscriptionRepo) ListActive(ctx context.Context) ([]*domain.Subscription, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT subscription_id, url, event_types, secret, active, created_at, updated_at
+		      FROM webhook_subscriptions
+		      WHERE active = TRUE`,
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var subs []*domain.Subscription
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query subscriptions: %w", err)
+		}
+
+		var data m_webhook_subscription.Data
+		if err := row.ToStruct(&data); err != nil {
+			return nil, fmt.Errorf("failed to parse subscription: %w", err)
+		}
+		subs = append(subs, dataToDomain(&data))
+	}
+
+	return subs, nil
+}
+
+func dataToDomain(data *m_webhook_subscription.Data) *domain.Subscription {
+	return domain.ReconstructSubscription(
+		data.SubscriptionID,
+		data.URL,
+		data.EventTypes,
+		data.Secret,
+		data.Active,
+		data.CreatedAt,
+		data.UpdatedAt,
+	)
+}
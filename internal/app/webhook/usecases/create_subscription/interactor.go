@@ -0,0 +1,69 @@
+package create_subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the data needed to create a subscription.
+type Request struct {
+	URL        string
+	EventTypes []string // Empty = all event types
+	Secret     string   // Optional, generated if empty
+}
+
+// Response contains the new subscription ID and its signing secret.
+// The secret is only ever returned here.
+type Response struct {
+	SubscriptionID string
+	Secret         string
+}
+
+// Interactor handles the create subscription use case.
+type Interactor struct {
+	repo      contracts.SubscriptionRepository
+	committer *committer.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new create subscription interactor.
+func NewInteractor(
+	repo contracts.SubscriptionRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute creates a new subscription.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Create domain entity
+	sub, err := domain.NewSubscription(uuid.New().String(), req.URL, req.EventTypes, req.Secret, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Create commit plan
+	plan := committer.NewPlan()
+	plan.Add(i.repo.InsertMut(sub))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &Response{
+		SubscriptionID: sub.ID(),
+		Secret:         sub.Secret(),
+	}, nil
+}
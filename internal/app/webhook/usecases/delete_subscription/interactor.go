@@ -0,0 +1,50 @@
+package delete_subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the subscription ID to delete.
+type Request struct {
+	SubscriptionID string
+}
+
+// Interactor handles the delete subscription use case.
+type Interactor struct {
+	repo      contracts.SubscriptionRepository
+	committer *committer.Committer
+}
+
+// NewInteractor creates a new delete subscription interactor.
+func NewInteractor(
+	repo contracts.SubscriptionRepository,
+	committer *committer.Committer,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		committer: committer,
+	}
+}
+
+// Execute deletes a subscription together with its pending deliveries and attempt log.
+func (i *Interactor) Execute(ctx context.Context, req *Request) error {
+	// 1. Ensure the subscription exists
+	if _, err := i.repo.GetByID(ctx, req.SubscriptionID); err != nil {
+		return err
+	}
+
+	// 2. Create commit plan
+	plan := committer.NewPlan()
+	plan.Add(i.repo.DeleteMut(req.SubscriptionID))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
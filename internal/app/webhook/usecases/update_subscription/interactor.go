@@ -0,0 +1,94 @@
+package update_subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/internal/pkg/committer"
+)
+
+// Request contains the subscription fields to update.
+// Nil fields are left unchanged.
+type Request struct {
+	SubscriptionID string
+	URL            *string
+	EventTypes     *[]string // Non-nil empty slice = all event types
+	Active         *bool
+	RotateSecret   bool
+}
+
+// Response contains the new signing secret if it was rotated.
+type Response struct {
+	Secret string // Empty unless RotateSecret was set
+}
+
+// Interactor handles the update subscription use case.
+type Interactor struct {
+	repo      contracts.SubscriptionRepository
+	committer *committer.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new update subscription interactor.
+func NewInteractor(
+	repo contracts.SubscriptionRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute updates a subscription.
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load entity
+	sub, err := i.repo.GetByID(ctx, req.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Apply changes
+	if req.URL != nil {
+		if err := sub.SetURL(*req.URL); err != nil {
+			return nil, err
+		}
+	}
+	if req.EventTypes != nil {
+		if err := sub.SetEventTypes(*req.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	if req.Active != nil {
+		sub.SetActive(*req.Active)
+	}
+
+	resp := &Response{}
+	if req.RotateSecret {
+		secret, err := domain.GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+		if err := sub.SetSecret(secret); err != nil {
+			return nil, err
+		}
+		resp.Secret = secret
+	}
+	sub.MarkUpdated(i.clock.Now())
+
+	// 3. Create commit plan
+	plan := committer.NewPlan()
+	plan.Add(i.repo.UpdateMut(sub))
+
+	// 4. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return resp, nil
+}
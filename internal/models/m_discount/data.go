@@ -0,0 +1,22 @@
+package m_discount
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents a scheduled discount in the database.
+// Percentage discounts set DiscountPercent, fixed-amount discounts the Amount columns.
+type Data struct {
+	ProductID         string              `spanner:"product_id"`
+	DiscountID        string              `spanner:"discount_id"`
+	DiscountPercent   spanner.NullNumeric `spanner:"discount_percent"`
+	AmountNumerator   spanner.NullInt64   `spanner:"amount_numerator"`
+	AmountDenominator spanner.NullInt64   `spanner:"amount_denominator"`
+	AmountCurrency    spanner.NullString  `spanner:"amount_currency"`
+	StartDate         time.Time           `spanner:"start_date"`
+	EndDate           time.Time           `spanner:"end_date"`
+	StartedAt         spanner.NullTime    `spanner:"started_at"` // Set once the discount has taken effect
+	CreatedAt         time.Time           `spanner:"created_at"`
+}
@@ -0,0 +1,18 @@
+package m_discount
+
+// Table name constant
+const TableName = "discounts"
+
+// Field name constants for type-safe database access
+const (
+	ProductID         = "product_id"
+	DiscountID        = "discount_id"
+	DiscountPercent   = "discount_percent"
+	AmountNumerator   = "amount_numerator"
+	AmountDenominator = "amount_denominator"
+	AmountCurrency    = "amount_currency"
+	StartDate         = "start_date"
+	EndDate           = "end_date"
+	StartedAt         = "started_at"
+	CreatedAt         = "created_at"
+)
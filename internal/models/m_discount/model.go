@@ -0,0 +1,81 @@
+package m_discount
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the discounts table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation for inserting a scheduled discount.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			ProductID,
+			DiscountID,
+			DiscountPercent,
+			AmountNumerator,
+			AmountDenominator,
+			AmountCurrency,
+			StartDate,
+			EndDate,
+			StartedAt,
+			CreatedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.DiscountID,
+			data.DiscountPercent,
+			data.AmountNumerator,
+			data.AmountDenominator,
+			data.AmountCurrency,
+			data.StartDate,
+			data.EndDate,
+			data.StartedAt,
+			spanner.CommitTimestamp,
+		},
+	)
+}
+
+// UpdateStartedAtMut creates a Spanner mutation recording when a discount took effect.
+func (m *Model) UpdateStartedAtMut(productID, discountID string, startedAt time.Time) *spanner.Mutation {
+	return spanner.Update(
+		TableName,
+		[]string{ProductID, DiscountID, StartedAt},
+		[]interface{}{productID, discountID, startedAt},
+	)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a scheduled discount.
+func (m *Model) DeleteMut(productID, discountID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID, discountID})
+}
+
+// DeleteAllMut creates a Spanner mutation for deleting every discount of a product.
+func (m *Model) DeleteAllMut(productID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID}.AsPrefix())
+}
+
+// ReadColumns returns the column names for reading scheduled discounts.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		ProductID,
+		DiscountID,
+		DiscountPercent,
+		AmountNumerator,
+		AmountDenominator,
+		AmountCurrency,
+		StartDate,
+		EndDate,
+		StartedAt,
+		CreatedAt,
+	}
+}
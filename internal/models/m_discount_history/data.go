@@ -0,0 +1,22 @@
+package m_discount_history
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents a discount a product had, in the database.
+// Percentage discounts set DiscountPercent, fixed-amount discounts the Amount columns.
+type Data struct {
+	ProductID         string              `spanner:"product_id"`
+	DiscountID        string              `spanner:"discount_id"`
+	DiscountPercent   spanner.NullNumeric `spanner:"discount_percent"`
+	AmountNumerator   spanner.NullInt64   `spanner:"amount_numerator"`
+	AmountDenominator spanner.NullInt64   `spanner:"amount_denominator"`
+	AmountCurrency    spanner.NullString  `spanner:"amount_currency"`
+	StartDate         time.Time           `spanner:"start_date"`
+	EndDate           time.Time           `spanner:"end_date"`
+	AppliedAt         spanner.NullTime    `spanner:"applied_at"` // Unknown for discounts applied before the history existed
+	EndedAt           spanner.NullTime    `spanner:"ended_at"`   // Set when the discount was removed before its end date
+}
@@ -0,0 +1,18 @@
+package m_discount_history
+
+// Table name constant
+const TableName = "discount_history"
+
+// Field name constants for type-safe database access
+const (
+	ProductID         = "product_id"
+	DiscountID        = "discount_id"
+	DiscountPercent   = "discount_percent"
+	AmountNumerator   = "amount_numerator"
+	AmountDenominator = "amount_denominator"
+	AmountCurrency    = "amount_currency"
+	StartDate         = "start_date"
+	EndDate           = "end_date"
+	AppliedAt         = "applied_at"
+	EndedAt           = "ended_at"
+)
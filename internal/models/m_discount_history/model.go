@@ -0,0 +1,94 @@
+package m_discount_history
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the discount_history table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation recording a discount as it is applied.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			ProductID,
+			DiscountID,
+			DiscountPercent,
+			AmountNumerator,
+			AmountDenominator,
+			AmountCurrency,
+			StartDate,
+			EndDate,
+			AppliedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.DiscountID,
+			data.DiscountPercent,
+			data.AmountNumerator,
+			data.AmountDenominator,
+			data.AmountCurrency,
+			data.StartDate,
+			data.EndDate,
+			data.AppliedAt,
+		},
+	)
+}
+
+// UpsertEndMut creates a Spanner mutation recording how a discount left the schedule.
+// The row is created for discounts applied before the history existed; applied_at is
+// kept for the others.
+func (m *Model) UpsertEndMut(data *Data) *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		[]string{
+			ProductID,
+			DiscountID,
+			DiscountPercent,
+			AmountNumerator,
+			AmountDenominator,
+			AmountCurrency,
+			StartDate,
+			EndDate,
+			EndedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.DiscountID,
+			data.DiscountPercent,
+			data.AmountNumerator,
+			data.AmountDenominator,
+			data.AmountCurrency,
+			data.StartDate,
+			data.EndDate,
+			data.EndedAt,
+		},
+	)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a discount that never took effect.
+func (m *Model) DeleteMut(productID, discountID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID, discountID})
+}
+
+// ReadColumns returns the column names for reading discount history.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		ProductID,
+		DiscountID,
+		DiscountPercent,
+		AmountNumerator,
+		AmountDenominator,
+		AmountCurrency,
+		StartDate,
+		EndDate,
+		AppliedAt,
+		EndedAt,
+	}
+}
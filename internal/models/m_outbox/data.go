@@ -8,13 +8,15 @@ import (
 
 // Data represents the database model for the outbox_events table.
 type Data struct {
-	EventID      string
-	EventType    string
-	AggregateID  string
-	Payload      spanner.NullJSON // JSON column
-	Status       string
-	CreatedAt    time.Time
-	ProcessedAt  spanner.NullTime
-	RetryCount   int64
-	ErrorMessage spanner.NullString
+	EventID        string
+	EventType      string
+	AggregateID    string
+	SequenceNumber spanner.NullInt64 // Per-aggregate ordering key (NULL for rows written before migration 006)
+	Payload        spanner.NullJSON  // JSON column
+	Status         string
+	CreatedAt      time.Time
+	ProcessedAt    spanner.NullTime
+	RetryCount     int64
+	ErrorMessage   spanner.NullString
+	NextAttemptAt  spanner.NullTime // Retry schedule (pending) or lease expiry (processing)
 }
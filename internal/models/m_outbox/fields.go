@@ -4,15 +4,17 @@ package m_outbox
 const (
 	TableName = "outbox_events"
 
-	EventID      = "event_id"
-	EventType    = "event_type"
-	AggregateID  = "aggregate_id"
-	Payload      = "payload"
-	Status       = "status"
-	CreatedAt    = "created_at"
-	ProcessedAt  = "processed_at"
-	RetryCount   = "retry_count"
-	ErrorMessage = "error_message"
+	EventID        = "event_id"
+	EventType      = "event_type"
+	AggregateID    = "aggregate_id"
+	Payload        = "payload"
+	Status         = "status"
+	CreatedAt      = "created_at"
+	ProcessedAt    = "processed_at"
+	RetryCount     = "retry_count"
+	ErrorMessage   = "error_message"
+	NextAttemptAt  = "next_attempt_at"
+	SequenceNumber = "sequence_number"
 )
 
 // Event status constants
@@ -26,6 +26,8 @@ func (m *Model) InsertMut(data *Data) *spanner.Mutation {
 			ProcessedAt,
 			RetryCount,
 			ErrorMessage,
+			NextAttemptAt,
+			SequenceNumber,
 		},
 		[]interface{}{
 			data.EventID,
@@ -37,6 +39,8 @@ func (m *Model) InsertMut(data *Data) *spanner.Mutation {
 			data.ProcessedAt,
 			data.RetryCount,
 			data.ErrorMessage,
+			data.NextAttemptAt,
+			data.SequenceNumber,
 		},
 	)
 }
@@ -0,0 +1,21 @@
+package m_outbox_attempt
+
+// Table name constant
+const TableName = "outbox_event_attempts"
+
+// Field name constants for type-safe database access
+const (
+	EventID      = "event_id"
+	AttemptID    = "attempt_id"
+	Outcome      = "outcome"
+	RetryCount   = "retry_count"
+	ErrorMessage = "error_message"
+	AttemptedAt  = "attempted_at"
+)
+
+// Outcome constants
+const (
+	OutcomeRetryScheduled = "retry_scheduled" // Publish failed, event returned to pending
+	OutcomeFailed         = "failed"          // Publish failed, retries exhausted
+	OutcomeRequeued       = "requeued"        // Failed event manually sent back to pending
+)
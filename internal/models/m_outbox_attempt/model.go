@@ -0,0 +1,47 @@
+package m_outbox_attempt
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents an outbox event attempt record in the database.
+type Data struct {
+	EventID      string             `spanner:"event_id"`
+	AttemptID    string             `spanner:"attempt_id"`
+	Outcome      string             `spanner:"outcome"`
+	RetryCount   int64              `spanner:"retry_count"`
+	ErrorMessage spanner.NullString `spanner:"error_message"`
+	AttemptedAt  time.Time          `spanner:"attempted_at"`
+}
+
+// Model provides type-safe database operations for outbox event attempts.
+type Model struct{}
+
+// NewModel creates a new outbox event attempt model.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a mutation for inserting an attempt record.
+// AttemptedAt is always the commit timestamp.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{EventID, AttemptID, Outcome, RetryCount, ErrorMessage, AttemptedAt},
+		[]interface{}{data.EventID, data.AttemptID, data.Outcome, data.RetryCount, data.ErrorMessage, spanner.CommitTimestamp},
+	)
+}
+
+// ReadColumns returns the column names for reading attempts.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		EventID,
+		AttemptID,
+		Outcome,
+		RetryCount,
+		ErrorMessage,
+		AttemptedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package m_price_change
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents a scheduled base price change in the database.
+type Data struct {
+	ProductID           string             `spanner:"product_id"`
+	PriceChangeID       string             `spanner:"price_change_id"`
+	NewPriceNumerator   int64              `spanner:"new_price_numerator"`
+	NewPriceDenominator int64              `spanner:"new_price_denominator"`
+	NewPriceCurrency    string             `spanner:"new_price_currency"`
+	EffectiveAt         time.Time          `spanner:"effective_at"`
+	ChangedBy           string             `spanner:"changed_by"`
+	ChangedReason       spanner.NullString `spanner:"changed_reason"`
+	CreatedAt           time.Time          `spanner:"created_at"`
+}
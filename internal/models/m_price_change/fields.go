@@ -0,0 +1,17 @@
+package m_price_change
+
+// Table name constant
+const TableName = "scheduled_price_changes"
+
+// Field name constants for type-safe database access
+const (
+	ProductID           = "product_id"
+	PriceChangeID       = "price_change_id"
+	NewPriceNumerator   = "new_price_numerator"
+	NewPriceDenominator = "new_price_denominator"
+	NewPriceCurrency    = "new_price_currency"
+	EffectiveAt         = "effective_at"
+	ChangedBy           = "changed_by"
+	ChangedReason       = "changed_reason"
+	CreatedAt           = "created_at"
+)
@@ -0,0 +1,67 @@
+package m_price_change
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the scheduled_price_changes table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation for inserting a scheduled price change.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			ProductID,
+			PriceChangeID,
+			NewPriceNumerator,
+			NewPriceDenominator,
+			NewPriceCurrency,
+			EffectiveAt,
+			ChangedBy,
+			ChangedReason,
+			CreatedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.PriceChangeID,
+			data.NewPriceNumerator,
+			data.NewPriceDenominator,
+			data.NewPriceCurrency,
+			data.EffectiveAt,
+			data.ChangedBy,
+			data.ChangedReason,
+			spanner.CommitTimestamp,
+		},
+	)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a scheduled price change.
+func (m *Model) DeleteMut(productID, priceChangeID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID, priceChangeID})
+}
+
+// DeleteAllMut creates a Spanner mutation for deleting every scheduled price change of a product.
+func (m *Model) DeleteAllMut(productID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID}.AsPrefix())
+}
+
+// ReadColumns returns the column names for reading scheduled price changes.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		ProductID,
+		PriceChangeID,
+		NewPriceNumerator,
+		NewPriceDenominator,
+		NewPriceCurrency,
+		EffectiveAt,
+		ChangedBy,
+		ChangedReason,
+		CreatedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package m_price_change_request
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents a pending price change request in the database.
+type Data struct {
+	ProductID           string             `spanner:"product_id"`
+	RequestID           string             `spanner:"request_id"`
+	OldPriceNumerator   int64              `spanner:"old_price_numerator"`
+	OldPriceDenominator int64              `spanner:"old_price_denominator"`
+	NewPriceNumerator   int64              `spanner:"new_price_numerator"`
+	NewPriceDenominator int64              `spanner:"new_price_denominator"`
+	Currency            string             `spanner:"currency"`
+	RequestedBy         string             `spanner:"requested_by"`
+	RequestedReason     spanner.NullString `spanner:"requested_reason"`
+	Violations          []string           `spanner:"violations"`
+	RequestedAt         time.Time          `spanner:"requested_at"`
+}
@@ -0,0 +1,19 @@
+package m_price_change_request
+
+// Table name constant
+const TableName = "price_change_requests"
+
+// Field name constants for type-safe database access
+const (
+	ProductID           = "product_id"
+	RequestID           = "request_id"
+	OldPriceNumerator   = "old_price_numerator"
+	OldPriceDenominator = "old_price_denominator"
+	NewPriceNumerator   = "new_price_numerator"
+	NewPriceDenominator = "new_price_denominator"
+	Currency            = "currency"
+	RequestedBy         = "requested_by"
+	RequestedReason     = "requested_reason"
+	Violations          = "violations"
+	RequestedAt         = "requested_at"
+)
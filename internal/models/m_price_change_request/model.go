@@ -0,0 +1,73 @@
+package m_price_change_request
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the price_change_requests table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation for inserting a price change request.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			ProductID,
+			RequestID,
+			OldPriceNumerator,
+			OldPriceDenominator,
+			NewPriceNumerator,
+			NewPriceDenominator,
+			Currency,
+			RequestedBy,
+			RequestedReason,
+			Violations,
+			RequestedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.RequestID,
+			data.OldPriceNumerator,
+			data.OldPriceDenominator,
+			data.NewPriceNumerator,
+			data.NewPriceDenominator,
+			data.Currency,
+			data.RequestedBy,
+			data.RequestedReason,
+			data.Violations,
+			data.RequestedAt,
+		},
+	)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a price change request.
+func (m *Model) DeleteMut(productID, requestID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID, requestID})
+}
+
+// DeleteAllMut creates a Spanner mutation for deleting every price change request of a product.
+func (m *Model) DeleteAllMut(productID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID}.AsPrefix())
+}
+
+// ReadColumns returns the column names for reading price change requests.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		ProductID,
+		RequestID,
+		OldPriceNumerator,
+		OldPriceDenominator,
+		NewPriceNumerator,
+		NewPriceDenominator,
+		Currency,
+		RequestedBy,
+		RequestedReason,
+		Violations,
+		RequestedAt,
+	}
+}
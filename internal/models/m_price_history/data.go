@@ -10,13 +10,19 @@ import (
 type Data struct {
 	HistoryID           string             `spanner:"history_id"`
 	ProductID           string             `spanner:"product_id"`
+	Region              spanner.NullString `spanner:"region"`
 	OldPriceNumerator   spanner.NullInt64  `spanner:"old_price_numerator"`
 	OldPriceDenominator spanner.NullInt64  `spanner:"old_price_denominator"`
-	NewPriceNumerator   int64              `spanner:"new_price_numerator"`
-	NewPriceDenominator int64              `spanner:"new_price_denominator"`
+	NewPriceNumerator   spanner.NullInt64  `spanner:"new_price_numerator"`
+	NewPriceDenominator spanner.NullInt64  `spanner:"new_price_denominator"`
+	Currency            string             `spanner:"currency"`
 	ChangedBy           spanner.NullString `spanner:"changed_by"`
 	ChangedReason       spanner.NullString `spanner:"changed_reason"`
 	ChangedAt           time.Time          `spanner:"changed_at"`
+	RequestID           spanner.NullString `spanner:"request_id"` // Set for decisions on price change requests
+	Decision            spanner.NullString `spanner:"decision"`
+	DecidedBy           spanner.NullString `spanner:"decided_by"`
+	DecisionReason      spanner.NullString `spanner:"decision_reason"`
 }
 
 // Model provides type-safe database operations for price history.
@@ -38,12 +44,18 @@ func (m *Model) ReadColumns() []string {
 	return []string{
 		HistoryID,
 		ProductID,
+		Region,
 		OldPriceNumerator,
 		OldPriceDenominator,
 		NewPriceNumerator,
 		NewPriceDenominator,
+		Currency,
 		ChangedBy,
 		ChangedReason,
 		ChangedAt,
+		RequestID,
+		Decision,
+		DecidedBy,
+		DecisionReason,
 	}
 }
@@ -7,11 +7,17 @@ const TableName = "price_history"
 const (
 	HistoryID           = "history_id"
 	ProductID           = "product_id"
+	Region              = "region"
 	OldPriceNumerator   = "old_price_numerator"
 	OldPriceDenominator = "old_price_denominator"
 	NewPriceNumerator   = "new_price_numerator"
 	NewPriceDenominator = "new_price_denominator"
+	Currency            = "currency"
 	ChangedBy           = "changed_by"
 	ChangedReason       = "changed_reason"
 	ChangedAt           = "changed_at"
+	RequestID           = "request_id"
+	Decision            = "decision"
+	DecidedBy           = "decided_by"
+	DecisionReason      = "decision_reason"
 )
@@ -0,0 +1,15 @@
+package m_price_list
+
+import (
+	"time"
+)
+
+// Data represents a regional price in the database.
+type Data struct {
+	ProductID        string    `spanner:"product_id"`
+	Region           string    `spanner:"region"`
+	PriceNumerator   int64     `spanner:"price_numerator"`
+	PriceDenominator int64     `spanner:"price_denominator"`
+	Currency         string    `spanner:"currency"`
+	UpdatedAt        time.Time `spanner:"updated_at"`
+}
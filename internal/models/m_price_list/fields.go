@@ -0,0 +1,14 @@
+package m_price_list
+
+// Table name constant
+const TableName = "price_lists"
+
+// Field name constants for type-safe database access
+const (
+	ProductID        = "product_id"
+	Region           = "region"
+	PriceNumerator   = "price_numerator"
+	PriceDenominator = "price_denominator"
+	Currency         = "currency"
+	UpdatedAt        = "updated_at"
+)
@@ -0,0 +1,53 @@
+package m_price_list
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the price_lists table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// UpsertMut creates a Spanner mutation that inserts or replaces a regional price.
+func (m *Model) UpsertMut(data *Data) *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		[]string{
+			ProductID,
+			Region,
+			PriceNumerator,
+			PriceDenominator,
+			Currency,
+			UpdatedAt,
+		},
+		[]interface{}{
+			data.ProductID,
+			data.Region,
+			data.PriceNumerator,
+			data.PriceDenominator,
+			data.Currency,
+			spanner.CommitTimestamp,
+		},
+	)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a regional price.
+func (m *Model) DeleteMut(productID, region string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID, region})
+}
+
+// ReadColumns returns the column names for reading regional prices.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		ProductID,
+		Region,
+		PriceNumerator,
+		PriceDenominator,
+		Currency,
+		UpdatedAt,
+	}
+}
@@ -8,18 +8,26 @@ import (
 
 // Data represents the database model for the products table.
 type Data struct {
-	ProductID            string              `spanner:"product_id"`
-	Name                 string              `spanner:"name"`
-	Description          string              `spanner:"description"`
-	Category             string              `spanner:"category"`
-	BasePriceNumerator   int64               `spanner:"base_price_numerator"`
-	BasePriceDenominator int64               `spanner:"base_price_denominator"`
-	DiscountPercent      spanner.NullNumeric `spanner:"discount_percent"` // Changed to NullNumeric for fractional percentages (NUMERIC type)
-	DiscountStartDate    spanner.NullTime    `spanner:"discount_start_date"`
-	DiscountEndDate      spanner.NullTime    `spanner:"discount_end_date"`
-	Status               string              `spanner:"status"`
-	Version              int64               `spanner:"version"`
-	CreatedAt            time.Time           `spanner:"created_at"`
-	UpdatedAt            time.Time           `spanner:"updated_at"`
-	ArchivedAt           spanner.NullTime    `spanner:"archived_at"`
+	ProductID                 string              `spanner:"product_id"`
+	Name                      string              `spanner:"name"`
+	Description               string              `spanner:"description"`
+	Category                  string              `spanner:"category"`
+	TaxClass                  string              `spanner:"tax_class"`
+	BasePriceNumerator        int64               `spanner:"base_price_numerator"`
+	BasePriceDenominator      int64               `spanner:"base_price_denominator"`
+	BasePriceCurrency         string              `spanner:"base_price_currency"`
+	CostPriceNumerator        spanner.NullInt64   `spanner:"cost_price_numerator"` // Set when a cost price is known
+	CostPriceDenominator      spanner.NullInt64   `spanner:"cost_price_denominator"`
+	CostPriceCurrency         spanner.NullString  `spanner:"cost_price_currency"`
+	DiscountPercent           spanner.NullNumeric `spanner:"discount_percent"`          // Changed to NullNumeric for fractional percentages (NUMERIC type)
+	DiscountAmountNumerator   spanner.NullInt64   `spanner:"discount_amount_numerator"` // Set for fixed-amount discounts
+	DiscountAmountDenominator spanner.NullInt64   `spanner:"discount_amount_denominator"`
+	DiscountAmountCurrency    spanner.NullString  `spanner:"discount_amount_currency"`
+	DiscountStartDate         spanner.NullTime    `spanner:"discount_start_date"`
+	DiscountEndDate           spanner.NullTime    `spanner:"discount_end_date"`
+	Status                    string              `spanner:"status"`
+	Version                   int64               `spanner:"version"`
+	CreatedAt                 time.Time           `spanner:"created_at"`
+	UpdatedAt                 time.Time           `spanner:"updated_at"`
+	ArchivedAt                spanner.NullTime    `spanner:"archived_at"`
 }
@@ -5,18 +5,26 @@ package m_product
 const (
 	TableName = "products"
 
-	ProductID            = "product_id"
-	Name                 = "name"
-	Description          = "description"
-	Category             = "category"
-	BasePriceNumerator   = "base_price_numerator"
-	BasePriceDenominator = "base_price_denominator"
-	DiscountPercent      = "discount_percent"
-	DiscountStartDate    = "discount_start_date"
-	DiscountEndDate      = "discount_end_date"
-	Status               = "status"
-	Version              = "version"
-	CreatedAt            = "created_at"
-	UpdatedAt            = "updated_at"
-	ArchivedAt           = "archived_at"
+	ProductID                 = "product_id"
+	Name                      = "name"
+	Description               = "description"
+	Category                  = "category"
+	TaxClass                  = "tax_class"
+	BasePriceNumerator        = "base_price_numerator"
+	BasePriceDenominator      = "base_price_denominator"
+	BasePriceCurrency         = "base_price_currency"
+	CostPriceNumerator        = "cost_price_numerator"
+	CostPriceDenominator      = "cost_price_denominator"
+	CostPriceCurrency         = "cost_price_currency"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+	DiscountAmountCurrency    = "discount_amount_currency"
+	DiscountStartDate         = "discount_start_date"
+	DiscountEndDate           = "discount_end_date"
+	Status                    = "status"
+	Version                   = "version"
+	CreatedAt                 = "created_at"
+	UpdatedAt                 = "updated_at"
+	ArchivedAt                = "archived_at"
 )
@@ -21,9 +21,17 @@ func (m *Model) InsertMut(data *Data) *spanner.Mutation {
 			Name,
 			Description,
 			Category,
+			TaxClass,
 			BasePriceNumerator,
 			BasePriceDenominator,
+			BasePriceCurrency,
+			CostPriceNumerator,
+			CostPriceDenominator,
+			CostPriceCurrency,
 			DiscountPercent,
+			DiscountAmountNumerator,
+			DiscountAmountDenominator,
+			DiscountAmountCurrency,
 			DiscountStartDate,
 			DiscountEndDate,
 			Status,
@@ -37,9 +45,17 @@ func (m *Model) InsertMut(data *Data) *spanner.Mutation {
 			data.Name,
 			data.Description,
 			data.Category,
+			data.TaxClass,
 			data.BasePriceNumerator,
 			data.BasePriceDenominator,
+			data.BasePriceCurrency,
+			data.CostPriceNumerator,
+			data.CostPriceDenominator,
+			data.CostPriceCurrency,
 			data.DiscountPercent,
+			data.DiscountAmountNumerator,
+			data.DiscountAmountDenominator,
+			data.DiscountAmountCurrency,
 			data.DiscountStartDate,
 			data.DiscountEndDate,
 			data.Status,
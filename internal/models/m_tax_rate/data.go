@@ -0,0 +1,16 @@
+package m_tax_rate
+
+import (
+	"math/big"
+	"time"
+)
+
+// Data represents a regional tax rate in the database.
+type Data struct {
+	Region           string    `spanner:"region"`
+	TaxClass         string    `spanner:"tax_class"`
+	EffectiveFrom    time.Time `spanner:"effective_from"`
+	RatePercent      big.Rat   `spanner:"rate_percent"`
+	PricesIncludeTax bool      `spanner:"prices_include_tax"`
+	UpdatedAt        time.Time `spanner:"updated_at"`
+}
@@ -0,0 +1,14 @@
+package m_tax_rate
+
+// Table name constant
+const TableName = "tax_rates"
+
+// Field name constants for type-safe database access
+const (
+	Region           = "region"
+	TaxClass         = "tax_class"
+	EffectiveFrom    = "effective_from"
+	RatePercent      = "rate_percent"
+	PricesIncludeTax = "prices_include_tax"
+	UpdatedAt        = "updated_at"
+)
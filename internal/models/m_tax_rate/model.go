@@ -0,0 +1,48 @@
+package m_tax_rate
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the tax_rates table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// UpsertMut creates a Spanner mutation that inserts or replaces a tax rate.
+func (m *Model) UpsertMut(data *Data) *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		[]string{
+			Region,
+			TaxClass,
+			EffectiveFrom,
+			RatePercent,
+			PricesIncludeTax,
+			UpdatedAt,
+		},
+		[]interface{}{
+			data.Region,
+			data.TaxClass,
+			data.EffectiveFrom,
+			&data.RatePercent,
+			data.PricesIncludeTax,
+			spanner.CommitTimestamp,
+		},
+	)
+}
+
+// ReadColumns returns the column names for reading tax rates.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		Region,
+		TaxClass,
+		EffectiveFrom,
+		RatePercent,
+		PricesIncludeTax,
+		UpdatedAt,
+	}
+}
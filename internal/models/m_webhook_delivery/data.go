@@ -0,0 +1,20 @@
+package m_webhook_delivery
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents the database model for the webhook_deliveries table.
+type Data struct {
+	SubscriptionID string             `spanner:"subscription_id"`
+	EventID        string             `spanner:"event_id"`
+	EventType      string             `spanner:"event_type"`
+	Status         string             `spanner:"status"`
+	AttemptCount   int64              `spanner:"attempt_count"`
+	NextAttemptAt  spanner.NullTime   `spanner:"next_attempt_at"` // Retry schedule, or lease while in flight
+	LastError      spanner.NullString `spanner:"last_error"`
+	CreatedAt      time.Time          `spanner:"created_at"`
+	DeliveredAt    spanner.NullTime   `spanner:"delivered_at"`
+}
@@ -0,0 +1,24 @@
+package m_webhook_delivery
+
+// Table name constant
+const TableName = "webhook_deliveries"
+
+// Field name constants for type-safe database access
+const (
+	SubscriptionID = "subscription_id"
+	EventID        = "event_id"
+	EventType      = "event_type"
+	Status         = "status"
+	AttemptCount   = "attempt_count"
+	NextAttemptAt  = "next_attempt_at"
+	LastError      = "last_error"
+	CreatedAt      = "created_at"
+	DeliveredAt    = "delivered_at"
+)
+
+// Status constants
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
@@ -0,0 +1,69 @@
+package m_webhook_delivery
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the webhook_deliveries table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation for inserting a delivery.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			SubscriptionID,
+			EventID,
+			EventType,
+			Status,
+			AttemptCount,
+			NextAttemptAt,
+			LastError,
+			CreatedAt,
+			DeliveredAt,
+		},
+		[]interface{}{
+			data.SubscriptionID,
+			data.EventID,
+			data.EventType,
+			data.Status,
+			data.AttemptCount,
+			data.NextAttemptAt,
+			data.LastError,
+			spanner.CommitTimestamp,
+			data.DeliveredAt,
+		},
+	)
+}
+
+// UpdateMut creates a Spanner mutation for updating specific delivery fields.
+func (m *Model) UpdateMut(subscriptionID, eventID string, updates map[string]interface{}) *spanner.Mutation {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	columns := make([]string, 0, len(updates)+2)
+	values := make([]interface{}, 0, len(updates)+2)
+
+	// Add primary key first
+	columns = append(columns, SubscriptionID, EventID)
+	values = append(values, subscriptionID, eventID)
+
+	// Add all update fields
+	for col, val := range updates {
+		columns = append(columns, col)
+		values = append(values, val)
+	}
+
+	return spanner.Update(TableName, columns, values)
+}
+
+// Key returns the primary key of a delivery.
+func (m *Model) Key(subscriptionID, eventID string) spanner.Key {
+	return spanner.Key{subscriptionID, eventID}
+}
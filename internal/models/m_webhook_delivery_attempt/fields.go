@@ -0,0 +1,15 @@
+package m_webhook_delivery_attempt
+
+// Table name constant
+const TableName = "webhook_delivery_attempts"
+
+// Field name constants for type-safe database access
+const (
+	SubscriptionID = "subscription_id"
+	EventID        = "event_id"
+	AttemptNumber  = "attempt_number"
+	AttemptedAt    = "attempted_at"
+	StatusCode     = "status_code"
+	Error          = "error"
+	DurationMs     = "duration_ms"
+)
@@ -0,0 +1,45 @@
+package m_webhook_delivery_attempt
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Data represents a delivery attempt record in the database.
+type Data struct {
+	SubscriptionID string             `spanner:"subscription_id"`
+	EventID        string             `spanner:"event_id"`
+	AttemptNumber  int64              `spanner:"attempt_number"`
+	AttemptedAt    time.Time          `spanner:"attempted_at"`
+	StatusCode     spanner.NullInt64  `spanner:"status_code"`
+	Error          spanner.NullString `spanner:"error"`
+	DurationMs     int64              `spanner:"duration_ms"`
+}
+
+// Model provides type-safe database operations for delivery attempts.
+type Model struct{}
+
+// NewModel creates a new delivery attempt model.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a mutation for inserting a delivery attempt record.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	mut, _ := spanner.InsertStruct(TableName, data)
+	return mut
+}
+
+// ReadColumns returns the column names for reading delivery attempts.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		SubscriptionID,
+		EventID,
+		AttemptNumber,
+		AttemptedAt,
+		StatusCode,
+		Error,
+		DurationMs,
+	}
+}
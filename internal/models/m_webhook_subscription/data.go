@@ -0,0 +1,14 @@
+package m_webhook_subscription
+
+import "time"
+
+// Data represents the database model for the webhook_subscriptions table.
+type Data struct {
+	SubscriptionID string    `spanner:"subscription_id"`
+	URL            string    `spanner:"url"`
+	EventTypes     []string  `spanner:"event_types"` // Empty = all event types
+	Secret         string    `spanner:"secret"`
+	Active         bool      `spanner:"active"`
+	CreatedAt      time.Time `spanner:"created_at"`
+	UpdatedAt      time.Time `spanner:"updated_at"`
+}
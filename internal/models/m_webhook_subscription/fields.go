@@ -0,0 +1,15 @@
+package m_webhook_subscription
+
+// Table name constant
+const TableName = "webhook_subscriptions"
+
+// Field name constants for type-safe database access
+const (
+	SubscriptionID = "subscription_id"
+	URL            = "url"
+	EventTypes     = "event_types"
+	Secret         = "secret"
+	Active         = "active"
+	CreatedAt      = "created_at"
+	UpdatedAt      = "updated_at"
+)
@@ -0,0 +1,82 @@
+package m_webhook_subscription
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// Model provides a facade for type-safe operations on the webhook_subscriptions table.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates a Spanner mutation for inserting a subscription.
+func (m *Model) InsertMut(data *Data) *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		[]string{
+			SubscriptionID,
+			URL,
+			EventTypes,
+			Secret,
+			Active,
+			CreatedAt,
+			UpdatedAt,
+		},
+		[]interface{}{
+			data.SubscriptionID,
+			data.URL,
+			data.EventTypes,
+			data.Secret,
+			data.Active,
+			spanner.CommitTimestamp,
+			spanner.CommitTimestamp,
+		},
+	)
+}
+
+// UpdateMut creates a Spanner mutation for updating specific subscription fields.
+func (m *Model) UpdateMut(subscriptionID string, updates map[string]interface{}) *spanner.Mutation {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	// Always update the UpdatedAt timestamp
+	updates[UpdatedAt] = spanner.CommitTimestamp
+
+	columns := make([]string, 0, len(updates)+1)
+	values := make([]interface{}, 0, len(updates)+1)
+
+	// Add subscription ID first
+	columns = append(columns, SubscriptionID)
+	values = append(values, subscriptionID)
+
+	// Add all update fields
+	for col, val := range updates {
+		columns = append(columns, col)
+		values = append(values, val)
+	}
+
+	return spanner.Update(TableName, columns, values)
+}
+
+// DeleteMut creates a Spanner mutation for deleting a subscription.
+// Deliveries and their attempt log are removed by ON DELETE CASCADE.
+func (m *Model) DeleteMut(subscriptionID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{subscriptionID})
+}
+
+// ReadColumns returns the column names for reading subscriptions.
+func (m *Model) ReadColumns() []string {
+	return []string{
+		SubscriptionID,
+		URL,
+		EventTypes,
+		Secret,
+		Active,
+		CreatedAt,
+		UpdatedAt,
+	}
+}
@@ -27,11 +27,18 @@ type Builder struct {
 	whereClauses []Condition
 	orderByCol   string
 	orderByDir   Direction
+	thenBy       []orderTerm
 	limitVal     int64
 	offsetVal    int64
 	paramCounter int
 }
 
+// orderTerm is a secondary ORDER BY column.
+type orderTerm struct {
+	column    string
+	direction Direction
+}
+
 // From creates a new Builder for the specified table.
 func From(table string) *Builder {
 	return &Builder{
@@ -66,6 +73,14 @@ func (b *Builder) OrderBy(column string, direction Direction) *Builder {
 	return newBuilder
 }
 
+// ThenBy adds a secondary sort column, applied after the OrderBy column.
+// Multiple calls add further tie-breakers in call order.
+func (b *Builder) ThenBy(column string, direction Direction) *Builder {
+	newBuilder := b.clone()
+	newBuilder.thenBy = append(newBuilder.thenBy, orderTerm{column: column, direction: direction})
+	return newBuilder
+}
+
 // Limit sets the maximum number of rows to return.
 func (b *Builder) Limit(limit int64) *Builder {
 	newBuilder := b.clone()
@@ -90,6 +105,7 @@ func (b *Builder) Count() *Builder {
 	newBuilder.limitVal = 0
 	newBuilder.offsetVal = 0
 	newBuilder.orderByCol = ""
+	newBuilder.thenBy = nil
 	return newBuilder
 }
 
@@ -129,11 +145,10 @@ func (b *Builder) Build() spanner.Statement {
 	// ORDER BY clause
 	if b.orderByCol != "" {
 		sql.WriteString(" ORDER BY ")
-		sql.WriteString(b.orderByCol)
-		if b.orderByDir == Desc {
-			sql.WriteString(" DESC")
-		} else {
-			sql.WriteString(" ASC")
+		writeOrderTerm(&sql, orderTerm{column: b.orderByCol, direction: b.orderByDir})
+		for _, term := range b.thenBy {
+			sql.WriteString(", ")
+			writeOrderTerm(&sql, term)
 		}
 	}
 
@@ -163,15 +178,27 @@ func (b *Builder) clone() *Builder {
 		whereClauses: make([]Condition, len(b.whereClauses)),
 		orderByCol:   b.orderByCol,
 		orderByDir:   b.orderByDir,
+		thenBy:       make([]orderTerm, len(b.thenBy)),
 		limitVal:     b.limitVal,
 		offsetVal:    b.offsetVal,
 		paramCounter: b.paramCounter,
 	}
 	copy(newBuilder.selectCols, b.selectCols)
 	copy(newBuilder.whereClauses, b.whereClauses)
+	copy(newBuilder.thenBy, b.thenBy)
 	return newBuilder
 }
 
+// writeOrderTerm writes a column and its direction.
+func writeOrderTerm(sql *strings.Builder, term orderTerm) {
+	sql.WriteString(term.column)
+	if term.direction == Desc {
+		sql.WriteString(" DESC")
+	} else {
+		sql.WriteString(" ASC")
+	}
+}
+
 // String returns a human-readable representation for debugging.
 func (b *Builder) String() string {
 	stmt := b.Build()
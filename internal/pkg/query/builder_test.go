@@ -238,6 +238,87 @@ func TestCondition_IsNotNull(t *testing.T) {
 	assert.Empty(t, params)
 }
 
+func TestCondition_Comparisons(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     Condition
+		expected string
+	}{
+		{"Gt", Gt("created_at", 1), "created_at > @p2"},
+		{"Gte", Gte("created_at", 1), "created_at >= @p2"},
+		{"Lt", Lt("created_at", 1), "created_at < @p2"},
+		{"Lte", Lte("created_at", 1), "created_at <= @p2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params := tt.cond.SQL(2)
+
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, map[string]interface{}{"p2": 1}, params)
+		})
+	}
+}
+
+func TestBuilder_WhereWithRange(t *testing.T) {
+	stmt := From("outbox_events").
+		Select("event_id").
+		Where(Eq("status", "failed")).
+		Where(Gte("processed_at", "from")).
+		Where(Lt("processed_at", "to")).
+		Build()
+
+	assert.Equal(t, "SELECT event_id FROM outbox_events WHERE status = @p0 AND processed_at >= @p1 AND processed_at < @p2", stmt.SQL)
+	assert.Equal(t, map[string]interface{}{
+		"p0": "failed",
+		"p1": "from",
+		"p2": "to",
+	}, stmt.Params)
+}
+
+func TestBuilder_ThenBy(t *testing.T) {
+	builder := From("outbox_events").
+		Select("event_id").
+		OrderBy("created_at", Asc).
+		ThenBy("event_id", Desc)
+
+	assert.Equal(t, "SELECT event_id FROM outbox_events ORDER BY created_at ASC, event_id DESC", builder.Build().SQL)
+	assert.Equal(t, "SELECT COUNT(*) FROM outbox_events", builder.Count().Build().SQL)
+}
+
+func TestCondition_Keyset(t *testing.T) {
+	t.Run("single column", func(t *testing.T) {
+		sql, params := After([]string{"created_at"}, "t").SQL(0)
+
+		assert.Equal(t, "(created_at > @p0)", sql)
+		assert.Equal(t, map[string]interface{}{"p0": "t"}, params)
+	})
+
+	t.Run("two columns", func(t *testing.T) {
+		sql, params := Before([]string{"created_at", "event_id"}, "t", "id").SQL(1)
+
+		assert.Equal(t, "(created_at < @p1 OR (created_at = @p1 AND event_id < @p2))", sql)
+		assert.Equal(t, map[string]interface{}{"p1": "t", "p2": "id"}, params)
+	})
+
+	t.Run("three columns", func(t *testing.T) {
+		sql, _ := After([]string{"a", "b", "c"}, 1, 2, 3).SQL(0)
+
+		assert.Equal(t, "(a > @p0 OR (a = @p0 AND (b > @p1 OR (b = @p1 AND c > @p2))))", sql)
+	})
+
+	t.Run("numbers following parameters", func(t *testing.T) {
+		stmt := From("outbox_events").
+			Where(Eq("status", "completed")).
+			Where(After([]string{"created_at", "event_id"}, "t", "id")).
+			Where(Eq("event_type", "product.created")).
+			Build()
+
+		assert.Equal(t, "SELECT * FROM outbox_events WHERE status = @p0 AND (created_at > @p1 OR (created_at = @p1 AND event_id > @p2)) AND event_type = @p3", stmt.SQL)
+		assert.Len(t, stmt.Params, 4)
+	})
+}
+
 func TestBuilder_String(t *testing.T) {
 	builder := From("products").
 		Select("product_id", "name").
@@ -71,3 +71,93 @@ func (c *isNotNullCondition) SQL(paramIndex int) (string, map[string]interface{}
 	sql := fmt.Sprintf("%s IS NOT NULL", c.field)
 	return sql, map[string]interface{}{}
 }
+
+// cmpCondition implements ordered comparison (field <op> value).
+type cmpCondition struct {
+	field string
+	op    string
+	value interface{}
+}
+
+// Gt creates a WHERE condition for greater-than comparison.
+// Example: Gt("created_at", t) generates "created_at > @p0"
+func Gt(field string, value interface{}) Condition {
+	return &cmpCondition{field: field, op: ">", value: value}
+}
+
+// Gte creates a WHERE condition for greater-than-or-equal comparison.
+// Example: Gte("created_at", t) generates "created_at >= @p0"
+func Gte(field string, value interface{}) Condition {
+	return &cmpCondition{field: field, op: ">=", value: value}
+}
+
+// Lt creates a WHERE condition for less-than comparison.
+// Example: Lt("created_at", t) generates "created_at < @p0"
+func Lt(field string, value interface{}) Condition {
+	return &cmpCondition{field: field, op: "<", value: value}
+}
+
+// Lte creates a WHERE condition for less-than-or-equal comparison.
+// Example: Lte("created_at", t) generates "created_at <= @p0"
+func Lte(field string, value interface{}) Condition {
+	return &cmpCondition{field: field, op: "<=", value: value}
+}
+
+// SQL generates the SQL fragment for ordered comparison.
+func (c *cmpCondition) SQL(paramIndex int) (string, map[string]interface{}) {
+	paramName := fmt.Sprintf("p%d", paramIndex)
+	sql := fmt.Sprintf("%s %s @%s", c.field, c.op, paramName)
+	params := map[string]interface{}{
+		paramName: c.value,
+	}
+	return sql, params
+}
+
+// keysetCondition implements lexicographic comparison over several columns,
+// the building block of keyset (cursor) pagination.
+type keysetCondition struct {
+	fields []string
+	values []interface{}
+	op     string
+}
+
+// After creates a WHERE condition matching rows that sort after the given key
+// in ascending order of fields. fields and values must have the same length.
+// Example: After([]string{"created_at", "event_id"}, t, id) generates
+// "(created_at > @p0 OR (created_at = @p0 AND event_id > @p1))"
+func After(fields []string, values ...interface{}) Condition {
+	return &keysetCondition{fields: fields, values: values, op: ">"}
+}
+
+// Before creates a WHERE condition matching rows that sort before the given key
+// in ascending order of fields (i.e. after it in descending order).
+// Example: Before([]string{"created_at", "event_id"}, t, id) generates
+// "(created_at < @p0 OR (created_at = @p0 AND event_id < @p1))"
+func Before(fields []string, values ...interface{}) Condition {
+	return &keysetCondition{fields: fields, values: values, op: "<"}
+}
+
+// SQL generates the expanded comparison, innermost column last.
+func (c *keysetCondition) SQL(paramIndex int) (string, map[string]interface{}) {
+	if len(c.fields) != len(c.values) {
+		panic(fmt.Sprintf("query: keyset condition has %d fields and %d values", len(c.fields), len(c.values)))
+	}
+
+	params := make(map[string]interface{}, len(c.fields))
+	names := make([]string, len(c.fields))
+	for i, value := range c.values {
+		names[i] = fmt.Sprintf("p%d", paramIndex+i)
+		params[names[i]] = value
+	}
+
+	// Build from the last column outwards
+	last := len(c.fields) - 1
+	sql := fmt.Sprintf("%s %s @%s", c.fields[last], c.op, names[last])
+	for i := last - 1; i >= 0; i-- {
+		if i < last-1 {
+			sql = "(" + sql + ")"
+		}
+		sql = fmt.Sprintf("%s %s @%s OR (%s = @%s AND %s)", c.fields[i], c.op, names[i], c.fields[i], names[i], sql)
+	}
+	return "(" + sql + ")", params
+}
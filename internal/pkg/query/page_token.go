@@ -0,0 +1,50 @@
+package query
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPageToken is returned when a page token cannot be decoded.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cursor is a keyset position in a listing ordered by (time, id), typically the key of
+// the last row of a page. Use it with Before or After to fetch the next page.
+type Cursor struct {
+	Time time.Time
+	ID   string
+}
+
+// EncodePageToken returns an opaque token for the cursor. Keyset tokens stay stable
+// while rows are inserted, unlike offsets.
+func EncodePageToken(cursor *Cursor) string {
+	raw := cursor.Time.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePageToken parses a token produced by EncodePageToken. An empty token
+// yields a nil cursor (first page).
+func DecodePageToken(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	timestamp, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &Cursor{Time: t, ID: id}, nil
+}
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken_RoundTrip(t *testing.T) {
+	cursor := &Cursor{
+		Time: time.Date(2026, 3, 4, 5, 6, 7, 123456000, time.UTC),
+		ID:   "2f1c6b0e-8d1a-4c3e-9b7a-0d6f5e4c3b2a",
+	}
+
+	decoded, err := DecodePageToken(EncodePageToken(cursor))
+	require.NoError(t, err)
+	assert.True(t, cursor.Time.Equal(decoded.Time))
+	assert.Equal(t, cursor.ID, decoded.ID)
+}
+
+func TestPageToken_Empty(t *testing.T) {
+	cursor, err := DecodePageToken("")
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestPageToken_Malformed(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "YmFkLXRpbWV8ZTE"} {
+		_, err := DecodePageToken(token)
+		assert.ErrorIs(t, err, ErrInvalidPageToken, token)
+	}
+}
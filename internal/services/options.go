@@ -3,33 +3,83 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/queries/list_failed_events"
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/replay_events"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_event"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_events_by_filter"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_prices"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_discounts"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_events"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_price_changes"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_tax_rates"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/watch_events"
 	"github.com/light-bringer/procat-service/internal/app/product/repo"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/approve_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/deactivate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/reject_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/restore_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_tax_rate"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_product"
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/get_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/list_deliveries"
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/list_subscriptions"
+	webhookrepo "github.com/light-bringer/procat-service/internal/app/webhook/repo"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/create_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/delete_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/update_subscription"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 	"github.com/light-bringer/procat-service/internal/transport/grpc/product"
+	"github.com/light-bringer/procat-service/internal/transport/grpc/webhook"
 )
 
+// watchPollInterval is how often WatchEvents streams check the outbox for new events.
+const watchPollInterval = 500 * time.Millisecond
+
+// DomainConfig holds the configurable business policies applied by the use cases.
+type DomainConfig struct {
+	Rounding   *domain.RoundingPolicy  // Rounding of effective prices, nil rounds half-up
+	Guardrails *domain.PriceGuardrails // Price changes that need approval, nil for none
+
+	// How long archived products can be restored, zero for no limit; see domain.ParseRestoreWindow
+	RestoreWindow time.Duration
+}
+
 // ServiceOptions holds all dependencies for the application.
 type ServiceOptions struct {
 	SpannerClient  *spanner.Client
 	ProductHandler *product.Handler
+	WebhookHandler *webhook.Handler
+
+	replayPublishers map[string]outboxcontracts.Publisher
 }
 
 // NewServiceOptions creates and wires up all application dependencies.
-func NewServiceOptions(ctx context.Context, spannerDB string) (*ServiceOptions, error) {
+// replayPublishers are the named targets of ReplayEvents; they are closed by Close.
+func NewServiceOptions(ctx context.Context, spannerDB string, replayPublishers map[string]outboxcontracts.Publisher, config DomainConfig) (*ServiceOptions, error) {
 	// 1. Initialize Spanner client
 	spannerClient, err := spanner.NewClient(ctx, spannerDB)
 	if err != nil {
@@ -39,52 +89,126 @@ func NewServiceOptions(ctx context.Context, spannerDB string) (*ServiceOptions,
 	// 2. Create infrastructure components
 	clk := clock.NewRealClock()
 	comm := committer.NewCommitter(spannerClient)
+	pricing := domain.NewPricingCalculatorWithRounding(config.Rounding)
 
 	// 3. Create repositories
 	productRepo := repo.NewProductRepo(spannerClient, clk)
 	outboxRepo := repo.NewOutboxRepo(spannerClient)
 	priceHistoryRepo := repo.NewPriceHistoryRepo(spannerClient)
-	readModel := repo.NewReadModel(spannerClient, clk)
+	priceListRepo := repo.NewPriceListRepo()
+	discountRepo := repo.NewDiscountRepo()
+	discountHistoryRepo := repo.NewDiscountHistoryRepo()
+	taxRateRepo := repo.NewTaxRateRepo()
+	priceChangeRepo := repo.NewPriceChangeRepo()
+	priceRequestRepo := repo.NewPriceChangeRequestRepo()
+	readModel := repo.NewReadModel(spannerClient, clk, pricing)
 	eventsReadModel := repo.NewEventsReadModel(spannerClient)
+	subscriptionRepo := webhookrepo.NewSubscriptionRepo(spannerClient)
+	webhookReadModel := webhookrepo.NewReadModel(spannerClient)
+	deadLetterRepo := outboxrepo.NewDeadLetterRepo(spannerClient)
+	replayRepo := outboxrepo.NewReplayRepo(spannerClient)
 
 	// 4. Create command use cases (write operations)
 	createProductUseCase := create_product.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	updateProductUseCase := update_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	updatePriceUseCase := update_price.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	updatePriceUseCase := update_price.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing, config.Guardrails)
+	setRegionalPriceUseCase := set_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	removeRegionalPriceUseCase := remove_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	activateProductUseCase := activate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
 	deactivateProductUseCase := deactivate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	archiveProductUseCase := archive_product.NewInteractor(productRepo, outboxRepo, comm, clk)
+	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	archiveProductUseCase := archive_product.NewInteractor(productRepo, discountRepo, discountHistoryRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, clk)
+	restoreProductUseCase := restore_product.NewInteractor(productRepo, outboxRepo, comm, clk, config.RestoreWindow)
+	cancelScheduledDiscountUseCase := cancel_scheduled_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk)
+	setTaxRateUseCase := set_tax_rate.NewInteractor(taxRateRepo, comm, clk)
+	schedulePriceChangeUseCase := schedule_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	cancelScheduledPriceChangeUseCase := cancel_scheduled_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	approvePriceChangeUseCase := approve_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing)
+	rejectPriceChangeUseCase := reject_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	createSubscriptionUseCase := create_subscription.NewInteractor(subscriptionRepo, comm, clk)
+	updateSubscriptionUseCase := update_subscription.NewInteractor(subscriptionRepo, comm, clk)
+	deleteSubscriptionUseCase := delete_subscription.NewInteractor(subscriptionRepo, comm)
+	retryEventUseCase := retry_event.NewInteractor(deadLetterRepo)
+	retryEventsByFilterUseCase := retry_events_by_filter.NewInteractor(deadLetterRepo, clk)
+	replayEventsUseCase := replay_events.NewInteractor(replayRepo, replayPublishers)
+	purgeArchivedProductsUseCase := purge_archived_products.NewInteractor(productRepo, outboxRepo, comm, clk)
 
 	// 5. Create query use cases (read operations)
 	getProductQuery := get_product.NewQuery(readModel)
 	listProductsQuery := list_products.NewQuery(readModel)
+	getPricesQuery := get_prices.NewQuery(readModel)
+	getPriceHistoryQuery := get_price_history.NewQuery(repo.NewPriceHistoryReadModel(spannerClient))
+	getPriceAtQuery := get_price_at.NewQuery(readModel)
+	listDiscountsQuery := list_discounts.NewQuery(readModel)
+	listTaxRatesQuery := list_tax_rates.NewQuery(readModel)
+	listPriceChangesQuery := list_price_changes.NewQuery(readModel)
 	listEventsQuery := list_events.NewQuery(eventsReadModel)
+	watchEventsQuery := watch_events.NewQuery(eventsReadModel, watchPollInterval)
+	getSubscriptionQuery := get_subscription.NewQuery(webhookReadModel)
+	listSubscriptionsQuery := list_subscriptions.NewQuery(webhookReadModel)
+	listDeliveriesQuery := list_deliveries.NewQuery(webhookReadModel)
+	listFailedEventsQuery := list_failed_events.NewQuery(deadLetterRepo)
 
-	// 6. Create gRPC handler
+	// 6. Create gRPC handlers
 	productHandler := product.NewHandler(
 		createProductUseCase,
 		updateProductUseCase,
 		updatePriceUseCase,
+		setRegionalPriceUseCase,
+		removeRegionalPriceUseCase,
 		activateProductUseCase,
 		deactivateProductUseCase,
 		applyDiscountUseCase,
 		removeDiscountUseCase,
 		archiveProductUseCase,
+		restoreProductUseCase,
+		cancelScheduledDiscountUseCase,
+		setTaxRateUseCase,
+		schedulePriceChangeUseCase,
+		cancelScheduledPriceChangeUseCase,
+		approvePriceChangeUseCase,
+		rejectPriceChangeUseCase,
 		getProductQuery,
 		listProductsQuery,
+		getPricesQuery,
+		getPriceHistoryQuery,
+		getPriceAtQuery,
+		listDiscountsQuery,
+		listTaxRatesQuery,
+		listPriceChangesQuery,
 		listEventsQuery,
+		watchEventsQuery,
+		listFailedEventsQuery,
+		retryEventUseCase,
+		retryEventsByFilterUseCase,
+		replayEventsUseCase,
+		purgeArchivedProductsUseCase,
+	)
+	webhookHandler := webhook.NewHandler(
+		createSubscriptionUseCase,
+		updateSubscriptionUseCase,
+		deleteSubscriptionUseCase,
+		getSubscriptionQuery,
+		listSubscriptionsQuery,
+		listDeliveriesQuery,
 	)
 
 	return &ServiceOptions{
-		SpannerClient:  spannerClient,
-		ProductHandler: productHandler,
+		SpannerClient:    spannerClient,
+		ProductHandler:   productHandler,
+		WebhookHandler:   webhookHandler,
+		replayPublishers: replayPublishers,
 	}, nil
 }
 
 // Close closes all resources.
 func (s *ServiceOptions) Close() {
+	for name, pub := range s.replayPublishers {
+		if err := pub.Close(); err != nil {
+			log.Printf("Failed to close replay publisher %s: %v", name, err)
+		}
+	}
 	if s.SpannerClient != nil {
 		s.SpannerClient.Close()
 	}
@@ -3,6 +3,7 @@ package product
 import (
 	"errors"
 
+	outboxdomain "github.com/light-bringer/procat-service/internal/app/outbox/domain"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,18 +29,84 @@ func mapDomainErrorToGRPC(err error) error {
 	case errors.Is(err, domain.ErrInvalidPrice):
 		return status.Error(codes.InvalidArgument, "product price must be positive")
 
+	case errors.Is(err, domain.ErrInvalidCurrency):
+		return status.Error(codes.InvalidArgument, "invalid ISO 4217 currency code")
+
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		return status.Error(codes.InvalidArgument, "price currency does not match the product currency")
+
+	case errors.Is(err, domain.ErrInvalidRegion):
+		return status.Error(codes.InvalidArgument, "region must be 2-16 letters, digits or hyphens starting with a letter")
+
+	case errors.Is(err, domain.ErrRegionalPriceNotFound):
+		return status.Error(codes.NotFound, "product has no price in this region")
+
+	case errors.Is(err, domain.ErrNoPriceAtTime):
+		return status.Error(codes.NotFound, "product has no recorded price at this time")
+
 	case errors.Is(err, domain.ErrInvalidCategory):
 		return status.Error(codes.InvalidArgument, "product category cannot be empty")
 
+	case errors.Is(err, domain.ErrInvalidTaxClass):
+		return status.Error(codes.InvalidArgument, "tax class must be 1-32 letters, digits, hyphens or underscores starting with a letter")
+
+	case errors.Is(err, domain.ErrInvalidTaxRate):
+		return status.Error(codes.InvalidArgument, "tax rate must be a percentage between 0 and 100 with at most 9 fractional digits")
+
+	case errors.Is(err, domain.ErrInvalidPriceChangeTime):
+		return status.Error(codes.InvalidArgument, "price change effective time must be in UTC")
+
+	case errors.Is(err, domain.ErrPriceChangeNotInFuture):
+		return status.Error(codes.InvalidArgument, "price change must take effect in the future")
+
+	case errors.Is(err, domain.ErrPriceChangeConflict):
+		return status.Error(codes.FailedPrecondition, "a price change is already scheduled at this time")
+
+	case errors.Is(err, domain.ErrPriceChangeNotFound):
+		return status.Error(codes.NotFound, "scheduled price change not found")
+
+	case errors.Is(err, domain.ErrInvalidCostPrice):
+		return status.Error(codes.InvalidArgument, "product cost price cannot be negative")
+
+	case errors.Is(err, domain.ErrPriceChangeRequestPending):
+		return status.Error(codes.FailedPrecondition, "product already has a pending price change request")
+
+	case errors.Is(err, domain.ErrPriceChangeRequestNotFound):
+		return status.Error(codes.NotFound, "price change request not found")
+
+	case errors.Is(err, domain.ErrPriceChangeRequestStale):
+		return status.Error(codes.FailedPrecondition, "base price has changed since the price change was requested")
+
+	case errors.Is(err, domain.ErrPriceChangeSelfApproval):
+		return status.Error(codes.PermissionDenied, "price change must be approved by someone other than the requester")
+
 	case errors.Is(err, domain.ErrInvalidDiscountPeriod):
 		return status.Error(codes.InvalidArgument, "discount end date must be after start date")
 
-	case errors.Is(err, domain.ErrDiscountAlreadyActive):
-		return status.Error(codes.FailedPrecondition, "product already has an active discount")
+	case errors.Is(err, domain.ErrDiscountOverlap):
+		return status.Error(codes.FailedPrecondition, "discount period overlaps a scheduled discount")
+
+	case errors.Is(err, domain.ErrDiscountNotFound):
+		return status.Error(codes.NotFound, "discount not found")
+
+	case errors.Is(err, domain.ErrNoActiveDiscount):
+		return status.Error(codes.FailedPrecondition, "product has no active discount")
+
+	case errors.Is(err, domain.ErrDiscountAlreadyStarted):
+		return status.Error(codes.FailedPrecondition, "discount has already started")
 
 	case errors.Is(err, domain.ErrInvalidDiscountPercent):
 		return status.Error(codes.InvalidArgument, "discount percentage must be between 0 and 100")
 
+	case errors.Is(err, domain.ErrDiscountPercentPrecision):
+		return status.Error(codes.InvalidArgument, "discount percentage must be a decimal with at most 9 fractional digits")
+
+	case errors.Is(err, domain.ErrInvalidDiscountAmount):
+		return status.Error(codes.InvalidArgument, "discount amount must be positive")
+
+	case errors.Is(err, domain.ErrDiscountExceedsPrice):
+		return status.Error(codes.FailedPrecondition, "discount amount exceeds the product price")
+
 	case errors.Is(err, domain.ErrCannotApplyToInactive):
 		return status.Error(codes.FailedPrecondition, "cannot apply discount to inactive product")
 
@@ -55,6 +122,33 @@ func mapDomainErrorToGRPC(err error) error {
 	case errors.Is(err, domain.ErrCannotModifyArchived):
 		return status.Error(codes.FailedPrecondition, "cannot modify archived product")
 
+	case errors.Is(err, domain.ErrNotArchived):
+		return status.Error(codes.FailedPrecondition, "product is not archived")
+
+	case errors.Is(err, domain.ErrArchivalTimeUnknown):
+		return status.Error(codes.FailedPrecondition, "archived product has no archival time")
+
+	case errors.Is(err, domain.ErrRestoreWindowExpired):
+		return status.Error(codes.FailedPrecondition, "restore window of archived product has expired")
+
+	case errors.Is(err, domain.ErrRetentionNotElapsed):
+		return status.Error(codes.FailedPrecondition, "archived product is still within its retention period")
+
+	case errors.Is(err, outboxdomain.ErrEventNotFound):
+		return status.Error(codes.NotFound, "event not found")
+
+	case errors.Is(err, outboxdomain.ErrEventNotFailed):
+		return status.Error(codes.FailedPrecondition, "event is not in failed status")
+
+	case errors.Is(err, outboxdomain.ErrUnknownTarget):
+		return status.Error(codes.InvalidArgument, "unknown replay publisher")
+
+	case errors.Is(err, outboxdomain.ErrInvalidRange):
+		return status.Error(codes.InvalidArgument, "time range start must be before its end")
+
+	case errors.Is(err, outboxdomain.ErrReplayTooLarge):
+		return status.Error(codes.FailedPrecondition, "too many events to replay in one request; narrow the filters or use cmd/outbox_replay")
+
 	default:
 		// Unknown error - return Internal
 		return status.Error(codes.Internal, "internal server error")
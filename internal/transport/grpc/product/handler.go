@@ -2,20 +2,48 @@ package product
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/queries/list_failed_events"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/replay_events"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_event"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_events_by_filter"
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_prices"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_discounts"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_events"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_price_changes"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_tax_rates"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/watch_events"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/approve_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/deactivate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/reject_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/restore_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_tax_rate"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_product"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
 	pb "github.com/light-bringer/procat-service/proto/product/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,19 +56,44 @@ type Handler struct {
 	pb.UnimplementedProductServiceServer
 
 	// Commands
-	createProduct     *create_product.Interactor
-	updateProduct     *update_product.Interactor
-	updatePrice       *update_price.Interactor
-	activateProduct   *activate_product.Interactor
-	deactivateProduct *deactivate_product.Interactor
-	applyDiscount     *apply_discount.Interactor
-	removeDiscount    *remove_discount.Interactor
-	archiveProduct    *archive_product.Interactor
+	createProduct       *create_product.Interactor
+	updateProduct       *update_product.Interactor
+	updatePrice         *update_price.Interactor
+	setRegionalPrice    *set_regional_price.Interactor
+	removeRegionalPrice *remove_regional_price.Interactor
+	activateProduct     *activate_product.Interactor
+	deactivateProduct   *deactivate_product.Interactor
+	applyDiscount       *apply_discount.Interactor
+	removeDiscount      *remove_discount.Interactor
+	archiveProduct      *archive_product.Interactor
+	restoreProduct      *restore_product.Interactor
+	cancelDiscount      *cancel_scheduled_discount.Interactor
+	setTaxRate          *set_tax_rate.Interactor
+	schedulePriceChange *schedule_price_change.Interactor
+	cancelPriceChange   *cancel_scheduled_price_change.Interactor
+	approvePriceChange  *approve_price_change.Interactor
+	rejectPriceChange   *reject_price_change.Interactor
 
 	// Queries
-	getProduct   *get_product.Query
-	listProducts *list_products.Query
-	listEvents   *list_events.Query
+	getProduct       *get_product.Query
+	listProducts     *list_products.Query
+	getPrices        *get_prices.Query
+	getPriceHistory  *get_price_history.Query
+	getPriceAt       *get_price_at.Query
+	listDiscounts    *list_discounts.Query
+	listTaxRates     *list_tax_rates.Query
+	listPriceChanges *list_price_changes.Query
+	listEvents       *list_events.Query
+	watchEvents      *watch_events.Query
+
+	// Outbox administration
+	listFailedEvents    *list_failed_events.Query
+	retryEvent          *retry_event.Interactor
+	retryEventsByFilter *retry_events_by_filter.Interactor
+	replayEvents        *replay_events.Interactor
+
+	// Data retention
+	purgeArchivedProducts *purge_archived_products.Interactor
 }
 
 // NewHandler creates a new gRPC product handler.
@@ -48,27 +101,70 @@ func NewHandler(
 	createProduct *create_product.Interactor,
 	updateProduct *update_product.Interactor,
 	updatePrice *update_price.Interactor,
+	setRegionalPrice *set_regional_price.Interactor,
+	removeRegionalPrice *remove_regional_price.Interactor,
 	activateProduct *activate_product.Interactor,
 	deactivateProduct *deactivate_product.Interactor,
 	applyDiscount *apply_discount.Interactor,
 	removeDiscount *remove_discount.Interactor,
 	archiveProduct *archive_product.Interactor,
+	restoreProduct *restore_product.Interactor,
+	cancelDiscount *cancel_scheduled_discount.Interactor,
+	setTaxRate *set_tax_rate.Interactor,
+	schedulePriceChange *schedule_price_change.Interactor,
+	cancelPriceChange *cancel_scheduled_price_change.Interactor,
+	approvePriceChange *approve_price_change.Interactor,
+	rejectPriceChange *reject_price_change.Interactor,
 	getProduct *get_product.Query,
 	listProducts *list_products.Query,
+	getPrices *get_prices.Query,
+	getPriceHistory *get_price_history.Query,
+	getPriceAt *get_price_at.Query,
+	listDiscounts *list_discounts.Query,
+	listTaxRates *list_tax_rates.Query,
+	listPriceChanges *list_price_changes.Query,
 	listEvents *list_events.Query,
+	watchEvents *watch_events.Query,
+	listFailedEvents *list_failed_events.Query,
+	retryEvent *retry_event.Interactor,
+	retryEventsByFilter *retry_events_by_filter.Interactor,
+	replayEvents *replay_events.Interactor,
+	purgeArchivedProducts *purge_archived_products.Interactor,
 ) *Handler {
 	return &Handler{
-		createProduct:     createProduct,
-		updateProduct:     updateProduct,
-		updatePrice:       updatePrice,
-		activateProduct:   activateProduct,
-		deactivateProduct: deactivateProduct,
-		applyDiscount:     applyDiscount,
-		removeDiscount:    removeDiscount,
-		archiveProduct:    archiveProduct,
-		getProduct:        getProduct,
-		listProducts:      listProducts,
-		listEvents:        listEvents,
+		createProduct:       createProduct,
+		updateProduct:       updateProduct,
+		updatePrice:         updatePrice,
+		setRegionalPrice:    setRegionalPrice,
+		removeRegionalPrice: removeRegionalPrice,
+		activateProduct:     activateProduct,
+		deactivateProduct:   deactivateProduct,
+		applyDiscount:       applyDiscount,
+		removeDiscount:      removeDiscount,
+		archiveProduct:      archiveProduct,
+		restoreProduct:      restoreProduct,
+		cancelDiscount:      cancelDiscount,
+		setTaxRate:          setTaxRate,
+		schedulePriceChange: schedulePriceChange,
+		cancelPriceChange:   cancelPriceChange,
+		approvePriceChange:  approvePriceChange,
+		rejectPriceChange:   rejectPriceChange,
+		getProduct:          getProduct,
+		listProducts:        listProducts,
+		getPrices:           getPrices,
+		getPriceHistory:     getPriceHistory,
+		getPriceAt:          getPriceAt,
+		listDiscounts:       listDiscounts,
+		listTaxRates:        listTaxRates,
+		listPriceChanges:    listPriceChanges,
+		listEvents:          listEvents,
+		watchEvents:         watchEvents,
+		listFailedEvents:    listFailedEvents,
+		retryEvent:          retryEvent,
+		retryEventsByFilter: retryEventsByFilter,
+		replayEvents:        replayEvents,
+
+		purgeArchivedProducts: purgeArchivedProducts,
 	}
 }
 
@@ -82,15 +178,21 @@ func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 	// 2. Map proto → application request
 	basePrice, err := protoMoneyToDomain(req.BasePrice)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid base_price")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid base_price: %v", err)
 	}
 
 	appReq := &create_product.Request{
 		Name:        req.Name,
 		Description: req.Description,
 		Category:    req.Category,
+		TaxClass:    req.TaxClass,
 		BasePrice:   basePrice,
 	}
+	if req.CostPrice != nil {
+		if appReq.CostPrice, err = protoMoneyToDomain(req.CostPrice); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cost_price: %v", err)
+		}
+	}
 
 	// 3. Call usecase (usecase applies plan)
 	productID, err := h.createProduct.Execute(ctx, appReq)
@@ -116,6 +218,14 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 		Name:        req.Name,
 		Description: req.Description,
 		Category:    req.Category,
+		TaxClass:    req.TaxClass,
+	}
+	if req.CostPrice != nil {
+		costPrice, err := protoMoneyToDomain(req.CostPrice)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cost_price: %v", err)
+		}
+		appReq.CostPrice = costPrice
 	}
 
 	// 3. Call usecase
@@ -127,7 +237,8 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	return &pb.UpdateProductReply{}, nil
 }
 
-// UpdatePrice updates a product's price.
+// UpdatePrice updates a product's price. Changes that violate the price guardrails are held
+// for approval and reported in the reply.
 func (h *Handler) UpdatePrice(ctx context.Context, req *pb.UpdatePriceRequest) (*pb.UpdatePriceReply, error) {
 	if req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product_id is required")
@@ -136,7 +247,7 @@ func (h *Handler) UpdatePrice(ctx context.Context, req *pb.UpdatePriceRequest) (
 	// Map proto money to domain money
 	newPrice, err := protoMoneyToDomain(req.NewPrice)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid new_price")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new_price: %v", err)
 	}
 
 	appReq := &update_price.Request{
@@ -147,11 +258,202 @@ func (h *Handler) UpdatePrice(ctx context.Context, req *pb.UpdatePriceRequest) (
 		ChangedReason: req.ChangedReason,
 	}
 
-	if err := h.updatePrice.Execute(ctx, appReq); err != nil {
+	resp, err := h.updatePrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.UpdatePriceReply{
+		PendingRequestId:    resp.PendingRequestID,
+		GuardrailViolations: resp.Violations,
+	}, nil
+}
+
+// ScheduleBasePriceChange schedules a change of a product's base price.
+func (h *Handler) ScheduleBasePriceChange(ctx context.Context, req *pb.ScheduleBasePriceChangeRequest) (*pb.ScheduleBasePriceChangeReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.EffectiveAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_at is required")
+	}
+	if req.ChangedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "changed_by is required")
+	}
+
+	newPrice, err := protoMoneyToDomain(req.NewPrice)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new_price: %v", err)
+	}
+
+	appReq := &schedule_price_change.Request{
+		ProductID:     req.ProductId,
+		Version:       req.GetVersion(), // Optional version for optimistic locking
+		NewPrice:      newPrice,
+		EffectiveAt:   req.EffectiveAt.AsTime(),
+		ChangedBy:     req.ChangedBy,
+		ChangedReason: req.ChangedReason,
+	}
+
+	priceChangeID, err := h.schedulePriceChange.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ScheduleBasePriceChangeReply{PriceChangeId: priceChangeID}, nil
+}
+
+// CancelScheduledPriceChange cancels a price change that has not been applied yet.
+func (h *Handler) CancelScheduledPriceChange(ctx context.Context, req *pb.CancelScheduledPriceChangeRequest) (*pb.CancelScheduledPriceChangeReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.PriceChangeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_change_id is required")
+	}
+
+	appReq := &cancel_scheduled_price_change.Request{
+		ProductID:     req.ProductId,
+		PriceChangeID: req.PriceChangeId,
+		Version:       req.GetVersion(), // Optional version for optimistic locking
+	}
+	if err := h.cancelPriceChange.Execute(ctx, appReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CancelScheduledPriceChangeReply{}, nil
+}
+
+// ApprovePriceChange applies a base price change held by the price guardrails.
+func (h *Handler) ApprovePriceChange(ctx context.Context, req *pb.ApprovePriceChangeRequest) (*pb.ApprovePriceChangeReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.RequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "request_id is required")
+	}
+	if req.ApprovedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "approved_by is required")
+	}
+
+	appReq := &approve_price_change.Request{
+		ProductID:  req.ProductId,
+		RequestID:  req.RequestId,
+		ApprovedBy: req.ApprovedBy,
+		Version:    req.GetVersion(), // Optional version for optimistic locking
+	}
+	if err := h.approvePriceChange.Execute(ctx, appReq); err != nil {
 		return nil, mapDomainErrorToGRPC(err)
 	}
 
-	return &pb.UpdatePriceReply{}, nil
+	return &pb.ApprovePriceChangeReply{}, nil
+}
+
+// RejectPriceChange discards a base price change held by the price guardrails.
+func (h *Handler) RejectPriceChange(ctx context.Context, req *pb.RejectPriceChangeRequest) (*pb.RejectPriceChangeReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.RequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "request_id is required")
+	}
+	if req.RejectedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejected_by is required")
+	}
+
+	appReq := &reject_price_change.Request{
+		ProductID:  req.ProductId,
+		RequestID:  req.RequestId,
+		RejectedBy: req.RejectedBy,
+		Reason:     req.Reason,
+		Version:    req.GetVersion(), // Optional version for optimistic locking
+	}
+	if err := h.rejectPriceChange.Execute(ctx, appReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RejectPriceChangeReply{}, nil
+}
+
+// SetRegionalPrice sets a product's price in a region.
+func (h *Handler) SetRegionalPrice(ctx context.Context, req *pb.SetRegionalPriceRequest) (*pb.SetRegionalPriceReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	// Map proto money to domain money
+	price, err := protoMoneyToDomain(req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid price: %v", err)
+	}
+
+	appReq := &set_regional_price.Request{
+		ProductID:     req.ProductId,
+		Version:       req.GetVersion(), // Optional version for optimistic locking
+		Region:        req.Region,
+		Price:         price,
+		ChangedBy:     req.ChangedBy,
+		ChangedReason: req.ChangedReason,
+	}
+
+	if err := h.setRegionalPrice.Execute(ctx, appReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetRegionalPriceReply{}, nil
+}
+
+// RemoveRegionalPrice removes a product's price in a region.
+func (h *Handler) RemoveRegionalPrice(ctx context.Context, req *pb.RemoveRegionalPriceRequest) (*pb.RemoveRegionalPriceReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := &remove_regional_price.Request{
+		ProductID:     req.ProductId,
+		Version:       req.GetVersion(), // Optional version for optimistic locking
+		Region:        req.Region,
+		ChangedBy:     req.ChangedBy,
+		ChangedReason: req.ChangedReason,
+	}
+
+	if err := h.removeRegionalPrice.Execute(ctx, appReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RemoveRegionalPriceReply{}, nil
+}
+
+// SetTaxRate sets a region's tax rate for a tax class.
+func (h *Handler) SetTaxRate(ctx context.Context, req *pb.SetTaxRateRequest) (*pb.SetTaxRateReply, error) {
+	if req.Region == "" {
+		return nil, status.Error(codes.InvalidArgument, "region is required")
+	}
+	if req.TaxClass == "" {
+		return nil, status.Error(codes.InvalidArgument, "tax_class is required")
+	}
+
+	ratePercent, ok := new(big.Rat).SetString(strings.TrimSpace(req.RatePercent))
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rate_percent %q", req.RatePercent)
+	}
+
+	appReq := &set_tax_rate.Request{
+		Region:           req.Region,
+		TaxClass:         req.TaxClass,
+		RatePercent:      ratePercent,
+		PricesIncludeTax: req.PricesIncludeTax,
+	}
+	if req.EffectiveFrom != nil {
+		appReq.EffectiveFrom = req.EffectiveFrom.AsTime()
+	}
+
+	rate, err := h.setTaxRate.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetTaxRateReply{TaxRate: taxRateToProto(&contracts.TaxRateDTO{Rate: rate})}, nil
 }
 
 // ActivateProduct activates a product.
@@ -197,23 +499,39 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountReques
 
 	// 2. Map proto → application request
 	appReq := &apply_discount.Request{
-		ProductID:       req.ProductId,
-		Version:         req.GetVersion(),    // Optional version for optimistic locking
-		DiscountPercent: req.DiscountPercent, // Now float64
-		StartDate:       req.StartDate.AsTime(),
-		EndDate:         req.EndDate.AsTime(),
+		ProductID: req.ProductId,
+		Version:   req.GetVersion(), // Optional version for optimistic locking
+		StartDate: req.StartDate.AsTime(),
+		EndDate:   req.EndDate.AsTime(),
+	}
+	switch {
+	case req.GetDiscountAmount() != nil:
+		amount, err := protoMoneyToDomain(req.GetDiscountAmount())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid discount_amount: %v", err)
+		}
+		appReq.DiscountAmount = amount
+	case req.GetDiscountPercentExact() != "":
+		percent, err := domain.ParsePercentage(req.GetDiscountPercentExact())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid discount_percent_exact: %v", err)
+		}
+		appReq.DiscountPercentExact = percent
+	default:
+		appReq.DiscountPercent = req.GetDiscountPercent()
 	}
 
 	// 3. Call usecase
-	if err := h.applyDiscount.Execute(ctx, appReq); err != nil {
+	discountID, err := h.applyDiscount.Execute(ctx, appReq)
+	if err != nil {
 		return nil, mapDomainErrorToGRPC(err)
 	}
 
 	// 4. Return response
-	return &pb.ApplyDiscountReply{}, nil
+	return &pb.ApplyDiscountReply{DiscountId: discountID}, nil
 }
 
-// RemoveDiscount removes a discount from a product.
+// RemoveDiscount removes the discount that is active now from a product.
 func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountReply, error) {
 	if req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product_id is required")
@@ -230,6 +548,27 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequ
 	return &pb.RemoveDiscountReply{}, nil
 }
 
+// CancelScheduledDiscount cancels a discount that has not started yet.
+func (h *Handler) CancelScheduledDiscount(ctx context.Context, req *pb.CancelScheduledDiscountRequest) (*pb.CancelScheduledDiscountReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.DiscountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "discount_id is required")
+	}
+
+	appReq := &cancel_scheduled_discount.Request{
+		ProductID:  req.ProductId,
+		DiscountID: req.DiscountId,
+		Version:    req.GetVersion(), // Optional version for optimistic locking
+	}
+	if err := h.cancelDiscount.Execute(ctx, appReq); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CancelScheduledDiscountReply{}, nil
+}
+
 // ArchiveProduct archives a product (soft delete).
 func (h *Handler) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ArchiveProductReply, error) {
 	if req.ProductId == "" {
@@ -250,13 +589,37 @@ func (h *Handler) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequ
 	}, nil
 }
 
+// RestoreProduct returns an archived product to inactive within the restore window.
+func (h *Handler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := &restore_product.Request{
+		ProductID: req.ProductId,
+		Version:   req.GetVersion(), // Optional version for optimistic locking
+	}
+	restoredAt, err := h.restoreProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RestoreProductReply{
+		RestoredAt: timestamppb.New(restoredAt),
+	}, nil
+}
+
 // GetProduct retrieves a product by ID.
 func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductReply, error) {
 	if req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product_id is required")
 	}
 
-	queryReq := &get_product.Request{ProductID: req.ProductId}
+	queryReq := &get_product.Request{
+		ProductID: req.ProductId,
+		Region:    req.Region,
+		Currency:  req.Currency,
+	}
 	dto, err := h.getProduct.Execute(ctx, queryReq)
 	if err != nil {
 		return nil, mapDomainErrorToGRPC(err)
@@ -272,6 +635,8 @@ func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 	queryReq := &list_products.Request{
 		Category:  req.Category,
 		Status:    req.Status,
+		Region:    req.Region,
+		Currency:  req.Currency,
 		PageSize:  int(req.PageSize),
 		PageToken: req.PageToken,
 	}
@@ -293,58 +658,359 @@ func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 	}, nil
 }
 
+// GetPrices retrieves a product's base price and price list.
+func (h *Handler) GetPrices(ctx context.Context, req *pb.GetPricesRequest) (*pb.GetPricesReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	dto, err := h.getPrices.Execute(ctx, &get_prices.Request{ProductID: req.ProductId})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	reply, err := pricesToProto(dto)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return reply, nil
+}
+
+// GetPriceHistory retrieves a page of a product's recorded price changes.
+func (h *Handler) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	changedAfter, changedBefore, err := timeRange(req.ChangedAfter, req.ChangedBefore, "changed_after", "changed_before")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getPriceHistory.Execute(ctx, &get_price_history.Request{
+		ProductID:     req.ProductId,
+		ChangedAfter:  changedAfter,
+		ChangedBefore: changedBefore,
+		Limit:         int(req.Limit),
+		PageToken:     req.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, query.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	entries, err := priceHistoryToProto(result.Records)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &pb.GetPriceHistoryReply{
+		Entries:       entries,
+		TotalCount:    result.TotalCount,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// GetPriceAt retrieves a product's base and effective price at a point in time.
+func (h *Handler) GetPriceAt(ctx context.Context, req *pb.GetPriceAtRequest) (*pb.GetPriceAtReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.At == nil {
+		return nil, status.Error(codes.InvalidArgument, "at is required")
+	}
+
+	dto, err := h.getPriceAt.Execute(ctx, &get_price_at.Request{
+		ProductID: req.ProductId,
+		At:        req.At.AsTime(),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	reply, err := priceAtToProto(dto)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return reply, nil
+}
+
+// ListDiscounts retrieves a product's discount schedule.
+func (h *Handler) ListDiscounts(ctx context.Context, req *pb.ListDiscountsRequest) (*pb.ListDiscountsReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	dtos, err := h.listDiscounts.Execute(ctx, &list_discounts.Request{ProductID: req.ProductId})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	discounts, err := discountsToProto(dtos)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &pb.ListDiscountsReply{Discounts: discounts}, nil
+}
+
+// ListScheduledPriceChanges retrieves a product's pending base price changes.
+func (h *Handler) ListScheduledPriceChanges(ctx context.Context, req *pb.ListScheduledPriceChangesRequest) (*pb.ListScheduledPriceChangesReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	dtos, err := h.listPriceChanges.Execute(ctx, &list_price_changes.Request{ProductID: req.ProductId})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	changes, err := priceChangesToProto(dtos)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &pb.ListScheduledPriceChangesReply{PriceChanges: changes}, nil
+}
+
+// ListTaxRates retrieves regional tax rates.
+func (h *Handler) ListTaxRates(ctx context.Context, req *pb.ListTaxRatesRequest) (*pb.ListTaxRatesReply, error) {
+	dtos, err := h.listTaxRates.Execute(ctx, &list_tax_rates.Request{Region: req.Region, TaxClass: req.TaxClass})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	rates := make([]*pb.TaxRate, 0, len(dtos))
+	for _, dto := range dtos {
+		rates = append(rates, taxRateToProto(dto))
+	}
+	return &pb.ListTaxRatesReply{TaxRates: rates}, nil
+}
+
 // ListEvents retrieves a list of domain events from the outbox.
 func (h *Handler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsReply, error) {
+	createdAfter, createdBefore, err := timeRange(req.CreatedAfter, req.CreatedBefore, "created_after", "created_before")
+	if err != nil {
+		return nil, err
+	}
+
 	queryReq := &list_events.Request{
-		Limit: int(req.Limit),
+		EventType:     req.EventType,
+		AggregateID:   req.AggregateId,
+		Status:        req.Status,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Limit:         int(req.Limit),
+		PageToken:     req.PageToken,
 	}
 
-	// Set optional filters
-	if req.EventType != nil {
-		queryReq.EventType = req.EventType
+	result, err := h.listEvents.Execute(ctx, queryReq)
+	if err != nil {
+		if errors.Is(err, query.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list events: %v", err))
 	}
-	if req.AggregateId != nil {
-		queryReq.AggregateID = req.AggregateId
+
+	// Convert events to proto
+	protoEvents := make([]*pb.Event, 0, len(result.Events))
+	for _, event := range result.Events {
+		protoEvents = append(protoEvents, outboxEventToProto(event))
 	}
-	if req.Status != nil {
-		queryReq.Status = req.Status
+
+	return &pb.ListEventsReply{
+		Events:        protoEvents,
+		TotalCount:    result.TotalCount,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// ListFailedEvents retrieves failed outbox events with their attempt history.
+func (h *Handler) ListFailedEvents(ctx context.Context, req *pb.ListFailedEventsRequest) (*pb.ListFailedEventsReply, error) {
+	failedAfter, failedBefore, err := timeRange(req.FailedAfter, req.FailedBefore, "failed_after", "failed_before")
+	if err != nil {
+		return nil, err
 	}
 
-	events, totalCount, err := h.listEvents.Execute(ctx, queryReq)
+	events, err := h.listFailedEvents.Execute(ctx, &list_failed_events.Request{
+		EventType:    req.GetEventType(),
+		AggregateID:  req.GetAggregateId(),
+		FailedAfter:  failedAfter,
+		FailedBefore: failedBefore,
+		Limit:        int(req.Limit),
+	})
 	if err != nil {
-		// Temporarily return the actual error for debugging
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list events: %v", err))
+		return nil, mapDomainErrorToGRPC(err)
 	}
 
-	// Convert events to proto
-	protoEvents := make([]*pb.Event, 0, len(events))
+	protoEvents := make([]*pb.FailedEvent, 0, len(events))
 	for _, event := range events {
-		// Convert NullJSON to string
-		payload := ""
-		if event.Payload.Valid {
-			// NullJSON.Value is interface{}, need to marshal to JSON string
-			payloadBytes, err := json.Marshal(event.Payload.Value)
-			if err == nil {
-				payload = string(payloadBytes)
-			}
+		protoEvents = append(protoEvents, failedEventToProto(event))
+	}
+
+	return &pb.ListFailedEventsReply{Events: protoEvents}, nil
+}
+
+// RetryEvent returns a failed outbox event to pending.
+func (h *Handler) RetryEvent(ctx context.Context, req *pb.RetryEventRequest) (*pb.RetryEventReply, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event_id is required")
+	}
+
+	if err := h.retryEvent.Execute(ctx, &retry_event.Request{EventID: req.EventId}); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RetryEventReply{}, nil
+}
+
+// RetryEventsByFilter returns all failed outbox events matching the filter to pending.
+func (h *Handler) RetryEventsByFilter(ctx context.Context, req *pb.RetryEventsByFilterRequest) (*pb.RetryEventsByFilterReply, error) {
+	failedAfter, failedBefore, err := timeRange(req.FailedAfter, req.FailedBefore, "failed_after", "failed_before")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.retryEventsByFilter.Execute(ctx, &retry_events_by_filter.Request{
+		EventType:    req.GetEventType(),
+		AggregateID:  req.GetAggregateId(),
+		FailedAfter:  failedAfter,
+		FailedBefore: failedBefore,
+		DryRun:       req.DryRun,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RetryEventsByFilterReply{
+		MatchedCount: resp.MatchedCount,
+		RetriedCount: resp.RetriedCount,
+	}, nil
+}
+
+// ReplayEvents re-publishes completed outbox events to a replay publisher. The replay runs
+// within the request, so it must be filtered and match at most
+// replay_events.MaxRequestEvents events; unbounded replays are left to cmd/outbox_replay.
+func (h *Handler) ReplayEvents(ctx context.Context, req *pb.ReplayEventsRequest) (*pb.ReplayEventsReply, error) {
+	if req.Publisher == "" {
+		return nil, status.Error(codes.InvalidArgument, "publisher is required")
+	}
+	if req.GetEventType() == "" && req.GetAggregateId() == "" && req.CreatedAfter == nil && req.CreatedBefore == nil {
+		return nil, status.Error(codes.InvalidArgument,
+			"event_type, aggregate_id or a created_after/created_before range is required; use cmd/outbox_replay for unfiltered replays")
+	}
+	createdAfter, createdBefore, err := timeRange(req.CreatedAfter, req.CreatedBefore, "created_after", "created_before")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.replayEvents.Execute(ctx, &replay_events.Request{
+		EventType:     req.GetEventType(),
+		AggregateID:   req.GetAggregateId(),
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Publisher:     req.Publisher,
+		DryRun:        req.DryRun,
+		MaxEvents:     replay_events.MaxRequestEvents,
+	})
+	if err != nil {
+		if resp == nil {
+			return nil, mapDomainErrorToGRPC(err)
+		}
+		// Partially replayed: report progress so the caller can narrow the range and resume
+		return nil, status.Errorf(codes.Unavailable, "replay stopped after %d of %d events: %v",
+			resp.ReplayedCount, resp.MatchedCount, err)
+	}
+
+	return &pb.ReplayEventsReply{
+		MatchedCount:  resp.MatchedCount,
+		ReplayedCount: resp.ReplayedCount,
+	}, nil
+}
+
+// PurgeArchivedProducts permanently deletes one batch of products archived before the cutoff.
+func (h *Handler) PurgeArchivedProducts(ctx context.Context, req *pb.PurgeArchivedProductsRequest) (*pb.PurgeArchivedProductsReply, error) {
+	if req.ArchivedBefore == nil {
+		return nil, status.Error(codes.InvalidArgument, "archived_before is required")
+	}
+	if err := req.ArchivedBefore.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid archived_before")
+	}
+	if req.BatchSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch_size must not be negative")
+	}
+
+	resp, err := h.purgeArchivedProducts.Execute(ctx, &purge_archived_products.Request{
+		ArchivedBefore: req.ArchivedBefore.AsTime(),
+		DryRun:         req.DryRun,
+		BatchSize:      int(req.BatchSize),
+	})
+	if resp == nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	// Products that failed are reported in failed_count and purged by a later call
+
+	return &pb.PurgeArchivedProductsReply{
+		ProductCount:      int64(resp.ProductCount),
+		PriceHistoryCount: resp.PriceHistoryCount,
+		PurgedCount:       int64(resp.PurgedCount),
+		FailedCount:       int64(resp.FailedCount),
+	}, nil
+}
+
+// timeRange converts optional [after, before) timestamp bounds.
+func timeRange(after, before *timestamppb.Timestamp, afterField, beforeField string) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if after != nil {
+		if err := after.CheckValid(); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid %s", afterField)
+		}
+		t := after.AsTime()
+		from = &t
+	}
+	if before != nil {
+		if err := before.CheckValid(); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid %s", beforeField)
 		}
+		t := before.AsTime()
+		to = &t
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s must be before %s", afterField, beforeField)
+	}
+	return from, to, nil
+}
+
+// WatchEvents streams outbox events as they are committed.
+func (h *Handler) WatchEvents(req *pb.WatchEventsRequest, stream pb.ProductService_WatchEventsServer) error {
+	queryReq := &watch_events.Request{
+		EventType:   req.EventType,
+		AggregateID: req.AggregateId,
+		Status:      req.Status,
+	}
 
-		protoEvent := &pb.Event{
-			EventId:     event.EventID,
-			EventType:   event.EventType,
-			AggregateId: event.AggregateID,
-			Payload:     payload,
-			Status:      event.Status,
-			CreatedAt:   timestamppb.New(event.CreatedAt),
+	switch resume := req.Resume.(type) {
+	case *pb.WatchEventsRequest_ResumeAfterEventId:
+		queryReq.AfterEventID = &resume.ResumeAfterEventId
+	case *pb.WatchEventsRequest_StartTime:
+		if err := resume.StartTime.CheckValid(); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid start_time")
 		}
-		if event.ProcessedAt.Valid {
-			protoEvent.ProcessedAt = timestamppb.New(event.ProcessedAt.Time)
+		startTime := resume.StartTime.AsTime()
+		queryReq.StartTime = &startTime
+	}
+
+	err := h.watchEvents.Execute(stream.Context(), queryReq, func(event *m_outbox.Data) error {
+		return stream.Send(&pb.WatchEventsReply{Event: outboxEventToProto(event)})
+	})
+	if err != nil {
+		if errors.Is(err, watch_events.ErrEventNotFound) {
+			return status.Error(codes.NotFound, "resume event not found")
+		}
+		if stream.Context().Err() != nil {
+			return status.FromContextError(stream.Context().Err()).Err()
 		}
-		protoEvents = append(protoEvents, protoEvent)
+		return status.Error(codes.Internal, "failed to watch events")
 	}
 
-	return &pb.ListEventsReply{
-		Events:     protoEvents,
-		TotalCount: totalCount,
-	}, nil
+	return nil
 }
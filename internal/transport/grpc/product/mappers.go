@@ -1,18 +1,253 @@
 package product
 
 import (
+	"encoding/json"
+
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
 	pb "github.com/light-bringer/procat-service/proto/product/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // protoMoneyToDomain converts proto Money to domain Money.
+// An empty currency means DefaultCurrency, for clients predating the currency field.
 func protoMoneyToDomain(m *pb.Money) (*domain.Money, error) {
 	if m == nil {
 		return nil, nil
 	}
-	return domain.NewMoney(m.Numerator, m.Denominator)
+	currency := domain.Currency(m.Currency)
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+	return domain.NewMoney(m.Numerator, m.Denominator, currency)
+}
+
+// domainMoneyToProto converts domain Money to proto Money.
+func domainMoneyToProto(m *domain.Money) (*pb.Money, error) {
+	num, err := m.Numerator()
+	if err != nil {
+		return nil, err
+	}
+	den, err := m.Denominator()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Money{Numerator: num, Denominator: den, Currency: string(m.Currency())}, nil
+}
+
+// pricesToProto converts a product's base price and price list to proto.
+func pricesToProto(dto *contracts.PricesDTO) (*pb.GetPricesReply, error) {
+	basePrice, err := domainMoneyToProto(dto.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	regionalPrices := make([]*pb.RegionalPrice, 0, len(dto.RegionalPrices))
+	for _, entry := range dto.RegionalPrices {
+		price, err := domainMoneyToProto(entry.Price)
+		if err != nil {
+			return nil, err
+		}
+		regionalPrices = append(regionalPrices, &pb.RegionalPrice{
+			Region:    entry.Region,
+			Price:     price,
+			UpdatedAt: timestamppb.New(entry.UpdatedAt),
+		})
+	}
+
+	return &pb.GetPricesReply{
+		BasePrice:      basePrice,
+		RegionalPrices: regionalPrices,
+	}, nil
+}
+
+// discountsToProto converts a product's discount schedule to proto Discounts.
+func discountsToProto(dtos []*contracts.DiscountDTO) ([]*pb.Discount, error) {
+	discounts := make([]*pb.Discount, 0, len(dtos))
+	for _, dto := range dtos {
+		discount := &pb.Discount{
+			DiscountId:      dto.DiscountID,
+			Kind:            string(dto.Kind),
+			DiscountPercent: dto.DiscountPercent,
+			StartDate:       timestamppb.New(dto.StartDate),
+			EndDate:         timestamppb.New(dto.EndDate),
+			Status:          dto.Status,
+		}
+		if dto.DiscountPercentExact != nil {
+			exact := domain.FormatPercentage(dto.DiscountPercentExact)
+			discount.DiscountPercentExact = &exact
+		}
+		if dto.DiscountAmount != nil {
+			amount, err := domainMoneyToProto(dto.DiscountAmount)
+			if err != nil {
+				return nil, err
+			}
+			discount.DiscountAmount = amount
+		}
+		discounts = append(discounts, discount)
+	}
+	return discounts, nil
+}
+
+// priceChangesToProto converts a product's pending price changes to proto.
+func priceChangesToProto(dtos []*contracts.PriceChangeDTO) ([]*pb.ScheduledPriceChange, error) {
+	changes := make([]*pb.ScheduledPriceChange, 0, len(dtos))
+	for _, dto := range dtos {
+		newPrice, err := domainMoneyToProto(dto.Change.NewPrice())
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &pb.ScheduledPriceChange{
+			PriceChangeId: dto.Change.ID(),
+			NewPrice:      newPrice,
+			EffectiveAt:   timestamppb.New(dto.Change.EffectiveAt()),
+			ChangedBy:     dto.Change.ChangedBy(),
+			ChangedReason: dto.Change.ChangedReason(),
+			CreatedAt:     timestamppb.New(dto.CreatedAt),
+		})
+	}
+	return changes, nil
+}
+
+// priceHistoryToProto converts price history records to proto, keeping the exact prices.
+func priceHistoryToProto(records []contracts.PriceHistoryRecord) ([]*pb.PriceHistoryEntry, error) {
+	entries := make([]*pb.PriceHistoryEntry, 0, len(records))
+	for _, record := range records {
+		entry := &pb.PriceHistoryEntry{
+			HistoryId:      record.HistoryID,
+			Region:         record.Region,
+			ChangedBy:      record.ChangedBy,
+			ChangedReason:  record.ChangedReason,
+			ChangedAt:      timestamppb.New(record.ChangedAt),
+			RequestId:      record.RequestID,
+			Decision:       string(record.Decision),
+			DecidedBy:      record.DecidedBy,
+			DecisionReason: record.DecisionReason,
+		}
+		if record.OldPrice != nil {
+			oldPrice, err := domainMoneyToProto(record.OldPrice)
+			if err != nil {
+				return nil, err
+			}
+			entry.OldPrice = oldPrice
+		}
+		if record.NewPrice != nil {
+			newPrice, err := domainMoneyToProto(record.NewPrice)
+			if err != nil {
+				return nil, err
+			}
+			entry.NewPrice = newPrice
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// priceAtToProto converts a product's price at a point in time to proto.
+func priceAtToProto(dto *contracts.PriceAtDTO) (*pb.GetPriceAtReply, error) {
+	basePrice, err := domainMoneyToProto(dto.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	effectivePrice, err := roundedPriceToProto(dto.EffectivePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetPriceAtReply{
+		BasePrice:      basePrice,
+		EffectivePrice: effectivePrice,
+	}
+	if !dto.BasePriceSince.IsZero() {
+		reply.BasePriceSince = timestamppb.New(dto.BasePriceSince)
+	}
+	if dto.Discount != nil {
+		discounts, err := discountsToProto([]*contracts.DiscountDTO{dto.Discount})
+		if err != nil {
+			return nil, err
+		}
+		reply.Discount = discounts[0]
+	}
+	return reply, nil
+}
+
+// priceChangeRequestToProto converts a pending price change request to proto.
+func priceChangeRequestToProto(request *domain.PriceChangeRequest) (*pb.PriceChangeRequest, error) {
+	oldPrice, err := domainMoneyToProto(request.OldPrice())
+	if err != nil {
+		return nil, err
+	}
+	newPrice, err := domainMoneyToProto(request.NewPrice())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PriceChangeRequest{
+		RequestId:       request.ID(),
+		OldPrice:        oldPrice,
+		NewPrice:        newPrice,
+		RequestedBy:     request.RequestedBy(),
+		RequestedReason: request.RequestedReason(),
+		Violations:      request.Violations(),
+		RequestedAt:     timestamppb.New(request.RequestedAt()),
+	}, nil
+}
+
+// roundedPriceToProto converts a rounded price to proto. Effective prices of
+// percentage discounts may not fit int64 fractions, in which case an error is returned.
+func roundedPriceToProto(price *domain.RoundedPrice) (*pb.RoundedPrice, error) {
+	exact, err := domainMoneyToProto(price.Exact)
+	if err != nil {
+		return nil, err
+	}
+	rounded, err := domainMoneyToProto(price.Rounded)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RoundedPrice{Exact: exact, Rounded: rounded, RoundingMode: string(price.Mode)}, nil
+}
+
+// taxBreakdownToProto converts a tax breakdown to proto. Amounts extracted from gross
+// prices may not fit int64 fractions, in which case an error is returned.
+func taxBreakdownToProto(breakdown *domain.TaxBreakdown) (*pb.TaxBreakdown, error) {
+	net, err := domainMoneyToProto(breakdown.Net)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := domainMoneyToProto(breakdown.Tax)
+	if err != nil {
+		return nil, err
+	}
+	gross, err := domainMoneyToProto(breakdown.Gross)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaxBreakdown{
+		Region:           breakdown.Rate.Region(),
+		TaxClass:         breakdown.Rate.TaxClass(),
+		RatePercent:      domain.FormatPercentage(breakdown.Rate.Percent()),
+		PricesIncludeTax: breakdown.Rate.PricesIncludeTax(),
+		Net:              net,
+		Tax:              tax,
+		Gross:            gross,
+	}, nil
+}
+
+// taxRateToProto converts a TaxRateDTO to proto TaxRate.
+func taxRateToProto(dto *contracts.TaxRateDTO) *pb.TaxRate {
+	rate := &pb.TaxRate{
+		Region:           dto.Rate.Region(),
+		TaxClass:         dto.Rate.TaxClass(),
+		RatePercent:      domain.FormatPercentage(dto.Rate.Percent()),
+		EffectiveFrom:    timestamppb.New(dto.Rate.EffectiveFrom()),
+		PricesIncludeTax: dto.Rate.PricesIncludeTax(),
+		Current:          dto.Current,
+	}
+	if !dto.UpdatedAt.IsZero() {
+		rate.UpdatedAt = timestamppb.New(dto.UpdatedAt)
+	}
+	return rate
 }
 
 // dtoToProtoProduct converts a ProductDTO to proto Product.
@@ -22,8 +257,11 @@ func dtoToProtoProduct(dto *contracts.ProductDTO) *pb.Product {
 		Name:           dto.Name,
 		Description:    dto.Description,
 		Category:       dto.Category,
+		TaxClass:       dto.TaxClass,
 		BasePrice:      dto.BasePrice,
 		EffectivePrice: dto.EffectivePrice,
+		Currency:       dto.Currency,
+		PriceRegion:    dto.PriceRegion,
 		DiscountActive: dto.DiscountActive,
 		Status:         dto.Status,
 		CreatedAt:      timestamppb.New(dto.CreatedAt),
@@ -33,6 +271,83 @@ func dtoToProtoProduct(dto *contracts.ProductDTO) *pb.Product {
 	if dto.DiscountPercent != nil {
 		p.DiscountPercent = dto.DiscountPercent
 	}
+	if dto.DiscountPercentExact != nil {
+		exact := domain.FormatPercentage(dto.DiscountPercentExact)
+		p.DiscountPercentExact = &exact
+	}
+	if dto.RoundedEffectivePrice != nil {
+		if rounded, err := roundedPriceToProto(dto.RoundedEffectivePrice); err == nil {
+			p.RoundedEffectivePrice = rounded
+		}
+	}
+	if dto.Tax != nil {
+		if tax, err := taxBreakdownToProto(dto.Tax); err == nil {
+			p.Tax = tax
+		}
+	}
+	if dto.DiscountAmount != nil {
+		// Stored amounts are already normalized to int64 fractions, so this cannot overflow
+		if amount, err := domainMoneyToProto(dto.DiscountAmount); err == nil {
+			p.DiscountAmount = amount
+		}
+	}
+	if dto.CostPrice != nil {
+		if cost, err := domainMoneyToProto(dto.CostPrice); err == nil {
+			p.CostPrice = cost
+		}
+	}
+	if dto.PendingPriceChange != nil {
+		if request, err := priceChangeRequestToProto(dto.PendingPriceChange); err == nil {
+			p.PendingPriceChange = request
+		}
+	}
 
 	return p
 }
+
+// outboxEventToProto converts an outbox row to proto Event.
+func outboxEventToProto(event *m_outbox.Data) *pb.Event {
+	// Convert NullJSON to string
+	payload := ""
+	if event.Payload.Valid {
+		// NullJSON.Value is interface{}, need to marshal to JSON string
+		payloadBytes, err := json.Marshal(event.Payload.Value)
+		if err == nil {
+			payload = string(payloadBytes)
+		}
+	}
+
+	protoEvent := &pb.Event{
+		EventId:     event.EventID,
+		EventType:   event.EventType,
+		AggregateId: event.AggregateID,
+		Payload:     payload,
+		Status:      event.Status,
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+	}
+	if event.ProcessedAt.Valid {
+		protoEvent.ProcessedAt = timestamppb.New(event.ProcessedAt.Time)
+	}
+
+	return protoEvent
+}
+
+// failedEventToProto converts a failed event and its attempt history to proto.
+func failedEventToProto(dto *outboxcontracts.FailedEventDTO) *pb.FailedEvent {
+	attempts := make([]*pb.EventAttempt, 0, len(dto.Attempts))
+	for _, attempt := range dto.Attempts {
+		attempts = append(attempts, &pb.EventAttempt{
+			Outcome:      attempt.Outcome,
+			RetryCount:   attempt.RetryCount,
+			ErrorMessage: attempt.ErrorMessage,
+			AttemptedAt:  timestamppb.New(attempt.AttemptedAt),
+		})
+	}
+
+	return &pb.FailedEvent{
+		Event:        outboxEventToProto(dto.Event),
+		RetryCount:   dto.Event.RetryCount,
+		ErrorMessage: dto.Event.ErrorMessage.StringVal,
+		Attempts:     attempts,
+	}
+}
@@ -29,7 +29,7 @@ func validateUpdateProductRequest(req *pb.UpdateProductRequest) error {
 		return status.Error(codes.InvalidArgument, "product_id is required")
 	}
 	// At least one field must be provided for update
-	if req.Name == nil && req.Description == nil && req.Category == nil {
+	if req.Name == nil && req.Description == nil && req.Category == nil && req.TaxClass == nil && req.CostPrice == nil {
 		return status.Error(codes.InvalidArgument, "at least one field must be provided for update")
 	}
 	return nil
@@ -40,8 +40,24 @@ func validateApplyDiscountRequest(req *pb.ApplyDiscountRequest) error {
 	if req.ProductId == "" {
 		return status.Error(codes.InvalidArgument, "product_id is required")
 	}
-	if req.DiscountPercent < 0 || req.DiscountPercent > 100 {
-		return status.Error(codes.InvalidArgument, "discount_percent must be between 0 and 100")
+	switch discount := req.Discount.(type) {
+	case *pb.ApplyDiscountRequest_DiscountPercent:
+		if discount.DiscountPercent < 0 || discount.DiscountPercent > 100 {
+			return status.Error(codes.InvalidArgument, "discount_percent must be between 0 and 100")
+		}
+	case *pb.ApplyDiscountRequest_DiscountPercentExact:
+		if discount.DiscountPercentExact == "" {
+			return status.Error(codes.InvalidArgument, "discount_percent_exact is required")
+		}
+	case *pb.ApplyDiscountRequest_DiscountAmount:
+		if discount.DiscountAmount == nil {
+			return status.Error(codes.InvalidArgument, "discount_amount is required")
+		}
+		if discount.DiscountAmount.Denominator == 0 {
+			return status.Error(codes.InvalidArgument, "discount_amount denominator cannot be zero")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "discount_percent, discount_percent_exact or discount_amount is required")
 	}
 	if req.StartDate == nil {
 		return status.Error(codes.InvalidArgument, "start_date is required")
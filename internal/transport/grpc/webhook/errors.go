@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"errors"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mapDomainErrorToGRPC converts webhook domain errors to gRPC status codes.
+func mapDomainErrorToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, "webhook subscription not found")
+
+	case errors.Is(err, domain.ErrInvalidURL):
+		return status.Error(codes.InvalidArgument, "url must be an absolute http or https url")
+
+	case errors.Is(err, domain.ErrInvalidEventFilter):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domain.ErrSecretTooShort):
+		return status.Error(codes.InvalidArgument, "secret must be at least 16 characters")
+
+	default:
+		// Unknown error - return Internal
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
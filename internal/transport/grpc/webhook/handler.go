@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/get_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/list_deliveries"
+	"github.com/light-bringer/procat-service/internal/app/webhook/queries/list_subscriptions"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/create_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/delete_subscription"
+	"github.com/light-bringer/procat-service/internal/app/webhook/usecases/update_subscription"
+	pb "github.com/light-bringer/procat-service/proto/webhook/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handler implements the gRPC WebhookService interface.
+// It's a thin coordinator that delegates to use cases and queries.
+type Handler struct {
+	pb.UnimplementedWebhookServiceServer
+
+	// Commands
+	createSubscription *create_subscription.Interactor
+	updateSubscription *update_subscription.Interactor
+	deleteSubscription *delete_subscription.Interactor
+
+	// Queries
+	getSubscription   *get_subscription.Query
+	listSubscriptions *list_subscriptions.Query
+	listDeliveries    *list_deliveries.Query
+}
+
+// NewHandler creates a new gRPC webhook handler.
+func NewHandler(
+	createSubscription *create_subscription.Interactor,
+	updateSubscription *update_subscription.Interactor,
+	deleteSubscription *delete_subscription.Interactor,
+	getSubscription *get_subscription.Query,
+	listSubscriptions *list_subscriptions.Query,
+	listDeliveries *list_deliveries.Query,
+) *Handler {
+	return &Handler{
+		createSubscription: createSubscription,
+		updateSubscription: updateSubscription,
+		deleteSubscription: deleteSubscription,
+		getSubscription:    getSubscription,
+		listSubscriptions:  listSubscriptions,
+		listDeliveries:     listDeliveries,
+	}
+}
+
+// CreateSubscription registers a new webhook endpoint.
+func (h *Handler) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.CreateSubscriptionReply, error) {
+	if req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	resp, err := h.createSubscription.Execute(ctx, &create_subscription.Request{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CreateSubscriptionReply{
+		SubscriptionId: resp.SubscriptionID,
+		Secret:         resp.Secret,
+	}, nil
+}
+
+// UpdateSubscription changes the endpoint, filters or state of a subscription.
+func (h *Handler) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.UpdateSubscriptionReply, error) {
+	if req.SubscriptionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+	if req.Url == nil && req.EventTypes == nil && req.Active == nil && !req.RotateSecret {
+		return nil, status.Error(codes.InvalidArgument, "at least one field must be provided for update")
+	}
+
+	appReq := &update_subscription.Request{
+		SubscriptionID: req.SubscriptionId,
+		URL:            req.Url,
+		Active:         req.Active,
+		RotateSecret:   req.RotateSecret,
+	}
+	if req.EventTypes != nil {
+		eventTypes := req.EventTypes.EventTypes
+		appReq.EventTypes = &eventTypes
+	}
+
+	resp, err := h.updateSubscription.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.UpdateSubscriptionReply{Secret: resp.Secret}, nil
+}
+
+// DeleteSubscription removes a subscription and its delivery log.
+func (h *Handler) DeleteSubscription(ctx context.Context, req *pb.DeleteSubscriptionRequest) (*pb.DeleteSubscriptionReply, error) {
+	if req.SubscriptionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+
+	if err := h.deleteSubscription.Execute(ctx, &delete_subscription.Request{SubscriptionID: req.SubscriptionId}); err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.DeleteSubscriptionReply{}, nil
+}
+
+// GetSubscription retrieves a subscription by ID.
+func (h *Handler) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.GetSubscriptionReply, error) {
+	if req.SubscriptionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+
+	dto, err := h.getSubscription.Execute(ctx, &get_subscription.Request{SubscriptionID: req.SubscriptionId})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &pb.GetSubscriptionReply{
+		Subscription: dtoToProtoSubscription(dto),
+	}, nil
+}
+
+// ListSubscriptions retrieves all subscriptions.
+func (h *Handler) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsReply, error) {
+	dtos, err := h.listSubscriptions.Execute(ctx)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	subs := make([]*pb.Subscription, 0, len(dtos))
+	for _, dto := range dtos {
+		subs = append(subs, dtoToProtoSubscription(dto))
+	}
+
+	return &pb.ListSubscriptionsReply{Subscriptions: subs}, nil
+}
+
+// ListDeliveries retrieves recent deliveries of a subscription with their attempt log.
+func (h *Handler) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.ListDeliveriesReply, error) {
+	if req.SubscriptionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription_id is required")
+	}
+
+	dtos, err := h.listDeliveries.Execute(ctx, &list_deliveries.Request{
+		SubscriptionID: req.SubscriptionId,
+		EventID:        req.GetEventId(),
+		Status:         req.GetStatus(),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	deliveries := make([]*pb.Delivery, 0, len(dtos))
+	for _, dto := range dtos {
+		deliveries = append(deliveries, dtoToProtoDelivery(dto))
+	}
+
+	return &pb.ListDeliveriesReply{Deliveries: deliveries}, nil
+}
@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	pb "github.com/light-bringer/procat-service/proto/webhook/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dtoToProtoSubscription converts a SubscriptionDTO to proto Subscription.
+func dtoToProtoSubscription(dto *contracts.SubscriptionDTO) *pb.Subscription {
+	return &pb.Subscription{
+		SubscriptionId: dto.SubscriptionID,
+		Url:            dto.URL,
+		EventTypes:     dto.EventTypes,
+		Active:         dto.Active,
+		CreatedAt:      timestamppb.New(dto.CreatedAt),
+		UpdatedAt:      timestamppb.New(dto.UpdatedAt),
+	}
+}
+
+// dtoToProtoDelivery converts a DeliveryDTO to proto Delivery.
+func dtoToProtoDelivery(dto *contracts.DeliveryDTO) *pb.Delivery {
+	d := &pb.Delivery{
+		SubscriptionId: dto.SubscriptionID,
+		EventId:        dto.EventID,
+		EventType:      dto.EventType,
+		Status:         dto.Status,
+		AttemptCount:   dto.AttemptCount,
+		LastError:      dto.LastError,
+		CreatedAt:      timestamppb.New(dto.CreatedAt),
+	}
+
+	if dto.NextAttemptAt != nil {
+		d.NextAttemptAt = timestamppb.New(*dto.NextAttemptAt)
+	}
+	if dto.DeliveredAt != nil {
+		d.DeliveredAt = timestamppb.New(*dto.DeliveredAt)
+	}
+
+	d.Attempts = make([]*pb.DeliveryAttempt, 0, len(dto.Attempts))
+	for _, attempt := range dto.Attempts {
+		d.Attempts = append(d.Attempts, &pb.DeliveryAttempt{
+			AttemptNumber: attempt.AttemptNumber,
+			AttemptedAt:   timestamppb.New(attempt.AttemptedAt),
+			StatusCode:    int32(attempt.StatusCode),
+			Error:         attempt.Error,
+			DurationMs:    attempt.DurationMs,
+		})
+	}
+
+	return d
+}
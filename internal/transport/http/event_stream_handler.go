@@ -0,0 +1,163 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/light-bringer/procat-service/proto/product/v1"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultKeepAliveInterval is how often an idle event stream sends a keepalive comment.
+const DefaultKeepAliveInterval = 15 * time.Second
+
+// EventStreamHandler streams outbox events as Server-Sent Events.
+type EventStreamHandler struct {
+	productService    pb.ProductServiceClient
+	keepAliveInterval time.Duration
+}
+
+// NewEventStreamHandler creates a new HTTP event stream handler.
+func NewEventStreamHandler(productService pb.ProductServiceClient, keepAliveInterval time.Duration) *EventStreamHandler {
+	if keepAliveInterval <= 0 {
+		keepAliveInterval = DefaultKeepAliveInterval
+	}
+	return &EventStreamHandler{
+		productService:    productService,
+		keepAliveInterval: keepAliveInterval,
+	}
+}
+
+// watchResult is one message (or the terminal error) received from WatchEvents.
+type watchResult struct {
+	event *pb.Event
+	err   error
+}
+
+// ServeHTTP handles GET /api/v1/events/stream requests.
+//
+// Each event is sent with its event_id as the SSE id and its event_type as the SSE event
+// name; data is the same JSON object returned by GET /api/v1/events. Browsers send the last
+// id back in the Last-Event-ID header when they reconnect, and the stream resumes after it.
+// The last_event_id query parameter does the same for the first connection.
+func (h *EventStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	req := &pb.WatchEventsRequest{}
+
+	if eventType := query.Get("event_type"); eventType != "" {
+		req.EventType = &eventType
+	}
+
+	if aggregateID := query.Get("aggregate_id"); aggregateID != "" {
+		req.AggregateId = &aggregateID
+	}
+
+	if status := query.Get("status"); status != "" {
+		req.Status = &status
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = query.Get("last_event_id")
+	}
+	if lastEventID != "" {
+		req.Resume = &pb.WatchEventsRequest_ResumeAfterEventId{ResumeAfterEventId: lastEventID}
+	}
+
+	// Call gRPC service; the stream ends when the client disconnects
+	ctx := r.Context()
+	stream, err := h.productService.WatchEvents(ctx, req)
+	if err != nil {
+		http.Error(w, "Failed to watch events: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable proxy buffering (nginx)
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	// Receive in the background so keepalives can be sent while the stream is idle
+	results := make(chan watchResult)
+	go func() {
+		defer close(results)
+		for {
+			reply, err := stream.Recv()
+			select {
+			case results <- watchResult{event: reply.GetEvent(), err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(h.keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+			flusher.Flush()
+
+		case result, ok := <-results:
+			if !ok {
+				return
+			}
+			if result.err != nil {
+				if ctx.Err() == nil {
+					writeSSE(w, "", "error", status.Convert(result.err).Message())
+					flusher.Flush()
+				}
+				return
+			}
+
+			data, err := json.Marshal(protoEventToHTTP(result.event))
+			if err != nil {
+				writeSSE(w, "", "error", "failed to encode event")
+				flusher.Flush()
+				return
+			}
+			writeSSE(w, result.event.EventId, result.event.EventType, string(data))
+			flusher.Flush()
+		}
+	}
+}
+
+// writeSSE writes one Server-Sent Event. Multi-line data is split into data lines.
+func writeSSE(w http.ResponseWriter, id, event, data string) {
+	if id != "" {
+		fmt.Fprintf(w, "id: %s\n", id)
+	}
+	if event != "" {
+		fmt.Fprintf(w, "event: %s\n", event)
+	}
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
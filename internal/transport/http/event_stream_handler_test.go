@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/light-bringer/procat-service/proto/product/v1"
+)
+
+// fakeWatchStream replays a fixed set of events, then blocks until cancelled.
+type fakeWatchStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events []*pb.Event
+	err    error
+}
+
+func (s *fakeWatchStream) Recv() (*pb.WatchEventsReply, error) {
+	if len(s.events) > 0 {
+		event := s.events[0]
+		s.events = s.events[1:]
+		return &pb.WatchEventsReply{Event: event}, nil
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	<-s.ctx.Done()
+	return nil, status.FromContextError(s.ctx.Err()).Err()
+}
+
+// fakeProductService records the WatchEvents request.
+type fakeProductService struct {
+	pb.ProductServiceClient
+	events []*pb.Event
+	err    error
+	req    *pb.WatchEventsRequest
+}
+
+func (f *fakeProductService) WatchEvents(ctx context.Context, in *pb.WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[pb.WatchEventsReply], error) {
+	f.req = in
+	return &fakeWatchStream{ctx: ctx, events: f.events, err: f.err}, nil
+}
+
+func newEvent(id, eventType string) *pb.Event {
+	return &pb.Event{
+		EventId:     id,
+		EventType:   eventType,
+		AggregateId: "product-1",
+		Payload:     `{"schema_version":1}`,
+		Status:      "pending",
+		CreatedAt:   timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+// readSSE reads the stream until n blocks matching keep have been collected.
+func readSSE(t *testing.T, scanner *bufio.Scanner, n int, keep func(string) bool) []string {
+	t.Helper()
+	var blocks []string
+	var current []string
+	for len(blocks) < n && scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			current = append(current, line)
+			continue
+		}
+		block := strings.Join(current, "\n")
+		current = nil
+		if keep(block) {
+			blocks = append(blocks, block)
+		}
+	}
+	require.Len(t, blocks, n, "stream ended early")
+	return blocks
+}
+
+func isEvent(block string) bool { return !strings.HasPrefix(block, ":") }
+
+func TestEventStreamHandler(t *testing.T) {
+	t.Run("streams events with filters", func(t *testing.T) {
+		service := &fakeProductService{events: []*pb.Event{
+			newEvent("e1", "product.created"),
+			newEvent("e2", "product.activated"),
+		}}
+		server := httptest.NewServer(NewEventStreamHandler(service, time.Hour))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "?event_type=product.created&aggregate_id=product-1&status=pending")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		blocks := readSSE(t, bufio.NewScanner(resp.Body), 2, isEvent)
+		assert.Equal(t, "id: e1\nevent: product.created\n"+
+			`data: {"event_id":"e1","event_type":"product.created","aggregate_id":"product-1","payload":"{\"schema_version\":1}","status":"pending","created_at":"2026-01-01T00:00:00Z"}`,
+			blocks[0])
+		assert.True(t, strings.HasPrefix(blocks[1], "id: e2\nevent: product.activated\n"))
+
+		assert.Equal(t, "product.created", service.req.GetEventType())
+		assert.Equal(t, "product-1", service.req.GetAggregateId())
+		assert.Equal(t, "pending", service.req.GetStatus())
+		assert.Nil(t, service.req.Resume)
+	})
+
+	t.Run("resumes from Last-Event-ID", func(t *testing.T) {
+		service := &fakeProductService{events: []*pb.Event{newEvent("e3", "product.archived")}}
+		server := httptest.NewServer(NewEventStreamHandler(service, time.Hour))
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "e2")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		readSSE(t, bufio.NewScanner(resp.Body), 1, isEvent)
+		assert.Equal(t, "e2", service.req.GetResumeAfterEventId())
+	})
+
+	t.Run("sends keepalive comments while idle", func(t *testing.T) {
+		server := httptest.NewServer(NewEventStreamHandler(&fakeProductService{}, 10*time.Millisecond))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		blocks := readSSE(t, bufio.NewScanner(resp.Body), 2, func(block string) bool { return block == ": keepalive" })
+		assert.Len(t, blocks, 2)
+	})
+
+	t.Run("reports stream errors as error events", func(t *testing.T) {
+		service := &fakeProductService{err: status.Error(codes.NotFound, "resume event not found")}
+		server := httptest.NewServer(NewEventStreamHandler(service, time.Hour))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "?last_event_id=gone")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		blocks := readSSE(t, bufio.NewScanner(resp.Body), 1, isEvent)
+		assert.Equal(t, "event: error\ndata: resume event not found", blocks[0])
+		assert.Equal(t, "gone", service.req.GetResumeAfterEventId())
+	})
+
+	t.Run("rejects non-GET", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		NewEventStreamHandler(&fakeProductService{}, time.Hour).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/events/stream", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
 	pb "github.com/light-bringer/procat-service/proto/product/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // EventsHandler handles HTTP requests for events.
@@ -34,8 +38,9 @@ type Event struct {
 
 // ListEventsResponse represents the HTTP response for listing events.
 type ListEventsResponse struct {
-	Events     []Event `json:"events"`
-	TotalCount int64   `json:"total_count"`
+	Events        []Event `json:"events"`
+	TotalCount    int64   `json:"total_count"`
+	NextPageToken string  `json:"next_page_token,omitempty"`
 }
 
 // ServeHTTP handles GET /api/v1/events requests.
@@ -69,9 +74,31 @@ func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	req.PageToken = query.Get("page_token")
+
+	for param, field := range map[string]**timestamppb.Timestamp{
+		"created_after":  &req.CreatedAfter,
+		"created_before": &req.CreatedBefore,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			http.Error(w, "Invalid "+param+": expected RFC3339 time", http.StatusBadRequest)
+			return
+		}
+		*field = timestamppb.New(t)
+	}
+
 	// Call gRPC service
 	resp, err := h.productService.ListEvents(context.Background(), req)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to fetch events: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -79,24 +106,13 @@ func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Convert proto events to HTTP response
 	events := make([]Event, 0, len(resp.Events))
 	for _, protoEvent := range resp.Events {
-		event := Event{
-			EventID:     protoEvent.EventId,
-			EventType:   protoEvent.EventType,
-			AggregateID: protoEvent.AggregateId,
-			Payload:     protoEvent.Payload,
-			Status:      protoEvent.Status,
-			CreatedAt:   protoEvent.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
-		}
-		if protoEvent.ProcessedAt != nil {
-			processedAt := protoEvent.ProcessedAt.AsTime().Format("2006-01-02T15:04:05Z07:00")
-			event.ProcessedAt = &processedAt
-		}
-		events = append(events, event)
+		events = append(events, protoEventToHTTP(protoEvent))
 	}
 
 	response := ListEventsResponse{
-		Events:     events,
-		TotalCount: resp.TotalCount,
+		Events:        events,
+		TotalCount:    resp.TotalCount,
+		NextPageToken: resp.NextPageToken,
 	}
 
 	// Send JSON response
@@ -107,3 +123,20 @@ func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// protoEventToHTTP converts a proto event to its HTTP representation.
+func protoEventToHTTP(protoEvent *pb.Event) Event {
+	event := Event{
+		EventID:     protoEvent.EventId,
+		EventType:   protoEvent.EventType,
+		AggregateID: protoEvent.AggregateId,
+		Payload:     protoEvent.Payload,
+		Status:      protoEvent.Status,
+		CreatedAt:   protoEvent.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if protoEvent.ProcessedAt != nil {
+		processedAt := protoEvent.ProcessedAt.AsTime().Format("2006-01-02T15:04:05Z07:00")
+		event.ProcessedAt = &processedAt
+	}
+	return event
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/light-bringer/procat-service/proto/product/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PriceHistoryPath is the route of a product's price history; {product_id} is a path wildcard.
+const PriceHistoryPath = "/api/v1/products/{product_id}/price-history"
+
+// PriceHistoryHandler handles HTTP requests for price history.
+type PriceHistoryHandler struct {
+	productService pb.ProductServiceClient
+}
+
+// NewPriceHistoryHandler creates a new HTTP price history handler.
+func NewPriceHistoryHandler(productService pb.ProductServiceClient) *PriceHistoryHandler {
+	return &PriceHistoryHandler{
+		productService: productService,
+	}
+}
+
+// Money is an exact amount as a fraction, like the gRPC Money message.
+type Money struct {
+	Numerator   int64  `json:"numerator"`
+	Denominator int64  `json:"denominator"`
+	Currency    string `json:"currency"`
+}
+
+// PriceHistoryEntry represents one recorded price change in the HTTP response.
+type PriceHistoryEntry struct {
+	HistoryID      string `json:"history_id"`
+	Region         string `json:"region,omitempty"`
+	OldPrice       *Money `json:"old_price,omitempty"`
+	NewPrice       *Money `json:"new_price,omitempty"`
+	ChangedBy      string `json:"changed_by,omitempty"`
+	ChangedReason  string `json:"changed_reason,omitempty"`
+	ChangedAt      string `json:"changed_at"`
+	RequestID      string `json:"request_id,omitempty"`
+	Decision       string `json:"decision,omitempty"`
+	DecidedBy      string `json:"decided_by,omitempty"`
+	DecisionReason string `json:"decision_reason,omitempty"`
+}
+
+// PriceHistoryResponse represents the HTTP response for a page of price history.
+type PriceHistoryResponse struct {
+	Entries       []PriceHistoryEntry `json:"entries"`
+	TotalCount    int64               `json:"total_count"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+}
+
+// ServeHTTP handles GET /api/v1/products/{product_id}/price-history requests.
+func (h *PriceHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	req := &pb.GetPriceHistoryRequest{
+		ProductId: r.PathValue("product_id"),
+		Limit:     100, // Default limit
+		PageToken: query.Get("page_token"),
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 {
+			req.Limit = int32(limit)
+		}
+	}
+
+	for param, field := range map[string]**timestamppb.Timestamp{
+		"changed_after":  &req.ChangedAfter,
+		"changed_before": &req.ChangedBefore,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			http.Error(w, "Invalid "+param+": expected RFC3339 time", http.StatusBadRequest)
+			return
+		}
+		*field = timestamppb.New(t)
+	}
+
+	// Call gRPC service
+	resp, err := h.productService.GetPriceHistory(r.Context(), req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+		default:
+			http.Error(w, "Failed to fetch price history: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Convert proto entries to HTTP response
+	entries := make([]PriceHistoryEntry, 0, len(resp.Entries))
+	for _, protoEntry := range resp.Entries {
+		entries = append(entries, protoPriceHistoryEntryToHTTP(protoEntry))
+	}
+
+	response := PriceHistoryResponse{
+		Entries:       entries,
+		TotalCount:    resp.TotalCount,
+		NextPageToken: resp.NextPageToken,
+	}
+
+	// Send JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// protoPriceHistoryEntryToHTTP converts a proto price history entry to its HTTP representation.
+func protoPriceHistoryEntryToHTTP(protoEntry *pb.PriceHistoryEntry) PriceHistoryEntry {
+	return PriceHistoryEntry{
+		HistoryID:      protoEntry.HistoryId,
+		Region:         protoEntry.Region,
+		OldPrice:       protoMoneyToHTTP(protoEntry.OldPrice),
+		NewPrice:       protoMoneyToHTTP(protoEntry.NewPrice),
+		ChangedBy:      protoEntry.ChangedBy,
+		ChangedReason:  protoEntry.ChangedReason,
+		ChangedAt:      protoEntry.ChangedAt.AsTime().Format(time.RFC3339Nano),
+		RequestID:      protoEntry.RequestId,
+		Decision:       protoEntry.Decision,
+		DecidedBy:      protoEntry.DecidedBy,
+		DecisionReason: protoEntry.DecisionReason,
+	}
+}
+
+// protoMoneyToHTTP converts proto Money, nil if unset.
+func protoMoneyToHTTP(m *pb.Money) *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{Numerator: m.Numerator, Denominator: m.Denominator, Currency: m.Currency}
+}
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/light-bringer/procat-service/proto/product/v1"
+)
+
+// fakePriceHistoryService records the GetPriceHistory request.
+type fakePriceHistoryService struct {
+	pb.ProductServiceClient
+	reply *pb.GetPriceHistoryReply
+	err   error
+	req   *pb.GetPriceHistoryRequest
+}
+
+func (f *fakePriceHistoryService) GetPriceHistory(ctx context.Context, in *pb.GetPriceHistoryRequest, opts ...grpc.CallOption) (*pb.GetPriceHistoryReply, error) {
+	f.req = in
+	return f.reply, f.err
+}
+
+func newPriceHistoryServer(service pb.ProductServiceClient) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(PriceHistoryPath, NewPriceHistoryHandler(service))
+	return httptest.NewServer(mux)
+}
+
+func TestPriceHistoryHandler(t *testing.T) {
+	t.Run("returns exact prices with filters", func(t *testing.T) {
+		service := &fakePriceHistoryService{reply: &pb.GetPriceHistoryReply{
+			Entries: []*pb.PriceHistoryEntry{{
+				HistoryId:     "h2",
+				OldPrice:      &pb.Money{Numerator: 1999, Denominator: 100, Currency: "USD"},
+				NewPrice:      &pb.Money{Numerator: 2199, Denominator: 100, Currency: "USD"},
+				ChangedBy:     "pricing",
+				ChangedReason: "weekly review",
+				ChangedAt:     timestamppb.New(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+			}},
+			TotalCount:    2,
+			NextPageToken: "next",
+		}}
+		server := newPriceHistoryServer(service)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/api/v1/products/product-1/price-history" +
+			"?limit=1&page_token=abc&changed_after=2026-01-01T00:00:00Z&changed_before=2026-02-01T00:00:00Z")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"entries": [{
+				"history_id": "h2",
+				"old_price": {"numerator": 1999, "denominator": 100, "currency": "USD"},
+				"new_price": {"numerator": 2199, "denominator": 100, "currency": "USD"},
+				"changed_by": "pricing",
+				"changed_reason": "weekly review",
+				"changed_at": "2026-01-02T00:00:00Z"
+			}],
+			"total_count": 2,
+			"next_page_token": "next"
+		}`, string(body))
+
+		assert.Equal(t, "product-1", service.req.ProductId)
+		assert.Equal(t, int32(1), service.req.Limit)
+		assert.Equal(t, "abc", service.req.PageToken)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), service.req.ChangedAfter.AsTime())
+		assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), service.req.ChangedBefore.AsTime())
+	})
+
+	t.Run("maps errors to status codes", func(t *testing.T) {
+		for code, expected := range map[codes.Code]int{
+			codes.NotFound:        http.StatusNotFound,
+			codes.InvalidArgument: http.StatusBadRequest,
+			codes.Internal:        http.StatusInternalServerError,
+		} {
+			server := newPriceHistoryServer(&fakePriceHistoryService{err: status.Error(code, "failed")})
+			resp, err := http.Get(server.URL + "/api/v1/products/product-1/price-history")
+			require.NoError(t, err)
+			resp.Body.Close()
+			server.Close()
+			assert.Equal(t, expected, resp.StatusCode, code.String())
+		}
+	})
+
+	t.Run("rejects invalid times", func(t *testing.T) {
+		server := newPriceHistoryServer(&fakePriceHistoryService{})
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/api/v1/products/product-1/price-history?changed_after=yesterday")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("rejects non-GET", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		NewPriceHistoryHandler(&fakePriceHistoryService{}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/products/product-1/price-history", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
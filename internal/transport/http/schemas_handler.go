@@ -0,0 +1,75 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/light-bringer/procat-service/internal/app/product/events"
+)
+
+// schemasPath is the route prefix for published event schemas.
+const schemasPath = "/api/v1/events/schemas"
+
+// SchemasHandler serves the JSON Schemas of event payloads.
+type SchemasHandler struct{}
+
+// NewSchemasHandler creates a new HTTP schemas handler.
+func NewSchemasHandler() *SchemasHandler {
+	return &SchemasHandler{}
+}
+
+// SchemaInfo describes one published schema in the listing response.
+type SchemaInfo struct {
+	EventType     string `json:"event_type"`
+	SchemaVersion int    `json:"schema_version"`
+	URL           string `json:"url"`
+}
+
+// ListSchemasResponse represents the HTTP response for listing schemas.
+type ListSchemasResponse struct {
+	Schemas []SchemaInfo `json:"schemas"`
+}
+
+// ServeHTTP handles GET /api/v1/events/schemas and GET /api/v1/events/schemas/{event_type}.
+func (h *SchemasHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	eventType := strings.Trim(strings.TrimPrefix(r.URL.Path, schemasPath), "/")
+	if eventType == "" {
+		h.listSchemas(w)
+		return
+	}
+
+	schema, ok := events.Schema(eventType)
+	if !ok {
+		http.Error(w, "Unknown event type: "+eventType, http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(schema)
+}
+
+func (h *SchemasHandler) listSchemas(w http.ResponseWriter) {
+	types := events.EventTypes()
+	response := ListSchemasResponse{Schemas: make([]SchemaInfo, 0, len(types))}
+	for _, eventType := range types {
+		response.Schemas = append(response.Schemas, SchemaInfo{
+			EventType:     eventType,
+			SchemaVersion: events.SchemaVersion,
+			URL:           schemasPath + "/" + eventType,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
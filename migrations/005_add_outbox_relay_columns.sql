@@ -0,0 +1,7 @@
+-- Migration 005: Add outbox relay scheduling column
+-- Purpose: Allow the outbox relay (cmd/outbox_relay) to schedule retries and lease claimed events
+
+-- next_attempt_at has two meanings depending on status:
+-- - pending:    earliest time the event may be retried (NULL = publish immediately)
+-- - processing: lease expiry; if the relay dies, the event is reclaimed after this time
+ALTER TABLE outbox_events ADD COLUMN next_attempt_at TIMESTAMP;
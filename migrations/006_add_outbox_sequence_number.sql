@@ -0,0 +1,8 @@
+-- Migration 006: Add per-aggregate sequence number to outbox events
+-- Purpose: Let the outbox relay publish events for the same aggregate strictly in commit order
+
+-- sequence_number is derived from the aggregate version written by the commit
+-- (version * 1000 + index of the event within the commit), so it is monotonic per
+-- aggregate_id and orders events that share a commit timestamp.
+-- Rows written before this migration have NULL and are ordered by created_at only.
+ALTER TABLE outbox_events ADD COLUMN sequence_number INT64;
@@ -0,0 +1,44 @@
+-- Migration 007: Add webhook subscription tables
+-- Purpose: HTTP callbacks for product events, fed from outbox_events by the outbox relay
+--          (webhook publisher) and delivered by cmd/webhook_dispatcher
+
+CREATE TABLE webhook_subscriptions (
+    subscription_id STRING(36) NOT NULL,
+    url STRING(2048) NOT NULL,
+    event_types ARRAY<STRING(100)>,  -- Filters, empty = all events
+    secret STRING(256) NOT NULL,  -- HMAC-SHA256 signing key
+    active BOOL NOT NULL,
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+    updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (subscription_id);
+
+-- One row per (subscription, outbox event)
+-- next_attempt_at doubles as the dispatcher lease while an attempt is in flight
+CREATE TABLE webhook_deliveries (
+    subscription_id STRING(36) NOT NULL,
+    event_id STRING(36) NOT NULL,
+    event_type STRING(100) NOT NULL,
+    status STRING(20) NOT NULL,  -- pending, delivered, failed
+    attempt_count INT64 NOT NULL DEFAULT (0),
+    next_attempt_at TIMESTAMP,
+    last_error STRING(1000),
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+    delivered_at TIMESTAMP,
+) PRIMARY KEY (subscription_id, event_id),
+INTERLEAVE IN PARENT webhook_subscriptions ON DELETE CASCADE;
+
+-- Index for the dispatcher to find due deliveries
+CREATE INDEX idx_webhook_deliveries_due
+ON webhook_deliveries(status, next_attempt_at);
+
+-- Delivery-attempt log
+CREATE TABLE webhook_delivery_attempts (
+    subscription_id STRING(36) NOT NULL,
+    event_id STRING(36) NOT NULL,
+    attempt_number INT64 NOT NULL,
+    attempted_at TIMESTAMP NOT NULL,
+    status_code INT64,  -- HTTP status, NULL if the request failed before a response
+    error STRING(1000),
+    duration_ms INT64 NOT NULL,
+) PRIMARY KEY (subscription_id, event_id, attempt_number),
+INTERLEAVE IN PARENT webhook_deliveries ON DELETE CASCADE;
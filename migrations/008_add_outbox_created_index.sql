@@ -0,0 +1,4 @@
+-- Migration 008: Index outbox events by commit order
+-- Purpose: WatchEvents tails outbox_events by (created_at, event_id) across all aggregates
+
+CREATE INDEX IF NOT EXISTS idx_outbox_created ON outbox_events(created_at);
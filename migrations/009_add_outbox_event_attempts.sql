@@ -0,0 +1,13 @@
+-- Migration 009: Add outbox event attempt history
+-- Purpose: Keep the failure history of outbox events across manual retries,
+--          which reset retry_count and error_message on the event itself
+
+CREATE TABLE outbox_event_attempts (
+    event_id STRING(36) NOT NULL,
+    attempt_id STRING(36) NOT NULL,
+    outcome STRING(20) NOT NULL,  -- retry_scheduled, failed, requeued
+    retry_count INT64 NOT NULL,  -- retry_count of the event when recorded
+    error_message STRING(MAX),
+    attempted_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (event_id, attempt_id),
+INTERLEAVE IN PARENT outbox_events ON DELETE CASCADE;
@@ -0,0 +1,8 @@
+-- Migration 010: Add ISO 4217 currency to prices
+-- Purpose: Record which currency a product's base price and each price change are in
+
+-- Existing rows predate currency tracking and were priced in USD.
+ALTER TABLE products ADD COLUMN base_price_currency STRING(3) NOT NULL DEFAULT ('USD');
+
+-- A product's base price never changes currency, so old and new price share this column.
+ALTER TABLE price_history ADD COLUMN currency STRING(3) NOT NULL DEFAULT ('USD');
@@ -0,0 +1,20 @@
+-- Migration 011: Add regional price lists
+-- Purpose: Sell the same product in several markets, each with its own price and currency.
+--          products.base_price stays the designated base price and applies to every
+--          region without a price list entry.
+
+CREATE TABLE price_lists (
+    product_id STRING(36) NOT NULL,
+    region STRING(16) NOT NULL,  -- Market code, e.g. DE, JP, US-CA
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+    currency STRING(3) NOT NULL,  -- ISO 4217
+    updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (product_id, region),
+INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- Regional price changes are recorded in price_history with their region.
+-- NULL region is the base price. Removing a regional price leaves the new price NULL.
+ALTER TABLE price_history ADD COLUMN region STRING(16);
+ALTER TABLE price_history ALTER COLUMN new_price_numerator INT64;
+ALTER TABLE price_history ALTER COLUMN new_price_denominator INT64;
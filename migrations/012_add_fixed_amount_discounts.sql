@@ -0,0 +1,8 @@
+-- Migration 012: Add fixed-amount discounts
+-- Purpose: Support "$10 off" promotions next to percentage discounts.
+--          A product's discount is a percentage when discount_percent is set and a
+--          fixed amount when the discount_amount columns are set. Never both.
+
+ALTER TABLE products ADD COLUMN discount_amount_numerator INT64;
+ALTER TABLE products ADD COLUMN discount_amount_denominator INT64;
+ALTER TABLE products ADD COLUMN discount_amount_currency STRING(3);
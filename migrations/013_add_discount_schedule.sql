@@ -0,0 +1,21 @@
+-- Migration 013: Add discount schedules
+-- Purpose: Let a product hold several discounts, each taking effect at its own start date.
+--          Discount periods of a product never overlap, which the domain enforces.
+--          Exactly one of discount_percent or the amount columns is set per row.
+
+CREATE TABLE discounts (
+    product_id STRING(36) NOT NULL,
+    discount_id STRING(36) NOT NULL,
+    discount_percent NUMERIC,  -- Percentage discounts, 0-100
+    amount_numerator INT64,  -- Fixed-amount discounts
+    amount_denominator INT64,
+    amount_currency STRING(3),  -- ISO 4217
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (product_id, discount_id),
+INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- The discount_* columns of products are no longer written. A discount stored there before
+-- this migration is still read as part of the schedule (its discount ID is the product ID)
+-- and the columns are cleared once it is removed or cancelled.
@@ -0,0 +1,14 @@
+-- Migration 014: Track when scheduled discounts take effect
+-- Purpose: The discount sweeper announces discounts that start after they were applied
+--          (product.discount.started) and removes ended ones (product.discount.expired).
+--          started_at is set once a discount has taken effect, so each start is announced
+--          exactly once. Discounts that are already valid when applied get it right away.
+--          Discounts stored before this migration have no started_at, so those already in
+--          effect are announced once by the first sweep.
+
+ALTER TABLE discounts ADD COLUMN started_at TIMESTAMP;
+
+-- Indexes for the sweeper to find discounts that have ended or are due to start
+CREATE INDEX IF NOT EXISTS idx_discounts_end_date ON discounts(end_date);
+CREATE INDEX IF NOT EXISTS idx_discounts_start_date ON discounts(started_at, start_date);
+CREATE INDEX IF NOT EXISTS idx_products_discount_end_date ON products(discount_end_date);
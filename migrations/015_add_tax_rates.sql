@@ -0,0 +1,17 @@
+-- Migration 015: Add tax classes and regional tax rates
+-- Purpose: Report net, tax and gross prices per region.
+--          Each product belongs to a tax class, and each region holds a rate per tax class
+--          that takes effect at its effective date. Rates are never overwritten by a change,
+--          a new rate with a later effective date supersedes the previous one.
+
+-- Existing products are taxed at the standard rate.
+ALTER TABLE products ADD COLUMN tax_class STRING(32) NOT NULL DEFAULT ('standard');
+
+CREATE TABLE tax_rates (
+    region STRING(16) NOT NULL,  -- Market code, e.g. DE, JP, US-CA
+    tax_class STRING(32) NOT NULL,  -- e.g. standard, reduced, zero
+    effective_from TIMESTAMP NOT NULL,
+    rate_percent NUMERIC NOT NULL,  -- 0-100, e.g. 19 for 19%
+    prices_include_tax BOOL NOT NULL,  -- List prices in the region are gross
+    updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (region, tax_class, effective_from DESC);
@@ -0,0 +1,21 @@
+-- Migration 016: Add scheduled price changes
+-- Purpose: Let pricing teams schedule base price changes ahead, e.g. "raise to $29.99 on Jan 1".
+--          Pending changes belong to their product's aggregate. The price change worker
+--          applies each one at its effective time like UpdatePrice (price_history row and
+--          product.price.changed event) and then deletes it, as does a cancellation.
+
+CREATE TABLE scheduled_price_changes (
+    product_id STRING(36) NOT NULL,
+    price_change_id STRING(36) NOT NULL,
+    new_price_numerator INT64 NOT NULL,
+    new_price_denominator INT64 NOT NULL,
+    new_price_currency STRING(3) NOT NULL,  -- ISO 4217, the product's base price currency
+    effective_at TIMESTAMP NOT NULL,
+    changed_by STRING(255) NOT NULL,
+    changed_reason STRING(MAX),
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (product_id, price_change_id),
+INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- Index for the worker to find changes that are due
+CREATE INDEX IF NOT EXISTS idx_scheduled_price_changes_effective_at ON scheduled_price_changes(effective_at);
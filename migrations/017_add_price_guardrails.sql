@@ -0,0 +1,31 @@
+-- Migration 017: Add price guardrails
+-- Purpose: Hold base price changes that break the configured guardrails (maximum change,
+--          category floor/ceiling, minimum margin over cost) until a second person approves
+--          or rejects them. Pending requests belong to their product's aggregate and are
+--          deleted once decided; the decision is kept in price_history.
+
+-- Optional cost price for the minimum margin guardrail, in the base price currency
+ALTER TABLE products ADD COLUMN cost_price_numerator INT64;
+ALTER TABLE products ADD COLUMN cost_price_denominator INT64;
+ALTER TABLE products ADD COLUMN cost_price_currency STRING(3);
+
+CREATE TABLE price_change_requests (
+    product_id STRING(36) NOT NULL,
+    request_id STRING(36) NOT NULL,
+    old_price_numerator INT64 NOT NULL,  -- Base price when requested
+    old_price_denominator INT64 NOT NULL,
+    new_price_numerator INT64 NOT NULL,
+    new_price_denominator INT64 NOT NULL,
+    currency STRING(3) NOT NULL,  -- ISO 4217, the product's base price currency
+    requested_by STRING(255) NOT NULL,
+    requested_reason STRING(MAX),
+    violations ARRAY<STRING(MAX)>,  -- Guardrails the change violates
+    requested_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, request_id),
+INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- Decisions on requests. Changes that passed the guardrails leave these NULL.
+ALTER TABLE price_history ADD COLUMN request_id STRING(36);
+ALTER TABLE price_history ADD COLUMN decision STRING(16);  -- approved, rejected
+ALTER TABLE price_history ADD COLUMN decided_by STRING(255);
+ALTER TABLE price_history ADD COLUMN decision_reason STRING(MAX);
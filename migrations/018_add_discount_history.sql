@@ -0,0 +1,25 @@
+-- Migration 018: Add discount history
+-- Purpose: Keep the discounts a product had once they leave its schedule, so past effective
+--          prices can be reconstructed. Rows are written when a discount is applied and
+--          updated when it is removed early or the product is archived. Cancelled discounts
+--          never took effect and are deleted.
+--          A discount took effect at the later of start_date and applied_at and stayed in
+--          effect through end_date, or until ended_at if that is earlier (exclusive).
+
+CREATE TABLE discount_history (
+    product_id STRING(36) NOT NULL,
+    discount_id STRING(36) NOT NULL,
+    discount_percent NUMERIC,  -- Percentage discounts, 0-100
+    amount_numerator INT64,  -- Fixed-amount discounts
+    amount_denominator INT64,
+    amount_currency STRING(3),  -- ISO 4217
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    applied_at TIMESTAMP,  -- NULL for discounts applied before this migration
+    ended_at TIMESTAMP,  -- Removed or archived before end_date
+) PRIMARY KEY (product_id, discount_id),
+INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- Discounts applied before this migration get a row once they are removed, swept or
+-- archived. Until then the lookup falls back to the discounts table and the legacy
+-- discount_* columns of products.
@@ -0,0 +1,8 @@
+-- Migration 019: Index archived products by archival time
+-- Purpose: The purge job (cmd/purge_products, PurgeArchivedProducts) permanently deletes
+--          archived products past the retention cutoff, oldest first. Their interleaved
+--          child rows (price_history, discounts, discount_history, price_lists,
+--          scheduled_price_changes, price_change_requests) go with them through
+--          ON DELETE CASCADE. archived_at is NULL for products that were never archived.
+
+CREATE INDEX IF NOT EXISTS idx_products_archived_at ON products(archived_at);
@@ -27,6 +27,7 @@ type Money struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Numerator     int64                  `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
 	Denominator   int64                  `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code (e.g. "USD", "JPY"); empty means USD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,23 +76,39 @@ func (x *Money) GetDenominator() int64 {
 	return 0
 }
 
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // Product represents a product in the catalog.
 type Product struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice       float64                `protobuf:"fixed64,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	EffectivePrice  float64                `protobuf:"fixed64,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
-	DiscountPercent *float64               `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3,oneof" json:"discount_percent,omitempty"` // Supports fractional values (e.g., 12.5 for 12.5%)
-	DiscountActive  bool                   `protobuf:"varint,8,opt,name=discount_active,json=discountActive,proto3" json:"discount_active,omitempty"`
-	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ArchivedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"` // When product was archived (if archived)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ProductId             string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category              string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice             float64                `protobuf:"fixed64,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice        float64                `protobuf:"fixed64,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	DiscountPercent       *float64               `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3,oneof" json:"discount_percent,omitempty"` // Supports fractional values (e.g., 12.5 for 12.5%)
+	DiscountActive        bool                   `protobuf:"varint,8,opt,name=discount_active,json=discountActive,proto3" json:"discount_active,omitempty"`
+	Status                string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`                                 // When product was archived (if archived)
+	Currency              string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`                                                             // ISO 4217 code of base_price and effective_price
+	PriceRegion           string                 `protobuf:"bytes,14,opt,name=price_region,json=priceRegion,proto3" json:"price_region,omitempty"`                                    // Price list region base_price comes from; empty for the product's base price
+	DiscountAmount        *Money                 `protobuf:"bytes,15,opt,name=discount_amount,json=discountAmount,proto3,oneof" json:"discount_amount,omitempty"`                     // Set instead of discount_percent for an active fixed-amount discount
+	DiscountPercentExact  *string                `protobuf:"bytes,16,opt,name=discount_percent_exact,json=discountPercentExact,proto3,oneof" json:"discount_percent_exact,omitempty"` // Exact decimal of discount_percent (e.g. "12.3"), set with it
+	RoundedEffectivePrice *RoundedPrice          `protobuf:"bytes,17,opt,name=rounded_effective_price,json=roundedEffectivePrice,proto3" json:"rounded_effective_price,omitempty"`    // Exact effective_price and its rounding for checkout
+	TaxClass              string                 `protobuf:"bytes,18,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`                                             // Selects the product's tax rate in each region (e.g. "standard")
+	Tax                   *TaxBreakdown          `protobuf:"bytes,19,opt,name=tax,proto3,oneof" json:"tax,omitempty"`                                                                 // Set when a region is requested and has a rate for tax_class
+	CostPrice             *Money                 `protobuf:"bytes,20,opt,name=cost_price,json=costPrice,proto3,oneof" json:"cost_price,omitempty"`                                    // Unit cost the minimum margin price guardrail is checked against
+	PendingPriceChange    *PriceChangeRequest    `protobuf:"bytes,21,opt,name=pending_price_change,json=pendingPriceChange,proto3,oneof" json:"pending_price_change,omitempty"`       // Base price change awaiting approval, GetProduct only
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -208,6 +225,224 @@ func (x *Product) GetArchivedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetPriceRegion() string {
+	if x != nil {
+		return x.PriceRegion
+	}
+	return ""
+}
+
+func (x *Product) GetDiscountAmount() *Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *Product) GetDiscountPercentExact() string {
+	if x != nil && x.DiscountPercentExact != nil {
+		return *x.DiscountPercentExact
+	}
+	return ""
+}
+
+func (x *Product) GetRoundedEffectivePrice() *RoundedPrice {
+	if x != nil {
+		return x.RoundedEffectivePrice
+	}
+	return nil
+}
+
+func (x *Product) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *Product) GetTax() *TaxBreakdown {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Product) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
+func (x *Product) GetPendingPriceChange() *PriceChangeRequest {
+	if x != nil {
+		return x.PendingPriceChange
+	}
+	return nil
+}
+
+// RoundedPrice is an exact price with its value rounded to the currency's minor unit.
+type RoundedPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exact         *Money                 `protobuf:"bytes,1,opt,name=exact,proto3" json:"exact,omitempty"`
+	Rounded       *Money                 `protobuf:"bytes,2,opt,name=rounded,proto3" json:"rounded,omitempty"`
+	RoundingMode  string                 `protobuf:"bytes,3,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode,omitempty"` // "half_up", "half_even", "floor" or "charm"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundedPrice) Reset() {
+	*x = RoundedPrice{}
+	mi := &file_product_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundedPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundedPrice) ProtoMessage() {}
+
+func (x *RoundedPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundedPrice.ProtoReflect.Descriptor instead.
+func (*RoundedPrice) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoundedPrice) GetExact() *Money {
+	if x != nil {
+		return x.Exact
+	}
+	return nil
+}
+
+func (x *RoundedPrice) GetRounded() *Money {
+	if x != nil {
+		return x.Rounded
+	}
+	return nil
+}
+
+func (x *RoundedPrice) GetRoundingMode() string {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return ""
+}
+
+// TaxBreakdown splits the exact effective price under a region's tax rate.
+// net + tax = gross holds exactly.
+type TaxBreakdown struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	TaxClass         string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	RatePercent      string                 `protobuf:"bytes,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`                   // Exact decimal (e.g. "19" or "7.7")
+	PricesIncludeTax bool                   `protobuf:"varint,4,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"` // The effective price is gross and the tax was extracted from it
+	Net              *Money                 `protobuf:"bytes,5,opt,name=net,proto3" json:"net,omitempty"`
+	Tax              *Money                 `protobuf:"bytes,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Gross            *Money                 `protobuf:"bytes,7,opt,name=gross,proto3" json:"gross,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaxBreakdown) Reset() {
+	*x = TaxBreakdown{}
+	mi := &file_product_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBreakdown) ProtoMessage() {}
+
+func (x *TaxBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBreakdown.ProtoReflect.Descriptor instead.
+func (*TaxBreakdown) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaxBreakdown) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetRatePercent() string {
+	if x != nil {
+		return x.RatePercent
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *TaxBreakdown) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *TaxBreakdown) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *TaxBreakdown) GetGross() *Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
 // CreateProduct
 type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -215,13 +450,15 @@ type CreateProductRequest struct {
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 	BasePrice     *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	TaxClass      string                 `protobuf:"bytes,5,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`          // Optional, defaults to "standard"
+	CostPrice     *Money                 `protobuf:"bytes,6,opt,name=cost_price,json=costPrice,proto3,oneof" json:"cost_price,omitempty"` // In the base price currency
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_product_service_proto_msgTypes[2]
+	mi := &file_product_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +470,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[2]
+	mi := &file_product_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +483,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{2}
+	return file_product_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateProductRequest) GetName() string {
@@ -277,6 +514,20 @@ func (x *CreateProductRequest) GetBasePrice() *Money {
 	return nil
 }
 
+func (x *CreateProductRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
 type CreateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -286,7 +537,7 @@ type CreateProductReply struct {
 
 func (x *CreateProductReply) Reset() {
 	*x = CreateProductReply{}
-	mi := &file_product_service_proto_msgTypes[3]
+	mi := &file_product_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +549,7 @@ func (x *CreateProductReply) String() string {
 func (*CreateProductReply) ProtoMessage() {}
 
 func (x *CreateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[3]
+	mi := &file_product_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +562,7 @@ func (x *CreateProductReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductReply.ProtoReflect.Descriptor instead.
 func (*CreateProductReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{3}
+	return file_product_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateProductReply) GetProductId() string {
@@ -329,13 +580,15 @@ type UpdateProductRequest struct {
 	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	TaxClass      *string                `protobuf:"bytes,6,opt,name=tax_class,json=taxClass,proto3,oneof" json:"tax_class,omitempty"`
+	CostPrice     *Money                 `protobuf:"bytes,7,opt,name=cost_price,json=costPrice,proto3,oneof" json:"cost_price,omitempty"` // In the base price currency
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_product_service_proto_msgTypes[4]
+	mi := &file_product_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +600,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[4]
+	mi := &file_product_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +613,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{4}
+	return file_product_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateProductRequest) GetProductId() string {
@@ -398,6 +651,20 @@ func (x *UpdateProductRequest) GetCategory() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetTaxClass() string {
+	if x != nil && x.TaxClass != nil {
+		return *x.TaxClass
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
 type UpdateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -406,7 +673,7 @@ type UpdateProductReply struct {
 
 func (x *UpdateProductReply) Reset() {
 	*x = UpdateProductReply{}
-	mi := &file_product_service_proto_msgTypes[5]
+	mi := &file_product_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +685,7 @@ func (x *UpdateProductReply) String() string {
 func (*UpdateProductReply) ProtoMessage() {}
 
 func (x *UpdateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[5]
+	mi := &file_product_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +698,7 @@ func (x *UpdateProductReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductReply.ProtoReflect.Descriptor instead.
 func (*UpdateProductReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{5}
+	return file_product_service_proto_rawDescGZIP(), []int{7}
 }
 
 // UpdatePrice
@@ -448,7 +715,7 @@ type UpdatePriceRequest struct {
 
 func (x *UpdatePriceRequest) Reset() {
 	*x = UpdatePriceRequest{}
-	mi := &file_product_service_proto_msgTypes[6]
+	mi := &file_product_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +727,7 @@ func (x *UpdatePriceRequest) String() string {
 func (*UpdatePriceRequest) ProtoMessage() {}
 
 func (x *UpdatePriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[6]
+	mi := &file_product_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +740,7 @@ func (x *UpdatePriceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePriceRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePriceRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{6}
+	return file_product_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdatePriceRequest) GetProductId() string {
@@ -511,15 +778,19 @@ func (x *UpdatePriceRequest) GetChangedReason() string {
 	return ""
 }
 
+// A change that violates the price guardrails does not take effect. It is held as a
+// price change request until ApprovePriceChange or RejectPriceChange decides it.
 type UpdatePriceReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PendingRequestId    string                 `protobuf:"bytes,1,opt,name=pending_request_id,json=pendingRequestId,proto3" json:"pending_request_id,omitempty"`        // Set when the change awaits approval
+	GuardrailViolations []string               `protobuf:"bytes,2,rep,name=guardrail_violations,json=guardrailViolations,proto3" json:"guardrail_violations,omitempty"` // Guardrails the held change violates
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UpdatePriceReply) Reset() {
 	*x = UpdatePriceReply{}
-	mi := &file_product_service_proto_msgTypes[7]
+	mi := &file_product_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +802,7 @@ func (x *UpdatePriceReply) String() string {
 func (*UpdatePriceReply) ProtoMessage() {}
 
 func (x *UpdatePriceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[7]
+	mi := &file_product_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,33 +815,52 @@ func (x *UpdatePriceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePriceReply.ProtoReflect.Descriptor instead.
 func (*UpdatePriceReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{7}
+	return file_product_service_proto_rawDescGZIP(), []int{9}
 }
 
-// ActivateProduct
-type ActivateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdatePriceReply) GetPendingRequestId() string {
+	if x != nil {
+		return x.PendingRequestId
+	}
+	return ""
 }
 
-func (x *ActivateProductRequest) Reset() {
-	*x = ActivateProductRequest{}
-	mi := &file_product_service_proto_msgTypes[8]
+func (x *UpdatePriceReply) GetGuardrailViolations() []string {
+	if x != nil {
+		return x.GuardrailViolations
+	}
+	return nil
+}
+
+// PriceChangeRequest is a base price change held for a second approver.
+type PriceChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestId       string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OldPrice        *Money                 `protobuf:"bytes,2,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"` // Base price when requested; approval requires it to be unchanged
+	NewPrice        *Money                 `protobuf:"bytes,3,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedReason string                 `protobuf:"bytes,5,opt,name=requested_reason,json=requestedReason,proto3" json:"requested_reason,omitempty"`
+	Violations      []string               `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+	RequestedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceChangeRequest) Reset() {
+	*x = PriceChangeRequest{}
+	mi := &file_product_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateProductRequest) String() string {
+func (x *PriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateProductRequest) ProtoMessage() {}
+func (*PriceChangeRequest) ProtoMessage() {}
 
-func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[8]
+func (x *PriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,85 +871,87 @@ func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
-func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*PriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ActivateProductRequest) GetProductId() string {
+func (x *PriceChangeRequest) GetRequestId() string {
 	if x != nil {
-		return x.ProductId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ActivateProductRequest) GetVersion() int64 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *PriceChangeRequest) GetOldPrice() *Money {
+	if x != nil {
+		return x.OldPrice
 	}
-	return 0
+	return nil
 }
 
-type ActivateProductReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PriceChangeRequest) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
 }
 
-func (x *ActivateProductReply) Reset() {
-	*x = ActivateProductReply{}
-	mi := &file_product_service_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PriceChangeRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
 }
 
-func (x *ActivateProductReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PriceChangeRequest) GetRequestedReason() string {
+	if x != nil {
+		return x.RequestedReason
+	}
+	return ""
 }
 
-func (*ActivateProductReply) ProtoMessage() {}
-
-func (x *ActivateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[9]
+func (x *PriceChangeRequest) GetViolations() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Violations
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ActivateProductReply.ProtoReflect.Descriptor instead.
-func (*ActivateProductReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{9}
+func (x *PriceChangeRequest) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
 }
 
-// DeactivateProduct
-type DeactivateProductRequest struct {
+// ApprovePriceChange applies a pending price change request. approved_by must differ from
+// the requester.
+type ApprovePriceChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	ApprovedBy    string                 `protobuf:"bytes,4,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductRequest) Reset() {
-	*x = DeactivateProductRequest{}
-	mi := &file_product_service_proto_msgTypes[10]
+func (x *ApprovePriceChangeRequest) Reset() {
+	*x = ApprovePriceChangeRequest{}
+	mi := &file_product_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductRequest) String() string {
+func (x *ApprovePriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductRequest) ProtoMessage() {}
+func (*ApprovePriceChangeRequest) ProtoMessage() {}
 
-func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[10]
+func (x *ApprovePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,46 +962,60 @@ func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApprovePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeactivateProductRequest) GetProductId() string {
+func (x *ApprovePriceChangeRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *DeactivateProductRequest) GetVersion() int64 {
+func (x *ApprovePriceChangeRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApprovePriceChangeRequest) GetVersion() int64 {
 	if x != nil && x.Version != nil {
 		return *x.Version
 	}
 	return 0
 }
 
-type DeactivateProductReply struct {
+func (x *ApprovePriceChangeRequest) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+type ApprovePriceChangeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductReply) Reset() {
-	*x = DeactivateProductReply{}
-	mi := &file_product_service_proto_msgTypes[11]
+func (x *ApprovePriceChangeReply) Reset() {
+	*x = ApprovePriceChangeReply{}
+	mi := &file_product_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductReply) String() string {
+func (x *ApprovePriceChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductReply) ProtoMessage() {}
+func (*ApprovePriceChangeReply) ProtoMessage() {}
 
-func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[11]
+func (x *ApprovePriceChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,38 +1026,39 @@ func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductReply.ProtoReflect.Descriptor instead.
-func (*DeactivateProductReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ApprovePriceChangeReply.ProtoReflect.Descriptor instead.
+func (*ApprovePriceChangeReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{12}
 }
 
-// ApplyDiscount
-type ApplyDiscountRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Version         *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`                                   // For optimistic locking (backwards compatible)
-	DiscountPercent float64                `protobuf:"fixed64,3,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"` // Supports fractional values (e.g., 12.5 for 12.5%)
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RejectPriceChange discards a pending price change request, leaving the base price unchanged.
+// The requester may reject their own request to withdraw it.
+type RejectPriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	RejectedBy    string                 `protobuf:"bytes,4,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountRequest) Reset() {
-	*x = ApplyDiscountRequest{}
-	mi := &file_product_service_proto_msgTypes[12]
+func (x *RejectPriceChangeRequest) Reset() {
+	*x = RejectPriceChangeRequest{}
+	mi := &file_product_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountRequest) String() string {
+func (x *RejectPriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountRequest) ProtoMessage() {}
+func (*RejectPriceChangeRequest) ProtoMessage() {}
 
-func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[12]
+func (x *RejectPriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,67 +1069,67 @@ func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RejectPriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectPriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ApplyDiscountRequest) GetProductId() string {
+func (x *RejectPriceChangeRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ApplyDiscountRequest) GetVersion() int64 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *RejectPriceChangeRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-func (x *ApplyDiscountRequest) GetDiscountPercent() float64 {
-	if x != nil {
-		return x.DiscountPercent
+func (x *RejectPriceChangeRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return 0
 }
 
-func (x *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *RejectPriceChangeRequest) GetRejectedBy() string {
 	if x != nil {
-		return x.StartDate
+		return x.RejectedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *RejectPriceChangeRequest) GetReason() string {
 	if x != nil {
-		return x.EndDate
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type ApplyDiscountReply struct {
+type RejectPriceChangeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountReply) Reset() {
-	*x = ApplyDiscountReply{}
-	mi := &file_product_service_proto_msgTypes[13]
+func (x *RejectPriceChangeReply) Reset() {
+	*x = RejectPriceChangeReply{}
+	mi := &file_product_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountReply) String() string {
+func (x *RejectPriceChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountReply) ProtoMessage() {}
+func (*RejectPriceChangeReply) ProtoMessage() {}
 
-func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[13]
+func (x *RejectPriceChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,35 +1140,39 @@ func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RejectPriceChangeReply.ProtoReflect.Descriptor instead.
+func (*RejectPriceChangeReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{14}
 }
 
-// RemoveDiscount
-type RemoveDiscountRequest struct {
+// ScheduledPriceChange is a base price change waiting for its effective time.
+type ScheduledPriceChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	PriceChangeId string                 `protobuf:"bytes,1,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
+	NewPrice      *Money                 `protobuf:"bytes,2,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedReason string                 `protobuf:"bytes,5,opt,name=changed_reason,json=changedReason,proto3" json:"changed_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountRequest) Reset() {
-	*x = RemoveDiscountRequest{}
-	mi := &file_product_service_proto_msgTypes[14]
+func (x *ScheduledPriceChange) Reset() {
+	*x = ScheduledPriceChange{}
+	mi := &file_product_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountRequest) String() string {
+func (x *ScheduledPriceChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountRequest) ProtoMessage() {}
+func (*ScheduledPriceChange) ProtoMessage() {}
 
-func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[14]
+func (x *ScheduledPriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,84 +1183,80 @@ func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ScheduledPriceChange.ProtoReflect.Descriptor instead.
+func (*ScheduledPriceChange) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RemoveDiscountRequest) GetProductId() string {
+func (x *ScheduledPriceChange) GetPriceChangeId() string {
 	if x != nil {
-		return x.ProductId
+		return x.PriceChangeId
 	}
 	return ""
 }
 
-func (x *RemoveDiscountRequest) GetVersion() int64 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *ScheduledPriceChange) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
 	}
-	return 0
-}
-
-type RemoveDiscountReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *RemoveDiscountReply) Reset() {
-	*x = RemoveDiscountReply{}
-	mi := &file_product_service_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScheduledPriceChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
 }
 
-func (x *RemoveDiscountReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduledPriceChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
 }
 
-func (*RemoveDiscountReply) ProtoMessage() {}
-
-func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[15]
+func (x *ScheduledPriceChange) GetChangedReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChangedReason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{15}
+func (x *ScheduledPriceChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-// ArchiveProduct
-type ArchiveProductRequest struct {
+// ScheduleBasePriceChange schedules a base price change, applied like UpdatePrice at effective_at.
+type ScheduleBasePriceChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`                     // For optimistic locking (backwards compatible)
+	NewPrice      *Money                 `protobuf:"bytes,3,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`          // In the base price currency
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // Must be in the future
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedReason string                 `protobuf:"bytes,6,opt,name=changed_reason,json=changedReason,proto3" json:"changed_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveProductRequest) Reset() {
-	*x = ArchiveProductRequest{}
+func (x *ScheduleBasePriceChangeRequest) Reset() {
+	*x = ScheduleBasePriceChangeRequest{}
 	mi := &file_product_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveProductRequest) String() string {
+func (x *ScheduleBasePriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveProductRequest) ProtoMessage() {}
+func (*ScheduleBasePriceChangeRequest) ProtoMessage() {}
 
-func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduleBasePriceChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,46 +1268,74 @@ func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleBasePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleBasePriceChangeRequest) Descriptor() ([]byte, []int) {
 	return file_product_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ArchiveProductRequest) GetProductId() string {
+func (x *ScheduleBasePriceChangeRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ArchiveProductRequest) GetVersion() int64 {
+func (x *ScheduleBasePriceChangeRequest) GetVersion() int64 {
 	if x != nil && x.Version != nil {
 		return *x.Version
 	}
 	return 0
 }
 
-type ArchiveProductReply struct {
+func (x *ScheduleBasePriceChangeRequest) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+func (x *ScheduleBasePriceChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ScheduleBasePriceChangeRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ScheduleBasePriceChangeRequest) GetChangedReason() string {
+	if x != nil {
+		return x.ChangedReason
+	}
+	return ""
+}
+
+type ScheduleBasePriceChangeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // When the product was archived
+	PriceChangeId string                 `protobuf:"bytes,1,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"` // Identifies the change for CancelScheduledPriceChange
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveProductReply) Reset() {
-	*x = ArchiveProductReply{}
+func (x *ScheduleBasePriceChangeReply) Reset() {
+	*x = ScheduleBasePriceChangeReply{}
 	mi := &file_product_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveProductReply) String() string {
+func (x *ScheduleBasePriceChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveProductReply) ProtoMessage() {}
+func (*ScheduleBasePriceChangeReply) ProtoMessage() {}
 
-func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
+func (x *ScheduleBasePriceChangeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_product_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,40 +1347,42 @@ func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveProductReply.ProtoReflect.Descriptor instead.
-func (*ArchiveProductReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleBasePriceChangeReply.ProtoReflect.Descriptor instead.
+func (*ScheduleBasePriceChangeReply) Descriptor() ([]byte, []int) {
 	return file_product_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ArchiveProductReply) GetArchivedAt() *timestamppb.Timestamp {
+func (x *ScheduleBasePriceChangeReply) GetPriceChangeId() string {
 	if x != nil {
-		return x.ArchivedAt
+		return x.PriceChangeId
 	}
-	return nil
+	return ""
 }
 
-// GetProduct
-type GetProductRequest struct {
+// CancelScheduledPriceChange cancels a price change that has not been applied yet.
+type CancelScheduledPriceChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PriceChangeId string                 `protobuf:"bytes,2,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *CancelScheduledPriceChangeRequest) Reset() {
+	*x = CancelScheduledPriceChangeRequest{}
 	mi := &file_product_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *CancelScheduledPriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*CancelScheduledPriceChangeRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelScheduledPriceChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,39 +1394,52 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelScheduledPriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPriceChangeRequest) Descriptor() ([]byte, []int) {
 	return file_product_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetProductRequest) GetProductId() string {
+func (x *CancelScheduledPriceChangeRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-type GetProductReply struct {
+func (x *CancelScheduledPriceChangeRequest) GetPriceChangeId() string {
+	if x != nil {
+		return x.PriceChangeId
+	}
+	return ""
+}
+
+func (x *CancelScheduledPriceChangeRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CancelScheduledPriceChangeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductReply) Reset() {
-	*x = GetProductReply{}
+func (x *CancelScheduledPriceChangeReply) Reset() {
+	*x = CancelScheduledPriceChangeReply{}
 	mi := &file_product_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductReply) String() string {
+func (x *CancelScheduledPriceChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductReply) ProtoMessage() {}
+func (*CancelScheduledPriceChangeReply) ProtoMessage() {}
 
-func (x *GetProductReply) ProtoReflect() protoreflect.Message {
+func (x *CancelScheduledPriceChangeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_product_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,44 +1451,117 @@ func (x *GetProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
-func (*GetProductReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelScheduledPriceChangeReply.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPriceChangeReply) Descriptor() ([]byte, []int) {
 	return file_product_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetProductReply) GetProduct() *Product {
+// SetRegionalPrice
+type SetRegionalPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`          // Market code, e.g. "DE", "JP" or "US-CA"
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`            // Any currency; need not match the base price
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedReason string                 `protobuf:"bytes,6,opt,name=changed_reason,json=changedReason,proto3" json:"changed_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRegionalPriceRequest) Reset() {
+	*x = SetRegionalPriceRequest{}
+	mi := &file_product_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRegionalPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRegionalPriceRequest) ProtoMessage() {}
+
+func (x *SetRegionalPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[20]
 	if x != nil {
-		return x.Product
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRegionalPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetRegionalPriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetRegionalPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetRegionalPriceRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *SetRegionalPriceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SetRegionalPriceRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
 	}
 	return nil
 }
 
-// ListProducts
-type ListProductsRequest struct {
+func (x *SetRegionalPriceRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *SetRegionalPriceRequest) GetChangedReason() string {
+	if x != nil {
+		return x.ChangedReason
+	}
+	return ""
+}
+
+type SetRegionalPriceReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_product_service_proto_msgTypes[20]
+func (x *SetRegionalPriceReply) Reset() {
+	*x = SetRegionalPriceReply{}
+	mi := &file_product_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *SetRegionalPriceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*SetRegionalPriceReply) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[20]
+func (x *SetRegionalPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1149,63 +1572,3077 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetRegionalPriceReply.ProtoReflect.Descriptor instead.
+func (*SetRegionalPriceReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListProductsRequest) GetCategory() string {
+// RemoveRegionalPrice
+type RemoveRegionalPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedReason string                 `protobuf:"bytes,5,opt,name=changed_reason,json=changedReason,proto3" json:"changed_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRegionalPriceRequest) Reset() {
+	*x = RemoveRegionalPriceRequest{}
+	mi := &file_product_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRegionalPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRegionalPriceRequest) ProtoMessage() {}
+
+func (x *RemoveRegionalPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[22]
 	if x != nil {
-		return x.Category
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRegionalPriceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRegionalPriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveRegionalPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetStatus() string {
+func (x *RemoveRegionalPriceRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *RemoveRegionalPriceRequest) GetRegion() string {
 	if x != nil {
-		return x.Status
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RemoveRegionalPriceRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *RemoveRegionalPriceRequest) GetChangedReason() string {
+	if x != nil {
+		return x.ChangedReason
+	}
+	return ""
+}
+
+type RemoveRegionalPriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRegionalPriceReply) Reset() {
+	*x = RemoveRegionalPriceReply{}
+	mi := &file_product_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRegionalPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRegionalPriceReply) ProtoMessage() {}
+
+func (x *RemoveRegionalPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRegionalPriceReply.ProtoReflect.Descriptor instead.
+func (*RemoveRegionalPriceReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{23}
+}
+
+// TaxRate is the rate of one tax class in one region from its effective date on.
+type TaxRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	TaxClass         string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	RatePercent      string                 `protobuf:"bytes,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"` // Exact decimal (e.g. "19" or "7.7")
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	PricesIncludeTax bool                   `protobuf:"varint,5,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"` // List prices in the region are gross
+	Current          bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`                                             // In force now for its region and tax class (set by ListTaxRates)
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                         // Set by ListTaxRates
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaxRate) Reset() {
+	*x = TaxRate{}
+	mi := &file_product_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRate) ProtoMessage() {}
+
+func (x *TaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRate.ProtoReflect.Descriptor instead.
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaxRate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxRate) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRatePercent() string {
+	if x != nil {
+		return x.RatePercent
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetPageSize() int32 {
+func (x *TaxRate) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *TaxRate) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *TaxRate) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *TaxRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SetTaxRate adds a rate, or replaces the one with the same region, tax class and effective date.
+type SetTaxRateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`                                          // Market code, e.g. "DE", "JP" or "US-CA"
+	TaxClass         string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`                      // e.g. "standard" or "reduced"
+	RatePercent      string                 `protobuf:"bytes,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`             // 0-100, decimal ("19", "7.7") or fraction ("77/10")
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3,oneof" json:"effective_from,omitempty"` // Defaults to now
+	PricesIncludeTax bool                   `protobuf:"varint,5,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	mi := &file_product_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTaxRateRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetRatePercent() string {
+	if x != nil {
+		return x.RatePercent
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *SetTaxRateRequest) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+type SetTaxRateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRate       *TaxRate               `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateReply) Reset() {
+	*x = SetTaxRateReply{}
+	mi := &file_product_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateReply) ProtoMessage() {}
+
+func (x *SetTaxRateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateReply.ProtoReflect.Descriptor instead.
+func (*SetTaxRateReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetTaxRateReply) GetTaxRate() *TaxRate {
+	if x != nil {
+		return x.TaxRate
+	}
+	return nil
+}
+
+// ActivateProduct
+type ActivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductRequest) Reset() {
+	*x = ActivateProductRequest{}
+	mi := &file_product_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductRequest) ProtoMessage() {}
+
+func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ActivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ActivateProductRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type ActivateProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductReply) Reset() {
+	*x = ActivateProductReply{}
+	mi := &file_product_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductReply) ProtoMessage() {}
+
+func (x *ActivateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductReply.ProtoReflect.Descriptor instead.
+func (*ActivateProductReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{28}
+}
+
+// DeactivateProduct
+type DeactivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductRequest) Reset() {
+	*x = DeactivateProductRequest{}
+	mi := &file_product_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductRequest) ProtoMessage() {}
+
+func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeactivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeactivateProductRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DeactivateProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductReply) Reset() {
+	*x = DeactivateProductReply{}
+	mi := &file_product_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductReply) ProtoMessage() {}
+
+func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductReply.ProtoReflect.Descriptor instead.
+func (*DeactivateProductReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{30}
+}
+
+// ApplyDiscount
+type ApplyDiscountRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version   *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	// Types that are valid to be assigned to Discount:
+	//
+	//	*ApplyDiscountRequest_DiscountPercent
+	//	*ApplyDiscountRequest_DiscountAmount
+	//	*ApplyDiscountRequest_DiscountPercentExact
+	Discount      isApplyDiscountRequest_Discount `protobuf_oneof:"discount"`
+	StartDate     *timestamppb.Timestamp          `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp          `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRequest) Reset() {
+	*x = ApplyDiscountRequest{}
+	mi := &file_product_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApplyDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRequest) GetDiscount() isApplyDiscountRequest_Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetDiscountPercent() float64 {
+	if x != nil {
+		if x, ok := x.Discount.(*ApplyDiscountRequest_DiscountPercent); ok {
+			return x.DiscountPercent
+		}
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRequest) GetDiscountAmount() *Money {
+	if x != nil {
+		if x, ok := x.Discount.(*ApplyDiscountRequest_DiscountAmount); ok {
+			return x.DiscountAmount
+		}
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetDiscountPercentExact() string {
+	if x != nil {
+		if x, ok := x.Discount.(*ApplyDiscountRequest_DiscountPercentExact); ok {
+			return x.DiscountPercentExact
+		}
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type isApplyDiscountRequest_Discount interface {
+	isApplyDiscountRequest_Discount()
+}
+
+type ApplyDiscountRequest_DiscountPercent struct {
+	DiscountPercent float64 `protobuf:"fixed64,3,opt,name=discount_percent,json=discountPercent,proto3,oneof"` // Supports fractional values (e.g., 12.5 for 12.5%)
+}
+
+type ApplyDiscountRequest_DiscountAmount struct {
+	DiscountAmount *Money `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3,oneof"` // Fixed amount off in the base price currency; cannot exceed the base price
+}
+
+type ApplyDiscountRequest_DiscountPercentExact struct {
+	DiscountPercentExact string `protobuf:"bytes,7,opt,name=discount_percent_exact,json=discountPercentExact,proto3,oneof"` // Exact percentage as a decimal ("12.3") or fraction ("25/2"), at most 9 fractional digits
+}
+
+func (*ApplyDiscountRequest_DiscountPercent) isApplyDiscountRequest_Discount() {}
+
+func (*ApplyDiscountRequest_DiscountAmount) isApplyDiscountRequest_Discount() {}
+
+func (*ApplyDiscountRequest_DiscountPercentExact) isApplyDiscountRequest_Discount() {}
+
+type ApplyDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId    string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"` // Identifies the scheduled discount for CancelScheduledDiscount
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountReply) Reset() {
+	*x = ApplyDiscountReply{}
+	mi := &file_product_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountReply) ProtoMessage() {}
+
+func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApplyDiscountReply) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+// RemoveDiscount removes the discount that is active now.
+type RemoveDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountRequest) Reset() {
+	*x = RemoveDiscountRequest{}
+	mi := &file_product_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountRequest) ProtoMessage() {}
+
+func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveDiscountRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type RemoveDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountReply) Reset() {
+	*x = RemoveDiscountReply{}
+	mi := &file_product_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountReply) ProtoMessage() {}
+
+func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{34}
+}
+
+// CancelScheduledDiscount cancels a discount that has not started yet.
+type CancelScheduledDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledDiscountRequest) Reset() {
+	*x = CancelScheduledDiscountRequest{}
+	mi := &file_product_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledDiscountRequest) ProtoMessage() {}
+
+func (x *CancelScheduledDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledDiscountRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelScheduledDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CancelScheduledDiscountRequest) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *CancelScheduledDiscountRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CancelScheduledDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledDiscountReply) Reset() {
+	*x = CancelScheduledDiscountReply{}
+	mi := &file_product_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledDiscountReply) ProtoMessage() {}
+
+func (x *CancelScheduledDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledDiscountReply.ProtoReflect.Descriptor instead.
+func (*CancelScheduledDiscountReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{36}
+}
+
+// ArchiveProduct
+type ArchiveProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
+	mi := &file_product_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductRequest) ProtoMessage() {}
+
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ArchiveProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ArchiveProductRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type ArchiveProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // When the product was archived
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductReply) Reset() {
+	*x = ArchiveProductReply{}
+	mi := &file_product_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductReply) ProtoMessage() {}
+
+func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductReply.ProtoReflect.Descriptor instead.
+func (*ArchiveProductReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ArchiveProductReply) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+// RestoreProduct
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       *int64                 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // For optimistic locking (backwards compatible)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_product_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RestoreProductRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type RestoreProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestoredAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=restored_at,json=restoredAt,proto3" json:"restored_at,omitempty"` // When the product was restored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductReply) Reset() {
+	*x = RestoreProductReply{}
+	mi := &file_product_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductReply) ProtoMessage() {}
+
+func (x *RestoreProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductReply.ProtoReflect.Descriptor instead.
+func (*RestoreProductReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreProductReply) GetRestoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestoredAt
+	}
+	return nil
+}
+
+// GetProduct
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`     // Optional: price the product with this region's price list entry and tax rates
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Optional: preferred currency when the region has no entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductReply) Reset() {
+	*x = GetProductReply{}
+	mi := &file_product_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductReply) ProtoMessage() {}
+
+func (x *GetProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
+func (*GetProductReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetProductReply) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// ListProducts
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`     // Optional: price products with this region's price list entry and tax rates
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // Optional: preferred currency when the region has no entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsReply) Reset() {
+	*x = ListProductsReply{}
+	mi := &file_product_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsReply) ProtoMessage() {}
+
+func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
+func (*ListProductsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListProductsReply) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// RegionalPrice is a product's price list entry for one region.
+type RegionalPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionalPrice) Reset() {
+	*x = RegionalPrice{}
+	mi := &file_product_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionalPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionalPrice) ProtoMessage() {}
+
+func (x *RegionalPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionalPrice.ProtoReflect.Descriptor instead.
+func (*RegionalPrice) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegionalPrice) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionalPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *RegionalPrice) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetPrices
+type GetPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPricesRequest) Reset() {
+	*x = GetPricesRequest{}
+	mi := &file_product_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricesRequest) ProtoMessage() {}
+
+func (x *GetPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetPricesRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPricesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetPricesReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BasePrice      *Money                 `protobuf:"bytes,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`                // Applies in every region without an entry
+	RegionalPrices []*RegionalPrice       `protobuf:"bytes,2,rep,name=regional_prices,json=regionalPrices,proto3" json:"regional_prices,omitempty"` // Ordered by region
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPricesReply) Reset() {
+	*x = GetPricesReply{}
+	mi := &file_product_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricesReply) ProtoMessage() {}
+
+func (x *GetPricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricesReply.ProtoReflect.Descriptor instead.
+func (*GetPricesReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetPricesReply) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *GetPricesReply) GetRegionalPrices() []*RegionalPrice {
+	if x != nil {
+		return x.RegionalPrices
+	}
+	return nil
+}
+
+// PriceHistoryEntry is one recorded change of a product's base or regional price.
+type PriceHistoryEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HistoryId      string                 `protobuf:"bytes,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Region         string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`                           // Empty for the base price
+	OldPrice       *Money                 `protobuf:"bytes,3,opt,name=old_price,json=oldPrice,proto3,oneof" json:"old_price,omitempty"` // Unset for the initial price and new regional prices
+	NewPrice       *Money                 `protobuf:"bytes,4,opt,name=new_price,json=newPrice,proto3,oneof" json:"new_price,omitempty"` // Unset when a regional price was removed
+	ChangedBy      string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedReason  string                 `protobuf:"bytes,6,opt,name=changed_reason,json=changedReason,proto3" json:"changed_reason,omitempty"`
+	ChangedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	RequestId      string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`  // Set for decisions on price change requests
+	Decision       string                 `protobuf:"bytes,9,opt,name=decision,proto3" json:"decision,omitempty"`                     // "approved" or "rejected", set with request_id; rejected prices never took effect
+	DecidedBy      string                 `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"` // Empty when archiving the product rejected the request
+	DecisionReason string                 `protobuf:"bytes,11,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_product_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PriceHistoryEntry) GetHistoryId() string {
+	if x != nil {
+		return x.HistoryId
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetOldPrice() *Money {
+	if x != nil {
+		return x.OldPrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetChangedReason() string {
+	if x != nil {
+		return x.ChangedReason
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
+}
+
+// GetPriceHistory
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                           // Max number of entries to return (default: 100)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // next_page_token from a previous reply
+	ChangedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_after,json=changedAfter,proto3,oneof" json:"changed_after,omitempty"`    // Changes at or after this time
+	ChangedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_before,json=changedBefore,proto3,oneof" json:"changed_before,omitempty"` // Changes before this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_product_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetChangedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAfter
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryRequest) GetChangedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedBefore
+	}
+	return nil
+}
+
+type GetPriceHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                    // Newest first
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // Entries matching the filters across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryReply) Reset() {
+	*x = GetPriceHistoryReply{}
+	mi := &file_product_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryReply) ProtoMessage() {}
+
+func (x *GetPriceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPriceHistoryReply) GetEntries() []*PriceHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetPriceAt
+type GetPriceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // Point in time to price the product at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceAtRequest) Reset() {
+	*x = GetPriceAtRequest{}
+	mi := &file_product_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceAtRequest) ProtoMessage() {}
+
+func (x *GetPriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPriceAtRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetPriceAtReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BasePrice      *Money                 `protobuf:"bytes,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`                        // Base price in effect at the requested time
+	BasePriceSince *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=base_price_since,json=basePriceSince,proto3,oneof" json:"base_price_since,omitempty"` // Unset if the price predates the price history
+	Discount       *Discount              `protobuf:"bytes,3,opt,name=discount,proto3,oneof" json:"discount,omitempty"`                                     // Discount in effect at the requested time
+	EffectivePrice *RoundedPrice          `protobuf:"bytes,4,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`         // Rounded under the current rounding policy
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPriceAtReply) Reset() {
+	*x = GetPriceAtReply{}
+	mi := &file_product_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceAtReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceAtReply) ProtoMessage() {}
+
+func (x *GetPriceAtReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceAtReply.ProtoReflect.Descriptor instead.
+func (*GetPriceAtReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPriceAtReply) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *GetPriceAtReply) GetBasePriceSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BasePriceSince
+	}
+	return nil
+}
+
+func (x *GetPriceAtReply) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *GetPriceAtReply) GetEffectivePrice() *RoundedPrice {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+// Discount is one entry of a product's discount schedule.
+type Discount struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId           string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Kind                 string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                                                      // "percentage" or "fixed_amount"
+	DiscountPercent      *float64               `protobuf:"fixed64,3,opt,name=discount_percent,json=discountPercent,proto3,oneof" json:"discount_percent,omitempty"` // Set for percentage discounts
+	DiscountAmount       *Money                 `protobuf:"bytes,4,opt,name=discount_amount,json=discountAmount,proto3,oneof" json:"discount_amount,omitempty"`      // Set for fixed-amount discounts
+	StartDate            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                                                 // "scheduled", "active" or "ended"
+	DiscountPercentExact *string                `protobuf:"bytes,8,opt,name=discount_percent_exact,json=discountPercentExact,proto3,oneof" json:"discount_percent_exact,omitempty"` // Exact decimal of discount_percent (e.g. "12.3"), set with it
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_product_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Discount) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *Discount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discount) GetDiscountPercent() float64 {
+	if x != nil && x.DiscountPercent != nil {
+		return *x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *Discount) GetDiscountAmount() *Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *Discount) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Discount) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Discount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Discount) GetDiscountPercentExact() string {
+	if x != nil && x.DiscountPercentExact != nil {
+		return *x.DiscountPercentExact
+	}
+	return ""
+}
+
+// ListDiscounts
+type ListDiscountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountsRequest) Reset() {
+	*x = ListDiscountsRequest{}
+	mi := &file_product_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountsRequest) ProtoMessage() {}
+
+func (x *ListDiscountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiscountsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListDiscountsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListDiscountsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*Discount            `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"` // Ordered by start date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountsReply) Reset() {
+	*x = ListDiscountsReply{}
+	mi := &file_product_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountsReply) ProtoMessage() {}
+
+func (x *ListDiscountsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountsReply.ProtoReflect.Descriptor instead.
+func (*ListDiscountsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDiscountsReply) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+// ListScheduledPriceChanges
+type ListScheduledPriceChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPriceChangesRequest) Reset() {
+	*x = ListScheduledPriceChangesRequest{}
+	mi := &file_product_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPriceChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPriceChangesRequest) ProtoMessage() {}
+
+func (x *ListScheduledPriceChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPriceChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledPriceChangesRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListScheduledPriceChangesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListScheduledPriceChangesReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PriceChanges  []*ScheduledPriceChange `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"` // Pending changes ordered by effective time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPriceChangesReply) Reset() {
+	*x = ListScheduledPriceChangesReply{}
+	mi := &file_product_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPriceChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPriceChangesReply) ProtoMessage() {}
+
+func (x *ListScheduledPriceChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPriceChangesReply.ProtoReflect.Descriptor instead.
+func (*ListScheduledPriceChangesReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListScheduledPriceChangesReply) GetPriceChanges() []*ScheduledPriceChange {
+	if x != nil {
+		return x.PriceChanges
+	}
+	return nil
+}
+
+// ListTaxRates
+type ListTaxRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`                     // Optional
+	TaxClass      string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRatesRequest) Reset() {
+	*x = ListTaxRatesRequest{}
+	mi := &file_product_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRatesRequest) ProtoMessage() {}
+
+func (x *ListTaxRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTaxRatesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListTaxRatesRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+type ListTaxRatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRates      []*TaxRate             `protobuf:"bytes,1,rep,name=tax_rates,json=taxRates,proto3" json:"tax_rates,omitempty"` // Ordered by region and tax class, latest effective date first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRatesReply) Reset() {
+	*x = ListTaxRatesReply{}
+	mi := &file_product_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRatesReply) ProtoMessage() {}
+
+func (x *ListTaxRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRatesReply.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListTaxRatesReply) GetTaxRates() []*TaxRate {
+	if x != nil {
+		return x.TaxRates
+	}
+	return nil
+}
+
+// Event represents a domain event from the outbox.
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	AggregateId   string                 `protobuf:"bytes,3,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_product_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Event) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Event) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Event) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Event) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Event) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+// ListEvents
+type ListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`             // Filter by event type (e.g., "product.created")
+	AggregateId   *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"`       // Filter by aggregate ID
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`                                    // Filter by status ("pending", "processed", "failed")
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                           // Max number of events to return (default: 100)
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // next_page_token from a previous reply
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`    // Events created at or after this time
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"` // Events created before this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_product_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetAggregateId() string {
+	if x != nil && x.AggregateId != nil {
+		return *x.AggregateId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type ListEventsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                                      // Newest first
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // Events matching the filters across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsReply) Reset() {
+	*x = ListEventsReply{}
+	mi := &file_product_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsReply) ProtoMessage() {}
+
+func (x *ListEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsReply.ProtoReflect.Descriptor instead.
+func (*ListEventsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListEventsReply) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEventsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// WatchEvents
+type WatchEventsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventType   *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`       // Filter by event type (e.g., "product.created")
+	AggregateId *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"` // Filter by aggregate ID
+	Status      *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`                              // Filter by status when the event is first observed
+	// Where to start. Unset = only events committed after the call.
+	//
+	// Types that are valid to be assigned to Resume:
+	//
+	//	*WatchEventsRequest_ResumeAfterEventId
+	//	*WatchEventsRequest_StartTime
+	Resume        isWatchEventsRequest_Resume `protobuf_oneof:"resume"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_product_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WatchEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetAggregateId() string {
+	if x != nil && x.AggregateId != nil {
+		return *x.AggregateId
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetResume() isWatchEventsRequest_Resume {
+	if x != nil {
+		return x.Resume
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetResumeAfterEventId() string {
+	if x != nil {
+		if x, ok := x.Resume.(*WatchEventsRequest_ResumeAfterEventId); ok {
+			return x.ResumeAfterEventId
+		}
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Resume.(*WatchEventsRequest_StartTime); ok {
+			return x.StartTime
+		}
+	}
+	return nil
+}
+
+type isWatchEventsRequest_Resume interface {
+	isWatchEventsRequest_Resume()
+}
+
+type WatchEventsRequest_ResumeAfterEventId struct {
+	ResumeAfterEventId string `protobuf:"bytes,4,opt,name=resume_after_event_id,json=resumeAfterEventId,proto3,oneof"` // Last event_id received before a reconnect
+}
+
+type WatchEventsRequest_StartTime struct {
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,oneof"` // Events created at or after this time
+}
+
+func (*WatchEventsRequest_ResumeAfterEventId) isWatchEventsRequest_Resume() {}
+
+func (*WatchEventsRequest_StartTime) isWatchEventsRequest_Resume() {}
+
+type WatchEventsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsReply) Reset() {
+	*x = WatchEventsReply{}
+	mi := &file_product_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsReply) ProtoMessage() {}
+
+func (x *WatchEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsReply.ProtoReflect.Descriptor instead.
+func (*WatchEventsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WatchEventsReply) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// EventAttempt is one entry of a failed event's attempt history.
+type EventAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       string                 `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`                          // "retry_scheduled", "failed" or "requeued"
+	RetryCount    int64                  `protobuf:"varint,2,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"` // Retry count of the event when recorded
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	AttemptedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAttempt) Reset() {
+	*x = EventAttempt{}
+	mi := &file_product_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAttempt) ProtoMessage() {}
+
+func (x *EventAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAttempt.ProtoReflect.Descriptor instead.
+func (*EventAttempt) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *EventAttempt) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *EventAttempt) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *EventAttempt) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *EventAttempt) GetAttemptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return nil
+}
+
+// FailedEvent is a failed outbox event with the reason it failed.
+type FailedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"` // processed_at is the time the event failed
+	RetryCount    int64                  `protobuf:"varint,2,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Last publish error
+	Attempts      []*EventAttempt        `protobuf:"bytes,4,rep,name=attempts,proto3" json:"attempts,omitempty"`                             // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailedEvent) Reset() {
+	*x = FailedEvent{}
+	mi := &file_product_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedEvent) ProtoMessage() {}
+
+func (x *FailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedEvent.ProtoReflect.Descriptor instead.
+func (*FailedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FailedEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *FailedEvent) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *FailedEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *FailedEvent) GetAttempts() []*EventAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// ListFailedEvents
+type ListFailedEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	AggregateId   *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"`
+	FailedAfter   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_after,json=failedAfter,proto3,oneof" json:"failed_after,omitempty"`    // Inclusive
+	FailedBefore  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=failed_before,json=failedBefore,proto3,oneof" json:"failed_before,omitempty"` // Exclusive
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                        // Max number of events to return (default: 50, max: 500)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedEventsRequest) Reset() {
+	*x = ListFailedEventsRequest{}
+	mi := &file_product_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedEventsRequest) ProtoMessage() {}
+
+func (x *ListFailedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListFailedEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *ListFailedEventsRequest) GetAggregateId() string {
+	if x != nil && x.AggregateId != nil {
+		return *x.AggregateId
+	}
+	return ""
+}
+
+func (x *ListFailedEventsRequest) GetFailedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAfter
+	}
+	return nil
+}
+
+func (x *ListFailedEventsRequest) GetFailedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedBefore
+	}
+	return nil
+}
+
+func (x *ListFailedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFailedEventsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*FailedEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Most recently failed first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedEventsReply) Reset() {
+	*x = ListFailedEventsReply{}
+	mi := &file_product_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedEventsReply) ProtoMessage() {}
+
+func (x *ListFailedEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedEventsReply.ProtoReflect.Descriptor instead.
+func (*ListFailedEventsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListFailedEventsReply) GetEvents() []*FailedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// RetryEvent
+type RetryEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEventRequest) Reset() {
+	*x = RetryEventRequest{}
+	mi := &file_product_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEventRequest) ProtoMessage() {}
+
+func (x *RetryEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEventRequest.ProtoReflect.Descriptor instead.
+func (*RetryEventRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RetryEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type RetryEventReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEventReply) Reset() {
+	*x = RetryEventReply{}
+	mi := &file_product_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEventReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEventReply) ProtoMessage() {}
+
+func (x *RetryEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEventReply.ProtoReflect.Descriptor instead.
+func (*RetryEventReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{70}
+}
+
+// RetryEventsByFilter
+type RetryEventsByFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	AggregateId   *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"`
+	FailedAfter   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_after,json=failedAfter,proto3,oneof" json:"failed_after,omitempty"`    // Inclusive
+	FailedBefore  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=failed_before,json=failedBefore,proto3,oneof" json:"failed_before,omitempty"` // Exclusive
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Only count matching events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEventsByFilterRequest) Reset() {
+	*x = RetryEventsByFilterRequest{}
+	mi := &file_product_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEventsByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEventsByFilterRequest) ProtoMessage() {}
+
+func (x *RetryEventsByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEventsByFilterRequest.ProtoReflect.Descriptor instead.
+func (*RetryEventsByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RetryEventsByFilterRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *RetryEventsByFilterRequest) GetAggregateId() string {
+	if x != nil && x.AggregateId != nil {
+		return *x.AggregateId
+	}
+	return ""
+}
+
+func (x *RetryEventsByFilterRequest) GetFailedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAfter
+	}
+	return nil
+}
+
+func (x *RetryEventsByFilterRequest) GetFailedBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.FailedBefore
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProductsRequest) GetPageToken() string {
+func (x *RetryEventsByFilterRequest) GetDryRun() bool {
 	if x != nil {
-		return x.PageToken
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type ListProductsReply struct {
+type RetryEventsByFilterReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	MatchedCount  int64                  `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	RetriedCount  int64                  `protobuf:"varint,2,opt,name=retried_count,json=retriedCount,proto3" json:"retried_count,omitempty"` // Always 0 for a dry run
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsReply) Reset() {
-	*x = ListProductsReply{}
-	mi := &file_product_service_proto_msgTypes[21]
+func (x *RetryEventsByFilterReply) Reset() {
+	*x = RetryEventsByFilterReply{}
+	mi := &file_product_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsReply) String() string {
+func (x *RetryEventsByFilterReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsReply) ProtoMessage() {}
+func (*RetryEventsByFilterReply) ProtoMessage() {}
 
-func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[21]
+func (x *RetryEventsByFilterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,61 +4653,56 @@ func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
-func (*ListProductsReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ListProductsReply) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+// Deprecated: Use RetryEventsByFilterReply.ProtoReflect.Descriptor instead.
+func (*RetryEventsByFilterReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListProductsReply) GetNextPageToken() string {
+func (x *RetryEventsByFilterReply) GetMatchedCount() int64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.MatchedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListProductsReply) GetTotalCount() int64 {
+func (x *RetryEventsByFilterReply) GetRetriedCount() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.RetriedCount
 	}
 	return 0
 }
 
-// Event represents a domain event from the outbox.
-type Event struct {
+// ReplayEvents re-publishes completed events as copies marked "replayed".
+// Outbox rows are not modified. The replay runs within the request: at least one
+// filter is required and at most 10000 events may match (FAILED_PRECONDITION
+// otherwise). Larger replays belong to cmd/outbox_replay.
+type ReplayEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	AggregateId   string                 `protobuf:"bytes,3,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
-	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	EventType     *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	AggregateId   *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`    // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"` // Exclusive
+	Publisher     string                 `protobuf:"bytes,5,opt,name=publisher,proto3" json:"publisher,omitempty"`                                    // Replay publisher configured on the server (e.g. "log", "http")
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                           // Only count matching events
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_product_service_proto_msgTypes[22]
+func (x *ReplayEventsRequest) Reset() {
+	*x = ReplayEventsRequest{}
+	mi := &file_product_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *ReplayEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ReplayEventsRequest) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[22]
+func (x *ReplayEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,86 +4713,76 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReplayEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayEventsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Event) GetEventId() string {
-	if x != nil {
-		return x.EventId
+func (x *ReplayEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
 	}
 	return ""
 }
 
-func (x *Event) GetEventType() string {
-	if x != nil {
-		return x.EventType
+func (x *ReplayEventsRequest) GetAggregateId() string {
+	if x != nil && x.AggregateId != nil {
+		return *x.AggregateId
 	}
 	return ""
 }
 
-func (x *Event) GetAggregateId() string {
+func (x *ReplayEventsRequest) GetCreatedAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AggregateId
+		return x.CreatedAfter
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetPayload() string {
+func (x *ReplayEventsRequest) GetCreatedBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Payload
+		return x.CreatedBefore
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetStatus() string {
+func (x *ReplayEventsRequest) GetPublisher() string {
 	if x != nil {
-		return x.Status
+		return x.Publisher
 	}
 	return ""
 }
 
-func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Event) GetProcessedAt() *timestamppb.Timestamp {
+func (x *ReplayEventsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.ProcessedAt
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-// ListEvents
-type ListEventsRequest struct {
+type ReplayEventsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventType     *string                `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`       // Filter by event type (e.g., "product.created")
-	AggregateId   *string                `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3,oneof" json:"aggregate_id,omitempty"` // Filter by aggregate ID
-	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`                              // Filter by status ("pending", "processed", "failed")
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                     // Max number of events to return (default: 100)
+	MatchedCount  int64                  `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	ReplayedCount int64                  `protobuf:"varint,2,opt,name=replayed_count,json=replayedCount,proto3" json:"replayed_count,omitempty"` // Always 0 for a dry run
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
-	mi := &file_product_service_proto_msgTypes[23]
+func (x *ReplayEventsReply) Reset() {
+	*x = ReplayEventsReply{}
+	mi := &file_product_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *ReplayEventsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*ReplayEventsReply) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[23]
+func (x *ReplayEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,62 +4793,112 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReplayEventsReply.ProtoReflect.Descriptor instead.
+func (*ReplayEventsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListEventsRequest) GetEventType() string {
-	if x != nil && x.EventType != nil {
-		return *x.EventType
+func (x *ReplayEventsReply) GetMatchedCount() int64 {
+	if x != nil {
+		return x.MatchedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListEventsRequest) GetAggregateId() string {
-	if x != nil && x.AggregateId != nil {
-		return *x.AggregateId
+func (x *ReplayEventsReply) GetReplayedCount() int64 {
+	if x != nil {
+		return x.ReplayedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListEventsRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+// PurgeArchivedProducts permanently deletes one batch of products archived before the
+// cutoff, with their price history, emitting a product.purged tombstone for each.
+type PurgeArchivedProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ArchivedBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=archived_before,json=archivedBefore,proto3" json:"archived_before,omitempty"` // Retention cutoff (required, exclusive)
+	DryRun         bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Only count what would be purged, across all batches
+	BatchSize      int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`               // Maximum products purged (default 100)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeArchivedProductsRequest) Reset() {
+	*x = PurgeArchivedProductsRequest{}
+	mi := &file_product_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeArchivedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeArchivedProductsRequest) ProtoMessage() {}
+
+func (x *PurgeArchivedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListEventsRequest) GetLimit() int32 {
+// Deprecated: Use PurgeArchivedProductsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeArchivedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PurgeArchivedProductsRequest) GetArchivedBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.ArchivedBefore
+	}
+	return nil
+}
+
+func (x *PurgeArchivedProductsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeArchivedProductsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
 	}
 	return 0
 }
 
-type ListEventsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PurgeArchivedProductsReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductCount      int64                  `protobuf:"varint,1,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"`                  // Products found past the cutoff
+	PriceHistoryCount int64                  `protobuf:"varint,2,opt,name=price_history_count,json=priceHistoryCount,proto3" json:"price_history_count,omitempty"` // Price history rows deleted with them
+	PurgedCount       int64                  `protobuf:"varint,3,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`                     // Always 0 for a dry run
+	FailedCount       int64                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`                     // Left for the next call, e.g. restored meanwhile
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListEventsReply) Reset() {
-	*x = ListEventsReply{}
-	mi := &file_product_service_proto_msgTypes[24]
+func (x *PurgeArchivedProductsReply) Reset() {
+	*x = PurgeArchivedProductsReply{}
+	mi := &file_product_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsReply) String() string {
+func (x *PurgeArchivedProductsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsReply) ProtoMessage() {}
+func (*PurgeArchivedProductsReply) ProtoMessage() {}
 
-func (x *ListEventsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[24]
+func (x *PurgeArchivedProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,21 +4909,35 @@ func (x *ListEventsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsReply.ProtoReflect.Descriptor instead.
-func (*ListEventsReply) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PurgeArchivedProductsReply.ProtoReflect.Descriptor instead.
+func (*PurgeArchivedProductsReply) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListEventsReply) GetEvents() []*Event {
+func (x *PurgeArchivedProductsReply) GetProductCount() int64 {
 	if x != nil {
-		return x.Events
+		return x.ProductCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListEventsReply) GetTotalCount() int64 {
+func (x *PurgeArchivedProductsReply) GetPriceHistoryCount() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.PriceHistoryCount
+	}
+	return 0
+}
+
+func (x *PurgeArchivedProductsReply) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+func (x *PurgeArchivedProductsReply) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
 	}
 	return 0
 }
@@ -1461,10 +4947,11 @@ var File_product_service_proto protoreflect.FileDescriptor
 const file_product_service_proto_rawDesc = "" +
 	"\n" +
 	"\x15product_service.proto\x12\n" +
-	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"c\n" +
 	"\x05Money\x12\x1c\n" +
 	"\tnumerator\x18\x01 \x01(\x03R\tnumerator\x12 \n" +
-	"\vdenominator\x18\x02 \x01(\x03R\vdenominator\"\x90\x04\n" +
+	"\vdenominator\x18\x02 \x01(\x03R\vdenominator\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xd8\b\n" +
 	"\aProduct\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
@@ -1483,30 +4970,67 @@ const file_product_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
 	"\varchived_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
-	"archivedAt\x88\x01\x01B\x13\n" +
+	"archivedAt\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12!\n" +
+	"\fprice_region\x18\x0e \x01(\tR\vpriceRegion\x12?\n" +
+	"\x0fdiscount_amount\x18\x0f \x01(\v2\x11.product.v1.MoneyH\x02R\x0ediscountAmount\x88\x01\x01\x129\n" +
+	"\x16discount_percent_exact\x18\x10 \x01(\tH\x03R\x14discountPercentExact\x88\x01\x01\x12P\n" +
+	"\x17rounded_effective_price\x18\x11 \x01(\v2\x18.product.v1.RoundedPriceR\x15roundedEffectivePrice\x12\x1b\n" +
+	"\ttax_class\x18\x12 \x01(\tR\btaxClass\x12/\n" +
+	"\x03tax\x18\x13 \x01(\v2\x18.product.v1.TaxBreakdownH\x04R\x03tax\x88\x01\x01\x125\n" +
+	"\n" +
+	"cost_price\x18\x14 \x01(\v2\x11.product.v1.MoneyH\x05R\tcostPrice\x88\x01\x01\x12U\n" +
+	"\x14pending_price_change\x18\x15 \x01(\v2\x1e.product.v1.PriceChangeRequestH\x06R\x12pendingPriceChange\x88\x01\x01B\x13\n" +
 	"\x11_discount_percentB\x0e\n" +
-	"\f_archived_at\"\x9a\x01\n" +
+	"\f_archived_atB\x12\n" +
+	"\x10_discount_amountB\x19\n" +
+	"\x17_discount_percent_exactB\x06\n" +
+	"\x04_taxB\r\n" +
+	"\v_cost_priceB\x17\n" +
+	"\x15_pending_price_change\"\x89\x01\n" +
+	"\fRoundedPrice\x12'\n" +
+	"\x05exact\x18\x01 \x01(\v2\x11.product.v1.MoneyR\x05exact\x12+\n" +
+	"\arounded\x18\x02 \x01(\v2\x11.product.v1.MoneyR\arounded\x12#\n" +
+	"\rrounding_mode\x18\x03 \x01(\tR\froundingMode\"\x87\x02\n" +
+	"\fTaxBreakdown\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12!\n" +
+	"\frate_percent\x18\x03 \x01(\tR\vratePercent\x12,\n" +
+	"\x12prices_include_tax\x18\x04 \x01(\bR\x10pricesIncludeTax\x12#\n" +
+	"\x03net\x18\x05 \x01(\v2\x11.product.v1.MoneyR\x03net\x12#\n" +
+	"\x03tax\x18\x06 \x01(\v2\x11.product.v1.MoneyR\x03tax\x12'\n" +
+	"\x05gross\x18\a \x01(\v2\x11.product.v1.MoneyR\x05gross\"\xfd\x01\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x120\n" +
 	"\n" +
-	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\"3\n" +
+	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12\x1b\n" +
+	"\ttax_class\x18\x05 \x01(\tR\btaxClass\x125\n" +
+	"\n" +
+	"cost_price\x18\x06 \x01(\v2\x11.product.v1.MoneyH\x00R\tcostPrice\x88\x01\x01B\r\n" +
+	"\v_cost_price\"3\n" +
 	"\x12CreateProductReply\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\xe7\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\xdd\x02\n" +
 	"\x14UpdateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
 	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1f\n" +
-	"\bcategory\x18\x05 \x01(\tH\x03R\bcategory\x88\x01\x01B\n" +
+	"\bcategory\x18\x05 \x01(\tH\x03R\bcategory\x88\x01\x01\x12 \n" +
+	"\ttax_class\x18\x06 \x01(\tH\x04R\btaxClass\x88\x01\x01\x125\n" +
+	"\n" +
+	"cost_price\x18\a \x01(\v2\x11.product.v1.MoneyH\x05R\tcostPrice\x88\x01\x01B\n" +
 	"\n" +
 	"\b_versionB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_category\"\x14\n" +
+	"\t_categoryB\f\n" +
+	"\n" +
+	"_tax_classB\r\n" +
+	"\v_cost_price\"\x14\n" +
 	"\x12UpdateProductReply\"\xd4\x01\n" +
 	"\x12UpdatePriceRequest\x12\x1d\n" +
 	"\n" +
@@ -1517,8 +5041,115 @@ const file_product_service_proto_rawDesc = "" +
 	"changed_by\x18\x04 \x01(\tR\tchangedBy\x12%\n" +
 	"\x0echanged_reason\x18\x05 \x01(\tR\rchangedReasonB\n" +
 	"\n" +
-	"\b_version\"\x12\n" +
-	"\x10UpdatePriceReply\"b\n" +
+	"\b_version\"s\n" +
+	"\x10UpdatePriceReply\x12,\n" +
+	"\x12pending_request_id\x18\x01 \x01(\tR\x10pendingRequestId\x121\n" +
+	"\x14guardrail_violations\x18\x02 \x03(\tR\x13guardrailViolations\"\xc0\x02\n" +
+	"\x12PriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12.\n" +
+	"\told_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\boldPrice\x12.\n" +
+	"\tnew_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\bnewPrice\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x12)\n" +
+	"\x10requested_reason\x18\x05 \x01(\tR\x0frequestedReason\x12\x1e\n" +
+	"\n" +
+	"violations\x18\x06 \x03(\tR\n" +
+	"violations\x12=\n" +
+	"\frequested_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xa5\x01\n" +
+	"\x19ApprovePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x03H\x00R\aversion\x88\x01\x01\x12\x1f\n" +
+	"\vapproved_by\x18\x04 \x01(\tR\n" +
+	"approvedByB\n" +
+	"\n" +
+	"\b_version\"\x19\n" +
+	"\x17ApprovePriceChangeReply\"\xbc\x01\n" +
+	"\x18RejectPriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x03H\x00R\aversion\x88\x01\x01\x12\x1f\n" +
+	"\vrejected_by\x18\x04 \x01(\tR\n" +
+	"rejectedBy\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reasonB\n" +
+	"\n" +
+	"\b_version\"\x18\n" +
+	"\x16RejectPriceChangeReply\"\xae\x02\n" +
+	"\x14ScheduledPriceChange\x12&\n" +
+	"\x0fprice_change_id\x18\x01 \x01(\tR\rpriceChangeId\x12.\n" +
+	"\tnew_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\bnewPrice\x12=\n" +
+	"\feffective_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\x12%\n" +
+	"\x0echanged_reason\x18\x05 \x01(\tR\rchangedReason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9f\x02\n" +
+	"\x1eScheduleBasePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01\x12.\n" +
+	"\tnew_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\bnewPrice\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x12%\n" +
+	"\x0echanged_reason\x18\x06 \x01(\tR\rchangedReasonB\n" +
+	"\n" +
+	"\b_version\"F\n" +
+	"\x1cScheduleBasePriceChangeReply\x12&\n" +
+	"\x0fprice_change_id\x18\x01 \x01(\tR\rpriceChangeId\"\x95\x01\n" +
+	"!CancelScheduledPriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12&\n" +
+	"\x0fprice_change_id\x18\x02 \x01(\tR\rpriceChangeId\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"!\n" +
+	"\x1fCancelScheduledPriceChangeReply\"\xea\x01\n" +
+	"\x17SetRegionalPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12'\n" +
+	"\x05price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\x05price\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x12%\n" +
+	"\x0echanged_reason\x18\x06 \x01(\tR\rchangedReasonB\n" +
+	"\n" +
+	"\b_version\"\x17\n" +
+	"\x15SetRegionalPriceReply\"\xc4\x01\n" +
+	"\x1aRemoveRegionalPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\x12%\n" +
+	"\x0echanged_reason\x18\x05 \x01(\tR\rchangedReasonB\n" +
+	"\n" +
+	"\b_version\"\x1a\n" +
+	"\x18RemoveRegionalPriceReply\"\xa7\x02\n" +
+	"\aTaxRate\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12!\n" +
+	"\frate_percent\x18\x03 \x01(\tR\vratePercent\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12,\n" +
+	"\x12prices_include_tax\x18\x05 \x01(\bR\x10pricesIncludeTax\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrent\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x01\n" +
+	"\x11SetTaxRateRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12!\n" +
+	"\frate_percent\x18\x03 \x01(\tR\vratePercent\x12F\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\reffectiveFrom\x88\x01\x01\x12,\n" +
+	"\x12prices_include_tax\x18\x05 \x01(\bR\x10pricesIncludeTaxB\x11\n" +
+	"\x0f_effective_from\"A\n" +
+	"\x0fSetTaxRateReply\x12.\n" +
+	"\btax_rate\x18\x01 \x01(\v2\x13.product.v1.TaxRateR\ataxRate\"b\n" +
 	"\x16ActivateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
@@ -1532,25 +5163,40 @@ const file_product_service_proto_rawDesc = "" +
 	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
 	"\n" +
 	"\b_version\"\x18\n" +
-	"\x16DeactivateProductReply\"\xfd\x01\n" +
+	"\x16DeactivateProductReply\"\x81\x03\n" +
 	"\x14ApplyDiscountRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
-	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01\x12)\n" +
-	"\x10discount_percent\x18\x03 \x01(\x01R\x0fdiscountPercent\x129\n" +
+	"\aversion\x18\x02 \x01(\x03H\x01R\aversion\x88\x01\x01\x12+\n" +
+	"\x10discount_percent\x18\x03 \x01(\x01H\x00R\x0fdiscountPercent\x12<\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\v2\x11.product.v1.MoneyH\x00R\x0ediscountAmount\x126\n" +
+	"\x16discount_percent_exact\x18\a \x01(\tH\x00R\x14discountPercentExact\x129\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\n" +
 	"\n" +
-	"\b_version\"\x14\n" +
-	"\x12ApplyDiscountReply\"a\n" +
+	"\bdiscountB\n" +
+	"\n" +
+	"\b_version\"5\n" +
+	"\x12ApplyDiscountReply\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\"a\n" +
 	"\x15RemoveDiscountRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
 	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
 	"\n" +
 	"\b_version\"\x15\n" +
-	"\x13RemoveDiscountReply\"a\n" +
+	"\x13RemoveDiscountReply\"\x8b\x01\n" +
+	"\x1eCancelScheduledDiscountRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vdiscount_id\x18\x02 \x01(\tR\n" +
+	"discountId\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x1e\n" +
+	"\x1cCancelScheduledDiscountReply\"a\n" +
 	"\x15ArchiveProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
@@ -1559,23 +5205,126 @@ const file_product_service_proto_rawDesc = "" +
 	"\b_version\"R\n" +
 	"\x13ArchiveProductReply\x12;\n" +
 	"\varchived_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"archivedAt\"2\n" +
+	"archivedAt\"a\n" +
+	"\x15RestoreProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x03H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"R\n" +
+	"\x13RestoreProductReply\x12;\n" +
+	"\vrestored_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"restoredAt\"f\n" +
 	"\x11GetProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"@\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"@\n" +
 	"\x0fGetProductReply\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\x85\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xb9\x01\n" +
 	"\x13ListProductsRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\x8d\x01\n" +
 	"\x11ListProductsReply\x12/\n" +
 	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x03R\n" +
-	"totalCount\"\x90\x02\n" +
+	"totalCount\"\x8b\x01\n" +
+	"\rRegionalPrice\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12'\n" +
+	"\x05price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x05price\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"1\n" +
+	"\x10GetPricesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x86\x01\n" +
+	"\x0eGetPricesReply\x120\n" +
+	"\n" +
+	"base_price\x18\x01 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12B\n" +
+	"\x0fregional_prices\x18\x02 \x03(\v2\x19.product.v1.RegionalPriceR\x0eregionalPrices\"\xd4\x03\n" +
+	"\x11PriceHistoryEntry\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\tR\thistoryId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x123\n" +
+	"\told_price\x18\x03 \x01(\v2\x11.product.v1.MoneyH\x00R\boldPrice\x88\x01\x01\x123\n" +
+	"\tnew_price\x18\x04 \x01(\v2\x11.product.v1.MoneyH\x01R\bnewPrice\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x12%\n" +
+	"\x0echanged_reason\x18\x06 \x01(\tR\rchangedReason\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12\x1a\n" +
+	"\bdecision\x18\t \x01(\tR\bdecision\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tR\tdecidedBy\x12'\n" +
+	"\x0fdecision_reason\x18\v \x01(\tR\x0edecisionReasonB\f\n" +
+	"\n" +
+	"_old_priceB\f\n" +
+	"\n" +
+	"_new_price\"\x9f\x02\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12D\n" +
+	"\rchanged_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fchangedAfter\x88\x01\x01\x12F\n" +
+	"\x0echanged_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rchangedBefore\x88\x01\x01B\x10\n" +
+	"\x0e_changed_afterB\x11\n" +
+	"\x0f_changed_before\"\x98\x01\n" +
+	"\x14GetPriceHistoryReply\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.product.v1.PriceHistoryEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"^\n" +
+	"\x11GetPriceAtRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xaa\x02\n" +
+	"\x0fGetPriceAtReply\x120\n" +
+	"\n" +
+	"base_price\x18\x01 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12I\n" +
+	"\x10base_price_since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0ebasePriceSince\x88\x01\x01\x125\n" +
+	"\bdiscount\x18\x03 \x01(\v2\x14.product.v1.DiscountH\x01R\bdiscount\x88\x01\x01\x12A\n" +
+	"\x0feffective_price\x18\x04 \x01(\v2\x18.product.v1.RoundedPriceR\x0eeffectivePriceB\x13\n" +
+	"\x11_base_price_sinceB\v\n" +
+	"\t_discount\"\xb9\x03\n" +
+	"\bDiscount\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12.\n" +
+	"\x10discount_percent\x18\x03 \x01(\x01H\x00R\x0fdiscountPercent\x88\x01\x01\x12?\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\v2\x11.product.v1.MoneyH\x01R\x0ediscountAmount\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\x16discount_percent_exact\x18\b \x01(\tH\x02R\x14discountPercentExact\x88\x01\x01B\x13\n" +
+	"\x11_discount_percentB\x12\n" +
+	"\x10_discount_amountB\x19\n" +
+	"\x17_discount_percent_exact\"5\n" +
+	"\x14ListDiscountsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"H\n" +
+	"\x12ListDiscountsReply\x122\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x14.product.v1.DiscountR\tdiscounts\"A\n" +
+	" ListScheduledPriceChangesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"g\n" +
+	"\x1eListScheduledPriceChangesReply\x12E\n" +
+	"\rprice_changes\x18\x01 \x03(\v2 .product.v1.ScheduledPriceChangeR\fpriceChanges\"J\n" +
+	"\x13ListTaxRatesRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\"E\n" +
+	"\x11ListTaxRatesReply\x120\n" +
+	"\ttax_rates\x18\x01 \x03(\v2\x13.product.v1.TaxRateR\btaxRates\"\x90\x02\n" +
 	"\x05Event\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -1585,34 +5334,146 @@ const file_product_service_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fprocessed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\xbd\x01\n" +
+	"\fprocessed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\x8f\x03\n" +
 	"\x11ListEventsRequest\x12\"\n" +
 	"\n" +
 	"event_type\x18\x01 \x01(\tH\x00R\teventType\x88\x01\x01\x12&\n" +
 	"\faggregate_id\x18\x02 \x01(\tH\x01R\vaggregateId\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\r\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12D\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\rcreatedBefore\x88\x01\x01B\r\n" +
 	"\v_event_typeB\x0f\n" +
 	"\r_aggregate_idB\t\n" +
-	"\a_status\"]\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_before\"\x85\x01\n" +
 	"\x0fListEventsReply\x12)\n" +
 	"\x06events\x18\x01 \x03(\v2\x11.product.v1.EventR\x06events\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount2\x9e\a\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa4\x02\n" +
+	"\x12WatchEventsRequest\x12\"\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tH\x01R\teventType\x88\x01\x01\x12&\n" +
+	"\faggregate_id\x18\x02 \x01(\tH\x02R\vaggregateId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x03R\x06status\x88\x01\x01\x123\n" +
+	"\x15resume_after_event_id\x18\x04 \x01(\tH\x00R\x12resumeAfterEventId\x12;\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTimeB\b\n" +
+	"\x06resumeB\r\n" +
+	"\v_event_typeB\x0f\n" +
+	"\r_aggregate_idB\t\n" +
+	"\a_status\";\n" +
+	"\x10WatchEventsReply\x12'\n" +
+	"\x05event\x18\x01 \x01(\v2\x11.product.v1.EventR\x05event\"\xad\x01\n" +
+	"\fEventAttempt\x12\x18\n" +
+	"\aoutcome\x18\x01 \x01(\tR\aoutcome\x12\x1f\n" +
+	"\vretry_count\x18\x02 \x01(\x03R\n" +
+	"retryCount\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12=\n" +
+	"\fattempted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vattemptedAt\"\xb2\x01\n" +
+	"\vFailedEvent\x12'\n" +
+	"\x05event\x18\x01 \x01(\v2\x11.product.v1.EventR\x05event\x12\x1f\n" +
+	"\vretry_count\x18\x02 \x01(\x03R\n" +
+	"retryCount\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x124\n" +
+	"\battempts\x18\x04 \x03(\v2\x18.product.v1.EventAttemptR\battempts\"\xc8\x02\n" +
+	"\x17ListFailedEventsRequest\x12\"\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tH\x00R\teventType\x88\x01\x01\x12&\n" +
+	"\faggregate_id\x18\x02 \x01(\tH\x01R\vaggregateId\x88\x01\x01\x12B\n" +
+	"\ffailed_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vfailedAfter\x88\x01\x01\x12D\n" +
+	"\rfailed_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\ffailedBefore\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\r\n" +
+	"\v_event_typeB\x0f\n" +
+	"\r_aggregate_idB\x0f\n" +
+	"\r_failed_afterB\x10\n" +
+	"\x0e_failed_before\"H\n" +
+	"\x15ListFailedEventsReply\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.product.v1.FailedEventR\x06events\".\n" +
+	"\x11RetryEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x11\n" +
+	"\x0fRetryEventReply\"\xce\x02\n" +
+	"\x1aRetryEventsByFilterRequest\x12\"\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tH\x00R\teventType\x88\x01\x01\x12&\n" +
+	"\faggregate_id\x18\x02 \x01(\tH\x01R\vaggregateId\x88\x01\x01\x12B\n" +
+	"\ffailed_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vfailedAfter\x88\x01\x01\x12D\n" +
+	"\rfailed_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\ffailedBefore\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRunB\r\n" +
+	"\v_event_typeB\x0f\n" +
+	"\r_aggregate_idB\x0f\n" +
+	"\r_failed_afterB\x10\n" +
+	"\x0e_failed_before\"d\n" +
+	"\x18RetryEventsByFilterReply\x12#\n" +
+	"\rmatched_count\x18\x01 \x01(\x03R\fmatchedCount\x12#\n" +
+	"\rretried_count\x18\x02 \x01(\x03R\fretriedCount\"\xeb\x02\n" +
+	"\x13ReplayEventsRequest\x12\"\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tH\x00R\teventType\x88\x01\x01\x12&\n" +
+	"\faggregate_id\x18\x02 \x01(\tH\x01R\vaggregateId\x88\x01\x01\x12D\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\rcreatedBefore\x88\x01\x01\x12\x1c\n" +
+	"\tpublisher\x18\x05 \x01(\tR\tpublisher\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRunB\r\n" +
+	"\v_event_typeB\x0f\n" +
+	"\r_aggregate_idB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_before\"_\n" +
+	"\x11ReplayEventsReply\x12#\n" +
+	"\rmatched_count\x18\x01 \x01(\x03R\fmatchedCount\x12%\n" +
+	"\x0ereplayed_count\x18\x02 \x01(\x03R\rreplayedCount\"\x9b\x01\n" +
+	"\x1cPurgeArchivedProductsRequest\x12C\n" +
+	"\x0farchived_before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0earchivedBefore\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"\xb7\x01\n" +
+	"\x1aPurgeArchivedProductsReply\x12#\n" +
+	"\rproduct_count\x18\x01 \x01(\x03R\fproductCount\x12.\n" +
+	"\x13price_history_count\x18\x02 \x01(\x03R\x11priceHistoryCount\x12!\n" +
+	"\fpurged_count\x18\x03 \x01(\x03R\vpurgedCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x03R\vfailedCount2\xb5\x16\n" +
 	"\x0eProductService\x12Q\n" +
 	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a\x1e.product.v1.CreateProductReply\x12Q\n" +
 	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a\x1e.product.v1.UpdateProductReply\x12W\n" +
 	"\x0fActivateProduct\x12\".product.v1.ActivateProductRequest\x1a .product.v1.ActivateProductReply\x12]\n" +
 	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a\".product.v1.DeactivateProductReply\x12Q\n" +
 	"\rApplyDiscount\x12 .product.v1.ApplyDiscountRequest\x1a\x1e.product.v1.ApplyDiscountReply\x12T\n" +
-	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12T\n" +
-	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\x1f.product.v1.ArchiveProductReply\x12K\n" +
-	"\vUpdatePrice\x12\x1e.product.v1.UpdatePriceRequest\x1a\x1c.product.v1.UpdatePriceReply\x12H\n" +
+	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12o\n" +
+	"\x17CancelScheduledDiscount\x12*.product.v1.CancelScheduledDiscountRequest\x1a(.product.v1.CancelScheduledDiscountReply\x12T\n" +
+	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\x1f.product.v1.ArchiveProductReply\x12T\n" +
+	"\x0eRestoreProduct\x12!.product.v1.RestoreProductRequest\x1a\x1f.product.v1.RestoreProductReply\x12K\n" +
+	"\vUpdatePrice\x12\x1e.product.v1.UpdatePriceRequest\x1a\x1c.product.v1.UpdatePriceReply\x12o\n" +
+	"\x17ScheduleBasePriceChange\x12*.product.v1.ScheduleBasePriceChangeRequest\x1a(.product.v1.ScheduleBasePriceChangeReply\x12x\n" +
+	"\x1aCancelScheduledPriceChange\x12-.product.v1.CancelScheduledPriceChangeRequest\x1a+.product.v1.CancelScheduledPriceChangeReply\x12`\n" +
+	"\x12ApprovePriceChange\x12%.product.v1.ApprovePriceChangeRequest\x1a#.product.v1.ApprovePriceChangeReply\x12]\n" +
+	"\x11RejectPriceChange\x12$.product.v1.RejectPriceChangeRequest\x1a\".product.v1.RejectPriceChangeReply\x12Z\n" +
+	"\x10SetRegionalPrice\x12#.product.v1.SetRegionalPriceRequest\x1a!.product.v1.SetRegionalPriceReply\x12c\n" +
+	"\x13RemoveRegionalPrice\x12&.product.v1.RemoveRegionalPriceRequest\x1a$.product.v1.RemoveRegionalPriceReply\x12H\n" +
+	"\n" +
+	"SetTaxRate\x12\x1d.product.v1.SetTaxRateRequest\x1a\x1b.product.v1.SetTaxRateReply\x12H\n" +
 	"\n" +
 	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1b.product.v1.GetProductReply\x12N\n" +
-	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReply\x12H\n" +
+	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReply\x12E\n" +
+	"\tGetPrices\x12\x1c.product.v1.GetPricesRequest\x1a\x1a.product.v1.GetPricesReply\x12W\n" +
+	"\x0fGetPriceHistory\x12\".product.v1.GetPriceHistoryRequest\x1a .product.v1.GetPriceHistoryReply\x12H\n" +
 	"\n" +
-	"ListEvents\x12\x1d.product.v1.ListEventsRequest\x1a\x1b.product.v1.ListEventsReplyBDZBgithub.com/light-bringer/procat-service/proto/product/v1;productv1b\x06proto3"
+	"GetPriceAt\x12\x1d.product.v1.GetPriceAtRequest\x1a\x1b.product.v1.GetPriceAtReply\x12Q\n" +
+	"\rListDiscounts\x12 .product.v1.ListDiscountsRequest\x1a\x1e.product.v1.ListDiscountsReply\x12u\n" +
+	"\x19ListScheduledPriceChanges\x12,.product.v1.ListScheduledPriceChangesRequest\x1a*.product.v1.ListScheduledPriceChangesReply\x12N\n" +
+	"\fListTaxRates\x12\x1f.product.v1.ListTaxRatesRequest\x1a\x1d.product.v1.ListTaxRatesReply\x12H\n" +
+	"\n" +
+	"ListEvents\x12\x1d.product.v1.ListEventsRequest\x1a\x1b.product.v1.ListEventsReply\x12Z\n" +
+	"\x10ListFailedEvents\x12#.product.v1.ListFailedEventsRequest\x1a!.product.v1.ListFailedEventsReply\x12H\n" +
+	"\n" +
+	"RetryEvent\x12\x1d.product.v1.RetryEventRequest\x1a\x1b.product.v1.RetryEventReply\x12c\n" +
+	"\x13RetryEventsByFilter\x12&.product.v1.RetryEventsByFilterRequest\x1a$.product.v1.RetryEventsByFilterReply\x12N\n" +
+	"\fReplayEvents\x12\x1f.product.v1.ReplayEventsRequest\x1a\x1d.product.v1.ReplayEventsReply\x12i\n" +
+	"\x15PurgeArchivedProducts\x12(.product.v1.PurgeArchivedProductsRequest\x1a&.product.v1.PurgeArchivedProductsReply\x12M\n" +
+	"\vWatchEvents\x12\x1e.product.v1.WatchEventsRequest\x1a\x1c.product.v1.WatchEventsReply0\x01BDZBgithub.com/light-bringer/procat-service/proto/product/v1;productv1b\x06proto3"
 
 var (
 	file_product_service_proto_rawDescOnce sync.Once
@@ -1626,76 +5487,233 @@ func file_product_service_proto_rawDescGZIP() []byte {
 	return file_product_service_proto_rawDescData
 }
 
-var file_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_product_service_proto_goTypes = []any{
-	(*Money)(nil),                    // 0: product.v1.Money
-	(*Product)(nil),                  // 1: product.v1.Product
-	(*CreateProductRequest)(nil),     // 2: product.v1.CreateProductRequest
-	(*CreateProductReply)(nil),       // 3: product.v1.CreateProductReply
-	(*UpdateProductRequest)(nil),     // 4: product.v1.UpdateProductRequest
-	(*UpdateProductReply)(nil),       // 5: product.v1.UpdateProductReply
-	(*UpdatePriceRequest)(nil),       // 6: product.v1.UpdatePriceRequest
-	(*UpdatePriceReply)(nil),         // 7: product.v1.UpdatePriceReply
-	(*ActivateProductRequest)(nil),   // 8: product.v1.ActivateProductRequest
-	(*ActivateProductReply)(nil),     // 9: product.v1.ActivateProductReply
-	(*DeactivateProductRequest)(nil), // 10: product.v1.DeactivateProductRequest
-	(*DeactivateProductReply)(nil),   // 11: product.v1.DeactivateProductReply
-	(*ApplyDiscountRequest)(nil),     // 12: product.v1.ApplyDiscountRequest
-	(*ApplyDiscountReply)(nil),       // 13: product.v1.ApplyDiscountReply
-	(*RemoveDiscountRequest)(nil),    // 14: product.v1.RemoveDiscountRequest
-	(*RemoveDiscountReply)(nil),      // 15: product.v1.RemoveDiscountReply
-	(*ArchiveProductRequest)(nil),    // 16: product.v1.ArchiveProductRequest
-	(*ArchiveProductReply)(nil),      // 17: product.v1.ArchiveProductReply
-	(*GetProductRequest)(nil),        // 18: product.v1.GetProductRequest
-	(*GetProductReply)(nil),          // 19: product.v1.GetProductReply
-	(*ListProductsRequest)(nil),      // 20: product.v1.ListProductsRequest
-	(*ListProductsReply)(nil),        // 21: product.v1.ListProductsReply
-	(*Event)(nil),                    // 22: product.v1.Event
-	(*ListEventsRequest)(nil),        // 23: product.v1.ListEventsRequest
-	(*ListEventsReply)(nil),          // 24: product.v1.ListEventsReply
-	(*timestamppb.Timestamp)(nil),    // 25: google.protobuf.Timestamp
+	(*Money)(nil),                             // 0: product.v1.Money
+	(*Product)(nil),                           // 1: product.v1.Product
+	(*RoundedPrice)(nil),                      // 2: product.v1.RoundedPrice
+	(*TaxBreakdown)(nil),                      // 3: product.v1.TaxBreakdown
+	(*CreateProductRequest)(nil),              // 4: product.v1.CreateProductRequest
+	(*CreateProductReply)(nil),                // 5: product.v1.CreateProductReply
+	(*UpdateProductRequest)(nil),              // 6: product.v1.UpdateProductRequest
+	(*UpdateProductReply)(nil),                // 7: product.v1.UpdateProductReply
+	(*UpdatePriceRequest)(nil),                // 8: product.v1.UpdatePriceRequest
+	(*UpdatePriceReply)(nil),                  // 9: product.v1.UpdatePriceReply
+	(*PriceChangeRequest)(nil),                // 10: product.v1.PriceChangeRequest
+	(*ApprovePriceChangeRequest)(nil),         // 11: product.v1.ApprovePriceChangeRequest
+	(*ApprovePriceChangeReply)(nil),           // 12: product.v1.ApprovePriceChangeReply
+	(*RejectPriceChangeRequest)(nil),          // 13: product.v1.RejectPriceChangeRequest
+	(*RejectPriceChangeReply)(nil),            // 14: product.v1.RejectPriceChangeReply
+	(*ScheduledPriceChange)(nil),              // 15: product.v1.ScheduledPriceChange
+	(*ScheduleBasePriceChangeRequest)(nil),    // 16: product.v1.ScheduleBasePriceChangeRequest
+	(*ScheduleBasePriceChangeReply)(nil),      // 17: product.v1.ScheduleBasePriceChangeReply
+	(*CancelScheduledPriceChangeRequest)(nil), // 18: product.v1.CancelScheduledPriceChangeRequest
+	(*CancelScheduledPriceChangeReply)(nil),   // 19: product.v1.CancelScheduledPriceChangeReply
+	(*SetRegionalPriceRequest)(nil),           // 20: product.v1.SetRegionalPriceRequest
+	(*SetRegionalPriceReply)(nil),             // 21: product.v1.SetRegionalPriceReply
+	(*RemoveRegionalPriceRequest)(nil),        // 22: product.v1.RemoveRegionalPriceRequest
+	(*RemoveRegionalPriceReply)(nil),          // 23: product.v1.RemoveRegionalPriceReply
+	(*TaxRate)(nil),                           // 24: product.v1.TaxRate
+	(*SetTaxRateRequest)(nil),                 // 25: product.v1.SetTaxRateRequest
+	(*SetTaxRateReply)(nil),                   // 26: product.v1.SetTaxRateReply
+	(*ActivateProductRequest)(nil),            // 27: product.v1.ActivateProductRequest
+	(*ActivateProductReply)(nil),              // 28: product.v1.ActivateProductReply
+	(*DeactivateProductRequest)(nil),          // 29: product.v1.DeactivateProductRequest
+	(*DeactivateProductReply)(nil),            // 30: product.v1.DeactivateProductReply
+	(*ApplyDiscountRequest)(nil),              // 31: product.v1.ApplyDiscountRequest
+	(*ApplyDiscountReply)(nil),                // 32: product.v1.ApplyDiscountReply
+	(*RemoveDiscountRequest)(nil),             // 33: product.v1.RemoveDiscountRequest
+	(*RemoveDiscountReply)(nil),               // 34: product.v1.RemoveDiscountReply
+	(*CancelScheduledDiscountRequest)(nil),    // 35: product.v1.CancelScheduledDiscountRequest
+	(*CancelScheduledDiscountReply)(nil),      // 36: product.v1.CancelScheduledDiscountReply
+	(*ArchiveProductRequest)(nil),             // 37: product.v1.ArchiveProductRequest
+	(*ArchiveProductReply)(nil),               // 38: product.v1.ArchiveProductReply
+	(*RestoreProductRequest)(nil),             // 39: product.v1.RestoreProductRequest
+	(*RestoreProductReply)(nil),               // 40: product.v1.RestoreProductReply
+	(*GetProductRequest)(nil),                 // 41: product.v1.GetProductRequest
+	(*GetProductReply)(nil),                   // 42: product.v1.GetProductReply
+	(*ListProductsRequest)(nil),               // 43: product.v1.ListProductsRequest
+	(*ListProductsReply)(nil),                 // 44: product.v1.ListProductsReply
+	(*RegionalPrice)(nil),                     // 45: product.v1.RegionalPrice
+	(*GetPricesRequest)(nil),                  // 46: product.v1.GetPricesRequest
+	(*GetPricesReply)(nil),                    // 47: product.v1.GetPricesReply
+	(*PriceHistoryEntry)(nil),                 // 48: product.v1.PriceHistoryEntry
+	(*GetPriceHistoryRequest)(nil),            // 49: product.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryReply)(nil),              // 50: product.v1.GetPriceHistoryReply
+	(*GetPriceAtRequest)(nil),                 // 51: product.v1.GetPriceAtRequest
+	(*GetPriceAtReply)(nil),                   // 52: product.v1.GetPriceAtReply
+	(*Discount)(nil),                          // 53: product.v1.Discount
+	(*ListDiscountsRequest)(nil),              // 54: product.v1.ListDiscountsRequest
+	(*ListDiscountsReply)(nil),                // 55: product.v1.ListDiscountsReply
+	(*ListScheduledPriceChangesRequest)(nil),  // 56: product.v1.ListScheduledPriceChangesRequest
+	(*ListScheduledPriceChangesReply)(nil),    // 57: product.v1.ListScheduledPriceChangesReply
+	(*ListTaxRatesRequest)(nil),               // 58: product.v1.ListTaxRatesRequest
+	(*ListTaxRatesReply)(nil),                 // 59: product.v1.ListTaxRatesReply
+	(*Event)(nil),                             // 60: product.v1.Event
+	(*ListEventsRequest)(nil),                 // 61: product.v1.ListEventsRequest
+	(*ListEventsReply)(nil),                   // 62: product.v1.ListEventsReply
+	(*WatchEventsRequest)(nil),                // 63: product.v1.WatchEventsRequest
+	(*WatchEventsReply)(nil),                  // 64: product.v1.WatchEventsReply
+	(*EventAttempt)(nil),                      // 65: product.v1.EventAttempt
+	(*FailedEvent)(nil),                       // 66: product.v1.FailedEvent
+	(*ListFailedEventsRequest)(nil),           // 67: product.v1.ListFailedEventsRequest
+	(*ListFailedEventsReply)(nil),             // 68: product.v1.ListFailedEventsReply
+	(*RetryEventRequest)(nil),                 // 69: product.v1.RetryEventRequest
+	(*RetryEventReply)(nil),                   // 70: product.v1.RetryEventReply
+	(*RetryEventsByFilterRequest)(nil),        // 71: product.v1.RetryEventsByFilterRequest
+	(*RetryEventsByFilterReply)(nil),          // 72: product.v1.RetryEventsByFilterReply
+	(*ReplayEventsRequest)(nil),               // 73: product.v1.ReplayEventsRequest
+	(*ReplayEventsReply)(nil),                 // 74: product.v1.ReplayEventsReply
+	(*PurgeArchivedProductsRequest)(nil),      // 75: product.v1.PurgeArchivedProductsRequest
+	(*PurgeArchivedProductsReply)(nil),        // 76: product.v1.PurgeArchivedProductsReply
+	(*timestamppb.Timestamp)(nil),             // 77: google.protobuf.Timestamp
 }
 var file_product_service_proto_depIdxs = []int32{
-	25, // 0: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	25, // 1: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 2: product.v1.Product.archived_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
-	0,  // 4: product.v1.UpdatePriceRequest.new_price:type_name -> product.v1.Money
-	25, // 5: product.v1.ApplyDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
-	25, // 6: product.v1.ApplyDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
-	25, // 7: product.v1.ArchiveProductReply.archived_at:type_name -> google.protobuf.Timestamp
-	1,  // 8: product.v1.GetProductReply.product:type_name -> product.v1.Product
-	1,  // 9: product.v1.ListProductsReply.products:type_name -> product.v1.Product
-	25, // 10: product.v1.Event.created_at:type_name -> google.protobuf.Timestamp
-	25, // 11: product.v1.Event.processed_at:type_name -> google.protobuf.Timestamp
-	22, // 12: product.v1.ListEventsReply.events:type_name -> product.v1.Event
-	2,  // 13: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	4,  // 14: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
-	8,  // 15: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
-	10, // 16: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
-	12, // 17: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
-	14, // 18: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
-	16, // 19: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
-	6,  // 20: product.v1.ProductService.UpdatePrice:input_type -> product.v1.UpdatePriceRequest
-	18, // 21: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	20, // 22: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	23, // 23: product.v1.ProductService.ListEvents:input_type -> product.v1.ListEventsRequest
-	3,  // 24: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
-	5,  // 25: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
-	9,  // 26: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
-	11, // 27: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
-	13, // 28: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
-	15, // 29: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
-	17, // 30: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductReply
-	7,  // 31: product.v1.ProductService.UpdatePrice:output_type -> product.v1.UpdatePriceReply
-	19, // 32: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
-	21, // 33: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
-	24, // 34: product.v1.ProductService.ListEvents:output_type -> product.v1.ListEventsReply
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	77,  // 0: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 1: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 2: product.v1.Product.archived_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: product.v1.Product.discount_amount:type_name -> product.v1.Money
+	2,   // 4: product.v1.Product.rounded_effective_price:type_name -> product.v1.RoundedPrice
+	3,   // 5: product.v1.Product.tax:type_name -> product.v1.TaxBreakdown
+	0,   // 6: product.v1.Product.cost_price:type_name -> product.v1.Money
+	10,  // 7: product.v1.Product.pending_price_change:type_name -> product.v1.PriceChangeRequest
+	0,   // 8: product.v1.RoundedPrice.exact:type_name -> product.v1.Money
+	0,   // 9: product.v1.RoundedPrice.rounded:type_name -> product.v1.Money
+	0,   // 10: product.v1.TaxBreakdown.net:type_name -> product.v1.Money
+	0,   // 11: product.v1.TaxBreakdown.tax:type_name -> product.v1.Money
+	0,   // 12: product.v1.TaxBreakdown.gross:type_name -> product.v1.Money
+	0,   // 13: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
+	0,   // 14: product.v1.CreateProductRequest.cost_price:type_name -> product.v1.Money
+	0,   // 15: product.v1.UpdateProductRequest.cost_price:type_name -> product.v1.Money
+	0,   // 16: product.v1.UpdatePriceRequest.new_price:type_name -> product.v1.Money
+	0,   // 17: product.v1.PriceChangeRequest.old_price:type_name -> product.v1.Money
+	0,   // 18: product.v1.PriceChangeRequest.new_price:type_name -> product.v1.Money
+	77,  // 19: product.v1.PriceChangeRequest.requested_at:type_name -> google.protobuf.Timestamp
+	0,   // 20: product.v1.ScheduledPriceChange.new_price:type_name -> product.v1.Money
+	77,  // 21: product.v1.ScheduledPriceChange.effective_at:type_name -> google.protobuf.Timestamp
+	77,  // 22: product.v1.ScheduledPriceChange.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 23: product.v1.ScheduleBasePriceChangeRequest.new_price:type_name -> product.v1.Money
+	77,  // 24: product.v1.ScheduleBasePriceChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	0,   // 25: product.v1.SetRegionalPriceRequest.price:type_name -> product.v1.Money
+	77,  // 26: product.v1.TaxRate.effective_from:type_name -> google.protobuf.Timestamp
+	77,  // 27: product.v1.TaxRate.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 28: product.v1.SetTaxRateRequest.effective_from:type_name -> google.protobuf.Timestamp
+	24,  // 29: product.v1.SetTaxRateReply.tax_rate:type_name -> product.v1.TaxRate
+	0,   // 30: product.v1.ApplyDiscountRequest.discount_amount:type_name -> product.v1.Money
+	77,  // 31: product.v1.ApplyDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
+	77,  // 32: product.v1.ApplyDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
+	77,  // 33: product.v1.ArchiveProductReply.archived_at:type_name -> google.protobuf.Timestamp
+	77,  // 34: product.v1.RestoreProductReply.restored_at:type_name -> google.protobuf.Timestamp
+	1,   // 35: product.v1.GetProductReply.product:type_name -> product.v1.Product
+	1,   // 36: product.v1.ListProductsReply.products:type_name -> product.v1.Product
+	0,   // 37: product.v1.RegionalPrice.price:type_name -> product.v1.Money
+	77,  // 38: product.v1.RegionalPrice.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 39: product.v1.GetPricesReply.base_price:type_name -> product.v1.Money
+	45,  // 40: product.v1.GetPricesReply.regional_prices:type_name -> product.v1.RegionalPrice
+	0,   // 41: product.v1.PriceHistoryEntry.old_price:type_name -> product.v1.Money
+	0,   // 42: product.v1.PriceHistoryEntry.new_price:type_name -> product.v1.Money
+	77,  // 43: product.v1.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	77,  // 44: product.v1.GetPriceHistoryRequest.changed_after:type_name -> google.protobuf.Timestamp
+	77,  // 45: product.v1.GetPriceHistoryRequest.changed_before:type_name -> google.protobuf.Timestamp
+	48,  // 46: product.v1.GetPriceHistoryReply.entries:type_name -> product.v1.PriceHistoryEntry
+	77,  // 47: product.v1.GetPriceAtRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 48: product.v1.GetPriceAtReply.base_price:type_name -> product.v1.Money
+	77,  // 49: product.v1.GetPriceAtReply.base_price_since:type_name -> google.protobuf.Timestamp
+	53,  // 50: product.v1.GetPriceAtReply.discount:type_name -> product.v1.Discount
+	2,   // 51: product.v1.GetPriceAtReply.effective_price:type_name -> product.v1.RoundedPrice
+	0,   // 52: product.v1.Discount.discount_amount:type_name -> product.v1.Money
+	77,  // 53: product.v1.Discount.start_date:type_name -> google.protobuf.Timestamp
+	77,  // 54: product.v1.Discount.end_date:type_name -> google.protobuf.Timestamp
+	53,  // 55: product.v1.ListDiscountsReply.discounts:type_name -> product.v1.Discount
+	15,  // 56: product.v1.ListScheduledPriceChangesReply.price_changes:type_name -> product.v1.ScheduledPriceChange
+	24,  // 57: product.v1.ListTaxRatesReply.tax_rates:type_name -> product.v1.TaxRate
+	77,  // 58: product.v1.Event.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 59: product.v1.Event.processed_at:type_name -> google.protobuf.Timestamp
+	77,  // 60: product.v1.ListEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	77,  // 61: product.v1.ListEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	60,  // 62: product.v1.ListEventsReply.events:type_name -> product.v1.Event
+	77,  // 63: product.v1.WatchEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	60,  // 64: product.v1.WatchEventsReply.event:type_name -> product.v1.Event
+	77,  // 65: product.v1.EventAttempt.attempted_at:type_name -> google.protobuf.Timestamp
+	60,  // 66: product.v1.FailedEvent.event:type_name -> product.v1.Event
+	65,  // 67: product.v1.FailedEvent.attempts:type_name -> product.v1.EventAttempt
+	77,  // 68: product.v1.ListFailedEventsRequest.failed_after:type_name -> google.protobuf.Timestamp
+	77,  // 69: product.v1.ListFailedEventsRequest.failed_before:type_name -> google.protobuf.Timestamp
+	66,  // 70: product.v1.ListFailedEventsReply.events:type_name -> product.v1.FailedEvent
+	77,  // 71: product.v1.RetryEventsByFilterRequest.failed_after:type_name -> google.protobuf.Timestamp
+	77,  // 72: product.v1.RetryEventsByFilterRequest.failed_before:type_name -> google.protobuf.Timestamp
+	77,  // 73: product.v1.ReplayEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	77,  // 74: product.v1.ReplayEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	77,  // 75: product.v1.PurgeArchivedProductsRequest.archived_before:type_name -> google.protobuf.Timestamp
+	4,   // 76: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	6,   // 77: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	27,  // 78: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
+	29,  // 79: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
+	31,  // 80: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
+	33,  // 81: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
+	35,  // 82: product.v1.ProductService.CancelScheduledDiscount:input_type -> product.v1.CancelScheduledDiscountRequest
+	37,  // 83: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
+	39,  // 84: product.v1.ProductService.RestoreProduct:input_type -> product.v1.RestoreProductRequest
+	8,   // 85: product.v1.ProductService.UpdatePrice:input_type -> product.v1.UpdatePriceRequest
+	16,  // 86: product.v1.ProductService.ScheduleBasePriceChange:input_type -> product.v1.ScheduleBasePriceChangeRequest
+	18,  // 87: product.v1.ProductService.CancelScheduledPriceChange:input_type -> product.v1.CancelScheduledPriceChangeRequest
+	11,  // 88: product.v1.ProductService.ApprovePriceChange:input_type -> product.v1.ApprovePriceChangeRequest
+	13,  // 89: product.v1.ProductService.RejectPriceChange:input_type -> product.v1.RejectPriceChangeRequest
+	20,  // 90: product.v1.ProductService.SetRegionalPrice:input_type -> product.v1.SetRegionalPriceRequest
+	22,  // 91: product.v1.ProductService.RemoveRegionalPrice:input_type -> product.v1.RemoveRegionalPriceRequest
+	25,  // 92: product.v1.ProductService.SetTaxRate:input_type -> product.v1.SetTaxRateRequest
+	41,  // 93: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	43,  // 94: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	46,  // 95: product.v1.ProductService.GetPrices:input_type -> product.v1.GetPricesRequest
+	49,  // 96: product.v1.ProductService.GetPriceHistory:input_type -> product.v1.GetPriceHistoryRequest
+	51,  // 97: product.v1.ProductService.GetPriceAt:input_type -> product.v1.GetPriceAtRequest
+	54,  // 98: product.v1.ProductService.ListDiscounts:input_type -> product.v1.ListDiscountsRequest
+	56,  // 99: product.v1.ProductService.ListScheduledPriceChanges:input_type -> product.v1.ListScheduledPriceChangesRequest
+	58,  // 100: product.v1.ProductService.ListTaxRates:input_type -> product.v1.ListTaxRatesRequest
+	61,  // 101: product.v1.ProductService.ListEvents:input_type -> product.v1.ListEventsRequest
+	67,  // 102: product.v1.ProductService.ListFailedEvents:input_type -> product.v1.ListFailedEventsRequest
+	69,  // 103: product.v1.ProductService.RetryEvent:input_type -> product.v1.RetryEventRequest
+	71,  // 104: product.v1.ProductService.RetryEventsByFilter:input_type -> product.v1.RetryEventsByFilterRequest
+	73,  // 105: product.v1.ProductService.ReplayEvents:input_type -> product.v1.ReplayEventsRequest
+	75,  // 106: product.v1.ProductService.PurgeArchivedProducts:input_type -> product.v1.PurgeArchivedProductsRequest
+	63,  // 107: product.v1.ProductService.WatchEvents:input_type -> product.v1.WatchEventsRequest
+	5,   // 108: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
+	7,   // 109: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
+	28,  // 110: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
+	30,  // 111: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
+	32,  // 112: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
+	34,  // 113: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
+	36,  // 114: product.v1.ProductService.CancelScheduledDiscount:output_type -> product.v1.CancelScheduledDiscountReply
+	38,  // 115: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductReply
+	40,  // 116: product.v1.ProductService.RestoreProduct:output_type -> product.v1.RestoreProductReply
+	9,   // 117: product.v1.ProductService.UpdatePrice:output_type -> product.v1.UpdatePriceReply
+	17,  // 118: product.v1.ProductService.ScheduleBasePriceChange:output_type -> product.v1.ScheduleBasePriceChangeReply
+	19,  // 119: product.v1.ProductService.CancelScheduledPriceChange:output_type -> product.v1.CancelScheduledPriceChangeReply
+	12,  // 120: product.v1.ProductService.ApprovePriceChange:output_type -> product.v1.ApprovePriceChangeReply
+	14,  // 121: product.v1.ProductService.RejectPriceChange:output_type -> product.v1.RejectPriceChangeReply
+	21,  // 122: product.v1.ProductService.SetRegionalPrice:output_type -> product.v1.SetRegionalPriceReply
+	23,  // 123: product.v1.ProductService.RemoveRegionalPrice:output_type -> product.v1.RemoveRegionalPriceReply
+	26,  // 124: product.v1.ProductService.SetTaxRate:output_type -> product.v1.SetTaxRateReply
+	42,  // 125: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
+	44,  // 126: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
+	47,  // 127: product.v1.ProductService.GetPrices:output_type -> product.v1.GetPricesReply
+	50,  // 128: product.v1.ProductService.GetPriceHistory:output_type -> product.v1.GetPriceHistoryReply
+	52,  // 129: product.v1.ProductService.GetPriceAt:output_type -> product.v1.GetPriceAtReply
+	55,  // 130: product.v1.ProductService.ListDiscounts:output_type -> product.v1.ListDiscountsReply
+	57,  // 131: product.v1.ProductService.ListScheduledPriceChanges:output_type -> product.v1.ListScheduledPriceChangesReply
+	59,  // 132: product.v1.ProductService.ListTaxRates:output_type -> product.v1.ListTaxRatesReply
+	62,  // 133: product.v1.ProductService.ListEvents:output_type -> product.v1.ListEventsReply
+	68,  // 134: product.v1.ProductService.ListFailedEvents:output_type -> product.v1.ListFailedEventsReply
+	70,  // 135: product.v1.ProductService.RetryEvent:output_type -> product.v1.RetryEventReply
+	72,  // 136: product.v1.ProductService.RetryEventsByFilter:output_type -> product.v1.RetryEventsByFilterReply
+	74,  // 137: product.v1.ProductService.ReplayEvents:output_type -> product.v1.ReplayEventsReply
+	76,  // 138: product.v1.ProductService.PurgeArchivedProducts:output_type -> product.v1.PurgeArchivedProductsReply
+	64,  // 139: product.v1.ProductService.WatchEvents:output_type -> product.v1.WatchEventsReply
+	108, // [108:140] is the sub-list for method output_type
+	76,  // [76:108] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_product_service_proto_init() }
@@ -1707,18 +5725,43 @@ func file_product_service_proto_init() {
 	file_product_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_product_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_product_service_proto_msgTypes[8].OneofWrappers = []any{}
-	file_product_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_product_service_proto_msgTypes[12].OneofWrappers = []any{}
-	file_product_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[13].OneofWrappers = []any{}
 	file_product_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_product_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[31].OneofWrappers = []any{
+		(*ApplyDiscountRequest_DiscountPercent)(nil),
+		(*ApplyDiscountRequest_DiscountAmount)(nil),
+		(*ApplyDiscountRequest_DiscountPercentExact)(nil),
+	}
+	file_product_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[53].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[63].OneofWrappers = []any{
+		(*WatchEventsRequest_ResumeAfterEventId)(nil),
+		(*WatchEventsRequest_StartTime)(nil),
+	}
+	file_product_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_product_service_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_service_proto_rawDesc), len(file_product_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,17 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.v1.ProductService/CreateProduct"
-	ProductService_UpdateProduct_FullMethodName     = "/product.v1.ProductService/UpdateProduct"
-	ProductService_ActivateProduct_FullMethodName   = "/product.v1.ProductService/ActivateProduct"
-	ProductService_DeactivateProduct_FullMethodName = "/product.v1.ProductService/DeactivateProduct"
-	ProductService_ApplyDiscount_FullMethodName     = "/product.v1.ProductService/ApplyDiscount"
-	ProductService_RemoveDiscount_FullMethodName    = "/product.v1.ProductService/RemoveDiscount"
-	ProductService_ArchiveProduct_FullMethodName    = "/product.v1.ProductService/ArchiveProduct"
-	ProductService_UpdatePrice_FullMethodName       = "/product.v1.ProductService/UpdatePrice"
-	ProductService_GetProduct_FullMethodName        = "/product.v1.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName      = "/product.v1.ProductService/ListProducts"
-	ProductService_ListEvents_FullMethodName        = "/product.v1.ProductService/ListEvents"
+	ProductService_CreateProduct_FullMethodName              = "/product.v1.ProductService/CreateProduct"
+	ProductService_UpdateProduct_FullMethodName              = "/product.v1.ProductService/UpdateProduct"
+	ProductService_ActivateProduct_FullMethodName            = "/product.v1.ProductService/ActivateProduct"
+	ProductService_DeactivateProduct_FullMethodName          = "/product.v1.ProductService/DeactivateProduct"
+	ProductService_ApplyDiscount_FullMethodName              = "/product.v1.ProductService/ApplyDiscount"
+	ProductService_RemoveDiscount_FullMethodName             = "/product.v1.ProductService/RemoveDiscount"
+	ProductService_CancelScheduledDiscount_FullMethodName    = "/product.v1.ProductService/CancelScheduledDiscount"
+	ProductService_ArchiveProduct_FullMethodName             = "/product.v1.ProductService/ArchiveProduct"
+	ProductService_RestoreProduct_FullMethodName             = "/product.v1.ProductService/RestoreProduct"
+	ProductService_UpdatePrice_FullMethodName                = "/product.v1.ProductService/UpdatePrice"
+	ProductService_ScheduleBasePriceChange_FullMethodName    = "/product.v1.ProductService/ScheduleBasePriceChange"
+	ProductService_CancelScheduledPriceChange_FullMethodName = "/product.v1.ProductService/CancelScheduledPriceChange"
+	ProductService_ApprovePriceChange_FullMethodName         = "/product.v1.ProductService/ApprovePriceChange"
+	ProductService_RejectPriceChange_FullMethodName          = "/product.v1.ProductService/RejectPriceChange"
+	ProductService_SetRegionalPrice_FullMethodName           = "/product.v1.ProductService/SetRegionalPrice"
+	ProductService_RemoveRegionalPrice_FullMethodName        = "/product.v1.ProductService/RemoveRegionalPrice"
+	ProductService_SetTaxRate_FullMethodName                 = "/product.v1.ProductService/SetTaxRate"
+	ProductService_GetProduct_FullMethodName                 = "/product.v1.ProductService/GetProduct"
+	ProductService_ListProducts_FullMethodName               = "/product.v1.ProductService/ListProducts"
+	ProductService_GetPrices_FullMethodName                  = "/product.v1.ProductService/GetPrices"
+	ProductService_GetPriceHistory_FullMethodName            = "/product.v1.ProductService/GetPriceHistory"
+	ProductService_GetPriceAt_FullMethodName                 = "/product.v1.ProductService/GetPriceAt"
+	ProductService_ListDiscounts_FullMethodName              = "/product.v1.ProductService/ListDiscounts"
+	ProductService_ListScheduledPriceChanges_FullMethodName  = "/product.v1.ProductService/ListScheduledPriceChanges"
+	ProductService_ListTaxRates_FullMethodName               = "/product.v1.ProductService/ListTaxRates"
+	ProductService_ListEvents_FullMethodName                 = "/product.v1.ProductService/ListEvents"
+	ProductService_ListFailedEvents_FullMethodName           = "/product.v1.ProductService/ListFailedEvents"
+	ProductService_RetryEvent_FullMethodName                 = "/product.v1.ProductService/RetryEvent"
+	ProductService_RetryEventsByFilter_FullMethodName        = "/product.v1.ProductService/RetryEventsByFilter"
+	ProductService_ReplayEvents_FullMethodName               = "/product.v1.ProductService/ReplayEvents"
+	ProductService_PurgeArchivedProducts_FullMethodName      = "/product.v1.ProductService/PurgeArchivedProducts"
+	ProductService_WatchEvents_FullMethodName                = "/product.v1.ProductService/WatchEvents"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -45,12 +66,36 @@ type ProductServiceClient interface {
 	DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...grpc.CallOption) (*DeactivateProductReply, error)
 	ApplyDiscount(ctx context.Context, in *ApplyDiscountRequest, opts ...grpc.CallOption) (*ApplyDiscountReply, error)
 	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountReply, error)
+	CancelScheduledDiscount(ctx context.Context, in *CancelScheduledDiscountRequest, opts ...grpc.CallOption) (*CancelScheduledDiscountReply, error)
 	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductReply, error)
 	UpdatePrice(ctx context.Context, in *UpdatePriceRequest, opts ...grpc.CallOption) (*UpdatePriceReply, error)
+	ScheduleBasePriceChange(ctx context.Context, in *ScheduleBasePriceChangeRequest, opts ...grpc.CallOption) (*ScheduleBasePriceChangeReply, error)
+	CancelScheduledPriceChange(ctx context.Context, in *CancelScheduledPriceChangeRequest, opts ...grpc.CallOption) (*CancelScheduledPriceChangeReply, error)
+	ApprovePriceChange(ctx context.Context, in *ApprovePriceChangeRequest, opts ...grpc.CallOption) (*ApprovePriceChangeReply, error)
+	RejectPriceChange(ctx context.Context, in *RejectPriceChangeRequest, opts ...grpc.CallOption) (*RejectPriceChangeReply, error)
+	SetRegionalPrice(ctx context.Context, in *SetRegionalPriceRequest, opts ...grpc.CallOption) (*SetRegionalPriceReply, error)
+	RemoveRegionalPrice(ctx context.Context, in *RemoveRegionalPriceRequest, opts ...grpc.CallOption) (*RemoveRegionalPriceReply, error)
+	SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error)
 	// Queries (read operations)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesReply, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error)
+	GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtReply, error)
+	ListDiscounts(ctx context.Context, in *ListDiscountsRequest, opts ...grpc.CallOption) (*ListDiscountsReply, error)
+	ListScheduledPriceChanges(ctx context.Context, in *ListScheduledPriceChangesRequest, opts ...grpc.CallOption) (*ListScheduledPriceChangesReply, error)
+	ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesReply, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsReply, error)
+	// Outbox administration (dead letters and replay)
+	ListFailedEvents(ctx context.Context, in *ListFailedEventsRequest, opts ...grpc.CallOption) (*ListFailedEventsReply, error)
+	RetryEvent(ctx context.Context, in *RetryEventRequest, opts ...grpc.CallOption) (*RetryEventReply, error)
+	RetryEventsByFilter(ctx context.Context, in *RetryEventsByFilterRequest, opts ...grpc.CallOption) (*RetryEventsByFilterReply, error)
+	ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsReply, error)
+	// Data retention
+	PurgeArchivedProducts(ctx context.Context, in *PurgeArchivedProductsRequest, opts ...grpc.CallOption) (*PurgeArchivedProductsReply, error)
+	// Streams (long-lived read operations)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsReply], error)
 }
 
 type productServiceClient struct {
@@ -121,6 +166,16 @@ func (c *productServiceClient) RemoveDiscount(ctx context.Context, in *RemoveDis
 	return out, nil
 }
 
+func (c *productServiceClient) CancelScheduledDiscount(ctx context.Context, in *CancelScheduledDiscountRequest, opts ...grpc.CallOption) (*CancelScheduledDiscountReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledDiscountReply)
+	err := c.cc.Invoke(ctx, ProductService_CancelScheduledDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveProductReply)
@@ -131,6 +186,16 @@ func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchivePr
 	return out, nil
 }
 
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductReply)
+	err := c.cc.Invoke(ctx, ProductService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdatePrice(ctx context.Context, in *UpdatePriceRequest, opts ...grpc.CallOption) (*UpdatePriceReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdatePriceReply)
@@ -141,6 +206,76 @@ func (c *productServiceClient) UpdatePrice(ctx context.Context, in *UpdatePriceR
 	return out, nil
 }
 
+func (c *productServiceClient) ScheduleBasePriceChange(ctx context.Context, in *ScheduleBasePriceChangeRequest, opts ...grpc.CallOption) (*ScheduleBasePriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleBasePriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_ScheduleBasePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelScheduledPriceChange(ctx context.Context, in *CancelScheduledPriceChangeRequest, opts ...grpc.CallOption) (*CancelScheduledPriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledPriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_CancelScheduledPriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ApprovePriceChange(ctx context.Context, in *ApprovePriceChangeRequest, opts ...grpc.CallOption) (*ApprovePriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovePriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_ApprovePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RejectPriceChange(ctx context.Context, in *RejectPriceChangeRequest, opts ...grpc.CallOption) (*RejectPriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectPriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_RejectPriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetRegionalPrice(ctx context.Context, in *SetRegionalPriceRequest, opts ...grpc.CallOption) (*SetRegionalPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRegionalPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_SetRegionalPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveRegionalPrice(ctx context.Context, in *RemoveRegionalPriceRequest, opts ...grpc.CallOption) (*RemoveRegionalPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRegionalPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_RemoveRegionalPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaxRateReply)
+	err := c.cc.Invoke(ctx, ProductService_SetTaxRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProductReply)
@@ -161,6 +296,66 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPricesReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceAtReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListDiscounts(ctx context.Context, in *ListDiscountsRequest, opts ...grpc.CallOption) (*ListDiscountsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiscountsReply)
+	err := c.cc.Invoke(ctx, ProductService_ListDiscounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListScheduledPriceChanges(ctx context.Context, in *ListScheduledPriceChangesRequest, opts ...grpc.CallOption) (*ListScheduledPriceChangesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledPriceChangesReply)
+	err := c.cc.Invoke(ctx, ProductService_ListScheduledPriceChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRatesReply)
+	err := c.cc.Invoke(ctx, ProductService_ListTaxRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListEventsReply)
@@ -171,6 +366,75 @@ func (c *productServiceClient) ListEvents(ctx context.Context, in *ListEventsReq
 	return out, nil
 }
 
+func (c *productServiceClient) ListFailedEvents(ctx context.Context, in *ListFailedEventsRequest, opts ...grpc.CallOption) (*ListFailedEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFailedEventsReply)
+	err := c.cc.Invoke(ctx, ProductService_ListFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RetryEvent(ctx context.Context, in *RetryEventRequest, opts ...grpc.CallOption) (*RetryEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryEventReply)
+	err := c.cc.Invoke(ctx, ProductService_RetryEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RetryEventsByFilter(ctx context.Context, in *RetryEventsByFilterRequest, opts ...grpc.CallOption) (*RetryEventsByFilterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryEventsByFilterReply)
+	err := c.cc.Invoke(ctx, ProductService_RetryEventsByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayEventsReply)
+	err := c.cc.Invoke(ctx, ProductService_ReplayEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeArchivedProducts(ctx context.Context, in *PurgeArchivedProductsRequest, opts ...grpc.CallOption) (*PurgeArchivedProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeArchivedProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_PurgeArchivedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsReply]
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -184,12 +448,36 @@ type ProductServiceServer interface {
 	DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error)
 	ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error)
 	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error)
+	CancelScheduledDiscount(context.Context, *CancelScheduledDiscountRequest) (*CancelScheduledDiscountReply, error)
 	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductReply, error)
 	UpdatePrice(context.Context, *UpdatePriceRequest) (*UpdatePriceReply, error)
+	ScheduleBasePriceChange(context.Context, *ScheduleBasePriceChangeRequest) (*ScheduleBasePriceChangeReply, error)
+	CancelScheduledPriceChange(context.Context, *CancelScheduledPriceChangeRequest) (*CancelScheduledPriceChangeReply, error)
+	ApprovePriceChange(context.Context, *ApprovePriceChangeRequest) (*ApprovePriceChangeReply, error)
+	RejectPriceChange(context.Context, *RejectPriceChangeRequest) (*RejectPriceChangeReply, error)
+	SetRegionalPrice(context.Context, *SetRegionalPriceRequest) (*SetRegionalPriceReply, error)
+	RemoveRegionalPrice(context.Context, *RemoveRegionalPriceRequest) (*RemoveRegionalPriceReply, error)
+	SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error)
 	// Queries (read operations)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	GetPrices(context.Context, *GetPricesRequest) (*GetPricesReply, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error)
+	GetPriceAt(context.Context, *GetPriceAtRequest) (*GetPriceAtReply, error)
+	ListDiscounts(context.Context, *ListDiscountsRequest) (*ListDiscountsReply, error)
+	ListScheduledPriceChanges(context.Context, *ListScheduledPriceChangesRequest) (*ListScheduledPriceChangesReply, error)
+	ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesReply, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsReply, error)
+	// Outbox administration (dead letters and replay)
+	ListFailedEvents(context.Context, *ListFailedEventsRequest) (*ListFailedEventsReply, error)
+	RetryEvent(context.Context, *RetryEventRequest) (*RetryEventReply, error)
+	RetryEventsByFilter(context.Context, *RetryEventsByFilterRequest) (*RetryEventsByFilterReply, error)
+	ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsReply, error)
+	// Data retention
+	PurgeArchivedProducts(context.Context, *PurgeArchivedProductsRequest) (*PurgeArchivedProductsReply, error)
+	// Streams (long-lived read operations)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsReply]) error
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -218,21 +506,84 @@ func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDi
 func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
 }
+func (UnimplementedProductServiceServer) CancelScheduledDiscount(context.Context, *CancelScheduledDiscountRequest) (*CancelScheduledDiscountReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledDiscount not implemented")
+}
 func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
 }
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreProduct not implemented")
+}
 func (UnimplementedProductServiceServer) UpdatePrice(context.Context, *UpdatePriceRequest) (*UpdatePriceReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdatePrice not implemented")
 }
+func (UnimplementedProductServiceServer) ScheduleBasePriceChange(context.Context, *ScheduleBasePriceChangeRequest) (*ScheduleBasePriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleBasePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) CancelScheduledPriceChange(context.Context, *CancelScheduledPriceChangeRequest) (*CancelScheduledPriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledPriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) ApprovePriceChange(context.Context, *ApprovePriceChangeRequest) (*ApprovePriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApprovePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) RejectPriceChange(context.Context, *RejectPriceChangeRequest) (*RejectPriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectPriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) SetRegionalPrice(context.Context, *SetRegionalPriceRequest) (*SetRegionalPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRegionalPrice not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveRegionalPrice(context.Context, *RemoveRegionalPriceRequest) (*RemoveRegionalPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveRegionalPrice not implemented")
+}
+func (UnimplementedProductServiceServer) SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaxRate not implemented")
+}
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) GetPrices(context.Context, *GetPricesRequest) (*GetPricesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrices not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceAt(context.Context, *GetPriceAtRequest) (*GetPriceAtReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceAt not implemented")
+}
+func (UnimplementedProductServiceServer) ListDiscounts(context.Context, *ListDiscountsRequest) (*ListDiscountsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDiscounts not implemented")
+}
+func (UnimplementedProductServiceServer) ListScheduledPriceChanges(context.Context, *ListScheduledPriceChangesRequest) (*ListScheduledPriceChangesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledPriceChanges not implemented")
+}
+func (UnimplementedProductServiceServer) ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaxRates not implemented")
+}
 func (UnimplementedProductServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedProductServiceServer) ListFailedEvents(context.Context, *ListFailedEventsRequest) (*ListFailedEventsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFailedEvents not implemented")
+}
+func (UnimplementedProductServiceServer) RetryEvent(context.Context, *RetryEventRequest) (*RetryEventReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryEvent not implemented")
+}
+func (UnimplementedProductServiceServer) RetryEventsByFilter(context.Context, *RetryEventsByFilterRequest) (*RetryEventsByFilterReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryEventsByFilter not implemented")
+}
+func (UnimplementedProductServiceServer) ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayEvents not implemented")
+}
+func (UnimplementedProductServiceServer) PurgeArchivedProducts(context.Context, *PurgeArchivedProductsRequest) (*PurgeArchivedProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeArchivedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsReply]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -362,6 +713,24 @@ func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_CancelScheduledDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelScheduledDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelScheduledDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelScheduledDiscount(ctx, req.(*CancelScheduledDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveProductRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +749,24 @@ func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_UpdatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePriceRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +785,132 @@ func _ProductService_UpdatePrice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_ScheduleBasePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleBasePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ScheduleBasePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ScheduleBasePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ScheduleBasePriceChange(ctx, req.(*ScheduleBasePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CancelScheduledPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelScheduledPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelScheduledPriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelScheduledPriceChange(ctx, req.(*CancelScheduledPriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApprovePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApprovePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApprovePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApprovePriceChange(ctx, req.(*ApprovePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RejectPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RejectPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RejectPriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RejectPriceChange(ctx, req.(*RejectPriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetRegionalPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRegionalPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetRegionalPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetRegionalPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetRegionalPrice(ctx, req.(*SetRegionalPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveRegionalPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRegionalPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveRegionalPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveRegionalPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveRegionalPrice(ctx, req.(*RemoveRegionalPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetTaxRate(ctx, req.(*SetTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +947,114 @@ func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPrices(ctx, req.(*GetPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceAt(ctx, req.(*GetPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListDiscounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListDiscounts(ctx, req.(*ListDiscountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListScheduledPriceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPriceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListScheduledPriceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListScheduledPriceChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListScheduledPriceChanges(ctx, req.(*ListScheduledPriceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListTaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListTaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListTaxRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListTaxRates(ctx, req.(*ListTaxRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventsRequest)
 	if err := dec(in); err != nil {
@@ -452,6 +1073,107 @@ func _ProductService_ListEvents_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_ListFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListFailedEvents(ctx, req.(*ListFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RetryEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RetryEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RetryEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RetryEvent(ctx, req.(*RetryEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RetryEventsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEventsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RetryEventsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RetryEventsByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RetryEventsByFilter(ctx, req.(*RetryEventsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReplayEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReplayEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReplayEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReplayEvents(ctx, req.(*ReplayEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PurgeArchivedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeArchivedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PurgeArchivedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PurgeArchivedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PurgeArchivedProducts(ctx, req.(*PurgeArchivedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsReply]
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -483,14 +1205,50 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveDiscount",
 			Handler:    _ProductService_RemoveDiscount_Handler,
 		},
+		{
+			MethodName: "CancelScheduledDiscount",
+			Handler:    _ProductService_CancelScheduledDiscount_Handler,
+		},
 		{
 			MethodName: "ArchiveProduct",
 			Handler:    _ProductService_ArchiveProduct_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
 		{
 			MethodName: "UpdatePrice",
 			Handler:    _ProductService_UpdatePrice_Handler,
 		},
+		{
+			MethodName: "ScheduleBasePriceChange",
+			Handler:    _ProductService_ScheduleBasePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPriceChange",
+			Handler:    _ProductService_CancelScheduledPriceChange_Handler,
+		},
+		{
+			MethodName: "ApprovePriceChange",
+			Handler:    _ProductService_ApprovePriceChange_Handler,
+		},
+		{
+			MethodName: "RejectPriceChange",
+			Handler:    _ProductService_RejectPriceChange_Handler,
+		},
+		{
+			MethodName: "SetRegionalPrice",
+			Handler:    _ProductService_SetRegionalPrice_Handler,
+		},
+		{
+			MethodName: "RemoveRegionalPrice",
+			Handler:    _ProductService_RemoveRegionalPrice_Handler,
+		},
+		{
+			MethodName: "SetTaxRate",
+			Handler:    _ProductService_SetTaxRate_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
@@ -499,11 +1257,61 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _ProductService_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ProductService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceAt",
+			Handler:    _ProductService_GetPriceAt_Handler,
+		},
+		{
+			MethodName: "ListDiscounts",
+			Handler:    _ProductService_ListDiscounts_Handler,
+		},
+		{
+			MethodName: "ListScheduledPriceChanges",
+			Handler:    _ProductService_ListScheduledPriceChanges_Handler,
+		},
+		{
+			MethodName: "ListTaxRates",
+			Handler:    _ProductService_ListTaxRates_Handler,
+		},
 		{
 			MethodName: "ListEvents",
 			Handler:    _ProductService_ListEvents_Handler,
 		},
+		{
+			MethodName: "ListFailedEvents",
+			Handler:    _ProductService_ListFailedEvents_Handler,
+		},
+		{
+			MethodName: "RetryEvent",
+			Handler:    _ProductService_RetryEvent_Handler,
+		},
+		{
+			MethodName: "RetryEventsByFilter",
+			Handler:    _ProductService_RetryEventsByFilter_Handler,
+		},
+		{
+			MethodName: "ReplayEvents",
+			Handler:    _ProductService_ReplayEvents_Handler,
+		},
+		{
+			MethodName: "PurgeArchivedProducts",
+			Handler:    _ProductService_PurgeArchivedProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ProductService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product_service.proto",
 }
@@ -10,6 +10,7 @@ type ProductBuilder struct {
 	name        string
 	description string
 	category    string
+	taxClass    string
 	price       float64
 }
 
@@ -41,6 +42,12 @@ func (b *ProductBuilder) WithCategory(category string) *ProductBuilder {
 	return b
 }
 
+// WithTaxClass sets the product tax class
+func (b *ProductBuilder) WithTaxClass(taxClass string) *ProductBuilder {
+	b.taxClass = taxClass
+	return b
+}
+
 // WithPrice sets the product base price
 func (b *ProductBuilder) WithPrice(price float64) *ProductBuilder {
 	b.price = price
@@ -51,12 +58,13 @@ func (b *ProductBuilder) WithPrice(price float64) *ProductBuilder {
 func (b *ProductBuilder) Build() *create_product.Request {
 	// 100 as denominator for 2 decimal places precision
 	numerator := int64(b.price * 100)
-	price, _ := domain.NewMoney(numerator, 100)
+	price, _ := domain.NewMoney(numerator, 100, "USD")
 
 	return &create_product.Request{
 		Name:        b.name,
 		Description: b.description,
 		Category:    b.category,
+		TaxClass:    b.taxClass,
 		BasePrice:   price,
 	}
 }
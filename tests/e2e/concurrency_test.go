@@ -66,7 +66,7 @@ func TestConcurrentDiscountApplication(t *testing.T) {
 			StartDate:       discount1.StartDate(),
 			EndDate:         discount1.EndDate(),
 		}
-		err1 = suite.ApplyDiscount.Execute(ctx, req)
+		_, err1 = suite.ApplyDiscount.Execute(ctx, req)
 	}()
 
 	go func() {
@@ -78,7 +78,7 @@ func TestConcurrentDiscountApplication(t *testing.T) {
 			StartDate:       discount2.StartDate(),
 			EndDate:         discount2.EndDate(),
 		}
-		err2 = suite.ApplyDiscount.Execute(ctx, req)
+		_, err2 = suite.ApplyDiscount.Execute(ctx, req)
 	}()
 
 	wg.Wait()
@@ -289,7 +289,7 @@ func TestConcurrentPriceUpdates(t *testing.T) {
 		wg.Add(1)
 		go func(idx int, val int64) {
 			defer wg.Done()
-			newPrice, _ := domain.NewMoney(val, 100)
+			newPrice, _ := domain.NewMoney(val, 100, "USD")
 
 			// Load product
 			product, err := suite.ProductRepo.GetByID(ctx, productID)
@@ -299,7 +299,7 @@ func TestConcurrentPriceUpdates(t *testing.T) {
 			}
 
 			// Update price
-			err = product.SetBasePrice(newPrice)
+			err = product.SetBasePrice(newPrice, domain.NewPricingCalculator())
 			if err != nil {
 				errors[idx] = err
 				return
@@ -4,12 +4,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/light-bringer/procat-service/internal/app/product/contracts"
 	"github.com/light-bringer/procat-service/internal/app/product/domain"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_discounts"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/sweep_discounts"
 	"github.com/light-bringer/procat-service/tests/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,7 +28,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	mockClock.Set(now)
 
 	// Create and activate a product
-	price, _ := domain.NewMoney(100000, 100) // $1000.00
+	price, _ := domain.NewMoney(100000, 100, "USD") // $1000.00
 	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
 		Name:        "Expensive Product",
 		Description: "High-end item",
@@ -44,7 +49,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	startDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
 
-	err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 		ProductID:       productID,
 		Version:         currentVersion,
 		DiscountPercent: 20,
@@ -66,6 +71,68 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	testutil.AssertOutboxEvent(t, services.Client, "product.discount.applied")
 }
 
+func TestFixedAmountDiscountFlow(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(4999, 100, "USD") // $49.99
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name:        "Gift Card Eligible",
+		Description: "Fixed discount item",
+		Category:    "electronics",
+		BasePrice:   price,
+	})
+	require.NoError(t, err)
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID}))
+
+	startDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	t.Run("amount above the price is rejected", func(t *testing.T) {
+		tooMuch, _ := domain.NewMoney(5000, 100, "USD")
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+			ProductID:      productID,
+			DiscountAmount: tooMuch,
+			StartDate:      startDate,
+			EndDate:        endDate,
+		})
+		assert.ErrorIs(t, err, domain.ErrDiscountExceedsPrice)
+	})
+
+	t.Run("amount off is applied and stored exactly", func(t *testing.T) {
+		amount, _ := domain.NewMoney(1000, 100, "USD") // $10.00 off
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+			ProductID:      productID,
+			Version:        productVersion(t, services, productID),
+			DiscountAmount: amount,
+			StartDate:      startDate,
+			EndDate:        endDate,
+		})
+		require.NoError(t, err)
+
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, 49.99, dto.BasePrice)
+		assert.Equal(t, 39.99, dto.EffectivePrice)
+		assert.True(t, dto.DiscountActive)
+		assert.Nil(t, dto.DiscountPercent)
+		require.NotNil(t, dto.DiscountAmount)
+		assert.True(t, dto.DiscountAmount.Equals(amount))
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		discount := product.DiscountCopy()
+		require.NotNil(t, discount)
+		assert.True(t, discount.IsFixedAmount())
+		assert.True(t, discount.Amount().Equals(amount))
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.discount.applied")
+	})
+}
+
 func TestDiscountRemovalFlow(t *testing.T) {
 	services, cleanup := setupTest(t)
 	defer cleanup()
@@ -88,23 +155,198 @@ func TestDiscountRemovalFlow(t *testing.T) {
 	assert.Equal(t, 100.00, dto.EffectivePrice) // Back to base price
 	assert.Nil(t, dto.DiscountPercent)
 
+	// Verify the legacy discount columns were cleared
+	data := testutil.GetProductByID(t, services.Client, productID)
+	assert.False(t, data.DiscountPercent.Valid)
+	discounts, err := services.ListDiscounts.Execute(ctx(), &list_discounts.Request{ProductID: productID})
+	require.NoError(t, err)
+	assert.Empty(t, discounts)
+
 	// Verify removal event
 	testutil.AssertOutboxEvent(t, services.Client, "product.discount.removed")
 }
 
+func TestDiscountScheduleFlow(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(10000, 100, "USD") // $100.00
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Seasonal", Description: "Scheduled discounts", Category: "electronics", BasePrice: price,
+	})
+	require.NoError(t, err)
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID}))
+
+	// Schedule a summer sale running now and a larger sale next month
+	summerID, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID:       productID,
+		Version:         productVersion(t, services, productID),
+		DiscountPercent: 10,
+		StartDate:       time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	julyID, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID:       productID,
+		Version:         productVersion(t, services, productID),
+		DiscountPercent: 30,
+		StartDate:       time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 7, 31, 23, 59, 59, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, summerID, julyID)
+
+	t.Run("overlapping discount is rejected", func(t *testing.T) {
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+			ProductID:       productID,
+			DiscountPercent: 50,
+			StartDate:       time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC),
+			EndDate:         time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC),
+		})
+		assert.ErrorIs(t, err, domain.ErrDiscountOverlap)
+	})
+
+	t.Run("lists the schedule with statuses", func(t *testing.T) {
+		discounts, err := services.ListDiscounts.Execute(ctx(), &list_discounts.Request{ProductID: productID})
+		require.NoError(t, err)
+		require.Len(t, discounts, 2)
+		assert.Equal(t, summerID, discounts[0].DiscountID)
+		assert.Equal(t, contracts.DiscountStatusActive, discounts[0].Status)
+		assert.Equal(t, julyID, discounts[1].DiscountID)
+		assert.Equal(t, contracts.DiscountStatusScheduled, discounts[1].Status)
+		require.NotNil(t, discounts[1].DiscountPercent)
+		assert.Equal(t, 30.0, *discounts[1].DiscountPercent)
+	})
+
+	t.Run("effective price follows the schedule", func(t *testing.T) {
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, 90.00, dto.EffectivePrice)
+
+		mockClock.Set(time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC))
+		defer mockClock.Set(now)
+		dto, err = services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, 70.00, dto.EffectivePrice)
+	})
+
+	t.Run("started discount cannot be cancelled", func(t *testing.T) {
+		err := services.CancelScheduledDiscount.Execute(ctx(), &cancel_scheduled_discount.Request{
+			ProductID: productID, DiscountID: summerID,
+		})
+		assert.ErrorIs(t, err, domain.ErrDiscountAlreadyStarted)
+	})
+
+	t.Run("scheduled discount is cancelled", func(t *testing.T) {
+		err := services.CancelScheduledDiscount.Execute(ctx(), &cancel_scheduled_discount.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), DiscountID: julyID,
+		})
+		require.NoError(t, err)
+
+		discounts, err := services.ListDiscounts.Execute(ctx(), &list_discounts.Request{ProductID: productID})
+		require.NoError(t, err)
+		require.Len(t, discounts, 1)
+		assert.Equal(t, summerID, discounts[0].DiscountID)
+		testutil.AssertOutboxEvent(t, services.Client, "product.discount.cancelled")
+	})
+
+	t.Run("archiving drops the schedule", func(t *testing.T) {
+		_, err := services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+		testutil.AssertRowCount(t, services.Client, "discounts", 0)
+	})
+}
+
+func TestDiscountSweeper(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(10000, 100, "USD") // $100.00
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Sweepable", Description: "Discount sweeper", Category: "electronics", BasePrice: price,
+	})
+	require.NoError(t, err)
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID}))
+
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID:       productID,
+		Version:         productVersion(t, services, productID),
+		DiscountPercent: 25,
+		StartDate:       time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2025, 7, 31, 23, 59, 59, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	t.Run("nothing is due before the start", func(t *testing.T) {
+		resp, err := services.SweepDiscounts.Execute(ctx(), &sweep_discounts.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.ProductCount)
+	})
+
+	t.Run("scheduled discount is announced once when it starts", func(t *testing.T) {
+		mockClock.Set(time.Date(2025, 7, 1, 0, 5, 0, 0, time.UTC))
+
+		resp, err := services.SweepDiscounts.Execute(ctx(), &sweep_discounts.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.ProductCount)
+		assert.Equal(t, 1, resp.StartedCount)
+		assert.Equal(t, 0, resp.ExpiredCount)
+		testutil.AssertOutboxEvent(t, services.Client, "product.discount.started")
+
+		resp, err = services.SweepDiscounts.Execute(ctx(), &sweep_discounts.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.ProductCount)
+	})
+
+	t.Run("ended discount is cleared", func(t *testing.T) {
+		mockClock.Set(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+
+		resp, err := services.SweepDiscounts.Execute(ctx(), &sweep_discounts.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.ExpiredCount)
+		assert.Equal(t, 0, resp.FailedCount)
+		testutil.AssertOutboxEvent(t, services.Client, "product.discount.expired")
+
+		discounts, err := services.ListDiscounts.Execute(ctx(), &list_discounts.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Empty(t, discounts)
+	})
+
+	t.Run("legacy discount columns are cleared on expiry", func(t *testing.T) {
+		legacyID := testutil.CreateTestProductWithDiscount(t, services.Client, "Legacy", 20)
+		mockClock.Set(time.Now().Add(48 * time.Hour))
+
+		resp, err := services.SweepDiscounts.Execute(ctx(), &sweep_discounts.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.ExpiredCount)
+
+		data := testutil.GetProductByID(t, services.Client, legacyID)
+		assert.False(t, data.DiscountPercent.Valid)
+		assert.False(t, data.DiscountEndDate.Valid)
+	})
+}
+
 func TestDiscountValidation(t *testing.T) {
 	services, cleanup := setupTest(t)
 	defer cleanup()
 
 	// Create and activate product
-	price, _ := domain.NewMoney(10000, 100)
+	price, _ := domain.NewMoney(10000, 100, "USD")
 	productID, _ := services.CreateProduct.Execute(ctx(), &create_product.Request{
 		Name: "Test", Description: "Test", Category: "electronics", BasePrice: price,
 	})
 	services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID})
 
 	t.Run("cannot apply discount > 100%", func(t *testing.T) {
-		err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 			ProductID:       productID,
 			DiscountPercent: 150,
 			StartDate:       time.Now().UTC(),
@@ -117,7 +359,7 @@ func TestDiscountValidation(t *testing.T) {
 		endDate := time.Now().UTC()
 		startDate := endDate.Add(24 * time.Hour) // Start after end
 
-		err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 			ProductID:       productID,
 			DiscountPercent: 20,
 			StartDate:       startDate,
@@ -132,7 +374,7 @@ func TestDiscountValidation(t *testing.T) {
 			Name: "Inactive", Description: "Test", Category: "electronics", BasePrice: price,
 		})
 
-		err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 			ProductID:       inactiveID,
 			DiscountPercent: 20,
 			StartDate:       time.Now().UTC(),
@@ -141,7 +383,7 @@ func TestDiscountValidation(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrCannotApplyToInactive)
 	})
 
-	t.Run("cannot apply discount when one already exists", func(t *testing.T) {
+	t.Run("cannot apply overlapping discount", func(t *testing.T) {
 		// Get current version
 		prod, _ := services.ProductRepo.GetByID(ctx(), productID)
 		ver := prod.Version()
@@ -160,14 +402,14 @@ func TestDiscountValidation(t *testing.T) {
 		ver = prod.Version()
 
 		// Try to apply second discount
-		err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		_, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 			ProductID:       productID,
 			Version:         ver,
 			DiscountPercent: 20,
 			StartDate:       time.Now().UTC(),
 			EndDate:         time.Now().UTC().Add(24 * time.Hour),
 		})
-		assert.ErrorIs(t, err, domain.ErrDiscountAlreadyActive)
+		assert.ErrorIs(t, err, domain.ErrDiscountOverlap)
 	})
 }
 
@@ -176,7 +418,7 @@ func TestDiscountTimeValidity(t *testing.T) {
 	defer cleanup()
 
 	// Create and activate product
-	price, _ := domain.NewMoney(10000, 100)
+	price, _ := domain.NewMoney(10000, 100, "USD")
 	productID, _ := services.CreateProduct.Execute(ctx(), &create_product.Request{
 		Name: "Test", Description: "Test", Category: "electronics", BasePrice: price,
 	})
@@ -0,0 +1,121 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/relay"
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+)
+
+// flakyPublisher fails the first attempt for each event type listed in failOnce.
+type flakyPublisher struct {
+	failOnce  map[string]bool
+	published []*outboxcontracts.Message
+	failures  []string
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, msg *outboxcontracts.Message) error {
+	if p.failOnce[msg.EventType] {
+		p.failOnce[msg.EventType] = false
+		p.failures = append(p.failures, msg.EventType)
+		return errors.New("downstream unavailable")
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *flakyPublisher) Close() error { return nil }
+
+// eventTypesFor returns the published event types for one aggregate, in publish order.
+func (p *flakyPublisher) eventTypesFor(aggregateID string) ([]string, []int64) {
+	var types []string
+	var sequences []int64
+	for _, msg := range p.published {
+		if msg.AggregateID == aggregateID {
+			types = append(types, msg.EventType)
+			sequences = append(sequences, msg.SequenceNumber)
+		}
+	}
+	return types, sequences
+}
+
+// drainOutbox runs the relay until the outbox stops yielding events.
+func drainOutbox(t *testing.T, r *relay.Relay) {
+	t.Helper()
+
+	idle := 0
+	for i := 0; i < 200 && idle < 10; i++ {
+		n, err := r.ProcessBatch(ctx())
+		require.NoError(t, err)
+		if n == 0 {
+			idle++
+			time.Sleep(20 * time.Millisecond)
+		} else {
+			idle = 0
+		}
+	}
+}
+
+func TestOutboxRelay_PerAggregateOrdering(t *testing.T) {
+	services, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Interleave commands on two products
+	productID, err := services.CreateProduct.Execute(ctx(), NewProductBuilder().WithName("Ordered").Build())
+	require.NoError(t, err)
+	otherID, err := services.CreateProduct.Execute(ctx(), NewProductBuilder().WithName("Other").Build())
+	require.NoError(t, err)
+
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID}))
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: otherID}))
+
+	baseTime := time.Now().UTC().Add(-5 * time.Minute)
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID:       productID,
+		Version:         1,
+		DiscountPercent: 15.0,
+		StartDate:       baseTime.Add(-1 * time.Hour),
+		EndDate:         baseTime.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = services.ArchiveProduct.Execute(ctx(), &archive_product.Request{ProductID: productID, Version: 2})
+	require.NoError(t, err)
+
+	// Discount event fails once; archive events must wait for its retry
+	publisher := &flakyPublisher{failOnce: map[string]bool{"product.discount.applied": true}}
+	config := relay.DefaultConfig()
+	config.Retry.InitialBackoff = 50 * time.Millisecond
+	config.Retry.MaxBackoff = 100 * time.Millisecond
+	r := relay.NewRelay(outboxrepo.NewRelayRepo(services.Client), publisher, clock.NewRealClock(), config)
+
+	drainOutbox(t, r)
+
+	assert.Equal(t, []string{"product.discount.applied"}, publisher.failures)
+
+	types, sequences := publisher.eventTypesFor(productID)
+	assert.Equal(t, []string{
+		"product.created",
+		"product.activated",
+		"product.discount.applied",
+		"product.discount.removed",
+		"product.archived",
+	}, types)
+	for i := 1; i < len(sequences); i++ {
+		assert.Greater(t, sequences[i], sequences[i-1], "sequence numbers must increase per aggregate")
+	}
+
+	otherTypes, _ := publisher.eventTypesFor(otherID)
+	assert.Equal(t, []string{"product.created", "product.activated"}, otherTypes)
+}
@@ -0,0 +1,124 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriceAt(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	start := time.Date(2025, 11, 3, 9, 0, 0, 0, time.UTC)
+	hour := func(h float64) time.Time { return start.Add(time.Duration(h * float64(time.Hour))) }
+	mockClock.Set(start)
+
+	price, _ := domain.NewMoney(2000, 100, "USD") // $20.00
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Kettle", Description: "Electric kettle", Category: "home", BasePrice: price,
+	})
+	require.NoError(t, err)
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID, Version: 0}))
+
+	version := func() int64 { return productVersion(t, services, productID) }
+
+	// $25.00 from hour 1; 20% off for hours 2-10, applied at 1.5 and removed at 5;
+	// 10% off for hours 5.5-8, applied late at 6 and ended by archiving at 7.5
+	mockClock.Set(hour(1))
+	raised, _ := domain.NewMoney(2500, 100, "USD")
+	_, err = services.UpdatePrice.Execute(ctx(), &update_price.Request{
+		ProductID: productID, Version: version(), NewPrice: raised, ChangedBy: "pricing",
+	})
+	require.NoError(t, err)
+
+	mockClock.Set(hour(1.5))
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID: productID, Version: version(), DiscountPercent: 20, StartDate: hour(2), EndDate: hour(10),
+	})
+	require.NoError(t, err)
+
+	mockClock.Set(hour(5))
+	require.NoError(t, services.RemoveDiscount.Execute(ctx(), &remove_discount.Request{ProductID: productID, Version: version()}))
+
+	mockClock.Set(hour(6))
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID: productID, Version: version(), DiscountPercent: 10, StartDate: hour(5.5), EndDate: hour(8),
+	})
+	require.NoError(t, err)
+
+	cancelledID, err := services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+		ProductID: productID, Version: version(), DiscountPercent: 50, StartDate: hour(9), EndDate: hour(12),
+	})
+	require.NoError(t, err)
+	require.NoError(t, services.CancelScheduledDiscount.Execute(ctx(), &cancel_scheduled_discount.Request{
+		ProductID: productID, Version: version(), DiscountID: cancelledID,
+	}))
+
+	mockClock.Set(hour(7.5))
+	_, err = services.ArchiveProduct.Execute(ctx(), &archive_product.Request{ProductID: productID, Version: version()})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name         string
+		at           time.Time
+		basePrice    int64 // Cents
+		since        time.Time
+		discount     float64 // Percent, zero without discount
+		roundedCents int64
+	}{
+		{"initial price", hour(0.5), 2000, start, 0, 2000},
+		{"after the price change", hour(1.75), 2500, hour(1), 0, 2500},
+		{"scheduled discount in effect", hour(3), 2500, hour(1), 20, 2000},
+		{"removed discount", hour(5.25), 2500, hour(1), 0, 2500},
+		{"discount applied after its start date", hour(5.75), 2500, hour(1), 0, 2500},
+		{"late discount in effect", hour(7), 2500, hour(1), 10, 2250},
+		{"archiving ended the discount", hour(7.75), 2500, hour(1), 0, 2500},
+		{"cancelled discount never took effect", hour(9.5), 2500, hour(1), 0, 2500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := services.GetPriceAt.Execute(ctx(), &get_price_at.Request{ProductID: productID, At: tc.at})
+			require.NoError(t, err)
+
+			expectedBase, _ := domain.NewMoney(tc.basePrice, 100, "USD")
+			assert.True(t, dto.BasePrice.Equals(expectedBase), "base price %s", dto.BasePrice)
+			assert.True(t, dto.BasePriceSince.Equal(tc.since))
+			if tc.discount == 0 {
+				assert.Nil(t, dto.Discount)
+			} else {
+				require.NotNil(t, dto.Discount)
+				assert.Equal(t, tc.discount, *dto.Discount.DiscountPercent)
+			}
+			expectedRounded, _ := domain.NewMoney(tc.roundedCents, 100, "USD")
+			assert.True(t, dto.EffectivePrice.Rounded.Equals(expectedRounded), "effective price %s", dto.EffectivePrice.Rounded)
+		})
+	}
+
+	t.Run("discount history keeps the discounts that took effect", func(t *testing.T) {
+		testutil.AssertRowCount(t, services.Client, "discounts", 0)
+		testutil.AssertRowCount(t, services.Client, "discount_history", 2)
+	})
+
+	t.Run("before the product existed", func(t *testing.T) {
+		_, err := services.GetPriceAt.Execute(ctx(), &get_price_at.Request{ProductID: productID, At: hour(-1)})
+		assert.ErrorIs(t, err, domain.ErrNoPriceAtTime)
+	})
+
+	t.Run("unknown product", func(t *testing.T) {
+		_, err := services.GetPriceAt.Execute(ctx(), &get_price_at.Request{ProductID: "00000000-0000-0000-0000-000000000000", At: start})
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+}
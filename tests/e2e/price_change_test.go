@@ -0,0 +1,190 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_price_changes"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_price_changes"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledPriceChangeFlow(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(2499, 100, "USD") // $24.99
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Calendar", Description: "Wall calendar", Category: "stationery", BasePrice: price,
+	})
+	require.NoError(t, err)
+
+	newYear := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	raised, _ := domain.NewMoney(2999, 100, "USD") // $29.99
+	raiseID, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+		ProductID:     productID,
+		NewPrice:      raised,
+		EffectiveAt:   newYear,
+		ChangedBy:     "pricing-team",
+		ChangedReason: "new year pricing",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, raiseID)
+	testutil.AssertOutboxEvent(t, services.Client, "product.price_change.scheduled")
+
+	t.Run("list scheduled changes", func(t *testing.T) {
+		changes, err := services.ListPriceChanges.Execute(ctx(), &list_price_changes.Request{ProductID: productID})
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, raiseID, changes[0].Change.ID())
+		assert.True(t, changes[0].Change.NewPrice().Equals(raised))
+		assert.Equal(t, newYear, changes[0].Change.EffectiveAt())
+		assert.Equal(t, "pricing-team", changes[0].Change.ChangedBy())
+	})
+
+	t.Run("cannot schedule a second change at the same time", func(t *testing.T) {
+		other, _ := domain.NewMoney(3499, 100, "USD")
+		_, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+			ProductID: productID, NewPrice: other, EffectiveAt: newYear, ChangedBy: "pricing-team",
+		})
+		assert.ErrorIs(t, err, domain.ErrPriceChangeConflict)
+	})
+
+	t.Run("cannot schedule in the past", func(t *testing.T) {
+		_, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+			ProductID: productID, NewPrice: raised, EffectiveAt: now.Add(-time.Hour), ChangedBy: "pricing-team",
+		})
+		assert.ErrorIs(t, err, domain.ErrPriceChangeNotInFuture)
+	})
+
+	t.Run("cancel a scheduled change", func(t *testing.T) {
+		cutID, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+			NewPrice: raised, EffectiveAt: newYear.Add(24 * time.Hour), ChangedBy: "pricing-team",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, services.CancelPriceChange.Execute(ctx(), &cancel_scheduled_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), PriceChangeID: cutID,
+		}))
+		testutil.AssertOutboxEvent(t, services.Client, "product.price_change.cancelled")
+
+		changes, err := services.ListPriceChanges.Execute(ctx(), &list_price_changes.Request{ProductID: productID})
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, raiseID, changes[0].Change.ID())
+
+		err = services.CancelPriceChange.Execute(ctx(), &cancel_scheduled_price_change.Request{
+			ProductID: productID, PriceChangeID: cutID,
+		})
+		assert.ErrorIs(t, err, domain.ErrPriceChangeNotFound)
+	})
+
+	t.Run("nothing is applied before the effective time", func(t *testing.T) {
+		resp, err := services.ApplyPriceChanges.Execute(ctx(), &apply_price_changes.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.ProductCount)
+	})
+
+	t.Run("due change is applied with price history", func(t *testing.T) {
+		mockClock.Set(newYear.Add(time.Minute))
+
+		resp, err := services.ApplyPriceChanges.Execute(ctx(), &apply_price_changes.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.ProductCount)
+		assert.Equal(t, 1, resp.AppliedCount)
+		assert.Equal(t, 0, resp.FailedCount)
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(raised))
+		assert.Empty(t, product.ScheduledPriceChanges())
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.price.changed")
+		testutil.AssertRowCount(t, services.Client, "scheduled_price_changes", 0)
+		testutil.AssertRowCount(t, services.Client, "price_history", 2) // creation + scheduled change
+
+		resp, err = services.ApplyPriceChanges.Execute(ctx(), &apply_price_changes.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.ProductCount)
+	})
+
+	t.Run("archiving drops pending changes", func(t *testing.T) {
+		_, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+			NewPrice: price, EffectiveAt: newYear.Add(30 * 24 * time.Hour), ChangedBy: "pricing-team",
+		})
+		require.NoError(t, err)
+
+		_, err = services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+		testutil.AssertRowCount(t, services.Client, "scheduled_price_changes", 0)
+	})
+}
+
+func TestScheduledPriceChangesDueTogether(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(2499, 100, "USD") // $24.99
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Calendar", Description: "Wall calendar", Category: "stationery", BasePrice: price,
+	})
+	require.NoError(t, err)
+
+	first, _ := domain.NewMoney(2699, 100, "USD")
+	second, _ := domain.NewMoney(2899, 100, "USD")
+	_, err = services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+		ProductID: productID, NewPrice: first, EffectiveAt: now.Add(time.Hour), ChangedBy: "pricing-team",
+	})
+	require.NoError(t, err)
+	_, err = services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+		ProductID: productID, Version: productVersion(t, services, productID),
+		NewPrice: second, EffectiveAt: now.Add(2 * time.Hour), ChangedBy: "pricing-team",
+	})
+	require.NoError(t, err)
+
+	// The worker was down while both changes fell due
+	appliedAt := now.Add(3 * time.Hour)
+	mockClock.Set(appliedAt)
+	resp, err := services.ApplyPriceChanges.Execute(ctx(), &apply_price_changes.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.AppliedCount)
+	assert.Equal(t, 1, resp.DroppedCount, "the earlier change is superseded")
+	testutil.AssertOutboxEvent(t, services.Client, "product.price_change.cancelled")
+	testutil.AssertRowCount(t, services.Client, "scheduled_price_changes", 0)
+
+	t.Run("the latest change is the current price", func(t *testing.T) {
+		dto, err := services.GetPriceAt.Execute(ctx(), &get_price_at.Request{ProductID: productID, At: appliedAt})
+		require.NoError(t, err)
+		assert.True(t, dto.BasePrice.Equals(second), "base price %s", dto.BasePrice)
+		assert.True(t, dto.BasePriceSince.Equal(appliedAt))
+	})
+
+	t.Run("history records only the change that took effect", func(t *testing.T) {
+		history, err := services.GetPriceHistory.Execute(ctx(), &get_price_history.Request{ProductID: productID, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, history.Records, 2) // creation + latest change
+		latest := history.Records[0]
+		assert.True(t, latest.OldPrice.Equals(price))
+		assert.True(t, latest.NewPrice.Equals(second))
+		assert.True(t, latest.ChangedAt.Equal(appliedAt))
+	})
+}
@@ -0,0 +1,224 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_price_changes"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/approve_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/reject_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceGuardrailFlow(t *testing.T) {
+	guardrails, err := domain.ParsePriceGuardrails("50", "20", "books=USD 5.00..500.00")
+	require.NoError(t, err)
+	services, mockClock, cleanup := setupTestWithPolicies(t, Policies{Guardrails: guardrails})
+	defer cleanup()
+
+	now := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(2499, 100, "USD") // $24.99
+	cost, _ := domain.NewMoney(1500, 100, "USD")  // $15.00
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Atlas", Description: "World atlas", Category: "books", BasePrice: price, CostPrice: cost,
+	})
+	require.NoError(t, err)
+
+	history := repo.NewPriceHistoryRepo(services.Client)
+
+	t.Run("change within the guardrails takes effect", func(t *testing.T) {
+		raised, _ := domain.NewMoney(2999, 100, "USD")
+		resp, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, NewPrice: raised, ChangedBy: "alice",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.PendingRequestID)
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(raised))
+		price = raised
+	})
+
+	typo, _ := domain.NewMoney(299900, 100, "USD") // $2999.00 instead of $29.99
+	var requestID string
+
+	t.Run("violating change is held for approval", func(t *testing.T) {
+		mockClock.Set(now.Add(time.Minute))
+		resp, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), NewPrice: typo, ChangedBy: "alice", ChangedReason: "relaunch",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.PendingRequestID)
+		assert.Len(t, resp.Violations, 2) // Maximum change and books ceiling
+		requestID = resp.PendingRequestID
+
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.True(t, dto.CostPrice.Equals(cost))
+		require.NotNil(t, dto.PendingPriceChange)
+		assert.Equal(t, requestID, dto.PendingPriceChange.ID())
+		assert.True(t, dto.PendingPriceChange.NewPrice().Equals(typo))
+		assert.Equal(t, 29.99, dto.BasePrice, "base price is unchanged until approved")
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.price_change.requested")
+		testutil.AssertRowCount(t, services.Client, "price_change_requests", 1)
+	})
+
+	t.Run("only one pending request", func(t *testing.T) {
+		_, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, NewPrice: typo, ChangedBy: "alice",
+		})
+		assert.ErrorIs(t, err, domain.ErrPriceChangeRequestPending)
+	})
+
+	t.Run("requester cannot approve", func(t *testing.T) {
+		err := services.ApprovePriceChange.Execute(ctx(), &approve_price_change.Request{
+			ProductID: productID, RequestID: requestID, ApprovedBy: "alice",
+		})
+		assert.ErrorIs(t, err, domain.ErrPriceChangeSelfApproval)
+	})
+
+	t.Run("rejection keeps the base price", func(t *testing.T) {
+		mockClock.Set(now.Add(2 * time.Minute))
+		require.NoError(t, services.RejectPriceChange.Execute(ctx(), &reject_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), RequestID: requestID, RejectedBy: "bob", Reason: "typo",
+		}))
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(price))
+		assert.Nil(t, product.PendingPriceChangeRequest())
+
+		records, err := history.GetByProductID(ctx(), productID, 1)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, requestID, records[0].RequestID)
+		assert.Equal(t, domain.PriceChangeRejected, records[0].Decision)
+		assert.Equal(t, "bob", records[0].DecidedBy)
+		assert.Equal(t, "typo", records[0].DecisionReason)
+		assert.Equal(t, "alice", records[0].ChangedBy)
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.price_change.rejected")
+		testutil.AssertRowCount(t, services.Client, "price_change_requests", 0)
+	})
+
+	t.Run("approval applies the change", func(t *testing.T) {
+		mockClock.Set(now.Add(3 * time.Minute))
+		hardcover, _ := domain.NewMoney(4999, 100, "USD")
+		resp, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), NewPrice: hardcover, ChangedBy: "alice", ChangedReason: "hardcover edition",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.PendingRequestID)
+
+		mockClock.Set(now.Add(4 * time.Minute))
+		require.NoError(t, services.ApprovePriceChange.Execute(ctx(), &approve_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), RequestID: resp.PendingRequestID, ApprovedBy: "bob",
+		}))
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(hardcover))
+
+		records, err := history.GetByProductID(ctx(), productID, 1)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, domain.PriceChangeApproved, records[0].Decision)
+		assert.Equal(t, "bob", records[0].DecidedBy)
+		assert.True(t, records[0].NewPrice.Equals(hardcover))
+		assert.True(t, records[0].OldPrice.Equals(price))
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.price_change.approved")
+	})
+
+	t.Run("archiving rejects the pending request", func(t *testing.T) {
+		mockClock.Set(now.Add(5 * time.Minute))
+		resp, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), NewPrice: typo, ChangedBy: "alice",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.PendingRequestID)
+
+		mockClock.Set(now.Add(6 * time.Minute))
+		_, err = services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+		testutil.AssertRowCount(t, services.Client, "price_change_requests", 0)
+
+		records, err := history.GetByProductID(ctx(), productID, 1)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, resp.PendingRequestID, records[0].RequestID)
+		assert.Equal(t, domain.PriceChangeRejected, records[0].Decision)
+		assert.Empty(t, records[0].DecidedBy)
+	})
+}
+
+func TestScheduledPriceChangeGuardrails(t *testing.T) {
+	guardrails, err := domain.ParsePriceGuardrails("50", "", "")
+	require.NoError(t, err)
+	services, mockClock, cleanup := setupTestWithPolicies(t, Policies{Guardrails: guardrails})
+	defer cleanup()
+
+	now := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	price, _ := domain.NewMoney(2499, 100, "USD") // $24.99
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Atlas", Description: "World atlas", Category: "books", BasePrice: price,
+	})
+	require.NoError(t, err)
+
+	cut, _ := domain.NewMoney(499, 100, "USD") // 80% off
+	cutID, err := services.SchedulePriceChange.Execute(ctx(), &schedule_price_change.Request{
+		ProductID: productID, NewPrice: cut, EffectiveAt: now.Add(time.Hour), ChangedBy: "alice", ChangedReason: "clearance",
+	})
+	require.NoError(t, err)
+
+	t.Run("large scheduled cut is held for approval", func(t *testing.T) {
+		mockClock.Set(now.Add(2 * time.Hour))
+		resp, err := services.ApplyPriceChanges.Execute(ctx(), &apply_price_changes.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.AppliedCount)
+		assert.Equal(t, 1, resp.HeldCount)
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(price))
+		assert.Empty(t, product.ScheduledPriceChanges())
+
+		request := product.PendingPriceChangeRequest()
+		require.NotNil(t, request)
+		assert.Equal(t, cutID, request.ID())
+		assert.Equal(t, "alice", request.RequestedBy())
+		assert.True(t, request.NewPrice().Equals(cut))
+
+		testutil.AssertOutboxEvent(t, services.Client, "product.price_change.requested")
+		testutil.AssertRowCount(t, services.Client, "scheduled_price_changes", 0)
+		testutil.AssertRowCount(t, services.Client, "price_change_requests", 1)
+	})
+
+	t.Run("approval applies the held cut", func(t *testing.T) {
+		mockClock.Set(now.Add(3 * time.Hour))
+		require.NoError(t, services.ApprovePriceChange.Execute(ctx(), &approve_price_change.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), RequestID: cutID, ApprovedBy: "bob",
+		}))
+
+		product, err := services.ProductRepo.GetByID(ctx(), productID)
+		require.NoError(t, err)
+		assert.True(t, product.BasePrice().Equals(cut))
+	})
+}
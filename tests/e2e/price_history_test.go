@@ -0,0 +1,81 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPriceHistory(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	start := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+	mockClock.Set(start)
+
+	price, _ := domain.NewMoney(1999, 100, "USD")
+	productID, err := services.CreateProduct.Execute(ctx(), &create_product.Request{
+		Name: "Lamp", Description: "Desk lamp", Category: "home", BasePrice: price,
+	})
+	require.NoError(t, err)
+
+	// One price change per day after creation: $21.99, $23.99, $25.99
+	for day := 1; day <= 3; day++ {
+		mockClock.Set(start.AddDate(0, 0, day))
+		newPrice, _ := domain.NewMoney(int64(1999+200*day), 100, "USD")
+		_, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+			ProductID: productID, Version: productVersion(t, services, productID), NewPrice: newPrice, ChangedBy: "pricing", ChangedReason: "weekly review",
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("pages newest first with exact prices", func(t *testing.T) {
+		first, err := services.GetPriceHistory.Execute(ctx(), &get_price_history.Request{ProductID: productID, Limit: 3})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), first.TotalCount)
+		require.Len(t, first.Records, 3)
+		require.NotEmpty(t, first.NextPageToken)
+
+		latest := first.Records[0]
+		expectedOld, _ := domain.NewMoney(2399, 100, "USD")
+		expectedNew, _ := domain.NewMoney(2599, 100, "USD")
+		assert.True(t, latest.OldPrice.Equals(expectedOld))
+		assert.True(t, latest.NewPrice.Equals(expectedNew))
+		assert.Equal(t, "pricing", latest.ChangedBy)
+		assert.Equal(t, "weekly review", latest.ChangedReason)
+		assert.True(t, latest.ChangedAt.Equal(start.AddDate(0, 0, 3)))
+
+		last, err := services.GetPriceHistory.Execute(ctx(), &get_price_history.Request{
+			ProductID: productID, Limit: 3, PageToken: first.NextPageToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, last.Records, 1)
+		assert.Empty(t, last.NextPageToken)
+		assert.Nil(t, last.Records[0].OldPrice, "initial price has no old price")
+		assert.True(t, last.Records[0].NewPrice.Equals(price))
+	})
+
+	t.Run("filters by time range", func(t *testing.T) {
+		after := start.AddDate(0, 0, 1)
+		before := start.AddDate(0, 0, 3)
+		result, err := services.GetPriceHistory.Execute(ctx(), &get_price_history.Request{
+			ProductID: productID, ChangedAfter: &after, ChangedBefore: &before,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.TotalCount)
+		require.Len(t, result.Records, 2)
+		assert.True(t, result.Records[0].ChangedAt.Equal(start.AddDate(0, 0, 2)))
+		assert.True(t, result.Records[1].ChangedAt.Equal(after), "changed_after is inclusive")
+	})
+
+	t.Run("unknown product", func(t *testing.T) {
+		_, err := services.GetPriceHistory.Execute(ctx(), &get_price_history.Request{ProductID: "00000000-0000-0000-0000-000000000000"})
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+}
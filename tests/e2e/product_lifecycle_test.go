@@ -164,7 +164,7 @@ func TestProductArchiving(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrCannotModifyArchived)
 
 	// Verify cannot apply discount to archived product
-	err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
+	_, err = services.ApplyDiscount.Execute(ctx(), &apply_discount.Request{
 		ProductID:       productID,
 		DiscountPercent: 10,
 		StartDate:       time.Now().UTC(),
@@ -252,7 +252,7 @@ func TestArchiveProductWithDiscount(t *testing.T) {
 		StartDate:       baseTime.Add(-1 * time.Hour),
 		EndDate:         baseTime.Add(24 * time.Hour),
 	}
-	err = services.ApplyDiscount.Execute(ctx(), discountReq)
+	_, err = services.ApplyDiscount.Execute(ctx(), discountReq)
 	require.NoError(t, err)
 
 	// Verify discount is active before archiving
@@ -339,7 +339,7 @@ func TestCannotModifyArchivedProduct(t *testing.T) {
 		StartDate:       now.Add(-1 * time.Hour),
 		EndDate:         now.Add(24 * time.Hour),
 	}
-	err = services.ApplyDiscount.Execute(ctx(), discountReq)
+	_, err = services.ApplyDiscount.Execute(ctx(), discountReq)
 	assert.ErrorIs(t, err, domain.ErrCannotModifyArchived, "Should not be able to apply discount to archived product")
 }
 
@@ -0,0 +1,99 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeArchivedProducts(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	longAgo := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := now.AddDate(-7, 0, 0)
+
+	create := func(name string) string {
+		productID, err := services.CreateProduct.Execute(ctx(), NewProductBuilder().
+			WithName(name).
+			WithDescription("Test").
+			WithCategory("electronics").
+			WithPrice(100.00).
+			Build())
+		require.NoError(t, err)
+		return productID
+	}
+	archive := func(productID string) {
+		_, err := services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+	}
+
+	// Two products archived eight years ago, one with a price change
+	mockClock.Set(longAgo)
+	expiredID := create("Discontinued")
+	repricedID := create("Discontinued and repriced")
+	newPrice, _ := domain.NewMoney(8999, 100, "USD")
+	_, err := services.UpdatePrice.Execute(ctx(), &update_price.Request{
+		ProductID: repricedID, Version: productVersion(t, services, repricedID), NewPrice: newPrice, ChangedBy: "pricing",
+	})
+	require.NoError(t, err)
+	archive(expiredID)
+	archive(repricedID)
+
+	// One product archived recently and one still on sale
+	mockClock.Set(now.AddDate(0, -1, 0))
+	recentID := create("Recently archived")
+	archive(recentID)
+	create("On sale")
+
+	mockClock.Set(now)
+
+	t.Run("dry run only counts", func(t *testing.T) {
+		resp, err := services.PurgeArchivedProducts.Execute(ctx(), &purge_archived_products.Request{
+			ArchivedBefore: cutoff, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.ProductCount)
+		assert.Equal(t, int64(3), resp.PriceHistoryCount) // Two creations and a price change
+		assert.Equal(t, 0, resp.PurgedCount)
+		testutil.AssertRowCount(t, services.Client, "products", 4)
+	})
+
+	t.Run("purges products past the cutoff with their price history", func(t *testing.T) {
+		resp, err := services.PurgeArchivedProducts.Execute(ctx(), &purge_archived_products.Request{
+			ArchivedBefore: cutoff,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.ProductCount)
+		assert.Equal(t, 2, resp.PurgedCount)
+		assert.Equal(t, int64(3), resp.PriceHistoryCount)
+		assert.Equal(t, 0, resp.FailedCount)
+
+		testutil.AssertRowCount(t, services.Client, "products", 2)
+		testutil.AssertRowCount(t, services.Client, "price_history", 2)
+		testutil.AssertOutboxEvent(t, services.Client, "product.purged")
+
+		_, err = services.ProductRepo.GetByID(ctx(), expiredID)
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+		_, err = services.ProductRepo.GetByID(ctx(), recentID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nothing left to purge", func(t *testing.T) {
+		resp, err := services.PurgeArchivedProducts.Execute(ctx(), &purge_archived_products.Request{
+			ArchivedBefore: cutoff,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.ProductCount)
+	})
+}
@@ -0,0 +1,165 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_prices"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionalPrices(t *testing.T) {
+	services, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// 1. Create a product priced in USD
+	productID, err := services.CreateProduct.Execute(ctx, NewProductBuilder().WithPrice(100.0).Build())
+	require.NoError(t, err)
+
+	// 2. Set a euro price for Germany and a yen price for Japan
+	euroPrice, err := domain.NewMoney(9499, 100, "EUR")
+	require.NoError(t, err)
+	err = services.SetRegionalPrice.Execute(ctx, &set_regional_price.Request{
+		ProductID: productID,
+		Version:   0,
+		Region:    "de",
+		Price:     euroPrice,
+		ChangedBy: "pricing-team",
+	})
+	require.NoError(t, err)
+
+	yenPrice, err := domain.NewMoney(15000, 1, "JPY")
+	require.NoError(t, err)
+	err = services.SetRegionalPrice.Execute(ctx, &set_regional_price.Request{
+		ProductID: productID,
+		Version:   1,
+		Region:    "JP",
+		Price:     yenPrice,
+		ChangedBy: "pricing-team",
+	})
+	require.NoError(t, err)
+
+	// 3. Each change bumps the product version
+	product, err := services.ProductRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), product.Version())
+	assert.Len(t, product.RegionalPrices(), 2)
+
+	// 4. GetPrices returns the base price and the price list ordered by region
+	prices, err := services.GetPrices.Execute(ctx, &get_prices.Request{ProductID: productID})
+	require.NoError(t, err)
+	assert.Equal(t, domain.Currency("USD"), prices.BasePrice.Currency())
+	require.Len(t, prices.RegionalPrices, 2)
+	assert.Equal(t, "DE", prices.RegionalPrices[0].Region)
+	assert.True(t, euroPrice.Equals(prices.RegionalPrices[0].Price))
+	assert.Equal(t, "JP", prices.RegionalPrices[1].Region)
+
+	// 5. Products are priced for the requested market
+	dto, err := services.GetProduct.Execute(ctx, &get_product.Request{ProductID: productID, Region: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, 94.99, dto.BasePrice)
+	assert.Equal(t, "EUR", dto.Currency)
+	assert.Equal(t, "DE", dto.PriceRegion)
+
+	dto, err = services.GetProduct.Execute(ctx, &get_product.Request{ProductID: productID, Currency: "jpy"})
+	require.NoError(t, err)
+	assert.Equal(t, 15000.0, dto.BasePrice)
+	assert.Equal(t, "JP", dto.PriceRegion)
+
+	dto, err = services.GetProduct.Execute(ctx, &get_product.Request{ProductID: productID, Region: "GB"})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, dto.BasePrice)
+	assert.Equal(t, "USD", dto.Currency)
+	assert.Empty(t, dto.PriceRegion)
+
+	list, err := services.ListProducts.Execute(ctx, &list_products.Request{Region: "DE", PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "EUR", list.Products[0].Currency)
+
+	// 6. Removing a price falls back to the base price
+	err = services.RemoveRegionalPrice.Execute(ctx, &remove_regional_price.Request{
+		ProductID: productID,
+		Version:   2,
+		Region:    "DE",
+		ChangedBy: "pricing-team",
+	})
+	require.NoError(t, err)
+
+	dto, err = services.GetProduct.Execute(ctx, &get_product.Request{ProductID: productID, Region: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, dto.BasePrice)
+	assert.Empty(t, dto.PriceRegion)
+
+	// 7. Every change is recorded in price history and the outbox
+	history, err := repo.NewPriceHistoryRepo(services.Client).GetByProductID(ctx, productID, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 4) // Initial price, DE, JP, DE removal
+	assert.Equal(t, "DE", history[0].Region)
+	assert.True(t, euroPrice.Equals(history[0].OldPrice))
+	assert.Nil(t, history[0].NewPrice)
+
+	testutil.AssertOutboxEvent(t, services.Client, "product.regional_price.set")
+	testutil.AssertOutboxEvent(t, services.Client, "product.regional_price.removed")
+}
+
+func TestRegionalPrices_Errors(t *testing.T) {
+	services, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	productID, err := services.CreateProduct.Execute(ctx, NewProductBuilder().WithPrice(100.0).Build())
+	require.NoError(t, err)
+	price, err := domain.NewMoney(95, 1, "EUR")
+	require.NoError(t, err)
+
+	t.Run("invalid region", func(t *testing.T) {
+		err := services.SetRegionalPrice.Execute(ctx, &set_regional_price.Request{
+			ProductID: productID,
+			Region:    "D",
+			Price:     price,
+			ChangedBy: "pricing-team",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidRegion)
+	})
+
+	t.Run("remove missing region", func(t *testing.T) {
+		err := services.RemoveRegionalPrice.Execute(ctx, &remove_regional_price.Request{
+			ProductID: productID,
+			Region:    "DE",
+			ChangedBy: "pricing-team",
+		})
+		assert.ErrorIs(t, err, domain.ErrRegionalPriceNotFound)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		err := services.SetRegionalPrice.Execute(ctx, &set_regional_price.Request{
+			ProductID: productID,
+			Version:   5,
+			Region:    "DE",
+			Price:     price,
+			ChangedBy: "pricing-team",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "concurrent modification")
+	})
+
+	t.Run("invalid market on queries", func(t *testing.T) {
+		_, err := services.GetProduct.Execute(ctx, &get_product.Request{ProductID: productID, Currency: "XYZ"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCurrency)
+
+		_, err = services.ListProducts.Execute(ctx, &list_products.Request{Region: "1"})
+		assert.ErrorIs(t, err, domain.ErrInvalidRegion)
+	})
+}
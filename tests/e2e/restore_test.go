@@ -0,0 +1,83 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/restore_product"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreArchivedProduct(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithPolicies(t, Policies{RestoreWindow: 7 * 24 * time.Hour})
+	defer cleanup()
+
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	req := NewProductBuilder().
+		WithName("Archived by Mistake").
+		WithDescription("Test").
+		WithCategory("electronics").
+		WithPrice(100.00).
+		Build()
+	productID, err := services.CreateProduct.Execute(ctx(), req)
+	require.NoError(t, err)
+	require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{ProductID: productID}))
+
+	_, err = services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+		ProductID: productID, Version: productVersion(t, services, productID),
+	})
+	require.NoError(t, err)
+
+	t.Run("restores to inactive within the window", func(t *testing.T) {
+		mockClock.Set(now.Add(3 * 24 * time.Hour))
+		restoredAt, err := services.RestoreProduct.Execute(ctx(), &restore_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(3*24*time.Hour), restoredAt)
+
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "inactive", dto.Status)
+		assert.Nil(t, dto.ArchivedAt)
+		testutil.AssertOutboxEvent(t, services.Client, "product.restored")
+	})
+
+	t.Run("restored product can be modified again", func(t *testing.T) {
+		require.NoError(t, services.ActivateProduct.Execute(ctx(), &activate_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		}))
+	})
+
+	t.Run("product that is not archived", func(t *testing.T) {
+		_, err := services.RestoreProduct.Execute(ctx(), &restore_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		assert.ErrorIs(t, err, domain.ErrNotArchived)
+	})
+
+	t.Run("restore window has expired", func(t *testing.T) {
+		archivedAt, err := services.ArchiveProduct.Execute(ctx(), &archive_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		require.NoError(t, err)
+
+		mockClock.Set(archivedAt.Add(8 * 24 * time.Hour))
+		_, err = services.RestoreProduct.Execute(ctx(), &restore_product.Request{
+			ProductID: productID, Version: productVersion(t, services, productID),
+		})
+		assert.ErrorIs(t, err, domain.ErrRestoreWindowExpired)
+
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "archived", dto.Status)
+	})
+}
@@ -3,40 +3,79 @@ package e2e
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_prices"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_discounts"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_price_changes"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_tax_rates"
 	"github.com/light-bringer/procat-service/internal/app/product/repo"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_price_changes"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/approve_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/deactivate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/reject_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/restore_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/sweep_discounts"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_product"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/internal/pkg/committer"
 	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 // Services holds all use cases and queries for E2E tests.
 type Services struct {
 	// Commands
-	CreateProduct     *create_product.Interactor
-	UpdateProduct     *update_product.Interactor
-	UpdatePrice       *update_price.Interactor
-	ActivateProduct   *activate_product.Interactor
-	DeactivateProduct *deactivate_product.Interactor
-	ApplyDiscount     *apply_discount.Interactor
-	RemoveDiscount    *remove_discount.Interactor
-	ArchiveProduct    *archive_product.Interactor
+	CreateProduct           *create_product.Interactor
+	UpdateProduct           *update_product.Interactor
+	UpdatePrice             *update_price.Interactor
+	SetRegionalPrice        *set_regional_price.Interactor
+	RemoveRegionalPrice     *remove_regional_price.Interactor
+	ActivateProduct         *activate_product.Interactor
+	DeactivateProduct       *deactivate_product.Interactor
+	ApplyDiscount           *apply_discount.Interactor
+	RemoveDiscount          *remove_discount.Interactor
+	ArchiveProduct          *archive_product.Interactor
+	RestoreProduct          *restore_product.Interactor
+	PurgeArchivedProducts   *purge_archived_products.Interactor
+	CancelScheduledDiscount *cancel_scheduled_discount.Interactor
+	SweepDiscounts          *sweep_discounts.Interactor
+	SetTaxRate              *set_tax_rate.Interactor
+	SchedulePriceChange     *schedule_price_change.Interactor
+	CancelPriceChange       *cancel_scheduled_price_change.Interactor
+	ApplyPriceChanges       *apply_price_changes.Interactor
+	ApprovePriceChange      *approve_price_change.Interactor
+	RejectPriceChange       *reject_price_change.Interactor
 
 	// Queries
-	GetProduct   *get_product.Query
-	ListProducts *list_products.Query
+	GetProduct       *get_product.Query
+	ListProducts     *list_products.Query
+	GetPrices        *get_prices.Query
+	GetPriceHistory  *get_price_history.Query
+	GetPriceAt       *get_price_at.Query
+	ListDiscounts    *list_discounts.Query
+	ListTaxRates     *list_tax_rates.Query
+	ListPriceChanges *list_price_changes.Query
 
 	// Infrastructure
 	Clock       clock.Clock
@@ -55,50 +94,106 @@ func setupTest(t *testing.T) (*Services, func()) {
 	// Create infrastructure components
 	clk := clock.NewRealClock()
 	comm := committer.NewCommitter(client)
+	pricing := domain.NewPricingCalculator()
 
 	// Create repositories
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(client)
 	priceHistoryRepo := repo.NewPriceHistoryRepo(client)
-	readModel := repo.NewReadModel(client, clk)
+	priceListRepo := repo.NewPriceListRepo()
+	discountRepo := repo.NewDiscountRepo()
+	discountHistoryRepo := repo.NewDiscountHistoryRepo()
+	taxRateRepo := repo.NewTaxRateRepo()
+	priceChangeRepo := repo.NewPriceChangeRepo()
+	priceRequestRepo := repo.NewPriceChangeRequestRepo()
+	readModel := repo.NewReadModel(client, clk, pricing)
 
 	// Create command use cases
 	createProductUseCase := create_product.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	updateProductUseCase := update_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	updatePriceUseCase := update_price.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	updatePriceUseCase := update_price.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing, nil)
+	setRegionalPriceUseCase := set_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	removeRegionalPriceUseCase := remove_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	activateProductUseCase := activate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
 	deactivateProductUseCase := deactivate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	archiveProductUseCase := archive_product.NewInteractor(productRepo, outboxRepo, comm, clk)
+	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	archiveProductUseCase := archive_product.NewInteractor(productRepo, discountRepo, discountHistoryRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, clk)
+	restoreProductUseCase := restore_product.NewInteractor(productRepo, outboxRepo, comm, clk, domain.DefaultRestoreWindow)
+	purgeArchivedProductsUseCase := purge_archived_products.NewInteractor(productRepo, outboxRepo, comm, clk)
+	cancelScheduledDiscountUseCase := cancel_scheduled_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk)
+	sweepDiscountsUseCase := sweep_discounts.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	setTaxRateUseCase := set_tax_rate.NewInteractor(taxRateRepo, comm, clk)
+	schedulePriceChangeUseCase := schedule_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	cancelPriceChangeUseCase := cancel_scheduled_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	applyPriceChangesUseCase := apply_price_changes.NewInteractor(productRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, clk, pricing, nil)
+	approvePriceChangeUseCase := approve_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing)
+	rejectPriceChangeUseCase := reject_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk)
 
 	// Create query use cases
 	getProductQuery := get_product.NewQuery(readModel)
 	listProductsQuery := list_products.NewQuery(readModel)
+	getPricesQuery := get_prices.NewQuery(readModel)
+	getPriceHistoryQuery := get_price_history.NewQuery(repo.NewPriceHistoryReadModel(client))
+	getPriceAtQuery := get_price_at.NewQuery(readModel)
+	listDiscountsQuery := list_discounts.NewQuery(readModel)
+	listTaxRatesQuery := list_tax_rates.NewQuery(readModel)
+	listPriceChangesQuery := list_price_changes.NewQuery(readModel)
 
 	services := &Services{
-		CreateProduct:     createProductUseCase,
-		UpdateProduct:     updateProductUseCase,
-		UpdatePrice:       updatePriceUseCase,
-		ActivateProduct:   activateProductUseCase,
-		DeactivateProduct: deactivateProductUseCase,
-		ApplyDiscount:     applyDiscountUseCase,
-		RemoveDiscount:    removeDiscountUseCase,
-		ArchiveProduct:    archiveProductUseCase,
-		GetProduct:        getProductQuery,
-		ListProducts:      listProductsQuery,
-		Clock:             clk,
-		Client:            client,
-		ProductRepo:       productRepo,
-		Committer:         comm,
+		CreateProduct:           createProductUseCase,
+		UpdateProduct:           updateProductUseCase,
+		UpdatePrice:             updatePriceUseCase,
+		SetRegionalPrice:        setRegionalPriceUseCase,
+		RemoveRegionalPrice:     removeRegionalPriceUseCase,
+		ActivateProduct:         activateProductUseCase,
+		DeactivateProduct:       deactivateProductUseCase,
+		ApplyDiscount:           applyDiscountUseCase,
+		RemoveDiscount:          removeDiscountUseCase,
+		ArchiveProduct:          archiveProductUseCase,
+		RestoreProduct:          restoreProductUseCase,
+		PurgeArchivedProducts:   purgeArchivedProductsUseCase,
+		CancelScheduledDiscount: cancelScheduledDiscountUseCase,
+		SweepDiscounts:          sweepDiscountsUseCase,
+		SetTaxRate:              setTaxRateUseCase,
+		SchedulePriceChange:     schedulePriceChangeUseCase,
+		CancelPriceChange:       cancelPriceChangeUseCase,
+		ApplyPriceChanges:       applyPriceChangesUseCase,
+		ApprovePriceChange:      approvePriceChangeUseCase,
+		RejectPriceChange:       rejectPriceChangeUseCase,
+		GetProduct:              getProductQuery,
+		ListProducts:            listProductsQuery,
+		GetPrices:               getPricesQuery,
+		GetPriceHistory:         getPriceHistoryQuery,
+		GetPriceAt:              getPriceAtQuery,
+		ListDiscounts:           listDiscountsQuery,
+		ListTaxRates:            listTaxRatesQuery,
+		ListPriceChanges:        listPriceChangesQuery,
+		Clock:                   clk,
+		Client:                  client,
+		ProductRepo:             productRepo,
+		Committer:               comm,
 	}
 
 	return services, cleanup
 }
 
+// Policies configures the business policies of the use cases under test.
+type Policies struct {
+	Guardrails    *domain.PriceGuardrails // Price changes that need approval, nil for none
+	RestoreWindow time.Duration           // How long archived products can be restored, zero for no limit
+}
+
 // setupTestWithMockClock initializes services with a controllable mock clock.
 func setupTestWithMockClock(t *testing.T) (*Services, *clock.MockClock, func()) {
 	t.Helper()
+	return setupTestWithPolicies(t, Policies{RestoreWindow: domain.DefaultRestoreWindow})
+}
+
+// setupTestWithPolicies initializes services with a controllable mock clock and the
+// given business policies.
+func setupTestWithPolicies(t *testing.T, policies Policies) (*Services, *clock.MockClock, func()) {
+	t.Helper()
 
 	// Setup Spanner client
 	client, cleanup := testutil.SetupSpannerTest(t)
@@ -106,47 +201,98 @@ func setupTestWithMockClock(t *testing.T) (*Services, *clock.MockClock, func())
 	// Create mock clock
 	mockClock := testutil.NewMockClock()
 	comm := committer.NewCommitter(client)
+	pricing := domain.NewPricingCalculator()
 
 	// Create repositories
 	productRepo := repo.NewProductRepo(client, mockClock)
 	outboxRepo := repo.NewOutboxRepo(client)
 	priceHistoryRepo := repo.NewPriceHistoryRepo(client)
-	readModel := repo.NewReadModel(client, mockClock)
+	priceListRepo := repo.NewPriceListRepo()
+	discountRepo := repo.NewDiscountRepo()
+	discountHistoryRepo := repo.NewDiscountHistoryRepo()
+	taxRateRepo := repo.NewTaxRateRepo()
+	priceChangeRepo := repo.NewPriceChangeRepo()
+	priceRequestRepo := repo.NewPriceChangeRequestRepo()
+	readModel := repo.NewReadModel(client, mockClock, pricing)
 
 	// Create command use cases with mock clock
 	createProductUseCase := create_product.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, mockClock)
 	updateProductUseCase := update_product.NewInteractor(productRepo, outboxRepo, comm, mockClock)
-	updatePriceUseCase := update_price.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, mockClock)
+	updatePriceUseCase := update_price.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, mockClock, pricing, policies.Guardrails)
+	setRegionalPriceUseCase := set_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, mockClock)
+	removeRegionalPriceUseCase := remove_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, mockClock)
 	activateProductUseCase := activate_product.NewInteractor(productRepo, outboxRepo, comm, mockClock)
 	deactivateProductUseCase := deactivate_product.NewInteractor(productRepo, outboxRepo, comm, mockClock)
-	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, outboxRepo, comm, mockClock)
-	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, outboxRepo, comm, mockClock)
-	archiveProductUseCase := archive_product.NewInteractor(productRepo, outboxRepo, comm, mockClock)
+	applyDiscountUseCase := apply_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, mockClock, pricing)
+	removeDiscountUseCase := remove_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, mockClock, pricing)
+	archiveProductUseCase := archive_product.NewInteractor(productRepo, discountRepo, discountHistoryRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, mockClock)
+	restoreProductUseCase := restore_product.NewInteractor(productRepo, outboxRepo, comm, mockClock, policies.RestoreWindow)
+	purgeArchivedProductsUseCase := purge_archived_products.NewInteractor(productRepo, outboxRepo, comm, mockClock)
+	cancelScheduledDiscountUseCase := cancel_scheduled_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, mockClock)
+	sweepDiscountsUseCase := sweep_discounts.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, mockClock, pricing)
+	setTaxRateUseCase := set_tax_rate.NewInteractor(taxRateRepo, comm, mockClock)
+	schedulePriceChangeUseCase := schedule_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, mockClock)
+	cancelPriceChangeUseCase := cancel_scheduled_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, mockClock)
+	applyPriceChangesUseCase := apply_price_changes.NewInteractor(productRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, mockClock, pricing, policies.Guardrails)
+	approvePriceChangeUseCase := approve_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, mockClock, pricing)
+	rejectPriceChangeUseCase := reject_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, mockClock)
 
 	// Create query use cases
 	getProductQuery := get_product.NewQuery(readModel)
 	listProductsQuery := list_products.NewQuery(readModel)
+	getPricesQuery := get_prices.NewQuery(readModel)
+	getPriceHistoryQuery := get_price_history.NewQuery(repo.NewPriceHistoryReadModel(client))
+	getPriceAtQuery := get_price_at.NewQuery(readModel)
+	listDiscountsQuery := list_discounts.NewQuery(readModel)
+	listTaxRatesQuery := list_tax_rates.NewQuery(readModel)
+	listPriceChangesQuery := list_price_changes.NewQuery(readModel)
 
 	services := &Services{
-		CreateProduct:     createProductUseCase,
-		UpdateProduct:     updateProductUseCase,
-		UpdatePrice:       updatePriceUseCase,
-		ActivateProduct:   activateProductUseCase,
-		DeactivateProduct: deactivateProductUseCase,
-		ApplyDiscount:     applyDiscountUseCase,
-		RemoveDiscount:    removeDiscountUseCase,
-		ArchiveProduct:    archiveProductUseCase,
-		GetProduct:        getProductQuery,
-		ListProducts:      listProductsQuery,
-		Clock:             mockClock,
-		Client:            client,
-		ProductRepo:       productRepo,
-		Committer:         comm,
+		CreateProduct:           createProductUseCase,
+		UpdateProduct:           updateProductUseCase,
+		UpdatePrice:             updatePriceUseCase,
+		SetRegionalPrice:        setRegionalPriceUseCase,
+		RemoveRegionalPrice:     removeRegionalPriceUseCase,
+		ActivateProduct:         activateProductUseCase,
+		DeactivateProduct:       deactivateProductUseCase,
+		ApplyDiscount:           applyDiscountUseCase,
+		RemoveDiscount:          removeDiscountUseCase,
+		ArchiveProduct:          archiveProductUseCase,
+		RestoreProduct:          restoreProductUseCase,
+		PurgeArchivedProducts:   purgeArchivedProductsUseCase,
+		CancelScheduledDiscount: cancelScheduledDiscountUseCase,
+		SweepDiscounts:          sweepDiscountsUseCase,
+		SetTaxRate:              setTaxRateUseCase,
+		SchedulePriceChange:     schedulePriceChangeUseCase,
+		CancelPriceChange:       cancelPriceChangeUseCase,
+		ApplyPriceChanges:       applyPriceChangesUseCase,
+		ApprovePriceChange:      approvePriceChangeUseCase,
+		RejectPriceChange:       rejectPriceChangeUseCase,
+		GetProduct:              getProductQuery,
+		ListProducts:            listProductsQuery,
+		GetPrices:               getPricesQuery,
+		GetPriceHistory:         getPriceHistoryQuery,
+		GetPriceAt:              getPriceAtQuery,
+		ListDiscounts:           listDiscountsQuery,
+		ListTaxRates:            listTaxRatesQuery,
+		ListPriceChanges:        listPriceChangesQuery,
+		Clock:                   mockClock,
+		Client:                  client,
+		ProductRepo:             productRepo,
+		Committer:               comm,
 	}
 
 	return services, mockClock, cleanup
 }
 
+// productVersion returns the product's current version, for commands after the first.
+func productVersion(t *testing.T, services *Services, productID string) int64 {
+	t.Helper()
+	product, err := services.ProductRepo.GetByID(ctx(), productID)
+	require.NoError(t, err)
+	return product.Version()
+}
+
 // ctx returns a context for testing.
 func ctx() context.Context {
 	return context.Background()
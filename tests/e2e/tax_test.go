@@ -0,0 +1,187 @@
+package e2e
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_tax_rates"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_product"
+	"github.com/light-bringer/procat-service/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxes(t *testing.T) {
+	services, mockClock, cleanup := setupTestWithMockClock(t)
+	defer cleanup()
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	mockClock.Set(now)
+
+	// 1. A US rate on net prices, and German rates on gross prices with a rate change ahead
+	rates := []*set_tax_rate.Request{
+		{Region: "US-CA", TaxClass: "standard", RatePercent: big.NewRat(725, 100), EffectiveFrom: now.Add(-24 * time.Hour)},
+		{Region: "DE", TaxClass: "standard", RatePercent: big.NewRat(19, 1), EffectiveFrom: now.Add(-24 * time.Hour), PricesIncludeTax: true},
+		{Region: "DE", TaxClass: "reduced", RatePercent: big.NewRat(7, 1), EffectiveFrom: now.Add(-24 * time.Hour), PricesIncludeTax: true},
+		{Region: "DE", TaxClass: "standard", RatePercent: big.NewRat(16, 1), EffectiveFrom: now.Add(30 * 24 * time.Hour), PricesIncludeTax: true},
+	}
+	for _, req := range rates {
+		_, err := services.SetTaxRate.Execute(ctx(), req)
+		require.NoError(t, err)
+	}
+
+	// 2. Create a product taxed at the standard rate with a German gross price
+	productID, err := services.CreateProduct.Execute(ctx(), NewProductBuilder().WithPrice(100.0).Build())
+	require.NoError(t, err)
+	euroPrice, _ := domain.NewMoney(11900, 100, "EUR")
+	require.NoError(t, services.SetRegionalPrice.Execute(ctx(), &set_regional_price.Request{
+		ProductID: productID,
+		Version:   0,
+		Region:    "DE",
+		Price:     euroPrice,
+	}))
+
+	t.Run("No region, no taxes", func(t *testing.T) {
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultTaxClass, dto.TaxClass)
+		assert.Nil(t, dto.Tax)
+	})
+
+	t.Run("Tax added to net prices", func(t *testing.T) {
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID, Region: "us-ca"})
+		require.NoError(t, err)
+		require.NotNil(t, dto.Tax)
+
+		net, _ := domain.NewMoney(100, 1, "USD")
+		tax, _ := domain.NewMoney(725, 100, "USD")
+		gross, _ := domain.NewMoney(10725, 100, "USD")
+		assert.True(t, net.Equals(dto.Tax.Net))
+		assert.True(t, tax.Equals(dto.Tax.Tax))
+		assert.True(t, gross.Equals(dto.Tax.Gross))
+		assert.False(t, dto.Tax.Rate.PricesIncludeTax())
+	})
+
+	t.Run("Tax extracted from gross prices", func(t *testing.T) {
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID, Region: "DE"})
+		require.NoError(t, err)
+		require.NotNil(t, dto.Tax)
+
+		net, _ := domain.NewMoney(100, 1, "EUR")
+		tax, _ := domain.NewMoney(19, 1, "EUR")
+		assert.True(t, net.Equals(dto.Tax.Net))
+		assert.True(t, tax.Equals(dto.Tax.Tax))
+		assert.True(t, euroPrice.Equals(dto.Tax.Gross))
+	})
+
+	t.Run("Region without rates", func(t *testing.T) {
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID, Region: "JP"})
+		require.NoError(t, err)
+		assert.Nil(t, dto.Tax)
+	})
+
+	t.Run("Tax class change selects another rate", func(t *testing.T) {
+		reduced := "Reduced"
+		require.NoError(t, services.UpdateProduct.Execute(ctx(), &update_product.Request{
+			ProductID: productID,
+			Version:   1,
+			TaxClass:  &reduced,
+		}))
+		testutil.AssertOutboxEvent(t, services.Client, "product.updated")
+
+		list, err := services.ListProducts.Execute(ctx(), &list_products.Request{Region: "DE", PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, list.Products, 1)
+		dto := list.Products[0]
+		assert.Equal(t, "reduced", dto.TaxClass)
+		require.NotNil(t, dto.Tax)
+		assert.Equal(t, 0, dto.Tax.Rate.Percent().Cmp(big.NewRat(7, 1)))
+
+		// 119 * 100/107
+		net, _ := domain.NewMoney(11900, 107, "EUR")
+		assert.True(t, net.Equals(dto.Tax.Net))
+	})
+
+	t.Run("Scheduled rate takes effect at its date", func(t *testing.T) {
+		standard := "standard"
+		require.NoError(t, services.UpdateProduct.Execute(ctx(), &update_product.Request{
+			ProductID: productID,
+			Version:   2,
+			TaxClass:  &standard,
+		}))
+
+		mockClock.Set(now.Add(31 * 24 * time.Hour))
+		defer mockClock.Set(now)
+
+		dto, err := services.GetProduct.Execute(ctx(), &get_product.Request{ProductID: productID, Region: "DE"})
+		require.NoError(t, err)
+		require.NotNil(t, dto.Tax)
+		assert.Equal(t, 0, dto.Tax.Rate.Percent().Cmp(big.NewRat(16, 1)))
+
+		// 119 * 100/116
+		net, _ := domain.NewMoney(11900, 116, "EUR")
+		assert.True(t, net.Equals(dto.Tax.Net))
+	})
+
+	t.Run("List tax rates", func(t *testing.T) {
+		list, err := services.ListTaxRates.Execute(ctx(), &list_tax_rates.Request{Region: "de"})
+		require.NoError(t, err)
+		require.Len(t, list, 3)
+
+		// Ordered by tax class, latest effective date first
+		assert.Equal(t, "reduced", list[0].Rate.TaxClass())
+		assert.True(t, list[0].Current)
+		assert.Equal(t, "standard", list[1].Rate.TaxClass())
+		assert.Equal(t, 0, list[1].Rate.Percent().Cmp(big.NewRat(16, 1)))
+		assert.False(t, list[1].Current, "scheduled rate is not in force yet")
+		assert.True(t, list[2].Current)
+
+		all, err := services.ListTaxRates.Execute(ctx(), &list_tax_rates.Request{})
+		require.NoError(t, err)
+		assert.Len(t, all, 4)
+	})
+}
+
+func TestTaxes_Errors(t *testing.T) {
+	services, cleanup := setupTest(t)
+	defer cleanup()
+
+	t.Run("Invalid tax class on create", func(t *testing.T) {
+		_, err := services.CreateProduct.Execute(ctx(), NewProductBuilder().WithTaxClass("not a class").Build())
+		assert.ErrorIs(t, err, domain.ErrInvalidTaxClass)
+	})
+
+	t.Run("Rate out of range", func(t *testing.T) {
+		_, err := services.SetTaxRate.Execute(ctx(), &set_tax_rate.Request{
+			Region:      "DE",
+			TaxClass:    "standard",
+			RatePercent: big.NewRat(101, 1),
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidTaxRate)
+	})
+
+	t.Run("Invalid region", func(t *testing.T) {
+		_, err := services.SetTaxRate.Execute(ctx(), &set_tax_rate.Request{
+			Region:      "1",
+			TaxClass:    "standard",
+			RatePercent: big.NewRat(19, 1),
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidRegion)
+	})
+
+	t.Run("Effective date defaults to now", func(t *testing.T) {
+		rate, err := services.SetTaxRate.Execute(ctx(), &set_tax_rate.Request{
+			Region:      "FR",
+			TaxClass:    "standard",
+			RatePercent: big.NewRat(20, 1),
+		})
+		require.NoError(t, err)
+		assert.False(t, rate.EffectiveFrom().IsZero())
+	})
+}
@@ -26,7 +26,7 @@ func TestUpdatePrice(t *testing.T) {
 	require.NoError(t, err)
 
 	// 2. Update price
-	newPrice, err := domain.NewMoney(150, 1) // 150.00
+	newPrice, err := domain.NewMoney(150, 1, "USD") // 150.00
 	require.NoError(t, err)
 
 	req := &update_price.Request{
@@ -36,7 +36,7 @@ func TestUpdatePrice(t *testing.T) {
 		ChangedReason: "inflation",
 	}
 
-	err = services.UpdatePrice.Execute(ctx, req)
+	_, err = services.UpdatePrice.Execute(ctx, req)
 	require.NoError(t, err)
 
 	// 3. Verify update
@@ -75,7 +75,7 @@ func TestUpdatePrice_OptimisticLocking(t *testing.T) {
 	currentVersion := product.Version()
 
 	// 3. Update price with correct version
-	newPrice1, err := domain.NewMoney(150, 1)
+	newPrice1, err := domain.NewMoney(150, 1, "USD")
 	require.NoError(t, err)
 
 	req1 := &update_price.Request{
@@ -85,7 +85,7 @@ func TestUpdatePrice_OptimisticLocking(t *testing.T) {
 		ChangedBy:     "user-1",
 		ChangedReason: "reason-1",
 	}
-	err = services.UpdatePrice.Execute(ctx, req1)
+	_, err = services.UpdatePrice.Execute(ctx, req1)
 	require.NoError(t, err)
 
 	// Verify version changed
@@ -93,7 +93,7 @@ func TestUpdatePrice_OptimisticLocking(t *testing.T) {
 	assert.Equal(t, currentVersion+1, productAfter1.Version())
 
 	// 4. Try to update again with OLD version (should fail)
-	newPrice2, err := domain.NewMoney(200, 1)
+	newPrice2, err := domain.NewMoney(200, 1, "USD")
 	require.NoError(t, err)
 
 	req2 := &update_price.Request{
@@ -103,7 +103,7 @@ func TestUpdatePrice_OptimisticLocking(t *testing.T) {
 		ChangedBy:     "user-2",
 		ChangedReason: "reason-2",
 	}
-	err = services.UpdatePrice.Execute(ctx, req2)
+	_, err = services.UpdatePrice.Execute(ctx, req2)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "concurrent modification", "Should fail with optimistic locking error")
 }
@@ -124,7 +124,7 @@ func TestUpdatePrice_InvalidPrice(t *testing.T) {
 	require.NoError(t, err)
 
 	// 2. Try to update to negative price
-	newPrice, err := domain.NewMoney(-50, 1)
+	newPrice, err := domain.NewMoney(-50, 1, "USD")
 	require.NoError(t, err)
 
 	req := &update_price.Request{
@@ -134,8 +134,41 @@ func TestUpdatePrice_InvalidPrice(t *testing.T) {
 		ChangedReason: "mistake",
 	}
 
-	err = services.UpdatePrice.Execute(ctx, req)
+	_, err = services.UpdatePrice.Execute(ctx, req)
 	require.Error(t, err)
 	// Expect domain error
 	// domain.ErrInvalidPrice
 }
+
+func TestUpdatePrice_CurrencyMismatch(t *testing.T) {
+	services, cleanup := setupTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// 1. Create a USD product
+	createReq := NewProductBuilder().
+		WithName("Test Product").
+		WithCategory("category-1").
+		WithPrice(100.0).
+		Build()
+	productID, err := services.CreateProduct.Execute(ctx, createReq)
+	require.NoError(t, err)
+
+	// 2. Try to update to a EUR price
+	newPrice, err := domain.NewMoney(150, 1, "EUR")
+	require.NoError(t, err)
+
+	_, err = services.UpdatePrice.Execute(ctx, &update_price.Request{
+		ProductID: productID,
+		NewPrice:  newPrice,
+		ChangedBy: "test-user",
+	})
+	assert.ErrorIs(t, err, domain.ErrCurrencyMismatch)
+
+	// 3. Price and currency are unchanged
+	product, err := services.ProductRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Currency("USD"), product.BasePrice().Currency())
+	assert.Equal(t, "100.00", product.BasePrice().String())
+}
@@ -0,0 +1,135 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/domain"
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_event"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_events_by_filter"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox_attempt"
+	"github.com/light-bringer/procat-service/internal/pkg/clock"
+	"github.com/light-bringer/procat-service/tests/testutil"
+)
+
+func TestDeadLetterRepository(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	relayRepo := outboxrepo.NewRelayRepo(client)
+	repository := outboxrepo.NewDeadLetterRepo(client)
+	now := time.Now().UTC()
+
+	// fail drives an event through one retry and a final failure
+	fail := func(eventType, aggregateID string, failedAt time.Time) string {
+		eventID := testutil.CreateTestOutboxEvent(t, client, eventType, aggregateID)
+		require.NoError(t, relayRepo.ScheduleRetry(ctx, eventID, 1, "connection refused", now))
+		require.NoError(t, relayRepo.MarkFailed(ctx, eventID, 2, "broker unavailable", failedAt))
+		return eventID
+	}
+
+	older := fail("product.created", "p1", now.Add(-2*time.Hour))
+	newer := fail("product.activated", "p2", now.Add(-time.Hour))
+	testutil.CreateTestOutboxEvent(t, client, "product.created", "p3") // pending, never listed
+
+	t.Run("lists failed events with attempt history", func(t *testing.T) {
+		events, err := repository.ListFailed(ctx, &contracts.FailedEventFilter{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		assert.Equal(t, newer, events[0].Event.EventID, "most recently failed first")
+		assert.Equal(t, older, events[1].Event.EventID)
+		assert.Equal(t, "broker unavailable", events[0].Event.ErrorMessage.StringVal)
+
+		require.Len(t, events[0].Attempts, 2)
+		assert.Equal(t, m_outbox_attempt.OutcomeRetryScheduled, events[0].Attempts[0].Outcome)
+		assert.Equal(t, "connection refused", events[0].Attempts[0].ErrorMessage)
+		assert.Equal(t, m_outbox_attempt.OutcomeFailed, events[0].Attempts[1].Outcome)
+		assert.Equal(t, int64(2), events[0].Attempts[1].RetryCount)
+	})
+
+	t.Run("filters by event type and failure time", func(t *testing.T) {
+		events, err := repository.ListFailed(ctx, &contracts.FailedEventFilter{EventType: "product.created", Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, older, events[0].Event.EventID)
+
+		cutoff := now.Add(-90 * time.Minute)
+		count, err := repository.CountFailed(ctx, &contracts.FailedEventFilter{FailedAfter: &cutoff})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("retry requeues a failed event and keeps its history", func(t *testing.T) {
+		interactor := retry_event.NewInteractor(repository)
+		require.NoError(t, interactor.Execute(ctx, &retry_event.Request{EventID: older}))
+
+		events, err := relayRepo.ClaimPending(ctx, 10, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		var claimed *m_outbox.Data
+		for _, event := range events {
+			if event.EventID == older {
+				claimed = event
+			}
+		}
+		require.NotNil(t, claimed, "requeued event should be claimable")
+		assert.Equal(t, int64(0), claimed.RetryCount)
+		assert.False(t, claimed.ErrorMessage.Valid)
+
+		// Fail it again and check the history spans both rounds
+		require.NoError(t, relayRepo.MarkFailed(ctx, older, 1, "still down", now))
+		failed, err := repository.ListFailed(ctx, &contracts.FailedEventFilter{AggregateID: "p1", Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+
+		outcomes := make([]string, 0, len(failed[0].Attempts))
+		for _, attempt := range failed[0].Attempts {
+			outcomes = append(outcomes, attempt.Outcome)
+		}
+		assert.Equal(t, []string{
+			m_outbox_attempt.OutcomeRetryScheduled,
+			m_outbox_attempt.OutcomeFailed,
+			m_outbox_attempt.OutcomeRequeued,
+			m_outbox_attempt.OutcomeFailed,
+		}, outcomes)
+	})
+
+	t.Run("retry rejects events that are not failed", func(t *testing.T) {
+		interactor := retry_event.NewInteractor(repository)
+
+		pending := testutil.CreateTestOutboxEvent(t, client, "product.created", "p4")
+		err := interactor.Execute(ctx, &retry_event.Request{EventID: pending})
+		assert.ErrorIs(t, err, domain.ErrEventNotFailed)
+
+		err = interactor.Execute(ctx, &retry_event.Request{EventID: "00000000-0000-0000-0000-000000000000"})
+		assert.ErrorIs(t, err, domain.ErrEventNotFound)
+	})
+
+	t.Run("retry by filter supports dry run", func(t *testing.T) {
+		interactor := retry_events_by_filter.NewInteractor(repository, clock.NewRealClock())
+
+		resp, err := interactor.Execute(ctx, &retry_events_by_filter.Request{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.MatchedCount)
+		assert.Equal(t, int64(0), resp.RetriedCount)
+
+		resp, err = interactor.Execute(ctx, &retry_events_by_filter.Request{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.MatchedCount)
+		assert.Equal(t, int64(2), resp.RetriedCount)
+
+		count, err := repository.CountFailed(ctx, &contracts.FailedEventFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
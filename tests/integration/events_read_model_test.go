@@ -0,0 +1,97 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_events"
+	"github.com/light-bringer/procat-service/internal/app/product/repo"
+	"github.com/light-bringer/procat-service/internal/pkg/query"
+	"github.com/light-bringer/procat-service/tests/testutil"
+)
+
+func TestEventsReadModel_ListEvents(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	listEvents := list_events.NewQuery(repo.NewEventsReadModel(client))
+
+	// Events are written one commit at a time, so created_at increases with i
+	var eventIDs []string
+	for i := 0; i < 5; i++ {
+		eventIDs = append(eventIDs, testutil.CreateTestOutboxEvent(t, client, "product.created", "p1"))
+	}
+	testutil.CreateTestOutboxEvent(t, client, "product.activated", "p2")
+
+	t.Run("pages newest first without gaps or duplicates", func(t *testing.T) {
+		eventType := "product.created"
+		var seen []string
+		token := ""
+		for pages := 0; pages < 10; pages++ {
+			result, err := listEvents.Execute(ctx, &list_events.Request{EventType: &eventType, Limit: 2, PageToken: token})
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), result.TotalCount, "total count covers all pages")
+
+			for _, event := range result.Events {
+				seen = append(seen, event.EventID)
+			}
+			if result.NextPageToken == "" {
+				break
+			}
+			token = result.NextPageToken
+		}
+
+		require.Len(t, seen, 5)
+		for i, id := range seen {
+			assert.Equal(t, eventIDs[len(eventIDs)-1-i], id)
+		}
+	})
+
+	t.Run("new events do not shift later pages", func(t *testing.T) {
+		aggregateID := "p1"
+		first, err := listEvents.Execute(ctx, &list_events.Request{AggregateID: &aggregateID, Limit: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextPageToken)
+
+		testutil.CreateTestOutboxEvent(t, client, "product.updated", "p1")
+
+		second, err := listEvents.Execute(ctx, &list_events.Request{AggregateID: &aggregateID, Limit: 2, PageToken: first.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, second.Events, 2)
+		assert.Equal(t, eventIDs[2], second.Events[0].EventID)
+		assert.Equal(t, int64(6), second.TotalCount)
+	})
+
+	t.Run("filters by creation time", func(t *testing.T) {
+		all, err := listEvents.Execute(ctx, &list_events.Request{Limit: 100})
+		require.NoError(t, err)
+
+		// all is newest first; pick the range covering the two oldest events
+		oldest := all.Events[len(all.Events)-1].CreatedAt
+		cutoff := all.Events[len(all.Events)-3].CreatedAt
+		result, err := listEvents.Execute(ctx, &list_events.Request{CreatedAfter: &oldest, CreatedBefore: &cutoff})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.TotalCount)
+		require.Len(t, result.Events, 2)
+		assert.Equal(t, eventIDs[1], result.Events[0].EventID)
+		assert.Equal(t, eventIDs[0], result.Events[1].EventID)
+
+		future := time.Now().Add(time.Hour)
+		result, err = listEvents.Execute(ctx, &list_events.Request{CreatedAfter: &future})
+		require.NoError(t, err)
+		assert.Empty(t, result.Events)
+		assert.Zero(t, result.TotalCount)
+	})
+
+	t.Run("rejects invalid page tokens", func(t *testing.T) {
+		_, err := listEvents.Execute(ctx, &list_events.Request{PageToken: "garbage"})
+		assert.ErrorIs(t, err, query.ErrInvalidPageToken)
+	})
+}
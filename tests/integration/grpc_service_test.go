@@ -17,16 +17,41 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/outbox/publisher"
+	"github.com/light-bringer/procat-service/internal/app/outbox/queries/list_failed_events"
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/replay_events"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_event"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/retry_events_by_filter"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_at"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_price_history"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/get_prices"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/get_product"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_discounts"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_events"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_price_changes"
 	"github.com/light-bringer/procat-service/internal/app/product/queries/list_products"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/list_tax_rates"
+	"github.com/light-bringer/procat-service/internal/app/product/queries/watch_events"
 	"github.com/light-bringer/procat-service/internal/app/product/repo"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/activate_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/apply_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/approve_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/archive_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/cancel_scheduled_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/create_product"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/deactivate_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/purge_archived_products"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/reject_price_change"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_discount"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/remove_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/restore_product"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_regional_price"
+	"github.com/light-bringer/procat-service/internal/app/product/usecases/set_tax_rate"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_price"
 	"github.com/light-bringer/procat-service/internal/app/product/usecases/update_product"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
@@ -41,6 +66,13 @@ const bufSize = 1024 * 1024
 // setupGRPCTest creates an in-memory gRPC server for testing.
 func setupGRPCTest(t *testing.T) (pb.ProductServiceClient, func()) {
 	t.Helper()
+	return setupGRPCTestWithGuardrails(t, nil)
+}
+
+// setupGRPCTestWithGuardrails creates an in-memory gRPC server whose price changes are
+// checked against the given guardrails.
+func setupGRPCTestWithGuardrails(t *testing.T, guardrails *domain.PriceGuardrails) (pb.ProductServiceClient, func()) {
+	t.Helper()
 
 	// Setup Spanner
 	client, cleanupDB := testutil.SetupSpannerTest(t)
@@ -48,42 +80,96 @@ func setupGRPCTest(t *testing.T) (pb.ProductServiceClient, func()) {
 	// Create infrastructure
 	clk := clock.NewRealClock()
 	comm := committer.NewCommitter(client)
+	pricing := domain.NewPricingCalculator()
 
 	// Create repositories
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(client)
 	priceHistoryRepo := repo.NewPriceHistoryRepo(client)
-	readModel := repo.NewReadModel(client, clk)
+	priceListRepo := repo.NewPriceListRepo()
+	discountRepo := repo.NewDiscountRepo()
+	discountHistoryRepo := repo.NewDiscountHistoryRepo()
+	taxRateRepo := repo.NewTaxRateRepo()
+	priceChangeRepo := repo.NewPriceChangeRepo()
+	priceRequestRepo := repo.NewPriceChangeRequestRepo()
+	readModel := repo.NewReadModel(client, clk, pricing)
 
 	// Create use cases
 	createProductUC := create_product.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	updateProductUC := update_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	updatePriceUC := update_price.NewInteractor(productRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	updatePriceUC := update_price.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing, guardrails)
+	setRegionalPriceUC := set_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
+	removeRegionalPriceUC := remove_regional_price.NewInteractor(productRepo, priceListRepo, outboxRepo, priceHistoryRepo, comm, clk)
 	activateProductUC := activate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
 	deactivateProductUC := deactivate_product.NewInteractor(productRepo, outboxRepo, comm, clk)
-	applyDiscountUC := apply_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	removeDiscountUC := remove_discount.NewInteractor(productRepo, outboxRepo, comm, clk)
-	archiveProductUC := archive_product.NewInteractor(productRepo, outboxRepo, comm, clk)
+	applyDiscountUC := apply_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	removeDiscountUC := remove_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk, pricing)
+	archiveProductUC := archive_product.NewInteractor(productRepo, discountRepo, discountHistoryRepo, priceChangeRepo, priceRequestRepo, priceHistoryRepo, outboxRepo, comm, clk)
+	restoreProductUC := restore_product.NewInteractor(productRepo, outboxRepo, comm, clk, domain.DefaultRestoreWindow)
+	purgeArchivedProductsUC := purge_archived_products.NewInteractor(productRepo, outboxRepo, comm, clk)
+	cancelScheduledDiscountUC := cancel_scheduled_discount.NewInteractor(productRepo, discountRepo, discountHistoryRepo, outboxRepo, comm, clk)
+	setTaxRateUC := set_tax_rate.NewInteractor(taxRateRepo, comm, clk)
+	schedulePriceChangeUC := schedule_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	cancelPriceChangeUC := cancel_scheduled_price_change.NewInteractor(productRepo, priceChangeRepo, outboxRepo, comm, clk)
+	approvePriceChangeUC := approve_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk, pricing)
+	rejectPriceChangeUC := reject_price_change.NewInteractor(productRepo, priceRequestRepo, outboxRepo, priceHistoryRepo, comm, clk)
 
 	// Create queries
 	getProductQ := get_product.NewQuery(readModel)
 	listProductsQ := list_products.NewQuery(readModel)
+	getPricesQ := get_prices.NewQuery(readModel)
+	getPriceHistoryQ := get_price_history.NewQuery(repo.NewPriceHistoryReadModel(client))
+	getPriceAtQ := get_price_at.NewQuery(readModel)
+	listDiscountsQ := list_discounts.NewQuery(readModel)
+	listTaxRatesQ := list_tax_rates.NewQuery(readModel)
+	listPriceChangesQ := list_price_changes.NewQuery(readModel)
 	eventsReadModel := repo.NewEventsReadModel(client)
 	listEventsQ := list_events.NewQuery(eventsReadModel)
+	watchEventsQ := watch_events.NewQuery(eventsReadModel, 50*time.Millisecond)
+
+	// Create dead-letter administration
+	deadLetterRepo := outboxrepo.NewDeadLetterRepo(client)
+	listFailedEventsQ := list_failed_events.NewQuery(deadLetterRepo)
+	retryEventUC := retry_event.NewInteractor(deadLetterRepo)
+	retryEventsByFilterUC := retry_events_by_filter.NewInteractor(deadLetterRepo, clk)
+	replayEventsUC := replay_events.NewInteractor(outboxrepo.NewReplayRepo(client), map[string]outboxcontracts.Publisher{
+		"log": publisher.NewLogPublisher(),
+	})
 
 	// Create handler
 	handler := product.NewHandler(
 		createProductUC,
 		updateProductUC,
 		updatePriceUC,
+		setRegionalPriceUC,
+		removeRegionalPriceUC,
 		activateProductUC,
 		deactivateProductUC,
 		applyDiscountUC,
 		removeDiscountUC,
 		archiveProductUC,
+		restoreProductUC,
+		cancelScheduledDiscountUC,
+		setTaxRateUC,
+		schedulePriceChangeUC,
+		cancelPriceChangeUC,
+		approvePriceChangeUC,
+		rejectPriceChangeUC,
 		getProductQ,
 		listProductsQ,
+		getPricesQ,
+		getPriceHistoryQ,
+		getPriceAtQ,
+		listDiscountsQ,
+		listTaxRatesQ,
+		listPriceChangesQ,
 		listEventsQ,
+		watchEventsQ,
+		listFailedEventsQ,
+		retryEventUC,
+		retryEventsByFilterUC,
+		replayEventsUC,
+		purgeArchivedProductsUC,
 	)
 
 	// Setup in-memory gRPC server
@@ -192,6 +278,33 @@ func TestGRPC_CreateProduct(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 		assert.Contains(t, st.Message(), "price")
 	})
+
+	t.Run("explicit currency is stored", func(t *testing.T) {
+		resp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:      "Yen Product",
+			Category:  "electronics",
+			BasePrice: &pb.Money{Numerator: 2499, Denominator: 1, Currency: "JPY"},
+		})
+		require.NoError(t, err)
+
+		got, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: resp.ProductId})
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", got.Product.Currency)
+		assert.Equal(t, 2499.0, got.Product.BasePrice)
+	})
+
+	t.Run("validation error - unknown currency", func(t *testing.T) {
+		_, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:      "Test",
+			Category:  "electronics",
+			BasePrice: &pb.Money{Numerator: 100, Denominator: 1, Currency: "XYZ"},
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "currency")
+	})
 }
 
 func TestGRPC_GetProduct(t *testing.T) {
@@ -223,6 +336,7 @@ func TestGRPC_GetProduct(t *testing.T) {
 		assert.Equal(t, 2499.00, product.BasePrice)
 		assert.Equal(t, 2499.00, product.EffectivePrice)
 		assert.Equal(t, "inactive", product.Status)
+		assert.Equal(t, "USD", product.Currency) // Empty request currency defaults to USD
 		assert.False(t, product.DiscountActive)
 	})
 
@@ -362,10 +476,10 @@ func TestGRPC_ApplyDiscount(t *testing.T) {
 		endDate := startDate.Add(30 * 24 * time.Hour)
 
 		_, err := client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
-			ProductId:       createResp.ProductId,
-			DiscountPercent: 20,
-			StartDate:       timestamppb.New(startDate),
-			EndDate:         timestamppb.New(endDate),
+			ProductId: createResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountPercent{DiscountPercent: 20},
+			StartDate: timestamppb.New(startDate),
+			EndDate:   timestamppb.New(endDate),
 		})
 		require.NoError(t, err)
 
@@ -382,10 +496,152 @@ func TestGRPC_ApplyDiscount(t *testing.T) {
 
 	t.Run("validation error - invalid discount percentage", func(t *testing.T) {
 		_, err := client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
-			ProductId:       createResp.ProductId,
-			DiscountPercent: 150,
-			StartDate:       timestamppb.Now(),
-			EndDate:         timestamppb.New(time.Now().Add(24 * time.Hour)),
+			ProductId: createResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountPercent{DiscountPercent: 150},
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("apply fixed amount discount", func(t *testing.T) {
+		fixedResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:        "Fixed Discount Product",
+			Description: "Test",
+			Category:    "electronics",
+			BasePrice:   &pb.Money{Numerator: 5000, Denominator: 100},
+		})
+		require.NoError(t, err)
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: fixedResp.ProductId})
+		require.NoError(t, err)
+
+		_, err = client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: fixedResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountAmount{DiscountAmount: &pb.Money{Numerator: 1250, Denominator: 100, Currency: "USD"}},
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		require.NoError(t, err)
+
+		getResp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: fixedResp.ProductId})
+		require.NoError(t, err)
+		assert.Equal(t, 50.00, getResp.Product.BasePrice)
+		assert.Equal(t, 37.50, getResp.Product.EffectivePrice)
+		assert.True(t, getResp.Product.DiscountActive)
+		assert.Nil(t, getResp.Product.DiscountPercent)
+		require.NotNil(t, getResp.Product.DiscountAmount)
+		assert.Equal(t, int64(25), getResp.Product.DiscountAmount.Numerator)
+		assert.Equal(t, int64(2), getResp.Product.DiscountAmount.Denominator)
+	})
+
+	t.Run("apply exact percentage discount", func(t *testing.T) {
+		exactResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:        "Exact Discount Product",
+			Description: "Test",
+			Category:    "electronics",
+			BasePrice:   &pb.Money{Numerator: 1000, Denominator: 1},
+		})
+		require.NoError(t, err)
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: exactResp.ProductId})
+		require.NoError(t, err)
+
+		_, err = client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: exactResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountPercentExact{DiscountPercentExact: "12.3"},
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		require.NoError(t, err)
+
+		getResp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: exactResp.ProductId})
+		require.NoError(t, err)
+		assert.Equal(t, 877.00, getResp.Product.EffectivePrice)
+		require.NotNil(t, getResp.Product.DiscountPercentExact)
+		assert.Equal(t, "12.3", *getResp.Product.DiscountPercentExact)
+		require.NotNil(t, getResp.Product.DiscountPercent)
+		assert.Equal(t, 12.3, *getResp.Product.DiscountPercent)
+
+		listResp, err := client.ListDiscounts(ctx, &pb.ListDiscountsRequest{ProductId: exactResp.ProductId})
+		require.NoError(t, err)
+		require.Len(t, listResp.Discounts, 1)
+		require.NotNil(t, listResp.Discounts[0].DiscountPercentExact)
+		assert.Equal(t, "12.3", *listResp.Discounts[0].DiscountPercentExact)
+	})
+
+	t.Run("effective price is rounded with the exact value alongside", func(t *testing.T) {
+		roundResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:        "Rounded Product",
+			Description: "Test",
+			Category:    "electronics",
+			BasePrice:   &pb.Money{Numerator: 9999, Denominator: 100},
+		})
+		require.NoError(t, err)
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: roundResp.ProductId})
+		require.NoError(t, err)
+		_, err = client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: roundResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountPercentExact{DiscountPercentExact: "12.5"},
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		require.NoError(t, err)
+
+		getResp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: roundResp.ProductId})
+		require.NoError(t, err)
+		rounded := getResp.Product.RoundedEffectivePrice
+		require.NotNil(t, rounded)
+		assert.Equal(t, int64(69993), rounded.Exact.Numerator) // 87.49125
+		assert.Equal(t, int64(800), rounded.Exact.Denominator)
+		assert.Equal(t, int64(8749), rounded.Rounded.Numerator) // 87.49
+		assert.Equal(t, int64(100), rounded.Rounded.Denominator)
+		assert.Equal(t, "half_up", rounded.RoundingMode)
+	})
+
+	t.Run("validation error - percentage finer than 9 decimals", func(t *testing.T) {
+		_, err := client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: createResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountPercentExact{DiscountPercentExact: "100/3"},
+			StartDate: timestamppb.New(time.Now().Add(60 * 24 * time.Hour)),
+			EndDate:   timestamppb.New(time.Now().Add(61 * 24 * time.Hour)),
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("validation error - fixed amount above the price", func(t *testing.T) {
+		cheapResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:        "Cheap Product",
+			Description: "Test",
+			Category:    "electronics",
+			BasePrice:   &pb.Money{Numerator: 1000, Denominator: 100},
+		})
+		require.NoError(t, err)
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: cheapResp.ProductId})
+		require.NoError(t, err)
+
+		_, err = client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: cheapResp.ProductId,
+			Discount:  &pb.ApplyDiscountRequest_DiscountAmount{DiscountAmount: &pb.Money{Numerator: 2000, Denominator: 100}},
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), "exceeds")
+	})
+
+	t.Run("validation error - no discount given", func(t *testing.T) {
+		_, err := client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: createResp.ProductId,
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
 		})
 		require.Error(t, err)
 		st, ok := status.FromError(err)
@@ -409,10 +665,10 @@ func TestGRPC_RemoveDiscount(t *testing.T) {
 	})
 	client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: createResp.ProductId})
 	client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
-		ProductId:       createResp.ProductId,
-		DiscountPercent: 15,
-		StartDate:       timestamppb.Now(),
-		EndDate:         timestamppb.New(time.Now().Add(24 * time.Hour)),
+		ProductId: createResp.ProductId,
+		Discount:  &pb.ApplyDiscountRequest_DiscountPercent{DiscountPercent: 15},
+		StartDate: timestamppb.Now(),
+		EndDate:   timestamppb.New(time.Now().Add(24 * time.Hour)),
 	})
 
 	t.Run("remove discount successfully", func(t *testing.T) {
@@ -431,6 +687,80 @@ func TestGRPC_RemoveDiscount(t *testing.T) {
 	})
 }
 
+func TestGRPC_DiscountSchedule(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, _ := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 10000, Denominator: 100},
+	})
+	client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: createResp.ProductId})
+
+	nextWeek := time.Now().Add(7 * 24 * time.Hour)
+	applyResp, err := client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+		ProductId: createResp.ProductId,
+		Discount:  &pb.ApplyDiscountRequest_DiscountAmount{DiscountAmount: &pb.Money{Numerator: 500, Denominator: 100, Currency: "USD"}},
+		StartDate: timestamppb.New(nextWeek),
+		EndDate:   timestamppb.New(nextWeek.Add(24 * time.Hour)),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, applyResp.DiscountId)
+
+	t.Run("list discounts", func(t *testing.T) {
+		listResp, err := client.ListDiscounts(ctx, &pb.ListDiscountsRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		require.Len(t, listResp.Discounts, 1)
+		discount := listResp.Discounts[0]
+		assert.Equal(t, applyResp.DiscountId, discount.DiscountId)
+		assert.Equal(t, "fixed_amount", discount.Kind)
+		assert.Equal(t, "scheduled", discount.Status)
+		assert.Nil(t, discount.DiscountPercent)
+		require.NotNil(t, discount.DiscountAmount)
+		assert.Equal(t, int64(5), discount.DiscountAmount.Numerator)
+	})
+
+	t.Run("cannot remove a discount that has not started", func(t *testing.T) {
+		_, err := client.RemoveDiscount(ctx, &pb.RemoveDiscountRequest{ProductId: createResp.ProductId})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("cancel scheduled discount", func(t *testing.T) {
+		_, err := client.CancelScheduledDiscount(ctx, &pb.CancelScheduledDiscountRequest{
+			ProductId:  createResp.ProductId,
+			DiscountId: applyResp.DiscountId,
+		})
+		require.NoError(t, err)
+
+		listResp, err := client.ListDiscounts(ctx, &pb.ListDiscountsRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Empty(t, listResp.Discounts)
+	})
+
+	t.Run("cancel unknown discount returns not found", func(t *testing.T) {
+		_, err := client.CancelScheduledDiscount(ctx, &pb.CancelScheduledDiscountRequest{
+			ProductId:  createResp.ProductId,
+			DiscountId: applyResp.DiscountId,
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("list discounts of unknown product returns not found", func(t *testing.T) {
+		_, err := client.ListDiscounts(ctx, &pb.ListDiscountsRequest{ProductId: "non-existent-id"})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
 func TestGRPC_ArchiveProduct(t *testing.T) {
 	client, cleanup := setupGRPCTest(t)
 	defer cleanup()
@@ -470,6 +800,88 @@ func TestGRPC_ArchiveProduct(t *testing.T) {
 		assert.Equal(t, codes.FailedPrecondition, st.Code())
 		assert.Contains(t, st.Message(), "archived")
 	})
+
+	t.Run("restore archived product", func(t *testing.T) {
+		version := int64(1)
+		restoreResp, err := client.RestoreProduct(ctx, &pb.RestoreProductRequest{
+			ProductId: createResp.ProductId,
+			Version:   &version,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, restoreResp.RestoredAt)
+
+		getResp, _ := client.GetProduct(ctx, &pb.GetProductRequest{
+			ProductId: createResp.ProductId,
+		})
+		assert.Equal(t, "inactive", getResp.Product.Status)
+	})
+
+	t.Run("cannot restore product that is not archived", func(t *testing.T) {
+		_, err := client.RestoreProduct(ctx, &pb.RestoreProductRequest{
+			ProductId: createResp.ProductId,
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+}
+
+func TestGRPC_PurgeArchivedProducts(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, _ := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product to Purge",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 10000, Denominator: 100},
+	})
+	_, err := client.ArchiveProduct(ctx, &pb.ArchiveProductRequest{
+		ProductId: createResp.ProductId,
+	})
+	require.NoError(t, err)
+
+	archivedBefore := timestamppb.New(time.Now().Add(time.Hour))
+
+	t.Run("archived_before is required", func(t *testing.T) {
+		_, err := client.PurgeArchivedProducts(ctx, &pb.PurgeArchivedProductsRequest{})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("dry run reports counts", func(t *testing.T) {
+		resp, err := client.PurgeArchivedProducts(ctx, &pb.PurgeArchivedProductsRequest{
+			ArchivedBefore: archivedBefore,
+			DryRun:         true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.ProductCount)
+		assert.Equal(t, int64(1), resp.PriceHistoryCount)
+		assert.Equal(t, int64(0), resp.PurgedCount)
+
+		_, err = client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+	})
+
+	t.Run("purge archived product", func(t *testing.T) {
+		resp, err := client.PurgeArchivedProducts(ctx, &pb.PurgeArchivedProductsRequest{
+			ArchivedBefore: archivedBefore,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.PurgedCount)
+		assert.Equal(t, int64(0), resp.FailedCount)
+
+		_, err = client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
 }
 
 func TestGRPC_ListProducts(t *testing.T) {
@@ -521,6 +933,598 @@ func TestGRPC_ListProducts(t *testing.T) {
 	})
 }
 
+func TestGRPC_RegionalPrices(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:      "Test Product",
+		Category:  "electronics",
+		BasePrice: &pb.Money{Numerator: 10000, Denominator: 100},
+	})
+	require.NoError(t, err)
+
+	t.Run("set regional price", func(t *testing.T) {
+		_, err := client.SetRegionalPrice(ctx, &pb.SetRegionalPriceRequest{
+			ProductId: createResp.ProductId,
+			Region:    "de",
+			Price:     &pb.Money{Numerator: 9499, Denominator: 100, Currency: "EUR"},
+			ChangedBy: "pricing-team",
+		})
+		require.NoError(t, err)
+
+		prices, err := client.GetPrices(ctx, &pb.GetPricesRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Equal(t, "USD", prices.BasePrice.Currency)
+		require.Len(t, prices.RegionalPrices, 1)
+		assert.Equal(t, "DE", prices.RegionalPrices[0].Region)
+		assert.Equal(t, int64(9499), prices.RegionalPrices[0].Price.Numerator)
+		assert.Equal(t, "EUR", prices.RegionalPrices[0].Price.Currency)
+	})
+
+	t.Run("get product in a market", func(t *testing.T) {
+		resp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId, Region: "DE"})
+		require.NoError(t, err)
+		assert.Equal(t, 94.99, resp.Product.BasePrice)
+		assert.Equal(t, "EUR", resp.Product.Currency)
+		assert.Equal(t, "DE", resp.Product.PriceRegion)
+
+		listResp, err := client.ListProducts(ctx, &pb.ListProductsRequest{Currency: "EUR", PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, listResp.Products, 1)
+		assert.Equal(t, "DE", listResp.Products[0].PriceRegion)
+	})
+
+	t.Run("validation error - invalid region", func(t *testing.T) {
+		_, err := client.SetRegionalPrice(ctx, &pb.SetRegionalPriceRequest{
+			ProductId: createResp.ProductId,
+			Region:    "D",
+			Price:     &pb.Money{Numerator: 1, Denominator: 1, Currency: "EUR"},
+			ChangedBy: "pricing-team",
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "region")
+	})
+
+	t.Run("remove regional price", func(t *testing.T) {
+		version := int64(1)
+		_, err := client.RemoveRegionalPrice(ctx, &pb.RemoveRegionalPriceRequest{
+			ProductId: createResp.ProductId,
+			Version:   &version,
+			Region:    "DE",
+			ChangedBy: "pricing-team",
+		})
+		require.NoError(t, err)
+
+		prices, err := client.GetPrices(ctx, &pb.GetPricesRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Empty(t, prices.RegionalPrices)
+	})
+
+	t.Run("remove missing regional price", func(t *testing.T) {
+		version := int64(2)
+		_, err := client.RemoveRegionalPrice(ctx, &pb.RemoveRegionalPriceRequest{
+			ProductId: createResp.ProductId,
+			Version:   &version,
+			Region:    "DE",
+			ChangedBy: "pricing-team",
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("get prices of non-existent product", func(t *testing.T) {
+		_, err := client.GetPrices(ctx, &pb.GetPricesRequest{ProductId: "non-existent-id"})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGRPC_Taxes(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:      "Test Book",
+		Category:  "books",
+		BasePrice: &pb.Money{Numerator: 2000, Denominator: 100},
+		TaxClass:  "reduced",
+	})
+	require.NoError(t, err)
+
+	t.Run("set tax rate", func(t *testing.T) {
+		resp, err := client.SetTaxRate(ctx, &pb.SetTaxRateRequest{
+			Region:        "us-ny",
+			TaxClass:      "reduced",
+			RatePercent:   "4.5",
+			EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "US-NY", resp.TaxRate.Region)
+		assert.Equal(t, "4.5", resp.TaxRate.RatePercent)
+
+		listResp, err := client.ListTaxRates(ctx, &pb.ListTaxRatesRequest{Region: "US-NY"})
+		require.NoError(t, err)
+		require.Len(t, listResp.TaxRates, 1)
+		assert.True(t, listResp.TaxRates[0].Current)
+		assert.NotNil(t, listResp.TaxRates[0].UpdatedAt)
+	})
+
+	t.Run("get product with taxes", func(t *testing.T) {
+		resp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId, Region: "US-NY"})
+		require.NoError(t, err)
+		assert.Equal(t, "reduced", resp.Product.TaxClass)
+		tax := resp.Product.Tax
+		require.NotNil(t, tax)
+		assert.Equal(t, "4.5", tax.RatePercent)
+		assert.False(t, tax.PricesIncludeTax)
+		assert.Equal(t, int64(20), tax.Net.Numerator/tax.Net.Denominator)
+		assert.Equal(t, int64(9), tax.Tax.Numerator) // 0.90
+		assert.Equal(t, int64(10), tax.Tax.Denominator)
+		assert.Equal(t, int64(209), tax.Gross.Numerator) // 20.90
+		assert.Equal(t, int64(10), tax.Gross.Denominator)
+
+		resp, err = client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Nil(t, resp.Product.Tax)
+	})
+
+	t.Run("validation error - invalid rate", func(t *testing.T) {
+		for _, rate := range []string{"", "abc", "120", "100/3"} {
+			_, err := client.SetTaxRate(ctx, &pb.SetTaxRateRequest{Region: "DE", TaxClass: "standard", RatePercent: rate})
+			require.Error(t, err, rate)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code(), rate)
+		}
+	})
+
+	t.Run("validation error - invalid tax class", func(t *testing.T) {
+		taxClass := "not valid"
+		_, err := client.UpdateProduct(ctx, &pb.UpdateProductRequest{ProductId: createResp.ProductId, TaxClass: &taxClass})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "tax class")
+	})
+}
+
+func TestGRPC_ScheduledPriceChanges(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, _ := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+	})
+
+	nextMonth := time.Now().Add(30 * 24 * time.Hour).UTC()
+	scheduleResp, err := client.ScheduleBasePriceChange(ctx, &pb.ScheduleBasePriceChangeRequest{
+		ProductId:     createResp.ProductId,
+		NewPrice:      &pb.Money{Numerator: 2999, Denominator: 100, Currency: "USD"},
+		EffectiveAt:   timestamppb.New(nextMonth),
+		ChangedBy:     "pricing-team",
+		ChangedReason: "new year pricing",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, scheduleResp.PriceChangeId)
+
+	t.Run("list scheduled price changes", func(t *testing.T) {
+		listResp, err := client.ListScheduledPriceChanges(ctx, &pb.ListScheduledPriceChangesRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		require.Len(t, listResp.PriceChanges, 1)
+		change := listResp.PriceChanges[0]
+		assert.Equal(t, scheduleResp.PriceChangeId, change.PriceChangeId)
+		assert.Equal(t, int64(2999), change.NewPrice.Numerator)
+		assert.Equal(t, "USD", change.NewPrice.Currency)
+		assert.Equal(t, "pricing-team", change.ChangedBy)
+		assert.NotNil(t, change.CreatedAt)
+	})
+
+	t.Run("conflicting effective time is rejected", func(t *testing.T) {
+		_, err := client.ScheduleBasePriceChange(ctx, &pb.ScheduleBasePriceChangeRequest{
+			ProductId:   createResp.ProductId,
+			NewPrice:    &pb.Money{Numerator: 3499, Denominator: 100, Currency: "USD"},
+			EffectiveAt: timestamppb.New(nextMonth),
+			ChangedBy:   "pricing-team",
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("past effective time is rejected", func(t *testing.T) {
+		_, err := client.ScheduleBasePriceChange(ctx, &pb.ScheduleBasePriceChangeRequest{
+			ProductId:   createResp.ProductId,
+			NewPrice:    &pb.Money{Numerator: 3499, Denominator: 100, Currency: "USD"},
+			EffectiveAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			ChangedBy:   "pricing-team",
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("changed_by is required", func(t *testing.T) {
+		_, err := client.ScheduleBasePriceChange(ctx, &pb.ScheduleBasePriceChangeRequest{
+			ProductId:   createResp.ProductId,
+			NewPrice:    &pb.Money{Numerator: 3499, Denominator: 100, Currency: "USD"},
+			EffectiveAt: timestamppb.New(nextMonth.Add(time.Hour)),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("cancel scheduled price change", func(t *testing.T) {
+		_, err := client.CancelScheduledPriceChange(ctx, &pb.CancelScheduledPriceChangeRequest{
+			ProductId:     createResp.ProductId,
+			PriceChangeId: scheduleResp.PriceChangeId,
+		})
+		require.NoError(t, err)
+
+		listResp, err := client.ListScheduledPriceChanges(ctx, &pb.ListScheduledPriceChangesRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Empty(t, listResp.PriceChanges)
+	})
+
+	t.Run("cancel unknown price change returns not found", func(t *testing.T) {
+		_, err := client.CancelScheduledPriceChange(ctx, &pb.CancelScheduledPriceChangeRequest{
+			ProductId:     createResp.ProductId,
+			PriceChangeId: scheduleResp.PriceChangeId,
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGRPC_GetPriceHistory(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, _ := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+	})
+	_, err := client.UpdatePrice(ctx, &pb.UpdatePriceRequest{
+		ProductId:     createResp.ProductId,
+		NewPrice:      &pb.Money{Numerator: 2999, Denominator: 100, Currency: "USD"},
+		ChangedBy:     "pricing-team",
+		ChangedReason: "new year pricing",
+	})
+	require.NoError(t, err)
+
+	t.Run("pages newest first", func(t *testing.T) {
+		resp, err := client.GetPriceHistory(ctx, &pb.GetPriceHistoryRequest{ProductId: createResp.ProductId, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.TotalCount)
+		require.Len(t, resp.Entries, 1)
+		require.NotEmpty(t, resp.NextPageToken)
+
+		entry := resp.Entries[0]
+		assert.Equal(t, int64(2499), entry.OldPrice.Numerator)
+		assert.Equal(t, int64(2999), entry.NewPrice.Numerator)
+		assert.Equal(t, "USD", entry.NewPrice.Currency)
+		assert.Equal(t, "pricing-team", entry.ChangedBy)
+		assert.Equal(t, "new year pricing", entry.ChangedReason)
+
+		resp, err = client.GetPriceHistory(ctx, &pb.GetPriceHistoryRequest{
+			ProductId: createResp.ProductId, Limit: 1, PageToken: resp.NextPageToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Entries, 1)
+		assert.Nil(t, resp.Entries[0].OldPrice)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("filters by time range", func(t *testing.T) {
+		resp, err := client.GetPriceHistory(ctx, &pb.GetPriceHistoryRequest{
+			ProductId:    createResp.ProductId,
+			ChangedAfter: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Entries)
+		assert.Zero(t, resp.TotalCount)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		_, err := client.GetPriceHistory(ctx, &pb.GetPriceHistoryRequest{ProductId: createResp.ProductId, PageToken: "not a token"})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("unknown product returns not found", func(t *testing.T) {
+		_, err := client.GetPriceHistory(ctx, &pb.GetPriceHistoryRequest{ProductId: "00000000-0000-0000-0000-000000000000"})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGRPC_GetPriceAt(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+	})
+	require.NoError(t, err)
+
+	t.Run("prices the product at a point in time", func(t *testing.T) {
+		resp, err := client.GetPriceAt(ctx, &pb.GetPriceAtRequest{
+			ProductId: createResp.ProductId,
+			At:        timestamppb.New(time.Now().Add(time.Minute)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2499), resp.BasePrice.Numerator)
+		assert.Equal(t, "USD", resp.BasePrice.Currency)
+		assert.NotNil(t, resp.BasePriceSince)
+		assert.Nil(t, resp.Discount)
+		assert.Equal(t, int64(2499), resp.EffectivePrice.Rounded.Numerator)
+	})
+
+	t.Run("before the product existed returns not found", func(t *testing.T) {
+		_, err := client.GetPriceAt(ctx, &pb.GetPriceAtRequest{
+			ProductId: createResp.ProductId,
+			At:        timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("missing time", func(t *testing.T) {
+		_, err := client.GetPriceAt(ctx, &pb.GetPriceAtRequest{ProductId: createResp.ProductId})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("unknown product returns not found", func(t *testing.T) {
+		_, err := client.GetPriceAt(ctx, &pb.GetPriceAtRequest{
+			ProductId: "00000000-0000-0000-0000-000000000000",
+			At:        timestamppb.Now(),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGRPC_PriceGuardrails(t *testing.T) {
+	guardrails, err := domain.ParsePriceGuardrails("50", "", "")
+	require.NoError(t, err)
+	client, cleanup := setupGRPCTestWithGuardrails(t, guardrails)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createResp, _ := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Product",
+		Description: "Test",
+		Category:    "electronics",
+		BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+		CostPrice:   &pb.Money{Numerator: 1500, Denominator: 100},
+	})
+
+	requestChange := func(t *testing.T) string {
+		t.Helper()
+		resp, err := client.UpdatePrice(ctx, &pb.UpdatePriceRequest{
+			ProductId: createResp.ProductId,
+			NewPrice:  &pb.Money{Numerator: 249900, Denominator: 100, Currency: "USD"},
+			ChangedBy: "alice",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.PendingRequestId)
+		assert.Len(t, resp.GuardrailViolations, 1)
+		return resp.PendingRequestId
+	}
+
+	requestID := requestChange(t)
+
+	t.Run("get product shows the pending request", func(t *testing.T) {
+		resp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), resp.Product.CostPrice.Numerator)
+		require.NotNil(t, resp.Product.PendingPriceChange)
+		assert.Equal(t, requestID, resp.Product.PendingPriceChange.RequestId)
+		assert.Equal(t, int64(249900), resp.Product.PendingPriceChange.NewPrice.Numerator)
+		assert.Equal(t, "alice", resp.Product.PendingPriceChange.RequestedBy)
+		assert.Equal(t, 24.99, resp.Product.BasePrice)
+	})
+
+	t.Run("requester cannot approve", func(t *testing.T) {
+		_, err := client.ApprovePriceChange(ctx, &pb.ApprovePriceChangeRequest{
+			ProductId:  createResp.ProductId,
+			RequestId:  requestID,
+			ApprovedBy: "alice",
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("reject price change", func(t *testing.T) {
+		_, err := client.RejectPriceChange(ctx, &pb.RejectPriceChangeRequest{
+			ProductId:  createResp.ProductId,
+			RequestId:  requestID,
+			RejectedBy: "bob",
+			Reason:     "typo",
+		})
+		require.NoError(t, err)
+
+		resp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Nil(t, resp.Product.PendingPriceChange)
+		assert.Equal(t, 24.99, resp.Product.BasePrice)
+	})
+
+	t.Run("approve price change", func(t *testing.T) {
+		requestID := requestChange(t)
+
+		_, err := client.ApprovePriceChange(ctx, &pb.ApprovePriceChangeRequest{
+			ProductId:  createResp.ProductId,
+			RequestId:  requestID,
+			ApprovedBy: "bob",
+		})
+		require.NoError(t, err)
+
+		resp, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: createResp.ProductId})
+		require.NoError(t, err)
+		assert.Nil(t, resp.Product.PendingPriceChange)
+		assert.Equal(t, 2499.0, resp.Product.BasePrice)
+	})
+
+	t.Run("unknown request returns not found", func(t *testing.T) {
+		_, err := client.ApprovePriceChange(ctx, &pb.ApprovePriceChangeRequest{
+			ProductId:  createResp.ProductId,
+			RequestId:  requestID,
+			ApprovedBy: "bob",
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestGRPC_WatchEvents(t *testing.T) {
+	client, cleanup := setupGRPCTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createProduct := func(t *testing.T) string {
+		t.Helper()
+		resp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:      "Watched Product",
+			Category:  "electronics",
+			BasePrice: &pb.Money{Numerator: 10000, Denominator: 100},
+		})
+		require.NoError(t, err)
+		return resp.ProductId
+	}
+
+	recv := func(t *testing.T, stream pb.ProductService_WatchEventsClient) *pb.Event {
+		t.Helper()
+		reply, err := stream.Recv()
+		require.NoError(t, err)
+		return reply.Event
+	}
+
+	t.Run("streams events committed after the call", func(t *testing.T) {
+		createProduct(t) // Existing event, not streamed
+
+		watchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		stream, err := client.WatchEvents(watchCtx, &pb.WatchEventsRequest{})
+		require.NoError(t, err)
+
+		// Give the stream time to establish its starting position
+		time.Sleep(200 * time.Millisecond)
+		productID := createProduct(t)
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+		require.NoError(t, err)
+
+		first := recv(t, stream)
+		assert.Equal(t, "product.created", first.EventType)
+		assert.Equal(t, productID, first.AggregateId)
+
+		second := recv(t, stream)
+		assert.Equal(t, "product.activated", second.EventType)
+	})
+
+	t.Run("honors filters", func(t *testing.T) {
+		productID := createProduct(t)
+
+		watchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		eventType := "product.activated"
+		stream, err := client.WatchEvents(watchCtx, &pb.WatchEventsRequest{
+			EventType:   &eventType,
+			AggregateId: &productID,
+			Resume:      &pb.WatchEventsRequest_StartTime{StartTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		})
+		require.NoError(t, err)
+
+		_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+		require.NoError(t, err)
+
+		event := recv(t, stream)
+		assert.Equal(t, "product.activated", event.EventType)
+		assert.Equal(t, productID, event.AggregateId)
+	})
+
+	t.Run("resumes after last received event", func(t *testing.T) {
+		productID := createProduct(t)
+		_, err := client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+		require.NoError(t, err)
+		_, err = client.DeactivateProduct(ctx, &pb.DeactivateProductRequest{ProductId: productID})
+		require.NoError(t, err)
+
+		watchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		stream, err := client.WatchEvents(watchCtx, &pb.WatchEventsRequest{
+			AggregateId: &productID,
+			Resume:      &pb.WatchEventsRequest_StartTime{StartTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		})
+		require.NoError(t, err)
+		created := recv(t, stream)
+		assert.Equal(t, "product.created", created.EventType)
+		cancel()
+
+		// Reconnect after the first event
+		resumeCtx, cancelResume := context.WithTimeout(ctx, 10*time.Second)
+		defer cancelResume()
+		resumed, err := client.WatchEvents(resumeCtx, &pb.WatchEventsRequest{
+			AggregateId: &productID,
+			Resume:      &pb.WatchEventsRequest_ResumeAfterEventId{ResumeAfterEventId: created.EventId},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "product.activated", recv(t, resumed).EventType)
+		assert.Equal(t, "product.deactivated", recv(t, resumed).EventType)
+	})
+
+	t.Run("unknown resume event", func(t *testing.T) {
+		stream, err := client.WatchEvents(ctx, &pb.WatchEventsRequest{
+			Resume: &pb.WatchEventsRequest_ResumeAfterEventId{ResumeAfterEventId: "does-not-exist"},
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
 func TestGRPC_ConcurrentRequests(t *testing.T) {
 	client, cleanup := setupGRPCTest(t)
 	defer cleanup()
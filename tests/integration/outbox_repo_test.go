@@ -30,7 +30,7 @@ func TestOutboxRepository_InsertMut(t *testing.T) {
 	}
 
 	// Enrich event to outbox event
-	outboxEvent := repository.EnrichEvent(event, `{"test": "payload"}`)
+	outboxEvent := repository.EnrichEvent(event, `{"test": "payload"}`, 0)
 
 	// Get mutation
 	mutation := repository.InsertMut(outboxEvent)
@@ -53,11 +53,12 @@ func TestOutboxRepository_EnrichEvent(t *testing.T) {
 		ProductID: "test-id",
 	}
 
-	outboxEvent := repository.EnrichEvent(event, `{"productId": "test-id"}`)
+	outboxEvent := repository.EnrichEvent(event, `{"productId": "test-id"}`, 2001)
 
 	assert.NotEmpty(t, outboxEvent.EventID, "event ID should be generated")
 	assert.Equal(t, "product.activated", outboxEvent.EventType)
 	assert.Equal(t, "test-id", outboxEvent.AggregateID)
+	assert.Equal(t, int64(2001), outboxEvent.SequenceNumber)
 	assert.Equal(t, `{"productId": "test-id"}`, outboxEvent.Payload)
 	assert.Equal(t, m_outbox.StatusPending, outboxEvent.Status)
 }
@@ -78,7 +79,7 @@ func TestOutboxRepository_MultipleEvents(t *testing.T) {
 
 	mutations := make([]*spanner.Mutation, 0)
 	for _, event := range events {
-		outboxEvent := repository.EnrichEvent(event, `{}`)
+		outboxEvent := repository.EnrichEvent(event, `{}`, 0)
 		mutations = append(mutations, repository.InsertMut(outboxEvent))
 	}
 
@@ -107,7 +108,7 @@ func TestOutboxReliability_EventOrdering(t *testing.T) {
 
 	mutations := make([]*spanner.Mutation, 0)
 	for _, event := range events {
-		outboxEvent := repository.EnrichEvent(event, `{}`)
+		outboxEvent := repository.EnrichEvent(event, `{}`, 0)
 		mutations = append(mutations, repository.InsertMut(outboxEvent))
 	}
 
@@ -153,7 +154,7 @@ func TestOutboxReliability_TransactionRollback(t *testing.T) {
 	_, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		// Insert an event
 		event := &domain.ProductCreatedEvent{ProductID: "p1"}
-		outboxEvent := repository.EnrichEvent(event, `{}`)
+		outboxEvent := repository.EnrichEvent(event, `{}`, 0)
 		mutation := repository.InsertMut(outboxEvent)
 		txn.BufferWrite([]*spanner.Mutation{mutation})
 
@@ -176,7 +177,7 @@ func TestOutboxReliability_DuplicateEventIDs(t *testing.T) {
 	repository := repo.NewOutboxRepo(client)
 
 	// Create first event
-	event1 := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{}`)
+	event1 := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{}`, 0)
 	_, err := client.Apply(ctx, []*spanner.Mutation{repository.InsertMut(event1)})
 	require.NoError(t, err)
 
@@ -210,7 +211,7 @@ func TestOutboxReliability_EventSerialization(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Enrich event
-			outboxEvent := repository.EnrichEvent(tc.event, `{"test": "data"}`)
+			outboxEvent := repository.EnrichEvent(tc.event, `{"test": "data"}`, 0)
 
 			// Verify all required fields are set
 			assert.NotEmpty(t, outboxEvent.EventID)
@@ -236,7 +237,7 @@ func TestOutboxReliability_AtomicWithBusinessLogic(t *testing.T) {
 		[]interface{}{"p1", "Product", "Desc", "electronics", int64(10000), int64(100), "inactive", int64(0), spanner.CommitTimestamp, spanner.CommitTimestamp},
 	)
 
-	event := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{}`)
+	event := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{}`, 0)
 	eventMutation := repository.InsertMut(event)
 
 	// Apply both mutations atomically
@@ -257,7 +258,7 @@ func TestOutboxReliability_IdempotentProcessing(t *testing.T) {
 	repository := repo.NewOutboxRepo(client)
 
 	// Insert event
-	event := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{"key": "value"}`)
+	event := repository.EnrichEvent(&domain.ProductCreatedEvent{ProductID: "p1"}, `{"key": "value"}`, 0)
 	_, err := client.Apply(ctx, []*spanner.Mutation{repository.InsertMut(event)})
 	require.NoError(t, err)
 
@@ -27,7 +27,7 @@ func TestProductRepository_InsertMut(t *testing.T) {
 	repository := repo.NewProductRepo(client, clk)
 
 	// Create a new product
-	price, _ := domain.NewMoney(10000, 100) // $100.00
+	price, _ := domain.NewMoney(10000, 100, "USD") // $100.00
 	product, err := domain.NewProduct("test-id-1", "Test Product", "Description", "electronics", price, now, clk)
 	require.NoError(t, err)
 
@@ -48,6 +48,32 @@ func TestProductRepository_InsertMut(t *testing.T) {
 	assert.Equal(t, "Test Product", retrieved.Name())
 	assert.Equal(t, "electronics", retrieved.Category())
 	assert.Equal(t, domain.StatusInactive, retrieved.Status())
+	assert.Equal(t, domain.Currency("USD"), retrieved.BasePrice().Currency())
+}
+
+func TestProductRepository_Currency(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+	clk := clock.NewMockClock(now)
+	repository := repo.NewProductRepo(client, clk)
+
+	price, err := domain.NewMoney(2499, 1, "JPY")
+	require.NoError(t, err)
+	product, err := domain.NewProduct("test-id-jpy", "Yen Product", "Description", "electronics", price, now, clk)
+	require.NoError(t, err)
+
+	mutation, err := repository.InsertMut(product)
+	require.NoError(t, err)
+	_, err = client.Apply(ctx, []*spanner.Mutation{mutation})
+	require.NoError(t, err)
+
+	retrieved, err := repository.GetByID(ctx, "test-id-jpy")
+	require.NoError(t, err)
+	assert.Equal(t, domain.Currency("JPY"), retrieved.BasePrice().Currency())
+	assert.Equal(t, "2499", retrieved.BasePrice().String())
 }
 
 func TestProductRepository_UpdateMut(t *testing.T) {
@@ -60,7 +86,7 @@ func TestProductRepository_UpdateMut(t *testing.T) {
 	repository := repo.NewProductRepo(client, clk)
 
 	// Create and insert a product
-	price, _ := domain.NewMoney(10000, 100)
+	price, _ := domain.NewMoney(10000, 100, "USD")
 	product, _ := domain.NewProduct("test-id-2", "Original Name", "Description", "electronics", price, now, clk)
 
 	insertMut, err := repository.InsertMut(product)
@@ -104,7 +130,7 @@ func TestProductRepository_UpdateMut_OnlyDirtyFields(t *testing.T) {
 	repository := repo.NewProductRepo(client, clk)
 
 	// Create a product
-	price, _ := domain.NewMoney(10000, 100)
+	price, _ := domain.NewMoney(10000, 100, "USD")
 	product, _ := domain.NewProduct("test-id-3", "Test", "Desc", "electronics", price, now, clk)
 	insertMut, err := repository.InsertMut(product)
 	require.NoError(t, err)
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/light-bringer/procat-service/internal/app/product/contracts"
+	"github.com/light-bringer/procat-service/internal/app/product/domain"
 	"github.com/light-bringer/procat-service/internal/app/product/repo"
 	"github.com/light-bringer/procat-service/internal/pkg/clock"
 	"github.com/light-bringer/procat-service/tests/testutil"
@@ -20,14 +21,14 @@ func TestReadModel_GetProductByID(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	t.Run("product found", func(t *testing.T) {
 		// Create test product
 		productID := testutil.CreateTestProduct(t, client, "Test Product")
 
 		// Query using read model
-		dto, err := readModel.GetProductByID(ctx, productID)
+		dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 		require.NoError(t, err)
 
 		assert.Equal(t, productID, dto.ProductID)
@@ -43,7 +44,7 @@ func TestReadModel_GetProductByID(t *testing.T) {
 		productID := testutil.CreateTestProductWithDiscount(t, client, "Discounted Product", 20)
 
 		// Query using read model
-		dto, err := readModel.GetProductByID(ctx, productID)
+		dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 		require.NoError(t, err)
 
 		assert.Equal(t, 100.0, dto.BasePrice)
@@ -59,7 +60,7 @@ func TestReadModel_ListProducts(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create test products in different categories
 	testutil.CreateTestProduct(t, client, "Product 1")
@@ -136,7 +137,7 @@ func TestReadModel_ListProducts_EmptyResult(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	filter := &contracts.ListFilter{
 		Category: "non-existent-category",
@@ -156,13 +157,13 @@ func TestReadConsistency_ReadYourWrites(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create a product
 	productID := testutil.CreateTestProduct(t, client, "Consistency Test Product")
 
 	// Immediately read it back - should be visible
-	dto, err := readModel.GetProductByID(ctx, productID)
+	dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 	require.NoError(t, err)
 	assert.Equal(t, productID, dto.ProductID)
 	assert.Equal(t, "Consistency Test Product", dto.Name)
@@ -174,7 +175,7 @@ func TestReadConsistency_UpdateImmediatelyVisible(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create a product
 	productID := testutil.CreateTestProduct(t, client, "Original Name")
@@ -183,7 +184,7 @@ func TestReadConsistency_UpdateImmediatelyVisible(t *testing.T) {
 	testutil.UpdateTestProductName(t, client, productID, "Updated Name")
 
 	// Read should immediately show new value
-	dto, err := readModel.GetProductByID(ctx, productID)
+	dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Name", dto.Name)
 }
@@ -194,7 +195,7 @@ func TestReadConsistency_ListPaginationNoMissingItems(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create 5 products
 	expectedIDs := make(map[string]bool)
@@ -234,7 +235,7 @@ func TestReadConsistency_FilterCorrectness(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create products with different attributes
 	electronicsInactive := testutil.CreateTestProduct(t, client, "Laptop")
@@ -319,12 +320,12 @@ func TestReadConsistency_DiscountCalculations(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	t.Run("no discount shows base price as effective price", func(t *testing.T) {
 		productID := testutil.CreateTestProduct(t, client, "No Discount Product")
 
-		dto, err := readModel.GetProductByID(ctx, productID)
+		dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 		require.NoError(t, err)
 
 		assert.Equal(t, dto.BasePrice, dto.EffectivePrice)
@@ -335,7 +336,7 @@ func TestReadConsistency_DiscountCalculations(t *testing.T) {
 		// 25% discount
 		productID := testutil.CreateTestProductWithDiscount(t, client, "Discounted Product", 25)
 
-		dto, err := readModel.GetProductByID(ctx, productID)
+		dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 		require.NoError(t, err)
 
 		assert.Equal(t, 100.0, dto.BasePrice)
@@ -348,7 +349,7 @@ func TestReadConsistency_DiscountCalculations(t *testing.T) {
 	t.Run("100% discount results in zero price", func(t *testing.T) {
 		productID := testutil.CreateTestProductWithDiscount(t, client, "Free Product", 100)
 
-		dto, err := readModel.GetProductByID(ctx, productID)
+		dto, err := readModel.GetProductByID(ctx, productID, contracts.Market{})
 		require.NoError(t, err)
 
 		assert.Equal(t, 100.0, dto.BasePrice)
@@ -363,7 +364,7 @@ func TestReadConsistency_TotalCountAccuracy(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	readModel := repo.NewReadModel(client, clock.NewRealClock())
+	readModel := repo.NewReadModel(client, clock.NewRealClock(), domain.NewPricingCalculator())
 
 	// Create known number of products
 	for i := 0; i < 7; i++ {
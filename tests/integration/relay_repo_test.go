@@ -0,0 +1,118 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/tests/testutil"
+)
+
+func TestRelayRepository_ClaimPending(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repository := outboxrepo.NewRelayRepo(client)
+
+	first := testutil.CreateTestOutboxEvent(t, client, "product.created", "p1")
+	second := testutil.CreateTestOutboxEvent(t, client, "product.activated", "p2")
+
+	now := time.Now().UTC()
+	lease := now.Add(time.Minute)
+
+	t.Run("claims pending events in created_at order", func(t *testing.T) {
+		events, err := repository.ClaimPending(ctx, 10, now, lease)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, first, events[0].EventID)
+		assert.Equal(t, second, events[1].EventID)
+		assert.Equal(t, m_outbox.StatusProcessing, events[0].Status)
+	})
+
+	t.Run("leased events are not claimed again", func(t *testing.T) {
+		events, err := repository.ClaimPending(ctx, 10, now, lease)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("expired leases are reclaimed", func(t *testing.T) {
+		events, err := repository.ClaimPending(ctx, 10, lease.Add(time.Second), lease.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+	})
+}
+
+func TestRelayRepository_StatusTransitions(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repository := outboxrepo.NewRelayRepo(client)
+	now := time.Now().UTC()
+
+	t.Run("retry returns event to pending until next attempt", func(t *testing.T) {
+		eventID := testutil.CreateTestOutboxEvent(t, client, "product.created", "p-retry")
+		_, err := repository.ClaimPending(ctx, 10, now, now.Add(time.Minute))
+		require.NoError(t, err)
+
+		require.NoError(t, repository.ScheduleRetry(ctx, eventID, 1, "boom", now.Add(time.Hour)))
+
+		events, err := repository.ClaimPending(ctx, 10, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, events, "event should wait for its backoff")
+
+		events, err = repository.ClaimPending(ctx, 10, now.Add(2*time.Hour), now.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, int64(1), events[0].RetryCount)
+		assert.Equal(t, "boom", events[0].ErrorMessage.StringVal)
+
+		require.NoError(t, repository.MarkCompleted(ctx, eventID, now))
+	})
+
+	t.Run("failed events are not claimed", func(t *testing.T) {
+		eventID := testutil.CreateTestOutboxEvent(t, client, "product.created", "p-failed")
+		require.NoError(t, repository.MarkFailed(ctx, eventID, 10, "gave up", now))
+
+		events, err := repository.ClaimPending(ctx, 10, now.Add(24*time.Hour), now.Add(25*time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func TestRelayRepository_BlockedAggregates(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repository := outboxrepo.NewRelayRepo(client)
+	now := time.Now().UTC()
+
+	head := testutil.CreateTestOutboxEvent(t, client, "product.created", "p-blocked")
+	testutil.CreateTestOutboxEvent(t, client, "product.activated", "p-blocked")
+	testutil.CreateTestOutboxEvent(t, client, "product.updated", "p-blocked")
+	require.NoError(t, repository.MarkFailed(ctx, head, 10, "gave up", now))
+
+	t.Run("later events are not claimed behind a failed event", func(t *testing.T) {
+		events, err := repository.ClaimPending(ctx, 10, now.Add(time.Hour), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("blocked aggregate is listed with its waiting events", func(t *testing.T) {
+		blocked, err := repository.ListBlockedAggregates(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, blocked, 1)
+		assert.Equal(t, "p-blocked", blocked[0].AggregateID)
+		assert.Equal(t, head, blocked[0].FailedEventID)
+		assert.Equal(t, int64(2), blocked[0].Waiting)
+	})
+}
@@ -0,0 +1,117 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	outboxrepo "github.com/light-bringer/procat-service/internal/app/outbox/repo"
+	"github.com/light-bringer/procat-service/internal/app/outbox/usecases/replay_events"
+	"github.com/light-bringer/procat-service/internal/models/m_outbox"
+	"github.com/light-bringer/procat-service/tests/testutil"
+)
+
+// capturePublisher records published messages.
+type capturePublisher struct {
+	messages []*contracts.Message
+}
+
+func (p *capturePublisher) Publish(ctx context.Context, msg *contracts.Message) error {
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *capturePublisher) Close() error { return nil }
+
+func TestReplayRepository(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	relayRepo := outboxrepo.NewRelayRepo(client)
+	repository := outboxrepo.NewReplayRepo(client)
+	now := time.Now().UTC().Truncate(time.Microsecond) // Spanner timestamp precision
+
+	complete := func(eventType, aggregateID string) string {
+		eventID := testutil.CreateTestOutboxEvent(t, client, eventType, aggregateID)
+		require.NoError(t, relayRepo.MarkCompleted(ctx, eventID, now))
+		return eventID
+	}
+
+	first := complete("product.created", "p1")
+	second := complete("product.activated", "p1")
+	third := complete("product.created", "p2")
+	testutil.CreateTestOutboxEvent(t, client, "product.archived", "p1") // pending, never replayed
+
+	t.Run("lists completed events in commit order across pages", func(t *testing.T) {
+		filter := &contracts.ReplayFilter{}
+
+		count, err := repository.CountCompleted(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		page, err := repository.ListCompleted(ctx, filter, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, first, page[0].EventID)
+		assert.Equal(t, second, page[1].EventID)
+
+		last := page[1]
+		page, err = repository.ListCompleted(ctx, filter, &contracts.ReplayCursor{
+			CreatedAt:      last.CreatedAt,
+			SequenceNumber: last.SequenceNumber.Int64,
+			EventID:        last.EventID,
+		}, 2)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, third, page[0].EventID)
+	})
+
+	t.Run("filters by type, aggregate and time range", func(t *testing.T) {
+		events, err := repository.ListCompleted(ctx, &contracts.ReplayFilter{EventType: "product.created"}, nil, 10)
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		events, err = repository.ListCompleted(ctx, &contracts.ReplayFilter{AggregateID: "p1"}, nil, 10)
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		future := now.Add(time.Hour)
+		count, err := repository.CountCompleted(ctx, &contracts.ReplayFilter{CreatedAfter: &future})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("replay leaves original rows unchanged", func(t *testing.T) {
+		pub := &capturePublisher{}
+		interactor := replay_events.NewInteractor(repository, map[string]contracts.Publisher{"capture": pub})
+
+		resp, err := interactor.Execute(ctx, &replay_events.Request{AggregateID: "p1", Publisher: "capture"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.ReplayedCount)
+
+		require.Len(t, pub.messages, 2)
+		assert.Equal(t, first, pub.messages[0].OriginalEventID)
+		assert.True(t, pub.messages[0].Replayed)
+		assert.NotEqual(t, first, pub.messages[0].EventID)
+
+		row, err := client.Single().ReadRow(ctx, m_outbox.TableName, spanner.Key{first}, []string{m_outbox.Status, m_outbox.ProcessedAt})
+		require.NoError(t, err)
+		var status string
+		var processedAt spanner.NullTime
+		require.NoError(t, row.Columns(&status, &processedAt))
+		assert.Equal(t, m_outbox.StatusCompleted, status)
+		assert.True(t, processedAt.Time.Equal(now))
+
+		count, err := repository.CountCompleted(ctx, &contracts.ReplayFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count, "replay must not add outbox rows")
+	})
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cloud.google.com/go/spanner"
+	outboxcontracts "github.com/light-bringer/procat-service/internal/app/outbox/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/contracts"
+	"github.com/light-bringer/procat-service/internal/app/webhook/domain"
+	webhookrepo "github.com/light-bringer/procat-service/internal/app/webhook/repo"
+	"github.com/light-bringer/procat-service/internal/models/m_webhook_delivery"
+	"github.com/light-bringer/procat-service/tests/testutil"
+)
+
+func createTestSubscription(t *testing.T, client *spanner.Client, eventTypes ...string) *domain.Subscription {
+	t.Helper()
+
+	sub, err := domain.NewSubscription(uuid.New().String(), "https://partner.example.com/hook", eventTypes, "", time.Now())
+	require.NoError(t, err)
+
+	_, err = client.Apply(context.Background(), []*spanner.Mutation{webhookrepo.NewSubscriptionRepo(client).InsertMut(sub)})
+	require.NoError(t, err, "failed to create test subscription")
+
+	return sub
+}
+
+func TestSubscriptionRepository(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repository := webhookrepo.NewSubscriptionRepo(client)
+
+	active := createTestSubscription(t, client, "product.created")
+	paused := createTestSubscription(t, client)
+
+	t.Run("get by id round-trips", func(t *testing.T) {
+		sub, err := repository.GetByID(ctx, active.ID())
+		require.NoError(t, err)
+		assert.Equal(t, active.URL(), sub.URL())
+		assert.Equal(t, []string{"product.created"}, sub.EventTypes())
+		assert.Equal(t, active.Secret(), sub.Secret())
+		assert.True(t, sub.Active())
+	})
+
+	t.Run("missing subscription", func(t *testing.T) {
+		_, err := repository.GetByID(ctx, "does-not-exist")
+		assert.ErrorIs(t, err, domain.ErrSubscriptionNotFound)
+	})
+
+	t.Run("list active skips paused subscriptions", func(t *testing.T) {
+		paused.SetActive(false)
+		_, err := client.Apply(ctx, []*spanner.Mutation{repository.UpdateMut(paused)})
+		require.NoError(t, err)
+
+		subs, err := repository.ListActive(ctx)
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, active.ID(), subs[0].ID())
+	})
+}
+
+func TestDeliveryRepository(t *testing.T) {
+	client, cleanup := testutil.SetupSpannerTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repository := webhookrepo.NewDeliveryRepo(client)
+	readModel := webhookrepo.NewReadModel(client)
+
+	sub := createTestSubscription(t, client)
+	eventID := testutil.CreateTestOutboxEvent(t, client, "product.created", "p1")
+	msg := &outboxcontracts.Message{EventID: eventID, EventType: "product.created", AggregateID: "p1"}
+	now := time.Now().UTC()
+
+	t.Run("enqueue is idempotent", func(t *testing.T) {
+		require.NoError(t, repository.Enqueue(ctx, msg, []string{sub.ID()}, now))
+		require.NoError(t, repository.Enqueue(ctx, msg, []string{sub.ID()}, now))
+
+		deliveries, err := readModel.ListDeliveries(ctx, &contracts.DeliveryFilter{SubscriptionID: sub.ID(), Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, m_webhook_delivery.StatusPending, deliveries[0].Status)
+	})
+
+	t.Run("claim joins subscription and event and leases", func(t *testing.T) {
+		claimed, err := repository.ClaimDue(ctx, 10, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, sub.URL(), claimed[0].URL)
+		assert.Equal(t, sub.Secret(), claimed[0].Secret)
+		assert.Equal(t, eventID, claimed[0].Message.EventID)
+		assert.Equal(t, "p1", claimed[0].Message.AggregateID)
+
+		again, err := repository.ClaimDue(ctx, 10, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, again, "leased deliveries are not claimed again")
+	})
+
+	t.Run("attempts are logged", func(t *testing.T) {
+		claimed, err := repository.ClaimDue(ctx, 10, now.Add(2*time.Minute), now.Add(3*time.Minute))
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+
+		require.NoError(t, repository.ScheduleRetry(ctx, claimed[0], &contracts.AttemptResult{
+			AttemptNumber: 1, AttemptedAt: now, StatusCode: 503, Error: "endpoint returned 503", Duration: 20 * time.Millisecond,
+		}, now.Add(time.Hour)))
+		require.NoError(t, repository.MarkDelivered(ctx, claimed[0], &contracts.AttemptResult{
+			AttemptNumber: 2, AttemptedAt: now.Add(time.Hour), StatusCode: 200,
+		}))
+
+		deliveries, err := readModel.ListDeliveries(ctx, &contracts.DeliveryFilter{SubscriptionID: sub.ID(), Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, m_webhook_delivery.StatusDelivered, deliveries[0].Status)
+		assert.Equal(t, int64(2), deliveries[0].AttemptCount)
+		require.Len(t, deliveries[0].Attempts, 2)
+		assert.Equal(t, int64(503), deliveries[0].Attempts[0].StatusCode)
+		assert.Equal(t, "endpoint returned 503", deliveries[0].Attempts[0].Error)
+		assert.Equal(t, int64(200), deliveries[0].Attempts[1].StatusCode)
+	})
+}
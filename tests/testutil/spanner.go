@@ -47,8 +47,18 @@ func CleanDatabase(t *testing.T, client *spanner.Client) {
 
 	// Delete all data from tables (order matters due to potential foreign keys)
 	mutations := []*spanner.Mutation{
+		spanner.Delete("webhook_delivery_attempts", spanner.AllKeys()),
+		spanner.Delete("webhook_deliveries", spanner.AllKeys()),
+		spanner.Delete("webhook_subscriptions", spanner.AllKeys()),
+		spanner.Delete("outbox_event_attempts", spanner.AllKeys()),
 		spanner.Delete("outbox_events", spanner.AllKeys()),
 		spanner.Delete("price_history", spanner.AllKeys()),
+		spanner.Delete("price_lists", spanner.AllKeys()),
+		spanner.Delete("discounts", spanner.AllKeys()),
+		spanner.Delete("discount_history", spanner.AllKeys()),
+		spanner.Delete("scheduled_price_changes", spanner.AllKeys()),
+		spanner.Delete("price_change_requests", spanner.AllKeys()),
+		spanner.Delete("tax_rates", spanner.AllKeys()),
 		spanner.Delete("products", spanner.AllKeys()),
 	}
 